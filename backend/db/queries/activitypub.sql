@@ -0,0 +1,77 @@
+-- name: GetActivityPubKey :one
+SELECT *
+FROM activitypub_keys
+WHERE actor = $1
+LIMIT 1;
+
+-- name: InsertActivityPubKey :one
+-- Keeps the existing key when another process generated one first
+INSERT INTO activitypub_keys (
+  actor, public_key_pem, private_key_pem
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (actor) DO UPDATE SET actor = EXCLUDED.actor
+RETURNING *;
+
+-- name: UpsertActivityPubFollower :one
+INSERT INTO activitypub_followers (
+  actor, follower_uri, inbox_url, shared_inbox_url
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (actor, follower_uri) DO UPDATE SET
+  inbox_url = EXCLUDED.inbox_url,
+  shared_inbox_url = EXCLUDED.shared_inbox_url
+RETURNING *;
+
+-- name: DeleteActivityPubFollower :execrows
+DELETE FROM activitypub_followers
+WHERE actor = $1 AND follower_uri = $2;
+
+-- name: CountActivityPubFollowers :one
+SELECT COUNT(*)
+FROM activitypub_followers
+WHERE actor = $1;
+
+-- name: ListActivityPubFollowerInboxes :many
+SELECT DISTINCT COALESCE(NULLIF(shared_inbox_url, ''), inbox_url)::text AS inbox
+FROM activitypub_followers
+WHERE actor = $1
+ORDER BY inbox;
+
+-- name: InsertActivityPubDelivery :one
+INSERT INTO activitypub_deliveries (
+  actor, inbox_url, activity_id, payload, next_attempt_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetActivityPubDeliveryByID :one
+SELECT *
+FROM activitypub_deliveries
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListDueActivityPubDeliveries :many
+SELECT *
+FROM activitypub_deliveries
+WHERE status = 'pending' AND next_attempt_at <= $1
+ORDER BY next_attempt_at ASC
+LIMIT $2;
+
+-- name: UpdateActivityPubDeliveryAttempt :one
+UPDATE activitypub_deliveries
+SET
+  status = $2,
+  attempts = $3,
+  last_error = $4,
+  next_attempt_at = $5,
+  delivered_at = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteActivityPubDeliveriesBefore :exec
+DELETE FROM activitypub_deliveries
+WHERE status <> 'pending' AND created_at < $1;
@@ -18,6 +18,68 @@ WHERE status = 'published'
 ORDER BY COALESCE(published_at, created_at) DESC, created_at DESC
 LIMIT $1 OFFSET $2;
 
+-- name: ListPublishedPostsBetween :many
+SELECT *
+FROM blog_posts
+WHERE status = 'published'
+  AND published_at >= @published_after::timestamptz
+  AND published_at < @published_before::timestamptz
+ORDER BY published_at DESC, created_at DESC
+LIMIT @max_rows OFFSET @skip_rows;
+
+-- name: ListPostsForListing :many
+-- Posts pinned at now() first, then by @sort_by: published_at (newest first, drafts by
+-- creation date), updated_at (newest first), title or manual (ascending sort_weight, then
+-- newest first). A NULL filter matches every post.
+SELECT p.*
+FROM blog_posts p
+WHERE (sqlc.narg('status')::text IS NULL OR p.status::text = sqlc.narg('status')::text)
+  AND (sqlc.narg('author_id')::uuid IS NULL OR p.author_id = sqlc.narg('author_id')::uuid)
+  AND (sqlc.narg('category')::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+    WHERE pc.post_id = p.id AND c.slug = sqlc.narg('category')::text))
+  AND (sqlc.narg('tag')::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+    WHERE pt.post_id = p.id AND t.slug = sqlc.narg('tag')::text))
+  AND (sqlc.narg('published_after')::timestamptz IS NULL OR p.published_at >= sqlc.narg('published_after')::timestamptz)
+  AND (sqlc.narg('published_before')::timestamptz IS NULL OR p.published_at < sqlc.narg('published_before')::timestamptz)
+ORDER BY
+  (p.pinned AND (p.pinned_until IS NULL OR p.pinned_until > now())) DESC,
+  CASE WHEN @sort_by::text = 'updated_at' THEN p.updated_at END DESC,
+  CASE WHEN @sort_by::text = 'title' THEN lower(p.title) END ASC,
+  CASE WHEN @sort_by::text = 'manual' THEN p.sort_weight END ASC,
+  COALESCE(p.published_at, p.created_at) DESC,
+  p.created_at DESC
+LIMIT @max_rows OFFSET @skip_rows;
+
+-- name: CountPostsForListing :one
+SELECT COUNT(*)::int
+FROM blog_posts p
+WHERE (sqlc.narg('status')::text IS NULL OR p.status::text = sqlc.narg('status')::text)
+  AND (sqlc.narg('author_id')::uuid IS NULL OR p.author_id = sqlc.narg('author_id')::uuid)
+  AND (sqlc.narg('category')::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+    WHERE pc.post_id = p.id AND c.slug = sqlc.narg('category')::text))
+  AND (sqlc.narg('tag')::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+    WHERE pt.post_id = p.id AND t.slug = sqlc.narg('tag')::text))
+  AND (sqlc.narg('published_after')::timestamptz IS NULL OR p.published_at >= sqlc.narg('published_after')::timestamptz)
+  AND (sqlc.narg('published_before')::timestamptz IS NULL OR p.published_at < sqlc.narg('published_before')::timestamptz);
+
+-- name: SetPostOrdering :exec
+UPDATE blog_posts
+SET pinned = @pinned, pinned_until = @pinned_until, sort_weight = @sort_weight
+WHERE id = @id;
+
+-- name: GetPostArchive :many
+SELECT EXTRACT(YEAR FROM published_at AT TIME ZONE 'UTC')::int AS year,
+       EXTRACT(MONTH FROM published_at AT TIME ZONE 'UTC')::int AS month,
+       COUNT(*)::int AS post_count
+FROM blog_posts
+WHERE status = 'published' AND published_at <= now()
+GROUP BY year, month
+ORDER BY year DESC, month DESC;
+
 -- name: ListPostsByAuthor :many
 SELECT *
 FROM blog_posts
@@ -143,4 +205,31 @@ WHERE post_id = $1 AND tag_id = $2;
 SELECT *
 FROM blog_posts
 ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
\ No newline at end of file
+LIMIT $1 OFFSET $2;
+
+-- name: ListRelatedPostCandidates :many
+-- Published posts sharing a category or tag with the post, or with a similar title
+-- (pg_trgm %, served by blog_posts_title_trgm_idx), newest first
+SELECT p.*,
+  (SELECT COUNT(*) FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+    WHERE pc.post_id = p.id AND c.name = ANY(@categories::text[]))::int AS shared_categories,
+  (SELECT COUNT(*) FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+    WHERE pt.post_id = p.id AND t.name = ANY(@tags::text[]))::int AS shared_tags,
+  similarity(p.title, @title::text)::float8 AS title_similarity
+FROM blog_posts p
+WHERE p.status = 'published'
+  AND p.slug <> @slug::text
+  AND (
+    p.title % @title::text
+    OR EXISTS (SELECT 1 FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+      WHERE pc.post_id = p.id AND c.name = ANY(@categories::text[]))
+    OR EXISTS (SELECT 1 FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id AND t.name = ANY(@tags::text[]))
+  )
+ORDER BY COALESCE(p.published_at, p.created_at) DESC
+LIMIT @max_candidates;
+
+-- name: ListPostsBySlugs :many
+SELECT *
+FROM blog_posts
+WHERE slug = ANY(@slugs::text[]);
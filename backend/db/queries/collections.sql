@@ -0,0 +1,69 @@
+-- name: GetCollectionByID :one
+SELECT *
+FROM collections
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetCollectionBySlug :one
+SELECT *
+FROM collections
+WHERE slug = $1
+LIMIT 1;
+
+-- name: InsertCollection :one
+INSERT INTO collections (
+  slug, title, description, kind
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: UpdateCollection :one
+UPDATE collections
+SET slug = $2,
+    title = $3,
+    description = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: TouchCollection :exec
+UPDATE collections
+SET updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteCollectionByID :exec
+DELETE FROM collections
+WHERE id = $1;
+
+-- name: ListCollections :many
+SELECT *
+FROM collections
+WHERE (sqlc.narg('kind')::text IS NULL OR kind = sqlc.narg('kind')::text)
+ORDER BY title ASC
+LIMIT $1 OFFSET $2;
+
+-- name: ListCollectionsByPost :many
+SELECT c.*
+FROM collections c
+JOIN collection_posts cp ON cp.collection_id = c.id
+WHERE cp.post_id = $1 AND c.kind = $2
+ORDER BY c.title ASC;
+
+-- name: ListCollectionPosts :many
+SELECT collection_id, post_id
+FROM collection_posts
+WHERE collection_id = ANY(@collection_ids::uuid[])
+ORDER BY collection_id, position ASC;
+
+-- name: DeleteCollectionPosts :exec
+DELETE FROM collection_posts
+WHERE collection_id = $1;
+
+-- name: InsertCollectionPosts :exec
+INSERT INTO collection_posts (collection_id, post_id, position)
+SELECT @collection_id, p.post_id, p.position
+FROM unnest(@post_ids::text[]) WITH ORDINALITY AS p(post_id, position);
+
+-- name: DeletePostFromCollections :exec
+DELETE FROM collection_posts
+WHERE post_id = $1;
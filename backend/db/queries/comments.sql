@@ -0,0 +1,48 @@
+-- name: GetCommentByID :one
+SELECT *
+FROM comments
+WHERE id = $1
+LIMIT 1;
+
+-- name: InsertComment :one
+INSERT INTO comments (
+  post_id, parent_id, author_user_id, author_name, author_email, author_url, body, status, ip_address, user_agent
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+RETURNING *;
+
+-- name: ListCommentsByPostAndStatus :many
+SELECT *
+FROM comments
+WHERE post_id = $1 AND status = $2
+ORDER BY created_at ASC;
+
+-- name: ListCommentsByStatus :many
+SELECT *
+FROM comments
+WHERE status = $1
+  AND (sqlc.narg('post_id')::text IS NULL OR post_id = sqlc.narg('post_id')::text)
+ORDER BY created_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CountCommentsByStatus :one
+SELECT COUNT(*)
+FROM comments
+WHERE status = $1
+  AND (sqlc.narg('post_id')::text IS NULL OR post_id = sqlc.narg('post_id')::text);
+
+-- name: UpdateCommentStatus :one
+UPDATE comments
+SET
+  status = $2,
+  moderated_by = $3,
+  moderated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CountApprovedCommentsByPosts :many
+SELECT post_id, COUNT(*) AS comment_count
+FROM comments
+WHERE status = 'approved' AND post_id = ANY(@post_ids::text[])
+GROUP BY post_id;
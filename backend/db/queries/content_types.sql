@@ -0,0 +1,81 @@
+-- name: InsertContentType :one
+INSERT INTO content_types (
+  slug, name, description, fields, created_by
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetContentTypeByID :one
+SELECT *
+FROM content_types
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetContentTypeBySlug :one
+SELECT *
+FROM content_types
+WHERE slug = $1
+LIMIT 1;
+
+-- name: ListContentTypes :many
+SELECT *
+FROM content_types
+ORDER BY name ASC, created_at ASC
+LIMIT $1 OFFSET $2;
+
+-- name: UpdateContentType :one
+UPDATE content_types
+SET
+  slug = $2,
+  name = $3,
+  description = $4,
+  fields = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteContentType :execrows
+DELETE FROM content_types
+WHERE id = $1;
+
+-- name: SyncEntryFieldIndexes :exec
+SELECT sync_entry_field_indexes(@content_type_id::uuid, @fields::text[]);
+
+-- name: InsertEntry :one
+INSERT INTO entries (
+  content_type_id, slug, status, data, published_at, created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetEntryByID :one
+SELECT *
+FROM entries
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetEntryBySlug :one
+SELECT *
+FROM entries
+WHERE content_type_id = $1 AND slug = $2
+LIMIT 1;
+
+-- name: UpdateEntry :one
+UPDATE entries
+SET
+  slug = $2,
+  status = $3,
+  data = $4,
+  published_at = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteEntry :execrows
+DELETE FROM entries
+WHERE id = $1;
+
+-- name: CountEntriesByContentType :one
+SELECT COUNT(*)
+FROM entries
+WHERE content_type_id = $1;
@@ -0,0 +1,68 @@
+-- name: InsertContentViewVisitor :execrows
+INSERT INTO content_view_visitors (content_id, day, visitor_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: UpsertContentViewDaily :exec
+INSERT INTO content_view_daily (content_id, day, content_type, path, views, unique_visitors)
+VALUES ($1, $2, $3, $4, 1, @new_visitor::bigint)
+ON CONFLICT (content_id, day)
+DO UPDATE SET views = content_view_daily.views + 1,
+              unique_visitors = content_view_daily.unique_visitors + EXCLUDED.unique_visitors,
+              path = EXCLUDED.path;
+
+-- name: UpsertContentViewReferrer :exec
+INSERT INTO content_view_referrers (content_id, day, referrer, views)
+VALUES ($1, $2, $3, 1)
+ON CONFLICT (content_id, day, referrer)
+DO UPDATE SET views = content_view_referrers.views + 1;
+
+-- name: ListContentViewDaily :many
+SELECT day,
+       SUM(views)::bigint AS views,
+       SUM(unique_visitors)::bigint AS unique_visitors
+FROM content_view_daily
+WHERE day BETWEEN @from_day AND @to_day
+  AND (sqlc.narg('content_id')::text IS NULL OR content_id = sqlc.narg('content_id')::text)
+GROUP BY day
+ORDER BY day ASC;
+
+-- name: ListContentViewReferrers :many
+SELECT referrer,
+       SUM(views)::bigint AS views
+FROM content_view_referrers
+WHERE day BETWEEN @from_day AND @to_day
+  AND (sqlc.narg('content_id')::text IS NULL OR content_id = sqlc.narg('content_id')::text)
+GROUP BY referrer
+ORDER BY views DESC, referrer ASC
+LIMIT @max_rows;
+
+-- name: ListPopularContent :many
+SELECT content_id,
+       content_type,
+       (ARRAY_AGG(path ORDER BY day DESC))[1]::text AS path,
+       SUM(views)::bigint AS views,
+       SUM(unique_visitors)::bigint AS unique_visitors
+FROM content_view_daily
+WHERE day BETWEEN @from_day AND @to_day
+  AND (sqlc.narg('content_type')::text IS NULL OR content_type = sqlc.narg('content_type')::text)
+GROUP BY content_id, content_type
+ORDER BY views DESC, content_id ASC
+LIMIT @max_rows;
+
+-- name: DeleteContentViewVisitorsBefore :exec
+DELETE FROM content_view_visitors
+WHERE day < $1;
+
+-- name: InsertContentViewSalt :execrows
+INSERT INTO content_view_salts (day, salt)
+VALUES ($1, $2)
+ON CONFLICT (day) DO NOTHING;
+
+-- name: GetContentViewSalt :one
+SELECT salt FROM content_view_salts
+WHERE day = $1;
+
+-- name: DeleteContentViewSaltsBefore :exec
+DELETE FROM content_view_salts
+WHERE day < $1;
@@ -0,0 +1,40 @@
+-- name: InsertLinkReport :one
+INSERT INTO link_reports (
+  trigger, pages_scanned, posts_scanned, links_checked, external_checked, started_at, finished_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: InsertLinkReportIssue :exec
+INSERT INTO link_report_issues (
+  report_id, content_type, content_id, content_slug, block_index, field, url, kind, status_code, detail
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+);
+
+-- name: GetLinkReportByID :one
+SELECT *
+FROM link_reports
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetLatestLinkReport :one
+SELECT *
+FROM link_reports
+ORDER BY started_at DESC
+LIMIT 1;
+
+-- name: ListLinkReportIssues :many
+SELECT *
+FROM link_report_issues
+WHERE report_id = $1
+ORDER BY id ASC;
+
+-- name: DeleteLinkReportsExceptLatest :exec
+DELETE FROM link_reports
+WHERE id NOT IN (
+  SELECT id FROM link_reports
+  ORDER BY started_at DESC
+  LIMIT @keep
+);
@@ -33,4 +33,9 @@ RETURNING *;
 SELECT *
 FROM media
 ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
\ No newline at end of file
+LIMIT $1 OFFSET $2;
+
+-- name: ListMediaByFilenames :many
+SELECT *
+FROM media
+WHERE filename = ANY(@filenames::text[]);
\ No newline at end of file
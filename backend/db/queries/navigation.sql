@@ -0,0 +1,39 @@
+-- name: InsertNavigationMenu :one
+INSERT INTO navigation_menus (
+  slug, name, description, items, created_by
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetNavigationMenuByID :one
+SELECT *
+FROM navigation_menus
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetNavigationMenuBySlug :one
+SELECT *
+FROM navigation_menus
+WHERE slug = $1
+LIMIT 1;
+
+-- name: ListNavigationMenus :many
+SELECT *
+FROM navigation_menus
+ORDER BY name ASC, created_at ASC
+LIMIT $1 OFFSET $2;
+
+-- name: UpdateNavigationMenu :one
+UPDATE navigation_menus
+SET
+  slug = $2,
+  name = $3,
+  description = $4,
+  items = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteNavigationMenu :execrows
+DELETE FROM navigation_menus
+WHERE id = $1;
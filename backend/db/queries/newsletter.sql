@@ -0,0 +1,68 @@
+-- name: InsertNewsletterSubscriber :one
+INSERT INTO newsletter_subscribers (
+  email, name, status, topics, ip_address, confirmation_sent_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetNewsletterSubscriberByID :one
+SELECT *
+FROM newsletter_subscribers
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetNewsletterSubscriberByEmail :one
+SELECT *
+FROM newsletter_subscribers
+WHERE email = $1
+LIMIT 1;
+
+-- name: UpdateNewsletterSubscriber :one
+UPDATE newsletter_subscribers
+SET
+  name = $2,
+  status = $3,
+  topics = $4,
+  soft_bounces = $5,
+  ip_address = $6,
+  confirmation_sent_at = $7,
+  confirmed_at = $8,
+  unsubscribed_at = $9
+WHERE id = $1
+RETURNING *;
+
+-- name: ListNewsletterSubscribers :many
+SELECT *
+FROM newsletter_subscribers
+WHERE (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+ORDER BY created_at DESC, id DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountNewsletterSubscribers :one
+SELECT COUNT(*)
+FROM newsletter_subscribers
+WHERE (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text);
+
+-- name: ListActiveNewsletterSubscribers :many
+-- Keyset pagination by ID so unsubscribes during a digest run do not shift pages
+SELECT *
+FROM newsletter_subscribers
+WHERE status = 'active'
+  AND (sqlc.narg('after_id')::uuid IS NULL OR id > sqlc.narg('after_id')::uuid)
+ORDER BY id ASC
+LIMIT $1;
+
+-- name: InsertNewsletterDigest :one
+INSERT INTO newsletter_digests (
+  period_start, period_end, post_ids, recipients, failures
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetLatestNewsletterDigest :one
+SELECT *
+FROM newsletter_digests
+ORDER BY period_end DESC
+LIMIT 1;
@@ -0,0 +1,61 @@
+-- name: GetPageTemplateByID :one
+SELECT *
+FROM page_templates
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetPageTemplateBySlug :one
+SELECT *
+FROM page_templates
+WHERE slug = $1
+LIMIT 1;
+
+-- name: ListPageTemplates :many
+SELECT *
+FROM page_templates
+WHERE (sqlc.narg('search')::text IS NULL OR name ILIKE '%' || sqlc.narg('search')::text || '%' OR slug ILIKE '%' || sqlc.narg('search')::text || '%')
+ORDER BY name ASC, created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: InsertPageTemplate :one
+WITH t AS (
+  INSERT INTO page_templates (
+    slug, name, description, content, meta
+  ) VALUES (
+    $1, $2, $3, $4, $5
+  )
+  RETURNING *
+), v AS (
+  INSERT INTO page_template_versions (template_id, version, content, meta)
+  SELECT t.id, t.version, t.content, t.meta FROM t
+)
+SELECT * FROM t;
+
+-- name: UpdatePageTemplate :one
+-- Every update bumps the version and snapshots the new content and meta.
+WITH t AS (
+  UPDATE page_templates
+  SET
+    slug = $2,
+    name = $3,
+    description = $4,
+    content = $5,
+    meta = $6,
+    version = version + 1
+  WHERE id = $1
+  RETURNING *
+), v AS (
+  INSERT INTO page_template_versions (template_id, version, content, meta)
+  SELECT t.id, t.version, t.content, t.meta FROM t
+)
+SELECT * FROM t;
+
+-- name: DeletePageTemplateByID :exec
+DELETE FROM page_templates
+WHERE id = $1;
+
+-- name: GetPageTemplateVersion :one
+SELECT *
+FROM page_template_versions
+WHERE template_id = $1 AND version = $2
+LIMIT 1;
@@ -0,0 +1,75 @@
+-- name: GetReusableBlockByID :one
+SELECT *
+FROM reusable_blocks
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetReusableBlockBySlug :one
+SELECT *
+FROM reusable_blocks
+WHERE slug = $1
+LIMIT 1;
+
+-- name: ListReusableBlocksByIDs :many
+SELECT *
+FROM reusable_blocks
+WHERE id = ANY(@ids::uuid[]);
+
+-- name: ListReusableBlocks :many
+SELECT *
+FROM reusable_blocks
+WHERE (sqlc.narg('search')::text IS NULL OR name ILIKE '%' || sqlc.narg('search')::text || '%' OR slug ILIKE '%' || sqlc.narg('search')::text || '%')
+ORDER BY name ASC, created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: InsertReusableBlock :one
+INSERT INTO reusable_blocks (
+  slug, name, description, content
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: UpdateReusableBlock :one
+UPDATE reusable_blocks
+SET
+  slug = $2,
+  name = $3,
+  description = $4,
+  content = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteReusableBlockByID :exec
+DELETE FROM reusable_blocks
+WHERE id = $1;
+
+-- name: ListReusableBlockUsages :many
+SELECT *
+FROM reusable_block_usages
+WHERE block_id = $1
+ORDER BY content_type ASC, content_slug ASC;
+
+-- name: CountReusableBlockUsages :one
+SELECT COUNT(*)
+FROM reusable_block_usages
+WHERE block_id = $1;
+
+-- name: CountReusableBlockUsagesByBlock :many
+SELECT block_id, COUNT(*)::int AS usage_count
+FROM reusable_block_usages
+WHERE block_id = ANY(@block_ids::uuid[])
+GROUP BY block_id;
+
+-- name: InsertReusableBlockUsage :exec
+INSERT INTO reusable_block_usages (
+  block_id, content_type, content_id, content_slug, content_title
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (block_id, content_type, content_id)
+DO UPDATE SET content_slug = EXCLUDED.content_slug, content_title = EXCLUDED.content_title;
+
+-- name: DeleteReusableBlockUsagesForContent :exec
+DELETE FROM reusable_block_usages
+WHERE content_type = $1 AND content_id = $2;
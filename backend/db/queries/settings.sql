@@ -0,0 +1,41 @@
+-- name: ListSiteSettings :many
+SELECT *
+FROM site_settings
+ORDER BY key ASC;
+
+-- name: SaveSiteSettings :exec
+-- Upserts every key and records one audit row per key in the same statement
+WITH input AS (
+  SELECT k.key, v.value
+  FROM unnest(@keys::text[]) WITH ORDINALITY AS k(key, position)
+  JOIN unnest(@setting_values::jsonb[]) WITH ORDINALITY AS v(value, position) USING (position)
+),
+previous AS (
+  SELECT s.key, s.value
+  FROM site_settings s
+  JOIN input i ON i.key = s.key
+),
+saved AS (
+  INSERT INTO site_settings (key, value, updated_by, updated_at)
+  SELECT i.key, i.value, sqlc.narg('changed_by')::text, NOW()
+  FROM input i
+  ON CONFLICT (key) DO UPDATE
+  SET value = EXCLUDED.value, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+  RETURNING key
+)
+INSERT INTO site_setting_changes (key, old_value, new_value, changed_by)
+SELECT i.key, p.value, i.value, sqlc.narg('changed_by')::text
+FROM input i
+LEFT JOIN previous p ON p.key = i.key;
+
+-- name: ListSiteSettingChanges :many
+SELECT *
+FROM site_setting_changes
+WHERE (sqlc.narg('key')::text IS NULL OR key = sqlc.narg('key')::text)
+ORDER BY created_at DESC, id DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountSiteSettingChanges :one
+SELECT COUNT(*)
+FROM site_setting_changes
+WHERE (sqlc.narg('key')::text IS NULL OR key = sqlc.narg('key')::text);
@@ -16,4 +16,9 @@ INSERT INTO users (
 ) VALUES (
   $1, $2, $3, $4
 )
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: ListUsersByIDs :many
+SELECT *
+FROM users
+WHERE id = ANY(@ids::uuid[]);
\ No newline at end of file
@@ -0,0 +1,91 @@
+-- name: InsertWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+  name, url, secret, event_types, active, created_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT *
+FROM webhook_subscriptions
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT *
+FROM webhook_subscriptions
+ORDER BY created_at ASC
+LIMIT $1 OFFSET $2;
+
+-- name: ListActiveWebhookSubscriptionsForEvent :many
+SELECT *
+FROM webhook_subscriptions
+WHERE active AND @event_type::text = ANY(event_types)
+ORDER BY created_at ASC;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET
+  name = $2,
+  url = $3,
+  secret = $4,
+  event_types = $5,
+  active = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: InsertWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+  subscription_id, event_id, event_type, payload, next_attempt_at
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT *
+FROM webhook_deliveries
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE subscription_id = $1
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*)
+FROM webhook_deliveries
+WHERE subscription_id = $1
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text);
+
+-- name: ListDueWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE status = 'pending' AND next_attempt_at <= $1
+ORDER BY next_attempt_at ASC
+LIMIT $2;
+
+-- name: UpdateWebhookDeliveryAttempt :one
+UPDATE webhook_deliveries
+SET
+  status = $2,
+  attempts = $3,
+  response_status = $4,
+  last_error = $5,
+  next_attempt_at = $6,
+  delivered_at = $7
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookDeliveriesBefore :exec
+DELETE FROM webhook_deliveries
+WHERE status <> 'pending' AND created_at < $1;
@@ -0,0 +1,59 @@
+-- name: UpsertWebmention :one
+-- A mention that is sent again is verified again; mentions filed as spam stay spam
+INSERT INTO webmentions (
+  post_id, source, target, ip_address
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (source, target) DO UPDATE
+SET
+  post_id = EXCLUDED.post_id,
+  ip_address = EXCLUDED.ip_address,
+  status = CASE WHEN webmentions.status = 'spam' THEN 'spam' ELSE 'pending' END
+RETURNING *;
+
+-- name: GetWebmentionByID :one
+SELECT *
+FROM webmentions
+WHERE id = $1
+LIMIT 1;
+
+-- name: UpdateWebmentionVerification :one
+UPDATE webmentions
+SET
+  status = $2,
+  title = $3,
+  author_name = $4,
+  last_error = $5,
+  verified_at = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: ListWebmentionsByPostAndStatus :many
+SELECT *
+FROM webmentions
+WHERE post_id = $1 AND status = $2
+ORDER BY created_at ASC;
+
+-- name: ListWebmentionsByStatus :many
+SELECT *
+FROM webmentions
+WHERE status = $1
+  AND (sqlc.narg('post_id')::text IS NULL OR post_id = sqlc.narg('post_id')::text)
+ORDER BY created_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CountWebmentionsByStatus :one
+SELECT COUNT(*)
+FROM webmentions
+WHERE status = $1
+  AND (sqlc.narg('post_id')::text IS NULL OR post_id = sqlc.narg('post_id')::text);
+
+-- name: UpdateWebmentionStatus :one
+UPDATE webmentions
+SET
+  status = $2,
+  moderated_by = $3,
+  moderated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -92,7 +92,12 @@ CREATE TABLE IF NOT EXISTS blog_posts (
   published_at TIMESTAMPTZ,
   created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
   updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-  search_tsv tsvector
+  search_tsv tsvector,
+  -- Pinned posts are listed first until pinned_until, if set; sort_weight orders the
+  -- manual sort, lower weights first
+  pinned BOOLEAN NOT NULL DEFAULT FALSE,
+  pinned_until TIMESTAMPTZ,
+  sort_weight INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS blog_posts_slug_unique ON blog_posts (slug);
@@ -302,4 +307,502 @@ BEGIN
     BEFORE INSERT OR UPDATE OF subject, message, email, name ON contact_submissions
     FOR EACH ROW EXECUTE FUNCTION contacts_update_tsv();
   END IF;
-END$$;
\ No newline at end of file
+END$$;
+
+-- reusable_blocks
+CREATE TABLE IF NOT EXISTS reusable_blocks (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT,
+  content TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS reusable_blocks_slug_unique ON reusable_blocks (slug);
+CREATE INDEX IF NOT EXISTS reusable_blocks_name_trgm_idx ON reusable_blocks USING GIN (name gin_trgm_ops);
+
+-- reusable_block_usages: pages/posts embedding a reusable block, maintained on content save
+CREATE TABLE IF NOT EXISTS reusable_block_usages (
+  block_id UUID NOT NULL REFERENCES reusable_blocks(id) ON DELETE CASCADE,
+  content_type TEXT NOT NULL,
+  content_id TEXT NOT NULL,
+  content_slug TEXT NOT NULL,
+  content_title TEXT NOT NULL DEFAULT '',
+  PRIMARY KEY (block_id, content_type, content_id)
+);
+CREATE INDEX IF NOT EXISTS reusable_block_usages_content_idx ON reusable_block_usages (content_type, content_id);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_reusable_blocks'
+  ) THEN
+    CREATE TRIGGER set_updated_at_reusable_blocks BEFORE UPDATE ON reusable_blocks
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- page_templates: current version of each template
+CREATE TABLE IF NOT EXISTS page_templates (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT,
+  version INTEGER NOT NULL DEFAULT 1,
+  content TEXT NOT NULL,
+  meta TEXT NOT NULL DEFAULT '{}',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS page_templates_slug_unique ON page_templates (slug);
+
+-- page_template_versions: immutable snapshot of every template version
+CREATE TABLE IF NOT EXISTS page_template_versions (
+  template_id UUID NOT NULL REFERENCES page_templates(id) ON DELETE CASCADE,
+  version INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  meta TEXT NOT NULL DEFAULT '{}',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (template_id, version)
+);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_page_templates'
+  ) THEN
+    CREATE TRIGGER set_updated_at_page_templates BEFORE UPDATE ON page_templates
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- comments: post_id is the blog post document ID ("blog:{slug}")
+CREATE TABLE IF NOT EXISTS comments (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  post_id TEXT NOT NULL,
+  parent_id UUID REFERENCES comments(id) ON DELETE SET NULL,
+  author_user_id TEXT,
+  author_name TEXT NOT NULL,
+  author_email TEXT NOT NULL,
+  author_url TEXT,
+  body TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'spam', 'deleted')),
+  ip_address TEXT,
+  user_agent TEXT,
+  moderated_by TEXT,
+  moderated_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS comments_post_status_created_idx ON comments (post_id, status, created_at);
+CREATE INDEX IF NOT EXISTS comments_status_created_idx ON comments (status, created_at);
+CREATE INDEX IF NOT EXISTS comments_parent_idx ON comments (parent_id);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_comments'
+  ) THEN
+    CREATE TRIGGER set_updated_at_comments BEFORE UPDATE ON comments
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- collections: kind 'series' powers prev/next navigation on posts, 'curated' holds hand-picked lists
+CREATE TABLE IF NOT EXISTS collections (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL UNIQUE,
+  title TEXT NOT NULL,
+  description TEXT,
+  kind TEXT NOT NULL CHECK (kind IN ('series', 'curated')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS collections_kind_title_idx ON collections (kind, title);
+
+-- collection_posts: post_id is the blog post document ID ("blog:{slug}"); position is 1-based
+CREATE TABLE IF NOT EXISTS collection_posts (
+  collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  post_id TEXT NOT NULL,
+  position INTEGER NOT NULL,
+  PRIMARY KEY (collection_id, post_id)
+);
+CREATE INDEX IF NOT EXISTS collection_posts_order_idx ON collection_posts (collection_id, position);
+CREATE INDEX IF NOT EXISTS collection_posts_post_idx ON collection_posts (post_id);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_collections'
+  ) THEN
+    CREATE TRIGGER set_updated_at_collections BEFORE UPDATE ON collections
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- content_view_daily: one row per content item and UTC day
+CREATE TABLE IF NOT EXISTS content_view_daily (
+  content_id TEXT NOT NULL,
+  day DATE NOT NULL,
+  content_type TEXT NOT NULL CHECK (content_type IN ('page', 'post')),
+  path TEXT NOT NULL DEFAULT '',
+  views BIGINT NOT NULL DEFAULT 0,
+  unique_visitors BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (content_id, day)
+);
+CREATE INDEX IF NOT EXISTS content_view_daily_day_idx ON content_view_daily (day, content_type);
+
+-- content_view_referrers: referrer host breakdown per content item and day ('' = direct)
+CREATE TABLE IF NOT EXISTS content_view_referrers (
+  content_id TEXT NOT NULL,
+  day DATE NOT NULL,
+  referrer TEXT NOT NULL,
+  views BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (content_id, day, referrer)
+);
+CREATE INDEX IF NOT EXISTS content_view_referrers_day_idx ON content_view_referrers (day);
+
+-- content_view_visitors: salted visitor hashes used only to count unique visitors for the
+-- current day. Each day has its own random salt, so hashes cannot be linked across days;
+-- rows from previous days are purged together with their salts.
+CREATE TABLE IF NOT EXISTS content_view_visitors (
+  content_id TEXT NOT NULL,
+  day DATE NOT NULL,
+  visitor_hash TEXT NOT NULL,
+  PRIMARY KEY (content_id, day, visitor_hash)
+);
+CREATE INDEX IF NOT EXISTS content_view_visitors_day_idx ON content_view_visitors (day);
+
+-- content_view_salts: the visitor hash salt of the current day, shared by all instances
+CREATE TABLE IF NOT EXISTS content_view_salts (
+  day DATE PRIMARY KEY,
+  salt BYTEA NOT NULL
+);
+
+-- link_reports: one row per completed scan; trigger is 'scheduled' or 'manual'
+CREATE TABLE IF NOT EXISTS link_reports (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  trigger TEXT NOT NULL CHECK (trigger IN ('scheduled', 'manual')),
+  pages_scanned INTEGER NOT NULL DEFAULT 0,
+  posts_scanned INTEGER NOT NULL DEFAULT 0,
+  links_checked INTEGER NOT NULL DEFAULT 0,
+  external_checked BOOLEAN NOT NULL DEFAULT FALSE,
+  started_at TIMESTAMPTZ NOT NULL,
+  finished_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS link_reports_started_idx ON link_reports (started_at DESC);
+
+-- link_report_issues: content_id is the page or post document ID; block_index is -1 for
+-- fields outside the content blocks such as a post's featured image
+CREATE TABLE IF NOT EXISTS link_report_issues (
+  id BIGSERIAL PRIMARY KEY,
+  report_id UUID NOT NULL REFERENCES link_reports(id) ON DELETE CASCADE,
+  content_type TEXT NOT NULL CHECK (content_type IN ('page', 'blog_post')),
+  content_id TEXT NOT NULL,
+  content_slug TEXT NOT NULL,
+  block_index INTEGER NOT NULL,
+  field TEXT NOT NULL,
+  url TEXT NOT NULL,
+  kind TEXT NOT NULL CHECK (kind IN ('missing_page', 'missing_post', 'missing_media', 'broken_external')),
+  status_code INTEGER NOT NULL DEFAULT 0,
+  detail TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS link_report_issues_report_idx ON link_report_issues (report_id, id);
+
+-- webhook_subscriptions: event_types holds event names such as 'post.published'; secret signs payloads
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL,
+  url TEXT NOT NULL,
+  secret TEXT NOT NULL,
+  event_types TEXT[] NOT NULL,
+  active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS webhook_subscriptions_event_types_idx ON webhook_subscriptions USING GIN (event_types);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_webhook_subscriptions'
+  ) THEN
+    CREATE TRIGGER set_updated_at_webhook_subscriptions BEFORE UPDATE ON webhook_subscriptions
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- webhook_deliveries: one row per event and subscription; payload is stored verbatim so
+-- redeliveries send the exact bytes that were signed. next_attempt_at is NULL once settled.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+  event_id UUID NOT NULL,
+  event_type TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+  attempts INTEGER NOT NULL DEFAULT 0,
+  response_status INTEGER NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  next_attempt_at TIMESTAMPTZ,
+  delivered_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_subscription_created_idx ON webhook_deliveries (subscription_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- content_types: fields is a JSON array of field definitions (key, type, validation, filterable)
+CREATE TABLE IF NOT EXISTS content_types (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT,
+  fields JSONB NOT NULL DEFAULT '[]',
+  created_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS content_types_slug_unique ON content_types (slug);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_content_types'
+  ) THEN
+    CREATE TRIGGER set_updated_at_content_types BEFORE UPDATE ON content_types
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- entries: data holds the field values of an entry keyed by field key
+CREATE TABLE IF NOT EXISTS entries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  content_type_id UUID NOT NULL REFERENCES content_types(id) ON DELETE CASCADE,
+  slug TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published', 'archived')),
+  data JSONB NOT NULL DEFAULT '{}',
+  published_at TIMESTAMPTZ,
+  created_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS entries_type_slug_unique ON entries (content_type_id, slug);
+CREATE INDEX IF NOT EXISTS entries_type_status_created_idx ON entries (content_type_id, status, created_at DESC);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_entries'
+  ) THEN
+    CREATE TRIGGER set_updated_at_entries BEFORE UPDATE ON entries
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- sync_entry_field_indexes keeps one expression index per filterable field of a content type,
+-- scoped to its entries, and drops the indexes of fields that are no longer filterable.
+-- ListEntries filters a field with (data -> 'field') @> value, which these GIN indexes serve.
+-- Index names are entry_field_<type hash>_<field hash> to stay within the identifier limit.
+CREATE OR REPLACE FUNCTION sync_entry_field_indexes(p_content_type_id UUID, p_fields TEXT[])
+RETURNS VOID AS $$
+DECLARE
+  prefix TEXT := 'entry_field_' || left(md5(p_content_type_id::text), 8) || '_';
+  wanted TEXT[];
+  existing RECORD;
+  field TEXT;
+BEGIN
+  p_fields := COALESCE(p_fields, '{}');
+  wanted := ARRAY(SELECT prefix || left(md5(f), 8) FROM unnest(p_fields) AS f);
+
+  FOR existing IN
+    SELECT indexname FROM pg_indexes
+    WHERE tablename = 'entries' AND starts_with(indexname, prefix)
+  LOOP
+    IF NOT existing.indexname = ANY(wanted) THEN
+      EXECUTE format('DROP INDEX IF EXISTS %I', existing.indexname);
+    END IF;
+  END LOOP;
+
+  FOREACH field IN ARRAY p_fields LOOP
+    EXECUTE format(
+      'CREATE INDEX IF NOT EXISTS %I ON entries USING GIN ((data -> %L) jsonb_path_ops) WHERE content_type_id = %L',
+      prefix || left(md5(field), 8), field, p_content_type_id
+    );
+  END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- webmentions: one row per source and target; post_id is the mentioned blog post document ID.
+-- status moves from 'pending' to 'verified' or 'rejected' once the source has been fetched,
+-- then moderators approve verified mentions or file them as spam.
+CREATE TABLE IF NOT EXISTS webmentions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  post_id TEXT NOT NULL,
+  source TEXT NOT NULL,
+  target TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  author_name TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'verified', 'approved', 'rejected', 'spam')),
+  last_error TEXT NOT NULL DEFAULT '',
+  ip_address TEXT,
+  verified_at TIMESTAMPTZ,
+  moderated_by TEXT,
+  moderated_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (source, target)
+);
+CREATE INDEX IF NOT EXISTS webmentions_post_status_created_idx ON webmentions (post_id, status, created_at);
+CREATE INDEX IF NOT EXISTS webmentions_status_created_idx ON webmentions (status, created_at);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_webmentions'
+  ) THEN
+    CREATE TRIGGER set_updated_at_webmentions BEFORE UPDATE ON webmentions
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- activitypub_keys: one RSA key pair per actor, generated on first use. actor is the
+-- preferred username, e.g. the site actor 'blog' or an author actor.
+CREATE TABLE IF NOT EXISTS activitypub_keys (
+  actor TEXT PRIMARY KEY,
+  public_key_pem TEXT NOT NULL,
+  private_key_pem TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- activitypub_followers: remote actors following one of our actors. shared_inbox_url is
+-- preferred for deliveries so a server hosting many followers receives each activity once.
+CREATE TABLE IF NOT EXISTS activitypub_followers (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  actor TEXT NOT NULL,
+  follower_uri TEXT NOT NULL,
+  inbox_url TEXT NOT NULL,
+  shared_inbox_url TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (actor, follower_uri)
+);
+CREATE INDEX IF NOT EXISTS activitypub_followers_actor_created_idx ON activitypub_followers (actor, created_at);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_activitypub_followers'
+  ) THEN
+    CREATE TRIGGER set_updated_at_activitypub_followers BEFORE UPDATE ON activitypub_followers
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- activitypub_deliveries: one row per activity and remote inbox, signed with the actor's key
+-- at send time. Retries follow the webhook delivery schedule; next_attempt_at is NULL once settled.
+CREATE TABLE IF NOT EXISTS activitypub_deliveries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  actor TEXT NOT NULL,
+  inbox_url TEXT NOT NULL,
+  activity_id TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+  attempts INTEGER NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  next_attempt_at TIMESTAMPTZ,
+  delivered_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS activitypub_deliveries_due_idx ON activitypub_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- newsletter_subscribers: one row per email address (stored lowercased). Subscribers are
+-- 'pending' until they follow the confirmation link and only 'active' subscribers receive
+-- digests. topics holds category slugs; an empty array subscribes to every category.
+-- 'bounced' and 'complained' are set from the delivery webhook and stop all mail.
+CREATE TABLE IF NOT EXISTS newsletter_subscribers (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  email TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'active', 'unsubscribed', 'bounced', 'complained')),
+  topics TEXT[] NOT NULL DEFAULT '{}',
+  soft_bounces INTEGER NOT NULL DEFAULT 0,
+  ip_address TEXT,
+  confirmation_sent_at TIMESTAMPTZ,
+  confirmed_at TIMESTAMPTZ,
+  unsubscribed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS newsletter_subscribers_status_created_idx ON newsletter_subscribers (status, created_at);
+
+-- newsletter_digests: one row per digest run, covering posts published in
+-- [period_start, period_end). The latest period_end is where the next digest starts.
+CREATE TABLE IF NOT EXISTS newsletter_digests (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  period_start TIMESTAMPTZ NOT NULL,
+  period_end TIMESTAMPTZ NOT NULL,
+  post_ids TEXT[] NOT NULL DEFAULT '{}',
+  recipients INTEGER NOT NULL DEFAULT 0,
+  failures INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS newsletter_digests_period_end_idx ON newsletter_digests (period_end DESC);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_newsletter_subscribers'
+  ) THEN
+    CREATE TRIGGER set_updated_at_newsletter_subscribers BEFORE UPDATE ON newsletter_subscribers
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- navigation_menus: items is a JSON tree of menu items in display order. Each item has a
+-- default label, optional labels per locale, a link (page ID, post ID, category slug,
+-- external URL or none) and its children. Content links are resolved when a menu is read.
+CREATE TABLE IF NOT EXISTS navigation_menus (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL,
+  description TEXT,
+  items JSONB NOT NULL DEFAULT '[]',
+  created_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_navigation_menus'
+  ) THEN
+    CREATE TRIGGER set_updated_at_navigation_menus BEFORE UPDATE ON navigation_menus
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- site_settings: one row per setting key. value is the JSON encoding of the setting;
+-- keys without a row use the defaults configured on the server.
+CREATE TABLE IF NOT EXISTS site_settings (
+  key TEXT PRIMARY KEY,
+  value JSONB NOT NULL,
+  updated_by TEXT,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- site_setting_changes: one row per changed key and update. old_value is NULL when the
+-- key previously used its default.
+CREATE TABLE IF NOT EXISTS site_setting_changes (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  key TEXT NOT NULL,
+  old_value JSONB,
+  new_value JSONB NOT NULL,
+  changed_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_site_setting_changes_created_at ON site_setting_changes(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_site_setting_changes_key ON site_setting_changes(key, created_at DESC);
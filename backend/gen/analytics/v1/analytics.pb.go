@@ -0,0 +1,748 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: analytics/v1/analytics.proto
+
+package analyticsv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Content types that can be tracked
+type ContentType int32
+
+const (
+	ContentType_CONTENT_TYPE_UNSPECIFIED ContentType = 0
+	ContentType_CONTENT_TYPE_PAGE        ContentType = 1
+	ContentType_CONTENT_TYPE_POST        ContentType = 2
+)
+
+// Enum value maps for ContentType.
+var (
+	ContentType_name = map[int32]string{
+		0: "CONTENT_TYPE_UNSPECIFIED",
+		1: "CONTENT_TYPE_PAGE",
+		2: "CONTENT_TYPE_POST",
+	}
+	ContentType_value = map[string]int32{
+		"CONTENT_TYPE_UNSPECIFIED": 0,
+		"CONTENT_TYPE_PAGE":        1,
+		"CONTENT_TYPE_POST":        2,
+	}
+)
+
+func (x ContentType) Enum() *ContentType {
+	p := new(ContentType)
+	*p = x
+	return p
+}
+
+func (x ContentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_analytics_v1_analytics_proto_enumTypes[0].Descriptor()
+}
+
+func (ContentType) Type() protoreflect.EnumType {
+	return &file_analytics_v1_analytics_proto_enumTypes[0]
+}
+
+func (x ContentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentType.Descriptor instead.
+func (ContentType) EnumDescriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+type TrackViewRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContentType ContentType            `protobuf:"varint,1,opt,name=content_type,json=contentType,proto3,enum=analytics.v1.ContentType" json:"content_type,omitempty"`
+	// Page or blog post ID
+	ContentId string `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Path the content was viewed at
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// document.referrer of the view; only the host is kept
+	Referrer      string `protobuf:"bytes,4,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackViewRequest) Reset() {
+	*x = TrackViewRequest{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackViewRequest) ProtoMessage() {}
+
+func (x *TrackViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackViewRequest.ProtoReflect.Descriptor instead.
+func (*TrackViewRequest) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrackViewRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *TrackViewRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *TrackViewRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TrackViewRequest) GetReferrer() string {
+	if x != nil {
+		return x.Referrer
+	}
+	return ""
+}
+
+type GetContentStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional; empty returns site-wide stats
+	ContentId string `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Inclusive UTC window as YYYY-MM-DD; defaults to the last 30 days
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Maximum referrers to return; defaults to 10
+	ReferrerLimit int32 `protobuf:"varint,4,opt,name=referrer_limit,json=referrerLimit,proto3" json:"referrer_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentStatsRequest) Reset() {
+	*x = GetContentStatsRequest{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentStatsRequest) ProtoMessage() {}
+
+func (x *GetContentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetContentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetContentStatsRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *GetContentStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetContentStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetContentStatsRequest) GetReferrerLimit() int32 {
+	if x != nil {
+		return x.ReferrerLimit
+	}
+	return 0
+}
+
+type ContentStats struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ContentId  string                 `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	StartDate  string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	TotalViews int64                  `protobuf:"varint,4,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
+	// Sum of daily unique visitors; visitors are not linkable across days
+	UniqueVisitors int64 `protobuf:"varint,5,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
+	// Days without views are omitted
+	Daily         []*DailyViews    `protobuf:"bytes,6,rep,name=daily,proto3" json:"daily,omitempty"`
+	Referrers     []*ReferrerViews `protobuf:"bytes,7,rep,name=referrers,proto3" json:"referrers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentStats) Reset() {
+	*x = ContentStats{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentStats) ProtoMessage() {}
+
+func (x *ContentStats) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentStats.ProtoReflect.Descriptor instead.
+func (*ContentStats) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ContentStats) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *ContentStats) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ContentStats) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ContentStats) GetTotalViews() int64 {
+	if x != nil {
+		return x.TotalViews
+	}
+	return 0
+}
+
+func (x *ContentStats) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
+}
+
+func (x *ContentStats) GetDaily() []*DailyViews {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *ContentStats) GetReferrers() []*ReferrerViews {
+	if x != nil {
+		return x.Referrers
+	}
+	return nil
+}
+
+type DailyViews struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD
+	Date           string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Views          int64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	UniqueVisitors int64  `protobuf:"varint,3,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DailyViews) Reset() {
+	*x = DailyViews{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyViews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyViews) ProtoMessage() {}
+
+func (x *DailyViews) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyViews.ProtoReflect.Descriptor instead.
+func (*DailyViews) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DailyViews) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyViews) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *DailyViews) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
+}
+
+type ReferrerViews struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Referring host; empty for direct traffic
+	Referrer      string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Views         int64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReferrerViews) Reset() {
+	*x = ReferrerViews{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferrerViews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferrerViews) ProtoMessage() {}
+
+func (x *ReferrerViews) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferrerViews.ProtoReflect.Descriptor instead.
+func (*ReferrerViews) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReferrerViews) GetReferrer() string {
+	if x != nil {
+		return x.Referrer
+	}
+	return ""
+}
+
+func (x *ReferrerViews) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+type ListPopularContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Inclusive UTC window as YYYY-MM-DD; defaults to the last 30 days
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Optional filter; unspecified ranks pages and posts together
+	ContentType ContentType `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=analytics.v1.ContentType" json:"content_type,omitempty"`
+	// Defaults to 10, maximum 100
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPopularContentRequest) Reset() {
+	*x = ListPopularContentRequest{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPopularContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPopularContentRequest) ProtoMessage() {}
+
+func (x *ListPopularContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPopularContentRequest.ProtoReflect.Descriptor instead.
+func (*ListPopularContentRequest) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPopularContentRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListPopularContentRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListPopularContentRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListPopularContentRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPopularContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PopularContent      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPopularContentResponse) Reset() {
+	*x = ListPopularContentResponse{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPopularContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPopularContentResponse) ProtoMessage() {}
+
+func (x *ListPopularContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPopularContentResponse.ProtoReflect.Descriptor instead.
+func (*ListPopularContentResponse) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPopularContentResponse) GetItems() []*PopularContent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PopularContent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContentId      string                 `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ContentType    ContentType            `protobuf:"varint,2,opt,name=content_type,json=contentType,proto3,enum=analytics.v1.ContentType" json:"content_type,omitempty"`
+	Path           string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Views          int64                  `protobuf:"varint,4,opt,name=views,proto3" json:"views,omitempty"`
+	UniqueVisitors int64                  `protobuf:"varint,5,opt,name=unique_visitors,json=uniqueVisitors,proto3" json:"unique_visitors,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PopularContent) Reset() {
+	*x = PopularContent{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PopularContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopularContent) ProtoMessage() {}
+
+func (x *PopularContent) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopularContent.ProtoReflect.Descriptor instead.
+func (*PopularContent) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PopularContent) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *PopularContent) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *PopularContent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PopularContent) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *PopularContent) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
+}
+
+var File_analytics_v1_analytics_proto protoreflect.FileDescriptor
+
+const file_analytics_v1_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x1canalytics/v1/analytics.proto\x12\fanalytics.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x9f\x01\n" +
+	"\x10TrackViewRequest\x12<\n" +
+	"\fcontent_type\x18\x01 \x01(\x0e2\x19.analytics.v1.ContentTypeR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\tcontentId\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1a\n" +
+	"\breferrer\x18\x04 \x01(\tR\breferrer\"\x98\x01\n" +
+	"\x16GetContentStatsRequest\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12%\n" +
+	"\x0ereferrer_limit\x18\x04 \x01(\x05R\rreferrerLimit\"\x9c\x02\n" +
+	"\fContentStats\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x1f\n" +
+	"\vtotal_views\x18\x04 \x01(\x03R\n" +
+	"totalViews\x12'\n" +
+	"\x0funique_visitors\x18\x05 \x01(\x03R\x0euniqueVisitors\x12.\n" +
+	"\x05daily\x18\x06 \x03(\v2\x18.analytics.v1.DailyViewsR\x05daily\x129\n" +
+	"\treferrers\x18\a \x03(\v2\x1b.analytics.v1.ReferrerViewsR\treferrers\"_\n" +
+	"\n" +
+	"DailyViews\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\x12'\n" +
+	"\x0funique_visitors\x18\x03 \x01(\x03R\x0euniqueVisitors\"A\n" +
+	"\rReferrerViews\x12\x1a\n" +
+	"\breferrer\x18\x01 \x01(\tR\breferrer\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\"\xa9\x01\n" +
+	"\x19ListPopularContentRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12<\n" +
+	"\fcontent_type\x18\x03 \x01(\x0e2\x19.analytics.v1.ContentTypeR\vcontentType\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"P\n" +
+	"\x1aListPopularContentResponse\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.analytics.v1.PopularContentR\x05items\"\xc0\x01\n" +
+	"\x0ePopularContent\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x12<\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x19.analytics.v1.ContentTypeR\vcontentType\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x14\n" +
+	"\x05views\x18\x04 \x01(\x03R\x05views\x12'\n" +
+	"\x0funique_visitors\x18\x05 \x01(\x03R\x0euniqueVisitors*Y\n" +
+	"\vContentType\x12\x1c\n" +
+	"\x18CONTENT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CONTENT_TYPE_PAGE\x10\x01\x12\x15\n" +
+	"\x11CONTENT_TYPE_POST\x10\x022\xfe\x02\n" +
+	"\x10AnalyticsService\x12g\n" +
+	"\tTrackView\x12\x1e.analytics.v1.TrackViewRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/analytics/views\x12t\n" +
+	"\x0fGetContentStats\x12$.analytics.v1.GetContentStatsRequest\x1a\x1a.analytics.v1.ContentStats\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/analytics/stats\x12\x8a\x01\n" +
+	"\x12ListPopularContent\x12'.analytics.v1.ListPopularContentRequest\x1a(.analytics.v1.ListPopularContentResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/analytics/popularBJZHgithub.com/7-solutions/saas-platformbackend/gen/analytics/v1;analyticsv1b\x06proto3"
+
+var (
+	file_analytics_v1_analytics_proto_rawDescOnce sync.Once
+	file_analytics_v1_analytics_proto_rawDescData []byte
+)
+
+func file_analytics_v1_analytics_proto_rawDescGZIP() []byte {
+	file_analytics_v1_analytics_proto_rawDescOnce.Do(func() {
+		file_analytics_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_analytics_v1_analytics_proto_rawDesc), len(file_analytics_v1_analytics_proto_rawDesc)))
+	})
+	return file_analytics_v1_analytics_proto_rawDescData
+}
+
+var file_analytics_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_analytics_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_analytics_v1_analytics_proto_goTypes = []any{
+	(ContentType)(0),                   // 0: analytics.v1.ContentType
+	(*TrackViewRequest)(nil),           // 1: analytics.v1.TrackViewRequest
+	(*GetContentStatsRequest)(nil),     // 2: analytics.v1.GetContentStatsRequest
+	(*ContentStats)(nil),               // 3: analytics.v1.ContentStats
+	(*DailyViews)(nil),                 // 4: analytics.v1.DailyViews
+	(*ReferrerViews)(nil),              // 5: analytics.v1.ReferrerViews
+	(*ListPopularContentRequest)(nil),  // 6: analytics.v1.ListPopularContentRequest
+	(*ListPopularContentResponse)(nil), // 7: analytics.v1.ListPopularContentResponse
+	(*PopularContent)(nil),             // 8: analytics.v1.PopularContent
+	(*emptypb.Empty)(nil),              // 9: google.protobuf.Empty
+}
+var file_analytics_v1_analytics_proto_depIdxs = []int32{
+	0, // 0: analytics.v1.TrackViewRequest.content_type:type_name -> analytics.v1.ContentType
+	4, // 1: analytics.v1.ContentStats.daily:type_name -> analytics.v1.DailyViews
+	5, // 2: analytics.v1.ContentStats.referrers:type_name -> analytics.v1.ReferrerViews
+	0, // 3: analytics.v1.ListPopularContentRequest.content_type:type_name -> analytics.v1.ContentType
+	8, // 4: analytics.v1.ListPopularContentResponse.items:type_name -> analytics.v1.PopularContent
+	0, // 5: analytics.v1.PopularContent.content_type:type_name -> analytics.v1.ContentType
+	1, // 6: analytics.v1.AnalyticsService.TrackView:input_type -> analytics.v1.TrackViewRequest
+	2, // 7: analytics.v1.AnalyticsService.GetContentStats:input_type -> analytics.v1.GetContentStatsRequest
+	6, // 8: analytics.v1.AnalyticsService.ListPopularContent:input_type -> analytics.v1.ListPopularContentRequest
+	9, // 9: analytics.v1.AnalyticsService.TrackView:output_type -> google.protobuf.Empty
+	3, // 10: analytics.v1.AnalyticsService.GetContentStats:output_type -> analytics.v1.ContentStats
+	7, // 11: analytics.v1.AnalyticsService.ListPopularContent:output_type -> analytics.v1.ListPopularContentResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_analytics_v1_analytics_proto_init() }
+func file_analytics_v1_analytics_proto_init() {
+	if File_analytics_v1_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analytics_v1_analytics_proto_rawDesc), len(file_analytics_v1_analytics_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_analytics_v1_analytics_proto_goTypes,
+		DependencyIndexes: file_analytics_v1_analytics_proto_depIdxs,
+		EnumInfos:         file_analytics_v1_analytics_proto_enumTypes,
+		MessageInfos:      file_analytics_v1_analytics_proto_msgTypes,
+	}.Build()
+	File_analytics_v1_analytics_proto = out.File
+	file_analytics_v1_analytics_proto_goTypes = nil
+	file_analytics_v1_analytics_proto_depIdxs = nil
+}
@@ -0,0 +1,1286 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: comment/v1/comment.proto
+
+package commentv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Comment moderation state
+type CommentStatus int32
+
+const (
+	CommentStatus_COMMENT_STATUS_UNSPECIFIED CommentStatus = 0
+	CommentStatus_COMMENT_STATUS_PENDING     CommentStatus = 1
+	CommentStatus_COMMENT_STATUS_APPROVED    CommentStatus = 2
+	CommentStatus_COMMENT_STATUS_SPAM        CommentStatus = 3
+	CommentStatus_COMMENT_STATUS_DELETED     CommentStatus = 4
+)
+
+// Enum value maps for CommentStatus.
+var (
+	CommentStatus_name = map[int32]string{
+		0: "COMMENT_STATUS_UNSPECIFIED",
+		1: "COMMENT_STATUS_PENDING",
+		2: "COMMENT_STATUS_APPROVED",
+		3: "COMMENT_STATUS_SPAM",
+		4: "COMMENT_STATUS_DELETED",
+	}
+	CommentStatus_value = map[string]int32{
+		"COMMENT_STATUS_UNSPECIFIED": 0,
+		"COMMENT_STATUS_PENDING":     1,
+		"COMMENT_STATUS_APPROVED":    2,
+		"COMMENT_STATUS_SPAM":        3,
+		"COMMENT_STATUS_DELETED":     4,
+	}
+)
+
+func (x CommentStatus) Enum() *CommentStatus {
+	p := new(CommentStatus)
+	*p = x
+	return p
+}
+
+func (x CommentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[0].Descriptor()
+}
+
+func (CommentStatus) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[0]
+}
+
+func (x CommentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentStatus.Descriptor instead.
+func (CommentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{0}
+}
+
+// Webmention verification and moderation state
+type WebmentionStatus int32
+
+const (
+	WebmentionStatus_WEBMENTION_STATUS_UNSPECIFIED WebmentionStatus = 0
+	// Received; the source has not been fetched yet
+	WebmentionStatus_WEBMENTION_STATUS_PENDING WebmentionStatus = 1
+	// The source links to the post; awaiting moderation
+	WebmentionStatus_WEBMENTION_STATUS_VERIFIED WebmentionStatus = 2
+	WebmentionStatus_WEBMENTION_STATUS_APPROVED WebmentionStatus = 3
+	// The source could not be fetched or does not link to the post, or a moderator rejected it
+	WebmentionStatus_WEBMENTION_STATUS_REJECTED WebmentionStatus = 4
+	WebmentionStatus_WEBMENTION_STATUS_SPAM     WebmentionStatus = 5
+)
+
+// Enum value maps for WebmentionStatus.
+var (
+	WebmentionStatus_name = map[int32]string{
+		0: "WEBMENTION_STATUS_UNSPECIFIED",
+		1: "WEBMENTION_STATUS_PENDING",
+		2: "WEBMENTION_STATUS_VERIFIED",
+		3: "WEBMENTION_STATUS_APPROVED",
+		4: "WEBMENTION_STATUS_REJECTED",
+		5: "WEBMENTION_STATUS_SPAM",
+	}
+	WebmentionStatus_value = map[string]int32{
+		"WEBMENTION_STATUS_UNSPECIFIED": 0,
+		"WEBMENTION_STATUS_PENDING":     1,
+		"WEBMENTION_STATUS_VERIFIED":    2,
+		"WEBMENTION_STATUS_APPROVED":    3,
+		"WEBMENTION_STATUS_REJECTED":    4,
+		"WEBMENTION_STATUS_SPAM":        5,
+	}
+)
+
+func (x WebmentionStatus) Enum() *WebmentionStatus {
+	p := new(WebmentionStatus)
+	*p = x
+	return p
+}
+
+func (x WebmentionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebmentionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[1].Descriptor()
+}
+
+func (WebmentionStatus) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[1]
+}
+
+func (x WebmentionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebmentionStatus.Descriptor instead.
+func (WebmentionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{1}
+}
+
+// Comment represents a reader comment on a blog post
+type Comment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Empty for top-level comments
+	ParentId   string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AuthorName string `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// Only returned to moderators
+	AuthorEmail string `protobuf:"bytes,5,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	AuthorUrl   string `protobuf:"bytes,6,opt,name=author_url,json=authorUrl,proto3" json:"author_url,omitempty"`
+	// True when the author was signed in
+	Authenticated bool          `protobuf:"varint,7,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	Body          string        `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+	Status        CommentStatus `protobuf:"varint,9,opt,name=status,proto3,enum=comment.v1.CommentStatus" json:"status,omitempty"`
+	// Only returned to moderators
+	IpAddress string `protobuf:"bytes,10,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Only returned to moderators
+	UserAgent string                 `protobuf:"bytes,11,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Approved replies; only populated by ListComments
+	Replies       []*Comment `protobuf:"bytes,14,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorUrl() string {
+	if x != nil {
+		return x.AuthorUrl
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_COMMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Comment) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Comment) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+type SubmitCommentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Reply target; must be an approved comment on the same post
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Required for guests; ignored for signed-in users
+	AuthorName string `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// Required for guests; ignored for signed-in users
+	AuthorEmail   string `protobuf:"bytes,4,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	AuthorUrl     string `protobuf:"bytes,5,opt,name=author_url,json=authorUrl,proto3" json:"author_url,omitempty"`
+	Body          string `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitCommentRequest) Reset() {
+	*x = SubmitCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCommentRequest) ProtoMessage() {}
+
+func (x *SubmitCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCommentRequest.ProtoReflect.Descriptor instead.
+func (*SubmitCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmitCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *SubmitCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *SubmitCommentRequest) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *SubmitCommentRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *SubmitCommentRequest) GetAuthorUrl() string {
+	if x != nil {
+		return x.AuthorUrl
+	}
+	return ""
+}
+
+func (x *SubmitCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCommentsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Top-level comments with nested replies, oldest first
+	Comments      []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	TotalCount    int32      `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ListModerationQueueRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Defaults to COMMENT_STATUS_PENDING
+	Status CommentStatus `protobuf:"varint,3,opt,name=status,proto3,enum=comment.v1.CommentStatus" json:"status,omitempty"`
+	// Optional filter by blog post
+	PostId        string `protobuf:"bytes,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListModerationQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListModerationQueueRequest) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_COMMENT_STATUS_UNSPECIFIED
+}
+
+func (x *ListModerationQueueRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type ListModerationQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueResponse) Reset() {
+	*x = ListModerationQueueResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueResponse) ProtoMessage() {}
+
+func (x *ListModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListModerationQueueResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListModerationQueueResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListModerationQueueResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ModerateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        CommentStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=comment.v1.CommentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ModerateCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateCommentRequest) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_COMMENT_STATUS_UNSPECIFIED
+}
+
+// Webmention is a mention of a blog post on another site
+type Webmention struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// URL of the page that mentions the post
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// URL of the mentioned post
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// Title and author of the source page, read during verification
+	Title      string           `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	AuthorName string           `protobuf:"bytes,6,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Status     WebmentionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=comment.v1.WebmentionStatus" json:"status,omitempty"`
+	// Why verification rejected the mention; only returned to moderators
+	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	VerifiedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webmention) Reset() {
+	*x = Webmention{}
+	mi := &file_comment_v1_comment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webmention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webmention) ProtoMessage() {}
+
+func (x *Webmention) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webmention.ProtoReflect.Descriptor instead.
+func (*Webmention) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Webmention) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webmention) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Webmention) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Webmention) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Webmention) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Webmention) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Webmention) GetStatus() WebmentionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebmentionStatus_WEBMENTION_STATUS_UNSPECIFIED
+}
+
+func (x *Webmention) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Webmention) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+func (x *Webmention) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webmention) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListWebmentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebmentionsRequest) Reset() {
+	*x = ListWebmentionsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebmentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebmentionsRequest) ProtoMessage() {}
+
+func (x *ListWebmentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebmentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebmentionsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListWebmentionsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type ListWebmentionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Approved mentions, oldest first
+	Webmentions   []*Webmention `protobuf:"bytes,1,rep,name=webmentions,proto3" json:"webmentions,omitempty"`
+	TotalCount    int32         `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebmentionsResponse) Reset() {
+	*x = ListWebmentionsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebmentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebmentionsResponse) ProtoMessage() {}
+
+func (x *ListWebmentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebmentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebmentionsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListWebmentionsResponse) GetWebmentions() []*Webmention {
+	if x != nil {
+		return x.Webmentions
+	}
+	return nil
+}
+
+func (x *ListWebmentionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ListWebmentionQueueRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Defaults to WEBMENTION_STATUS_VERIFIED
+	Status WebmentionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=comment.v1.WebmentionStatus" json:"status,omitempty"`
+	// Optional filter by blog post
+	PostId        string `protobuf:"bytes,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebmentionQueueRequest) Reset() {
+	*x = ListWebmentionQueueRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebmentionQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebmentionQueueRequest) ProtoMessage() {}
+
+func (x *ListWebmentionQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebmentionQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListWebmentionQueueRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListWebmentionQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebmentionQueueRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWebmentionQueueRequest) GetStatus() WebmentionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebmentionStatus_WEBMENTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebmentionQueueRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type ListWebmentionQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webmentions   []*Webmention          `protobuf:"bytes,1,rep,name=webmentions,proto3" json:"webmentions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebmentionQueueResponse) Reset() {
+	*x = ListWebmentionQueueResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebmentionQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebmentionQueueResponse) ProtoMessage() {}
+
+func (x *ListWebmentionQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebmentionQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListWebmentionQueueResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListWebmentionQueueResponse) GetWebmentions() []*Webmention {
+	if x != nil {
+		return x.Webmentions
+	}
+	return nil
+}
+
+func (x *ListWebmentionQueueResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListWebmentionQueueResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ModerateWebmentionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of WEBMENTION_STATUS_APPROVED, WEBMENTION_STATUS_REJECTED or WEBMENTION_STATUS_SPAM
+	Status        WebmentionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=comment.v1.WebmentionStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateWebmentionRequest) Reset() {
+	*x = ModerateWebmentionRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateWebmentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateWebmentionRequest) ProtoMessage() {}
+
+func (x *ModerateWebmentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateWebmentionRequest.ProtoReflect.Descriptor instead.
+func (*ModerateWebmentionRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ModerateWebmentionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateWebmentionRequest) GetStatus() WebmentionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebmentionStatus_WEBMENTION_STATUS_UNSPECIFIED
+}
+
+var File_comment_v1_comment_proto protoreflect.FileDescriptor
+
+const file_comment_v1_comment_proto_rawDesc = "" +
+	"\n" +
+	"\x18comment/v1/comment.proto\x12\n" +
+	"comment.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\x04\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12!\n" +
+	"\fauthor_email\x18\x05 \x01(\tR\vauthorEmail\x12\x1d\n" +
+	"\n" +
+	"author_url\x18\x06 \x01(\tR\tauthorUrl\x12$\n" +
+	"\rauthenticated\x18\a \x01(\bR\rauthenticated\x12\x12\n" +
+	"\x04body\x18\b \x01(\tR\x04body\x121\n" +
+	"\x06status\x18\t \x01(\x0e2\x19.comment.v1.CommentStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\n" +
+	" \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\v \x01(\tR\tuserAgent\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12-\n" +
+	"\areplies\x18\x0e \x03(\v2\x13.comment.v1.CommentR\areplies\"\xc3\x01\n" +
+	"\x14SubmitCommentRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x1f\n" +
+	"\vauthor_name\x18\x03 \x01(\tR\n" +
+	"authorName\x12!\n" +
+	"\fauthor_email\x18\x04 \x01(\tR\vauthorEmail\x12\x1d\n" +
+	"\n" +
+	"author_url\x18\x05 \x01(\tR\tauthorUrl\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\".\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\"h\n" +
+	"\x14ListCommentsResponse\x12/\n" +
+	"\bcomments\x18\x01 \x03(\v2\x13.comment.v1.CommentR\bcomments\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xa4\x01\n" +
+	"\x1aListModerationQueueRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.comment.v1.CommentStatusR\x06status\x12\x17\n" +
+	"\apost_id\x18\x04 \x01(\tR\x06postId\"\x97\x01\n" +
+	"\x1bListModerationQueueResponse\x12/\n" +
+	"\bcomments\x18\x01 \x03(\v2\x13.comment.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"[\n" +
+	"\x16ModerateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.comment.v1.CommentStatusR\x06status\"\xa4\x03\n" +
+	"\n" +
+	"Webmention\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x1f\n" +
+	"\vauthor_name\x18\x06 \x01(\tR\n" +
+	"authorName\x124\n" +
+	"\x06status\x18\a \x01(\x0e2\x1c.comment.v1.WebmentionStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12;\n" +
+	"\vverified_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"1\n" +
+	"\x16ListWebmentionsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\"t\n" +
+	"\x17ListWebmentionsResponse\x128\n" +
+	"\vwebmentions\x18\x01 \x03(\v2\x16.comment.v1.WebmentionR\vwebmentions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xa7\x01\n" +
+	"\x1aListWebmentionQueueRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.comment.v1.WebmentionStatusR\x06status\x12\x17\n" +
+	"\apost_id\x18\x04 \x01(\tR\x06postId\"\xa0\x01\n" +
+	"\x1bListWebmentionQueueResponse\x128\n" +
+	"\vwebmentions\x18\x01 \x03(\v2\x16.comment.v1.WebmentionR\vwebmentions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"a\n" +
+	"\x19ModerateWebmentionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.comment.v1.WebmentionStatusR\x06status*\x9d\x01\n" +
+	"\rCommentStatus\x12\x1e\n" +
+	"\x1aCOMMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COMMENT_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17COMMENT_STATUS_APPROVED\x10\x02\x12\x17\n" +
+	"\x13COMMENT_STATUS_SPAM\x10\x03\x12\x1a\n" +
+	"\x16COMMENT_STATUS_DELETED\x10\x04*\xd0\x01\n" +
+	"\x10WebmentionStatus\x12!\n" +
+	"\x1dWEBMENTION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19WEBMENTION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aWEBMENTION_STATUS_VERIFIED\x10\x02\x12\x1e\n" +
+	"\x1aWEBMENTION_STATUS_APPROVED\x10\x03\x12\x1e\n" +
+	"\x1aWEBMENTION_STATUS_REJECTED\x10\x04\x12\x1a\n" +
+	"\x16WEBMENTION_STATUS_SPAM\x10\x052\x88\a\n" +
+	"\x0eCommentService\x12r\n" +
+	"\rSubmitComment\x12 .comment.v1.SubmitCommentRequest\x1a\x13.comment.v1.Comment\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/blog/{post_id}/comments\x12z\n" +
+	"\fListComments\x12\x1f.comment.v1.ListCommentsRequest\x1a .comment.v1.ListCommentsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/blog/{post_id}/comments\x12\x80\x01\n" +
+	"\x13ListModerationQueue\x12&.comment.v1.ListModerationQueueRequest\x1a'.comment.v1.ListModerationQueueResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/comments\x12s\n" +
+	"\x0fModerateComment\x12\".comment.v1.ModerateCommentRequest\x1a\x13.comment.v1.Comment\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/comments/{id}/status\x12\x86\x01\n" +
+	"\x0fListWebmentions\x12\".comment.v1.ListWebmentionsRequest\x1a#.comment.v1.ListWebmentionsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/blog/{post_id}/webmentions\x12\x83\x01\n" +
+	"\x13ListWebmentionQueue\x12&.comment.v1.ListWebmentionQueueRequest\x1a'.comment.v1.ListWebmentionQueueResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/webmentions\x12\x7f\n" +
+	"\x12ModerateWebmention\x12%.comment.v1.ModerateWebmentionRequest\x1a\x16.comment.v1.Webmention\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/v1/webmentions/{id}/statusBFZDgithub.com/7-solutions/saas-platformbackend/gen/comment/v1;commentv1b\x06proto3"
+
+var (
+	file_comment_v1_comment_proto_rawDescOnce sync.Once
+	file_comment_v1_comment_proto_rawDescData []byte
+)
+
+func file_comment_v1_comment_proto_rawDescGZIP() []byte {
+	file_comment_v1_comment_proto_rawDescOnce.Do(func() {
+		file_comment_v1_comment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_comment_v1_comment_proto_rawDesc), len(file_comment_v1_comment_proto_rawDesc)))
+	})
+	return file_comment_v1_comment_proto_rawDescData
+}
+
+var file_comment_v1_comment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_comment_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_comment_v1_comment_proto_goTypes = []any{
+	(CommentStatus)(0),                  // 0: comment.v1.CommentStatus
+	(WebmentionStatus)(0),               // 1: comment.v1.WebmentionStatus
+	(*Comment)(nil),                     // 2: comment.v1.Comment
+	(*SubmitCommentRequest)(nil),        // 3: comment.v1.SubmitCommentRequest
+	(*ListCommentsRequest)(nil),         // 4: comment.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),        // 5: comment.v1.ListCommentsResponse
+	(*ListModerationQueueRequest)(nil),  // 6: comment.v1.ListModerationQueueRequest
+	(*ListModerationQueueResponse)(nil), // 7: comment.v1.ListModerationQueueResponse
+	(*ModerateCommentRequest)(nil),      // 8: comment.v1.ModerateCommentRequest
+	(*Webmention)(nil),                  // 9: comment.v1.Webmention
+	(*ListWebmentionsRequest)(nil),      // 10: comment.v1.ListWebmentionsRequest
+	(*ListWebmentionsResponse)(nil),     // 11: comment.v1.ListWebmentionsResponse
+	(*ListWebmentionQueueRequest)(nil),  // 12: comment.v1.ListWebmentionQueueRequest
+	(*ListWebmentionQueueResponse)(nil), // 13: comment.v1.ListWebmentionQueueResponse
+	(*ModerateWebmentionRequest)(nil),   // 14: comment.v1.ModerateWebmentionRequest
+	(*timestamppb.Timestamp)(nil),       // 15: google.protobuf.Timestamp
+}
+var file_comment_v1_comment_proto_depIdxs = []int32{
+	0,  // 0: comment.v1.Comment.status:type_name -> comment.v1.CommentStatus
+	15, // 1: comment.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: comment.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: comment.v1.Comment.replies:type_name -> comment.v1.Comment
+	2,  // 4: comment.v1.ListCommentsResponse.comments:type_name -> comment.v1.Comment
+	0,  // 5: comment.v1.ListModerationQueueRequest.status:type_name -> comment.v1.CommentStatus
+	2,  // 6: comment.v1.ListModerationQueueResponse.comments:type_name -> comment.v1.Comment
+	0,  // 7: comment.v1.ModerateCommentRequest.status:type_name -> comment.v1.CommentStatus
+	1,  // 8: comment.v1.Webmention.status:type_name -> comment.v1.WebmentionStatus
+	15, // 9: comment.v1.Webmention.verified_at:type_name -> google.protobuf.Timestamp
+	15, // 10: comment.v1.Webmention.created_at:type_name -> google.protobuf.Timestamp
+	15, // 11: comment.v1.Webmention.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 12: comment.v1.ListWebmentionsResponse.webmentions:type_name -> comment.v1.Webmention
+	1,  // 13: comment.v1.ListWebmentionQueueRequest.status:type_name -> comment.v1.WebmentionStatus
+	9,  // 14: comment.v1.ListWebmentionQueueResponse.webmentions:type_name -> comment.v1.Webmention
+	1,  // 15: comment.v1.ModerateWebmentionRequest.status:type_name -> comment.v1.WebmentionStatus
+	3,  // 16: comment.v1.CommentService.SubmitComment:input_type -> comment.v1.SubmitCommentRequest
+	4,  // 17: comment.v1.CommentService.ListComments:input_type -> comment.v1.ListCommentsRequest
+	6,  // 18: comment.v1.CommentService.ListModerationQueue:input_type -> comment.v1.ListModerationQueueRequest
+	8,  // 19: comment.v1.CommentService.ModerateComment:input_type -> comment.v1.ModerateCommentRequest
+	10, // 20: comment.v1.CommentService.ListWebmentions:input_type -> comment.v1.ListWebmentionsRequest
+	12, // 21: comment.v1.CommentService.ListWebmentionQueue:input_type -> comment.v1.ListWebmentionQueueRequest
+	14, // 22: comment.v1.CommentService.ModerateWebmention:input_type -> comment.v1.ModerateWebmentionRequest
+	2,  // 23: comment.v1.CommentService.SubmitComment:output_type -> comment.v1.Comment
+	5,  // 24: comment.v1.CommentService.ListComments:output_type -> comment.v1.ListCommentsResponse
+	7,  // 25: comment.v1.CommentService.ListModerationQueue:output_type -> comment.v1.ListModerationQueueResponse
+	2,  // 26: comment.v1.CommentService.ModerateComment:output_type -> comment.v1.Comment
+	11, // 27: comment.v1.CommentService.ListWebmentions:output_type -> comment.v1.ListWebmentionsResponse
+	13, // 28: comment.v1.CommentService.ListWebmentionQueue:output_type -> comment.v1.ListWebmentionQueueResponse
+	9,  // 29: comment.v1.CommentService.ModerateWebmention:output_type -> comment.v1.Webmention
+	23, // [23:30] is the sub-list for method output_type
+	16, // [16:23] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_comment_v1_comment_proto_init() }
+func file_comment_v1_comment_proto_init() {
+	if File_comment_v1_comment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comment_v1_comment_proto_rawDesc), len(file_comment_v1_comment_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_comment_v1_comment_proto_goTypes,
+		DependencyIndexes: file_comment_v1_comment_proto_depIdxs,
+		EnumInfos:         file_comment_v1_comment_proto_enumTypes,
+		MessageInfos:      file_comment_v1_comment_proto_msgTypes,
+	}.Build()
+	File_comment_v1_comment_proto = out.File
+	file_comment_v1_comment_proto_goTypes = nil
+	file_comment_v1_comment_proto_depIdxs = nil
+}
@@ -25,6 +25,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Twitter card layouts
+type TwitterCardType int32
+
+const (
+	TwitterCardType_TWITTER_CARD_TYPE_UNSPECIFIED         TwitterCardType = 0
+	TwitterCardType_TWITTER_CARD_TYPE_SUMMARY             TwitterCardType = 1
+	TwitterCardType_TWITTER_CARD_TYPE_SUMMARY_LARGE_IMAGE TwitterCardType = 2
+)
+
+// Enum value maps for TwitterCardType.
+var (
+	TwitterCardType_name = map[int32]string{
+		0: "TWITTER_CARD_TYPE_UNSPECIFIED",
+		1: "TWITTER_CARD_TYPE_SUMMARY",
+		2: "TWITTER_CARD_TYPE_SUMMARY_LARGE_IMAGE",
+	}
+	TwitterCardType_value = map[string]int32{
+		"TWITTER_CARD_TYPE_UNSPECIFIED":         0,
+		"TWITTER_CARD_TYPE_SUMMARY":             1,
+		"TWITTER_CARD_TYPE_SUMMARY_LARGE_IMAGE": 2,
+	}
+)
+
+func (x TwitterCardType) Enum() *TwitterCardType {
+	p := new(TwitterCardType)
+	*p = x
+	return p
+}
+
+func (x TwitterCardType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TwitterCardType) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[0].Descriptor()
+}
+
+func (TwitterCardType) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[0]
+}
+
+func (x TwitterCardType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TwitterCardType.Descriptor instead.
+func (TwitterCardType) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{0}
+}
+
 // Page status enumeration
 type PageStatus int32
 
@@ -62,11 +112,11 @@ func (x PageStatus) String() string {
 }
 
 func (PageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_content_v1_content_proto_enumTypes[0].Descriptor()
+	return file_content_v1_content_proto_enumTypes[1].Descriptor()
 }
 
 func (PageStatus) Type() protoreflect.EnumType {
-	return &file_content_v1_content_proto_enumTypes[0]
+	return &file_content_v1_content_proto_enumTypes[1]
 }
 
 func (x PageStatus) Number() protoreflect.EnumNumber {
@@ -75,22 +125,474 @@ func (x PageStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PageStatus.Descriptor instead.
 func (PageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{0}
+	return file_content_v1_content_proto_rawDescGZIP(), []int{1}
+}
+
+// Who may read a page or post
+type VisibilityLevel int32
+
+const (
+	// Treated as public
+	VisibilityLevel_VISIBILITY_LEVEL_UNSPECIFIED VisibilityLevel = 0
+	VisibilityLevel_VISIBILITY_LEVEL_PUBLIC      VisibilityLevel = 1
+	// Any signed-in user
+	VisibilityLevel_VISIBILITY_LEVEL_AUTHENTICATED VisibilityLevel = 2
+	// Users with one of the listed roles or groups; editors and admins always have access
+	VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED VisibilityLevel = 3
+)
+
+// Enum value maps for VisibilityLevel.
+var (
+	VisibilityLevel_name = map[int32]string{
+		0: "VISIBILITY_LEVEL_UNSPECIFIED",
+		1: "VISIBILITY_LEVEL_PUBLIC",
+		2: "VISIBILITY_LEVEL_AUTHENTICATED",
+		3: "VISIBILITY_LEVEL_RESTRICTED",
+	}
+	VisibilityLevel_value = map[string]int32{
+		"VISIBILITY_LEVEL_UNSPECIFIED":   0,
+		"VISIBILITY_LEVEL_PUBLIC":        1,
+		"VISIBILITY_LEVEL_AUTHENTICATED": 2,
+		"VISIBILITY_LEVEL_RESTRICTED":    3,
+	}
+)
+
+func (x VisibilityLevel) Enum() *VisibilityLevel {
+	p := new(VisibilityLevel)
+	*p = x
+	return p
+}
+
+func (x VisibilityLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VisibilityLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[2].Descriptor()
+}
+
+func (VisibilityLevel) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[2]
+}
+
+func (x VisibilityLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisibilityLevel.Descriptor instead.
+func (VisibilityLevel) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{2}
+}
+
+// Sort orders for listing blog posts
+type BlogPostSort int32
+
+const (
+	// Same as BLOG_POST_SORT_PUBLISHED_AT
+	BlogPostSort_BLOG_POST_SORT_UNSPECIFIED BlogPostSort = 0
+	// Newest publication date first; drafts by creation date
+	BlogPostSort_BLOG_POST_SORT_PUBLISHED_AT BlogPostSort = 1
+	// Most recently updated first
+	BlogPostSort_BLOG_POST_SORT_UPDATED_AT BlogPostSort = 2
+	// Alphabetical by title
+	BlogPostSort_BLOG_POST_SORT_TITLE BlogPostSort = 3
+	// Ascending sort_weight, then newest publication date first
+	BlogPostSort_BLOG_POST_SORT_MANUAL BlogPostSort = 4
+)
+
+// Enum value maps for BlogPostSort.
+var (
+	BlogPostSort_name = map[int32]string{
+		0: "BLOG_POST_SORT_UNSPECIFIED",
+		1: "BLOG_POST_SORT_PUBLISHED_AT",
+		2: "BLOG_POST_SORT_UPDATED_AT",
+		3: "BLOG_POST_SORT_TITLE",
+		4: "BLOG_POST_SORT_MANUAL",
+	}
+	BlogPostSort_value = map[string]int32{
+		"BLOG_POST_SORT_UNSPECIFIED":  0,
+		"BLOG_POST_SORT_PUBLISHED_AT": 1,
+		"BLOG_POST_SORT_UPDATED_AT":   2,
+		"BLOG_POST_SORT_TITLE":        3,
+		"BLOG_POST_SORT_MANUAL":       4,
+	}
+)
+
+func (x BlogPostSort) Enum() *BlogPostSort {
+	p := new(BlogPostSort)
+	*p = x
+	return p
+}
+
+func (x BlogPostSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlogPostSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[3].Descriptor()
+}
+
+func (BlogPostSort) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[3]
+}
+
+func (x BlogPostSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlogPostSort.Descriptor instead.
+func (BlogPostSort) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{3}
+}
+
+// Collection kinds
+type CollectionKind int32
+
+const (
+	CollectionKind_COLLECTION_KIND_UNSPECIFIED CollectionKind = 0
+	// Ordered multi-part posts with previous/next navigation
+	CollectionKind_COLLECTION_KIND_SERIES CollectionKind = 1
+	// Hand-picked post lists such as "featured"
+	CollectionKind_COLLECTION_KIND_CURATED CollectionKind = 2
+)
+
+// Enum value maps for CollectionKind.
+var (
+	CollectionKind_name = map[int32]string{
+		0: "COLLECTION_KIND_UNSPECIFIED",
+		1: "COLLECTION_KIND_SERIES",
+		2: "COLLECTION_KIND_CURATED",
+	}
+	CollectionKind_value = map[string]int32{
+		"COLLECTION_KIND_UNSPECIFIED": 0,
+		"COLLECTION_KIND_SERIES":      1,
+		"COLLECTION_KIND_CURATED":     2,
+	}
+)
+
+func (x CollectionKind) Enum() *CollectionKind {
+	p := new(CollectionKind)
+	*p = x
+	return p
+}
+
+func (x CollectionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[4].Descriptor()
+}
+
+func (CollectionKind) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[4]
+}
+
+func (x CollectionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionKind.Descriptor instead.
+func (CollectionKind) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{4}
+}
+
+// Kinds of broken references found by the link scanner
+type LinkIssueKind int32
+
+const (
+	LinkIssueKind_LINK_ISSUE_KIND_UNSPECIFIED LinkIssueKind = 0
+	// Internal link to a page slug that does not exist
+	LinkIssueKind_LINK_ISSUE_KIND_MISSING_PAGE LinkIssueKind = 1
+	// Internal link to a blog post slug that does not exist
+	LinkIssueKind_LINK_ISSUE_KIND_MISSING_POST LinkIssueKind = 2
+	// Reference to an uploaded file that has been deleted
+	LinkIssueKind_LINK_ISSUE_KIND_MISSING_MEDIA LinkIssueKind = 3
+	// External URL that failed or returned an error status
+	LinkIssueKind_LINK_ISSUE_KIND_BROKEN_EXTERNAL LinkIssueKind = 4
+)
+
+// Enum value maps for LinkIssueKind.
+var (
+	LinkIssueKind_name = map[int32]string{
+		0: "LINK_ISSUE_KIND_UNSPECIFIED",
+		1: "LINK_ISSUE_KIND_MISSING_PAGE",
+		2: "LINK_ISSUE_KIND_MISSING_POST",
+		3: "LINK_ISSUE_KIND_MISSING_MEDIA",
+		4: "LINK_ISSUE_KIND_BROKEN_EXTERNAL",
+	}
+	LinkIssueKind_value = map[string]int32{
+		"LINK_ISSUE_KIND_UNSPECIFIED":     0,
+		"LINK_ISSUE_KIND_MISSING_PAGE":    1,
+		"LINK_ISSUE_KIND_MISSING_POST":    2,
+		"LINK_ISSUE_KIND_MISSING_MEDIA":   3,
+		"LINK_ISSUE_KIND_BROKEN_EXTERNAL": 4,
+	}
+)
+
+func (x LinkIssueKind) Enum() *LinkIssueKind {
+	p := new(LinkIssueKind)
+	*p = x
+	return p
+}
+
+func (x LinkIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[5].Descriptor()
+}
+
+func (LinkIssueKind) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[5]
+}
+
+func (x LinkIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkIssueKind.Descriptor instead.
+func (LinkIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{5}
+}
+
+// Severity of an SEO finding
+type SEOSeverity int32
+
+const (
+	SEOSeverity_SEO_SEVERITY_UNSPECIFIED SEOSeverity = 0
+	SEOSeverity_SEO_SEVERITY_INFO        SEOSeverity = 1
+	SEOSeverity_SEO_SEVERITY_WARNING     SEOSeverity = 2
+	SEOSeverity_SEO_SEVERITY_ERROR       SEOSeverity = 3
+)
+
+// Enum value maps for SEOSeverity.
+var (
+	SEOSeverity_name = map[int32]string{
+		0: "SEO_SEVERITY_UNSPECIFIED",
+		1: "SEO_SEVERITY_INFO",
+		2: "SEO_SEVERITY_WARNING",
+		3: "SEO_SEVERITY_ERROR",
+	}
+	SEOSeverity_value = map[string]int32{
+		"SEO_SEVERITY_UNSPECIFIED": 0,
+		"SEO_SEVERITY_INFO":        1,
+		"SEO_SEVERITY_WARNING":     2,
+		"SEO_SEVERITY_ERROR":       3,
+	}
+)
+
+func (x SEOSeverity) Enum() *SEOSeverity {
+	p := new(SEOSeverity)
+	*p = x
+	return p
+}
+
+func (x SEOSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SEOSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[6].Descriptor()
+}
+
+func (SEOSeverity) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[6]
+}
+
+func (x SEOSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SEOSeverity.Descriptor instead.
+func (SEOSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{6}
+}
+
+// Severity of a publish quality gate violation
+type PublishRuleSeverity int32
+
+const (
+	PublishRuleSeverity_PUBLISH_RULE_SEVERITY_UNSPECIFIED PublishRuleSeverity = 0
+	// Reported, but does not stop publishing
+	PublishRuleSeverity_PUBLISH_RULE_SEVERITY_WARNING PublishRuleSeverity = 1
+	// Stops publishing unless an admin overrides it
+	PublishRuleSeverity_PUBLISH_RULE_SEVERITY_ERROR PublishRuleSeverity = 2
+)
+
+// Enum value maps for PublishRuleSeverity.
+var (
+	PublishRuleSeverity_name = map[int32]string{
+		0: "PUBLISH_RULE_SEVERITY_UNSPECIFIED",
+		1: "PUBLISH_RULE_SEVERITY_WARNING",
+		2: "PUBLISH_RULE_SEVERITY_ERROR",
+	}
+	PublishRuleSeverity_value = map[string]int32{
+		"PUBLISH_RULE_SEVERITY_UNSPECIFIED": 0,
+		"PUBLISH_RULE_SEVERITY_WARNING":     1,
+		"PUBLISH_RULE_SEVERITY_ERROR":       2,
+	}
+)
+
+func (x PublishRuleSeverity) Enum() *PublishRuleSeverity {
+	p := new(PublishRuleSeverity)
+	*p = x
+	return p
+}
+
+func (x PublishRuleSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishRuleSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[7].Descriptor()
+}
+
+func (PublishRuleSeverity) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[7]
+}
+
+func (x PublishRuleSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishRuleSeverity.Descriptor instead.
+func (PublishRuleSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{7}
+}
+
+// Kind of resource a ContentEvent refers to
+type ContentResourceType int32
+
+const (
+	ContentResourceType_CONTENT_RESOURCE_TYPE_UNSPECIFIED ContentResourceType = 0
+	ContentResourceType_CONTENT_RESOURCE_TYPE_PAGE        ContentResourceType = 1
+	ContentResourceType_CONTENT_RESOURCE_TYPE_BLOG_POST   ContentResourceType = 2
+	ContentResourceType_CONTENT_RESOURCE_TYPE_MEDIA       ContentResourceType = 3
+)
+
+// Enum value maps for ContentResourceType.
+var (
+	ContentResourceType_name = map[int32]string{
+		0: "CONTENT_RESOURCE_TYPE_UNSPECIFIED",
+		1: "CONTENT_RESOURCE_TYPE_PAGE",
+		2: "CONTENT_RESOURCE_TYPE_BLOG_POST",
+		3: "CONTENT_RESOURCE_TYPE_MEDIA",
+	}
+	ContentResourceType_value = map[string]int32{
+		"CONTENT_RESOURCE_TYPE_UNSPECIFIED": 0,
+		"CONTENT_RESOURCE_TYPE_PAGE":        1,
+		"CONTENT_RESOURCE_TYPE_BLOG_POST":   2,
+		"CONTENT_RESOURCE_TYPE_MEDIA":       3,
+	}
+)
+
+func (x ContentResourceType) Enum() *ContentResourceType {
+	p := new(ContentResourceType)
+	*p = x
+	return p
+}
+
+func (x ContentResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[8].Descriptor()
+}
+
+func (ContentResourceType) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[8]
+}
+
+func (x ContentResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentResourceType.Descriptor instead.
+func (ContentResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{8}
+}
+
+// What happened to the resource
+type ContentEventAction int32
+
+const (
+	ContentEventAction_CONTENT_EVENT_ACTION_UNSPECIFIED ContentEventAction = 0
+	ContentEventAction_CONTENT_EVENT_ACTION_CREATED     ContentEventAction = 1
+	ContentEventAction_CONTENT_EVENT_ACTION_UPDATED     ContentEventAction = 2
+	ContentEventAction_CONTENT_EVENT_ACTION_PUBLISHED   ContentEventAction = 3
+	ContentEventAction_CONTENT_EVENT_ACTION_DELETED     ContentEventAction = 4
+)
+
+// Enum value maps for ContentEventAction.
+var (
+	ContentEventAction_name = map[int32]string{
+		0: "CONTENT_EVENT_ACTION_UNSPECIFIED",
+		1: "CONTENT_EVENT_ACTION_CREATED",
+		2: "CONTENT_EVENT_ACTION_UPDATED",
+		3: "CONTENT_EVENT_ACTION_PUBLISHED",
+		4: "CONTENT_EVENT_ACTION_DELETED",
+	}
+	ContentEventAction_value = map[string]int32{
+		"CONTENT_EVENT_ACTION_UNSPECIFIED": 0,
+		"CONTENT_EVENT_ACTION_CREATED":     1,
+		"CONTENT_EVENT_ACTION_UPDATED":     2,
+		"CONTENT_EVENT_ACTION_PUBLISHED":   3,
+		"CONTENT_EVENT_ACTION_DELETED":     4,
+	}
+)
+
+func (x ContentEventAction) Enum() *ContentEventAction {
+	p := new(ContentEventAction)
+	*p = x
+	return p
+}
+
+func (x ContentEventAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentEventAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[9].Descriptor()
+}
+
+func (ContentEventAction) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[9]
+}
+
+func (x ContentEventAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentEventAction.Descriptor instead.
+func (ContentEventAction) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{9}
 }
 
 // Page represents a content page
 type Page struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Content       *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Meta          *PageMeta              `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        PageStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug      string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Content   *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Meta      *PageMeta              `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status    PageStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// schema.org JSON-LD document (WebPage, BreadcrumbList, Organization); only populated by GetPage
+	JsonLd     string      `protobuf:"bytes,9,opt,name=json_ld,json=jsonLd,proto3" json:"json_ld,omitempty"`
+	Visibility *Visibility `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Output only: the caller may not read the page, so content holds only its teaser blocks
+	Teaser bool `protobuf:"varint,11,opt,name=teaser,proto3" json:"teaser,omitempty"`
+	// Output only: warnings from the publish quality gate when the update published the page
+	PublishWarnings []*PublishViolation `protobuf:"bytes,12,rep,name=publish_warnings,json=publishWarnings,proto3" json:"publish_warnings,omitempty"`
+	// The admin override that published the page despite blocking violations, if any
+	PublishOverride *PublishOverride `protobuf:"bytes,13,opt,name=publish_override,json=publishOverride,proto3" json:"publish_override,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Page) Reset() {
@@ -151,57 +653,4087 @@ func (x *Page) GetContent() *PageContent {
 	return nil
 }
 
-func (x *Page) GetMeta() *PageMeta {
+func (x *Page) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *Page) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *Page) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Page) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Page) GetJsonLd() string {
+	if x != nil {
+		return x.JsonLd
+	}
+	return ""
+}
+
+func (x *Page) GetVisibility() *Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *Page) GetTeaser() bool {
+	if x != nil {
+		return x.Teaser
+	}
+	return false
+}
+
+func (x *Page) GetPublishWarnings() []*PublishViolation {
+	if x != nil {
+		return x.PublishWarnings
+	}
+	return nil
+}
+
+func (x *Page) GetPublishOverride() *PublishOverride {
+	if x != nil {
+		return x.PublishOverride
+	}
+	return nil
+}
+
+// Page content structure
+type PageContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocks        []*ContentBlock        `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageContent) Reset() {
+	*x = PageContent{}
+	mi := &file_content_v1_content_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageContent) ProtoMessage() {}
+
+func (x *PageContent) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageContent.ProtoReflect.Descriptor instead.
+func (*PageContent) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PageContent) GetBlocks() []*ContentBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+// Content block for flexible page content
+type ContentBlock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Data  map[string]string      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Blocks of the referenced reusable block; only set on reads of "reusable" blocks
+	ResolvedBlocks []*ContentBlock `protobuf:"bytes,3,rep,name=resolved_blocks,json=resolvedBlocks,proto3" json:"resolved_blocks,omitempty"`
+	// oEmbed data of "embed" blocks; only set on reads when the URL could be resolved
+	Embed         *Embed `protobuf:"bytes,4,opt,name=embed,proto3" json:"embed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentBlock) Reset() {
+	*x = ContentBlock{}
+	mi := &file_content_v1_content_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentBlock) ProtoMessage() {}
+
+func (x *ContentBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentBlock.ProtoReflect.Descriptor instead.
+func (*ContentBlock) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ContentBlock) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContentBlock) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ContentBlock) GetResolvedBlocks() []*ContentBlock {
+	if x != nil {
+		return x.ResolvedBlocks
+	}
+	return nil
+}
+
+func (x *ContentBlock) GetEmbed() *Embed {
+	if x != nil {
+		return x.Embed
+	}
+	return nil
+}
+
+// Resolved oEmbed data of an "embed" content block
+type Embed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// oEmbed type, e.g. "video" or "rich"
+	Type         string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ProviderName string `protobuf:"bytes,2,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	Title        string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	AuthorName   string `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Width        int32  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height       int32  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// Sanitized HTML, either an iframe on the provider's embed host or a sandboxed srcdoc iframe
+	Html          string `protobuf:"bytes,8,opt,name=html,proto3" json:"html,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Embed) Reset() {
+	*x = Embed{}
+	mi := &file_content_v1_content_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Embed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Embed) ProtoMessage() {}
+
+func (x *Embed) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Embed.ProtoReflect.Descriptor instead.
+func (*Embed) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Embed) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Embed) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *Embed) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Embed) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Embed) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Embed) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Embed) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Embed) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+// Page metadata for SEO
+type PageMeta struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Keywords    []string               `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	// Open Graph title; defaults to the meta title
+	OgTitle string `protobuf:"bytes,4,opt,name=og_title,json=ogTitle,proto3" json:"og_title,omitempty"`
+	// Open Graph description; defaults to the meta description
+	OgDescription string `protobuf:"bytes,5,opt,name=og_description,json=ogDescription,proto3" json:"og_description,omitempty"`
+	// Media ID of the social share image
+	OgImage string `protobuf:"bytes,6,opt,name=og_image,json=ogImage,proto3" json:"og_image,omitempty"`
+	// Absolute URL of og_image; output only, set by GetPage and GetBlogPost
+	OgImageUrl string `protobuf:"bytes,7,opt,name=og_image_url,json=ogImageUrl,proto3" json:"og_image_url,omitempty"`
+	// Absolute canonical URL; defaults to the item's own URL
+	CanonicalUrl string `protobuf:"bytes,8,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`
+	// Robots meta directives, e.g. "noindex, nofollow"
+	Robots        string          `protobuf:"bytes,9,opt,name=robots,proto3" json:"robots,omitempty"`
+	TwitterCard   TwitterCardType `protobuf:"varint,10,opt,name=twitter_card,json=twitterCard,proto3,enum=content.v1.TwitterCardType" json:"twitter_card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageMeta) Reset() {
+	*x = PageMeta{}
+	mi := &file_content_v1_content_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageMeta) ProtoMessage() {}
+
+func (x *PageMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageMeta.ProtoReflect.Descriptor instead.
+func (*PageMeta) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PageMeta) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PageMeta) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PageMeta) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *PageMeta) GetOgTitle() string {
+	if x != nil {
+		return x.OgTitle
+	}
+	return ""
+}
+
+func (x *PageMeta) GetOgDescription() string {
+	if x != nil {
+		return x.OgDescription
+	}
+	return ""
+}
+
+func (x *PageMeta) GetOgImage() string {
+	if x != nil {
+		return x.OgImage
+	}
+	return ""
+}
+
+func (x *PageMeta) GetOgImageUrl() string {
+	if x != nil {
+		return x.OgImageUrl
+	}
+	return ""
+}
+
+func (x *PageMeta) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *PageMeta) GetRobots() string {
+	if x != nil {
+		return x.Robots
+	}
+	return ""
+}
+
+func (x *PageMeta) GetTwitterCard() TwitterCardType {
+	if x != nil {
+		return x.TwitterCard
+	}
+	return TwitterCardType_TWITTER_CARD_TYPE_UNSPECIFIED
+}
+
+// Visibility is the access rule of a page or post. Readers without access get a teaser:
+// the excerpt and the first teaser_blocks content blocks.
+type Visibility struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Level VisibilityLevel        `protobuf:"varint,1,opt,name=level,proto3,enum=content.v1.VisibilityLevel" json:"level,omitempty"`
+	// Roles (admin, editor, viewer) granted access to restricted content
+	Roles []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	// User groups granted access to restricted content
+	Groups        []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	TeaserBlocks  int32    `protobuf:"varint,4,opt,name=teaser_blocks,json=teaserBlocks,proto3" json:"teaser_blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Visibility) Reset() {
+	*x = Visibility{}
+	mi := &file_content_v1_content_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Visibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Visibility) ProtoMessage() {}
+
+func (x *Visibility) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Visibility.ProtoReflect.Descriptor instead.
+func (*Visibility) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Visibility) GetLevel() VisibilityLevel {
+	if x != nil {
+		return x.Level
+	}
+	return VisibilityLevel_VISIBILITY_LEVEL_UNSPECIFIED
+}
+
+func (x *Visibility) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Visibility) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Visibility) GetTeaserBlocks() int32 {
+	if x != nil {
+		return x.TeaserBlocks
+	}
+	return 0
+}
+
+// Request messages
+type CreatePageRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Slug    string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Content *PageContent           `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Meta    *PageMeta              `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status  PageStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	// Defaults to public when unset
+	Visibility *Visibility `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Publishes despite blocking quality gate violations; admins only
+	PublishOverrideReason string `protobuf:"bytes,7,opt,name=publish_override_reason,json=publishOverrideReason,proto3" json:"publish_override_reason,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreatePageRequest) Reset() {
+	*x = CreatePageRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePageRequest) ProtoMessage() {}
+
+func (x *CreatePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePageRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePageRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePageRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreatePageRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreatePageRequest) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreatePageRequest) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *CreatePageRequest) GetVisibility() *Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *CreatePageRequest) GetPublishOverrideReason() string {
+	if x != nil {
+		return x.PublishOverrideReason
+	}
+	return ""
+}
+
+type GetPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPageRequest) Reset() {
+	*x = GetPageRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPageRequest) ProtoMessage() {}
+
+func (x *GetPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPageRequest.ProtoReflect.Descriptor instead.
+func (*GetPageRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdatePageRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug    string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Content *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Meta    *PageMeta              `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status  PageStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	// Left unchanged when unset
+	Visibility *Visibility `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Publishes despite blocking quality gate violations; admins only
+	PublishOverrideReason string `protobuf:"bytes,8,opt,name=publish_override_reason,json=publishOverrideReason,proto3" json:"publish_override_reason,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdatePageRequest) Reset() {
+	*x = UpdatePageRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePageRequest) ProtoMessage() {}
+
+func (x *UpdatePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePageRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePageRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePageRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePageRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdatePageRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UpdatePageRequest) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdatePageRequest) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *UpdatePageRequest) GetVisibility() *Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *UpdatePageRequest) GetPublishOverrideReason() string {
+	if x != nil {
+		return x.PublishOverrideReason
+	}
+	return ""
+}
+
+type DeletePageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePageRequest) Reset() {
+	*x = DeletePageRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePageRequest) ProtoMessage() {}
+
+func (x *DeletePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePageRequest.ProtoReflect.Descriptor instead.
+func (*DeletePageRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeletePageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Status        PageStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	Search        string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPagesRequest) Reset() {
+	*x = ListPagesRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPagesRequest) ProtoMessage() {}
+
+func (x *ListPagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPagesRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPagesRequest) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ListPagesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListPagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         []*Page                `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPagesResponse) Reset() {
+	*x = ListPagesResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPagesResponse) ProtoMessage() {}
+
+func (x *ListPagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPagesResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPagesResponse) GetPages() []*Page {
+	if x != nil {
+		return x.Pages
+	}
+	return nil
+}
+
+func (x *ListPagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPagesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// BlogPost represents a blog post
+type BlogPost struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug       string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Excerpt    string                 `protobuf:"bytes,4,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	Content    *PageContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Meta       *PageMeta              `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status     PageStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	Author     string                 `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	Categories []string               `protobuf:"bytes,9,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags       []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Media ID of the featured image
+	FeaturedImage   string                 `protobuf:"bytes,11,opt,name=featured_image,json=featuredImage,proto3" json:"featured_image,omitempty"`
+	PublishedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CommentsEnabled bool                   `protobuf:"varint,15,opt,name=comments_enabled,json=commentsEnabled,proto3" json:"comments_enabled,omitempty"`
+	// Number of approved comments
+	CommentCount int32 `protobuf:"varint,16,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// Navigation for every series the post belongs to; only populated by GetBlogPost
+	Series []*SeriesNavigation `protobuf:"bytes,17,rep,name=series,proto3" json:"series,omitempty"`
+	// schema.org JSON-LD document (Article, BreadcrumbList, Organization); only populated by GetBlogPost
+	JsonLd string `protobuf:"bytes,18,opt,name=json_ld,json=jsonLd,proto3" json:"json_ld,omitempty"`
+	// The resolved featured_image; output only, unset when the media file no longer exists
+	FeaturedImageMedia *ImageAsset `protobuf:"bytes,19,opt,name=featured_image_media,json=featuredImageMedia,proto3" json:"featured_image_media,omitempty"`
+	Visibility         *Visibility `protobuf:"bytes,20,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Output only: the caller may not read the post, so content holds only its teaser blocks
+	Teaser bool `protobuf:"varint,21,opt,name=teaser,proto3" json:"teaser,omitempty"`
+	// Output only: warnings from the publish quality gate when the update published the post
+	PublishWarnings []*PublishViolation `protobuf:"bytes,25,rep,name=publish_warnings,json=publishWarnings,proto3" json:"publish_warnings,omitempty"`
+	// The admin override that published the post despite blocking violations, if any
+	PublishOverride *PublishOverride `protobuf:"bytes,26,opt,name=publish_override,json=publishOverride,proto3" json:"publish_override,omitempty"`
+	// Pinned posts are listed before all others until pinned_until, if set
+	Pinned      bool                   `protobuf:"varint,22,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	PinnedUntil *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=pinned_until,json=pinnedUntil,proto3" json:"pinned_until,omitempty"`
+	// Position in the manual sort order; lower weights come first
+	SortWeight    int32 `protobuf:"varint,24,opt,name=sort_weight,json=sortWeight,proto3" json:"sort_weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogPost) Reset() {
+	*x = BlogPost{}
+	mi := &file_content_v1_content_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogPost) ProtoMessage() {}
+
+func (x *BlogPost) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogPost.ProtoReflect.Descriptor instead.
+func (*BlogPost) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlogPost) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlogPost) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BlogPost) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *BlogPost) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *BlogPost) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *BlogPost) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *BlogPost) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *BlogPost) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *BlogPost) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *BlogPost) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BlogPost) GetFeaturedImage() string {
+	if x != nil {
+		return x.FeaturedImage
+	}
+	return ""
+}
+
+func (x *BlogPost) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *BlogPost) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BlogPost) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BlogPost) GetCommentsEnabled() bool {
+	if x != nil {
+		return x.CommentsEnabled
+	}
+	return false
+}
+
+func (x *BlogPost) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *BlogPost) GetSeries() []*SeriesNavigation {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *BlogPost) GetJsonLd() string {
+	if x != nil {
+		return x.JsonLd
+	}
+	return ""
+}
+
+func (x *BlogPost) GetFeaturedImageMedia() *ImageAsset {
+	if x != nil {
+		return x.FeaturedImageMedia
+	}
+	return nil
+}
+
+func (x *BlogPost) GetVisibility() *Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *BlogPost) GetTeaser() bool {
+	if x != nil {
+		return x.Teaser
+	}
+	return false
+}
+
+func (x *BlogPost) GetPublishWarnings() []*PublishViolation {
+	if x != nil {
+		return x.PublishWarnings
+	}
+	return nil
+}
+
+func (x *BlogPost) GetPublishOverride() *PublishOverride {
+	if x != nil {
+		return x.PublishOverride
+	}
+	return nil
+}
+
+func (x *BlogPost) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *BlogPost) GetPinnedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedUntil
+	}
+	return nil
+}
+
+func (x *BlogPost) GetSortWeight() int32 {
+	if x != nil {
+		return x.SortWeight
+	}
+	return 0
+}
+
+// ImageAsset is a media file resolved for display
+type ImageAsset struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url      string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Width    int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height   int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	AltText  string                 `protobuf:"bytes,5,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	MimeType string                 `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// Downscaled renditions, smallest first
+	Variants      []*ImageVariant `protobuf:"bytes,7,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageAsset) Reset() {
+	*x = ImageAsset{}
+	mi := &file_content_v1_content_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageAsset) ProtoMessage() {}
+
+func (x *ImageAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageAsset.ProtoReflect.Descriptor instead.
+func (*ImageAsset) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImageAsset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageAsset) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageAsset) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageAsset) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageAsset) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *ImageAsset) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ImageAsset) GetVariants() []*ImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// ImageVariant is a downscaled rendition of an image
+type ImageVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
+	mi := &file_content_v1_content_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariant) ProtoMessage() {}
+
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImageVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// SeriesNavigation locates a post within an ordered series
+type SeriesNavigation struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Slug     string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// 1-based position of the post among the series' published posts
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Total    int32 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// Unset on the first post
+	Previous *PostLink `protobuf:"bytes,6,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Unset on the last post
+	Next          *PostLink `protobuf:"bytes,7,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesNavigation) Reset() {
+	*x = SeriesNavigation{}
+	mi := &file_content_v1_content_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesNavigation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesNavigation) ProtoMessage() {}
+
+func (x *SeriesNavigation) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesNavigation.ProtoReflect.Descriptor instead.
+func (*SeriesNavigation) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SeriesNavigation) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *SeriesNavigation) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SeriesNavigation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SeriesNavigation) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SeriesNavigation) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SeriesNavigation) GetPrevious() *PostLink {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *SeriesNavigation) GetNext() *PostLink {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+// PostLink is a lightweight reference to a blog post
+type PostLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostLink) Reset() {
+	*x = PostLink{}
+	mi := &file_content_v1_content_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLink) ProtoMessage() {}
+
+func (x *PostLink) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLink.ProtoReflect.Descriptor instead.
+func (*PostLink) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PostLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostLink) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// Blog post request messages
+type CreateBlogPostRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Title      string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Slug       string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Excerpt    string                 `protobuf:"bytes,3,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	Content    *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Meta       *PageMeta              `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status     PageStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	Author     string                 `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	Categories []string               `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags       []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Media ID of an image; an /uploads/ URL of a known file is converted to its ID
+	FeaturedImage string                 `protobuf:"bytes,10,opt,name=featured_image,json=featuredImage,proto3" json:"featured_image,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// Defaults to true when unset
+	CommentsEnabled *bool `protobuf:"varint,12,opt,name=comments_enabled,json=commentsEnabled,proto3,oneof" json:"comments_enabled,omitempty"`
+	// Defaults to public when unset
+	Visibility *Visibility `protobuf:"bytes,13,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Pinned     bool        `protobuf:"varint,14,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Unpins the post automatically at this time; requires pinned
+	PinnedUntil *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=pinned_until,json=pinnedUntil,proto3" json:"pinned_until,omitempty"`
+	SortWeight  int32                  `protobuf:"varint,16,opt,name=sort_weight,json=sortWeight,proto3" json:"sort_weight,omitempty"`
+	// Publishes despite blocking quality gate violations; admins only
+	PublishOverrideReason string `protobuf:"bytes,17,opt,name=publish_override_reason,json=publishOverrideReason,proto3" json:"publish_override_reason,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateBlogPostRequest) Reset() {
+	*x = CreateBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlogPostRequest) ProtoMessage() {}
+
+func (x *CreateBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateBlogPostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateBlogPostRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateBlogPostRequest) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *CreateBlogPostRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreateBlogPostRequest) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateBlogPostRequest) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *CreateBlogPostRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CreateBlogPostRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CreateBlogPostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateBlogPostRequest) GetFeaturedImage() string {
+	if x != nil {
+		return x.FeaturedImage
+	}
+	return ""
+}
+
+func (x *CreateBlogPostRequest) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *CreateBlogPostRequest) GetCommentsEnabled() bool {
+	if x != nil && x.CommentsEnabled != nil {
+		return *x.CommentsEnabled
+	}
+	return false
+}
+
+func (x *CreateBlogPostRequest) GetVisibility() *Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *CreateBlogPostRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *CreateBlogPostRequest) GetPinnedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedUntil
+	}
+	return nil
+}
+
+func (x *CreateBlogPostRequest) GetSortWeight() int32 {
+	if x != nil {
+		return x.SortWeight
+	}
+	return 0
+}
+
+func (x *CreateBlogPostRequest) GetPublishOverrideReason() string {
+	if x != nil {
+		return x.PublishOverrideReason
+	}
+	return ""
+}
+
+type GetBlogPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogPostRequest) Reset() {
+	*x = GetBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogPostRequest) ProtoMessage() {}
+
+func (x *GetBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*GetBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBlogPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateBlogPostRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug       string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Excerpt    string                 `protobuf:"bytes,4,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+	Content    *PageContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Meta       *PageMeta              `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status     PageStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	Author     string                 `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	Categories []string               `protobuf:"bytes,9,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags       []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Media ID of an image; an /uploads/ URL of a known file is converted to its ID
+	FeaturedImage string                 `protobuf:"bytes,11,opt,name=featured_image,json=featuredImage,proto3" json:"featured_image,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// Left unchanged when unset
+	CommentsEnabled *bool `protobuf:"varint,13,opt,name=comments_enabled,json=commentsEnabled,proto3,oneof" json:"comments_enabled,omitempty"`
+	// Left unchanged when unset
+	Visibility *Visibility `protobuf:"bytes,14,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Left unchanged when unset; unpinning also clears pinned_until
+	Pinned *bool `protobuf:"varint,15,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`
+	// Left unchanged when unset; requires the post to be pinned
+	PinnedUntil *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=pinned_until,json=pinnedUntil,proto3" json:"pinned_until,omitempty"`
+	// Left unchanged when unset
+	SortWeight *int32 `protobuf:"varint,17,opt,name=sort_weight,json=sortWeight,proto3,oneof" json:"sort_weight,omitempty"`
+	// Publishes despite blocking quality gate violations; admins only
+	PublishOverrideReason string `protobuf:"bytes,18,opt,name=publish_override_reason,json=publishOverrideReason,proto3" json:"publish_override_reason,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateBlogPostRequest) Reset() {
+	*x = UpdateBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBlogPostRequest) ProtoMessage() {}
+
+func (x *UpdateBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateBlogPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBlogPostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateBlogPostRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateBlogPostRequest) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+func (x *UpdateBlogPostRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UpdateBlogPostRequest) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateBlogPostRequest) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateBlogPostRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *UpdateBlogPostRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UpdateBlogPostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateBlogPostRequest) GetFeaturedImage() string {
+	if x != nil {
+		return x.FeaturedImage
+	}
+	return ""
+}
+
+func (x *UpdateBlogPostRequest) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *UpdateBlogPostRequest) GetCommentsEnabled() bool {
+	if x != nil && x.CommentsEnabled != nil {
+		return *x.CommentsEnabled
+	}
+	return false
+}
+
+func (x *UpdateBlogPostRequest) GetVisibility() *Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *UpdateBlogPostRequest) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *UpdateBlogPostRequest) GetPinnedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedUntil
+	}
+	return nil
+}
+
+func (x *UpdateBlogPostRequest) GetSortWeight() int32 {
+	if x != nil && x.SortWeight != nil {
+		return *x.SortWeight
+	}
+	return 0
+}
+
+func (x *UpdateBlogPostRequest) GetPublishOverrideReason() string {
+	if x != nil {
+		return x.PublishOverrideReason
+	}
+	return ""
+}
+
+type DeleteBlogPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlogPostRequest) Reset() {
+	*x = DeleteBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlogPostRequest) ProtoMessage() {}
+
+func (x *DeleteBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteBlogPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBlogPostsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Status    PageStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	Category  string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tag       string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	Author    string                 `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	// Only published posts with published_at >= published_after; combines with category, tag or author
+	PublishedAfter *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=published_after,json=publishedAfter,proto3" json:"published_after,omitempty"`
+	// Only published posts with published_at < published_before; combines with category, tag or author
+	PublishedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_before,json=publishedBefore,proto3" json:"published_before,omitempty"`
+	// Pinned posts come first in every sort order. Publication date ranges are only listed by
+	// BLOG_POST_SORT_PUBLISHED_AT.
+	Sort          BlogPostSort `protobuf:"varint,9,opt,name=sort,proto3,enum=content.v1.BlogPostSort" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlogPostsRequest) Reset() {
+	*x = ListBlogPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlogPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlogPostsRequest) ProtoMessage() {}
+
+func (x *ListBlogPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlogPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlogPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListBlogPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBlogPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBlogPostsRequest) GetStatus() PageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PageStatus_PAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ListBlogPostsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListBlogPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListBlogPostsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ListBlogPostsRequest) GetPublishedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAfter
+	}
+	return nil
+}
+
+func (x *ListBlogPostsRequest) GetPublishedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedBefore
+	}
+	return nil
+}
+
+func (x *ListBlogPostsRequest) GetSort() BlogPostSort {
+	if x != nil {
+		return x.Sort
+	}
+	return BlogPostSort_BLOG_POST_SORT_UNSPECIFIED
+}
+
+type ListBlogPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*BlogPost            `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlogPostsResponse) Reset() {
+	*x = ListBlogPostsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlogPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlogPostsResponse) ProtoMessage() {}
+
+func (x *ListBlogPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlogPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlogPostsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListBlogPostsResponse) GetPosts() []*BlogPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListBlogPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListBlogPostsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type SearchBlogPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBlogPostsRequest) Reset() {
+	*x = SearchBlogPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBlogPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlogPostsRequest) ProtoMessage() {}
+
+func (x *SearchBlogPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlogPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchBlogPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchBlogPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchBlogPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchBlogPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchBlogPostsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchBlogPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type SearchBlogPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*BlogPost            `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBlogPostsResponse) Reset() {
+	*x = SearchBlogPostsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBlogPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlogPostsResponse) ProtoMessage() {}
+
+func (x *SearchBlogPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlogPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchBlogPostsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchBlogPostsResponse) GetPosts() []*BlogPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *SearchBlogPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchBlogPostsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetBlogCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogCategoriesRequest) Reset() {
+	*x = GetBlogCategoriesRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogCategoriesRequest) ProtoMessage() {}
+
+func (x *GetBlogCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetBlogCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{25}
+}
+
+type GetBlogCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*BlogCategory        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogCategoriesResponse) Reset() {
+	*x = GetBlogCategoriesResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogCategoriesResponse) ProtoMessage() {}
+
+func (x *GetBlogCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetBlogCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetBlogCategoriesResponse) GetCategories() []*BlogCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type BlogCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	PostCount     int32                  `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogCategory) Reset() {
+	*x = BlogCategory{}
+	mi := &file_content_v1_content_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogCategory) ProtoMessage() {}
+
+func (x *BlogCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogCategory.ProtoReflect.Descriptor instead.
+func (*BlogCategory) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BlogCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlogCategory) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *BlogCategory) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+type GetBlogTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogTagsRequest) Reset() {
+	*x = GetBlogTagsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogTagsRequest) ProtoMessage() {}
+
+func (x *GetBlogTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetBlogTagsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{28}
+}
+
+type GetBlogTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*BlogTag             `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogTagsResponse) Reset() {
+	*x = GetBlogTagsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogTagsResponse) ProtoMessage() {}
+
+func (x *GetBlogTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetBlogTagsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBlogTagsResponse) GetTags() []*BlogTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetBlogArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogArchiveRequest) Reset() {
+	*x = GetBlogArchiveRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogArchiveRequest) ProtoMessage() {}
+
+func (x *GetBlogArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogArchiveRequest.ProtoReflect.Descriptor instead.
+func (*GetBlogArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{30}
+}
+
+type GetBlogArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Years         []*BlogArchiveYear     `protobuf:"bytes,1,rep,name=years,proto3" json:"years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogArchiveResponse) Reset() {
+	*x = GetBlogArchiveResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogArchiveResponse) ProtoMessage() {}
+
+func (x *GetBlogArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogArchiveResponse.ProtoReflect.Descriptor instead.
+func (*GetBlogArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetBlogArchiveResponse) GetYears() []*BlogArchiveYear {
+	if x != nil {
+		return x.Years
+	}
+	return nil
+}
+
+type BlogArchiveYear struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Year      int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	PostCount int32                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	// Months with published posts, newest first
+	Months        []*BlogArchiveMonth `protobuf:"bytes,3,rep,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogArchiveYear) Reset() {
+	*x = BlogArchiveYear{}
+	mi := &file_content_v1_content_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogArchiveYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogArchiveYear) ProtoMessage() {}
+
+func (x *BlogArchiveYear) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogArchiveYear.ProtoReflect.Descriptor instead.
+func (*BlogArchiveYear) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BlogArchiveYear) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *BlogArchiveYear) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *BlogArchiveYear) GetMonths() []*BlogArchiveMonth {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+type BlogArchiveMonth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1 (January) to 12
+	Month         int32 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	PostCount     int32 `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogArchiveMonth) Reset() {
+	*x = BlogArchiveMonth{}
+	mi := &file_content_v1_content_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogArchiveMonth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogArchiveMonth) ProtoMessage() {}
+
+func (x *BlogArchiveMonth) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogArchiveMonth.ProtoReflect.Descriptor instead.
+func (*BlogArchiveMonth) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BlogArchiveMonth) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *BlogArchiveMonth) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+type BlogTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	PostCount     int32                  `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogTag) Reset() {
+	*x = BlogTag{}
+	mi := &file_content_v1_content_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogTag) ProtoMessage() {}
+
+func (x *BlogTag) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogTag.ProtoReflect.Descriptor instead.
+func (*BlogTag) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BlogTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlogTag) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *BlogTag) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+type GetRelatedPostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of posts to return, 1-12; defaults to 4
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedPostsRequest) Reset() {
+	*x = GetRelatedPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedPostsRequest) ProtoMessage() {}
+
+func (x *GetRelatedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetRelatedPostsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRelatedPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRelatedPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most related first
+	Posts         []*BlogPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedPostsResponse) Reset() {
+	*x = GetRelatedPostsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedPostsResponse) ProtoMessage() {}
+
+func (x *GetRelatedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetRelatedPostsResponse) GetPosts() []*BlogPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetRSSFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRSSFeedRequest) Reset() {
+	*x = GetRSSFeedRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRSSFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRSSFeedRequest) ProtoMessage() {}
+
+func (x *GetRSSFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRSSFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetRSSFeedRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{37}
+}
+
+type GetRSSFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	XmlContent    string                 `protobuf:"bytes,1,opt,name=xml_content,json=xmlContent,proto3" json:"xml_content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRSSFeedResponse) Reset() {
+	*x = GetRSSFeedResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRSSFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRSSFeedResponse) ProtoMessage() {}
+
+func (x *GetRSSFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRSSFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetRSSFeedResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRSSFeedResponse) GetXmlContent() string {
+	if x != nil {
+		return x.XmlContent
+	}
+	return ""
+}
+
+func (x *GetRSSFeedResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// ReusableBlock is a named set of content blocks that pages and posts embed by reference
+// using a ContentBlock of type "reusable" with data {"block_id": "<id>"}.
+type ReusableBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Content       *PageContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	UsageCount    int32                  `protobuf:"varint,6,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReusableBlock) Reset() {
+	*x = ReusableBlock{}
+	mi := &file_content_v1_content_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReusableBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReusableBlock) ProtoMessage() {}
+
+func (x *ReusableBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReusableBlock.ProtoReflect.Descriptor instead.
+func (*ReusableBlock) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReusableBlock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReusableBlock) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReusableBlock) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ReusableBlock) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ReusableBlock) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ReusableBlock) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *ReusableBlock) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReusableBlock) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateReusableBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Content       *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReusableBlockRequest) Reset() {
+	*x = CreateReusableBlockRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReusableBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReusableBlockRequest) ProtoMessage() {}
+
+func (x *CreateReusableBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReusableBlockRequest.ProtoReflect.Descriptor instead.
+func (*CreateReusableBlockRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateReusableBlockRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateReusableBlockRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateReusableBlockRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateReusableBlockRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetReusableBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReusableBlockRequest) Reset() {
+	*x = GetReusableBlockRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReusableBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReusableBlockRequest) ProtoMessage() {}
+
+func (x *GetReusableBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReusableBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetReusableBlockRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetReusableBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateReusableBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Content       *PageContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReusableBlockRequest) Reset() {
+	*x = UpdateReusableBlockRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReusableBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReusableBlockRequest) ProtoMessage() {}
+
+func (x *UpdateReusableBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReusableBlockRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReusableBlockRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateReusableBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateReusableBlockRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateReusableBlockRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateReusableBlockRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateReusableBlockRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DeleteReusableBlockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Delete even if pages or posts still embed the block
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReusableBlockRequest) Reset() {
+	*x = DeleteReusableBlockRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReusableBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReusableBlockRequest) ProtoMessage() {}
+
+func (x *DeleteReusableBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReusableBlockRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReusableBlockRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteReusableBlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteReusableBlockRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ListReusableBlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReusableBlocksRequest) Reset() {
+	*x = ListReusableBlocksRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReusableBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReusableBlocksRequest) ProtoMessage() {}
+
+func (x *ListReusableBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReusableBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListReusableBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListReusableBlocksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReusableBlocksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReusableBlocksRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListReusableBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocks        []*ReusableBlock       `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReusableBlocksResponse) Reset() {
+	*x = ListReusableBlocksResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReusableBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReusableBlocksResponse) ProtoMessage() {}
+
+func (x *ListReusableBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReusableBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListReusableBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListReusableBlocksResponse) GetBlocks() []*ReusableBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *ListReusableBlocksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListReusableBlocksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ListReusableBlockUsagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReusableBlockUsagesRequest) Reset() {
+	*x = ListReusableBlockUsagesRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReusableBlockUsagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReusableBlockUsagesRequest) ProtoMessage() {}
+
+func (x *ListReusableBlockUsagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReusableBlockUsagesRequest.ProtoReflect.Descriptor instead.
+func (*ListReusableBlockUsagesRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListReusableBlockUsagesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ReusableBlockUsage identifies a page or post that embeds a reusable block
+type ReusableBlockUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ContentId     string                 `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReusableBlockUsage) Reset() {
+	*x = ReusableBlockUsage{}
+	mi := &file_content_v1_content_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReusableBlockUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReusableBlockUsage) ProtoMessage() {}
+
+func (x *ReusableBlockUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReusableBlockUsage.ProtoReflect.Descriptor instead.
+func (*ReusableBlockUsage) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReusableBlockUsage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ReusableBlockUsage) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *ReusableBlockUsage) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ReusableBlockUsage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type ListReusableBlockUsagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usages        []*ReusableBlockUsage  `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReusableBlockUsagesResponse) Reset() {
+	*x = ListReusableBlockUsagesResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReusableBlockUsagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReusableBlockUsagesResponse) ProtoMessage() {}
+
+func (x *ListReusableBlockUsagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReusableBlockUsagesResponse.ProtoReflect.Descriptor instead.
+func (*ListReusableBlockUsagesResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListReusableBlockUsagesResponse) GetUsages() []*ReusableBlockUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+type DuplicatePageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Title of the copy; defaults to "<original title> (Copy)"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Slug of the copy; defaults to a unique slug derived from the original
+	Slug          string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicatePageRequest) Reset() {
+	*x = DuplicatePageRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicatePageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicatePageRequest) ProtoMessage() {}
+
+func (x *DuplicatePageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicatePageRequest.ProtoReflect.Descriptor instead.
+func (*DuplicatePageRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DuplicatePageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DuplicatePageRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DuplicatePageRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type DuplicateBlogPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Title of the copy; defaults to "<original title> (Copy)"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Slug of the copy; defaults to a unique slug derived from the original
+	Slug          string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateBlogPostRequest) Reset() {
+	*x = DuplicateBlogPostRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateBlogPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateBlogPostRequest) ProtoMessage() {}
+
+func (x *DuplicateBlogPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateBlogPostRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateBlogPostRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DuplicateBlogPostRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DuplicateBlogPostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DuplicateBlogPostRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// PageTemplate is a named, versioned page skeleton with default meta.
+// Every update creates a new version; earlier versions stay available.
+type PageTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Version       int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Content       *PageContent           `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Meta          *PageMeta              `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageTemplate) Reset() {
+	*x = PageTemplate{}
+	mi := &file_content_v1_content_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageTemplate) ProtoMessage() {}
+
+func (x *PageTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageTemplate.ProtoReflect.Descriptor instead.
+func (*PageTemplate) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PageTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PageTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PageTemplate) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PageTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PageTemplate) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PageTemplate) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *PageTemplate) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PageTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PageTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePageTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Content       *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Meta          *PageMeta              `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePageTemplateRequest) Reset() {
+	*x = CreatePageTemplateRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePageTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePageTemplateRequest) ProtoMessage() {}
+
+func (x *CreatePageTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePageTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreatePageTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreatePageTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePageTemplateRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreatePageTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePageTemplateRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreatePageTemplateRequest) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type GetPageTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version to return; 0 returns the latest
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPageTemplateRequest) Reset() {
+	*x = GetPageTemplateRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPageTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPageTemplateRequest) ProtoMessage() {}
+
+func (x *GetPageTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPageTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetPageTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetPageTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPageTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdatePageTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Content       *PageContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Meta          *PageMeta              `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePageTemplateRequest) Reset() {
+	*x = UpdatePageTemplateRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePageTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePageTemplateRequest) ProtoMessage() {}
+
+func (x *UpdatePageTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePageTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePageTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdatePageTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePageTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePageTemplateRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdatePageTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePageTemplateRequest) GetContent() *PageContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UpdatePageTemplateRequest) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type DeletePageTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePageTemplateRequest) Reset() {
+	*x = DeletePageTemplateRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePageTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePageTemplateRequest) ProtoMessage() {}
+
+func (x *DeletePageTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePageTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeletePageTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeletePageTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPageTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPageTemplatesRequest) Reset() {
+	*x = ListPageTemplatesRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPageTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPageTemplatesRequest) ProtoMessage() {}
+
+func (x *ListPageTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[56]
 	if x != nil {
-		return x.Meta
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Page) GetStatus() PageStatus {
+// Deprecated: Use ListPageTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListPageTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListPageTemplatesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Status
+		return x.PageSize
 	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *Page) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListPageTemplatesRequest) GetPageToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Page) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListPageTemplatesRequest) GetSearch() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Search
 	}
-	return nil
+	return ""
 }
 
-// Page content structure
-type PageContent struct {
+type ListPageTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Blocks        []*ContentBlock        `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Templates     []*PageTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageContent) Reset() {
-	*x = PageContent{}
-	mi := &file_content_v1_content_proto_msgTypes[1]
+func (x *ListPageTemplatesResponse) Reset() {
+	*x = ListPageTemplatesResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageContent) String() string {
+func (x *ListPageTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageContent) ProtoMessage() {}
+func (*ListPageTemplatesResponse) ProtoMessage() {}
 
-func (x *PageContent) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[1]
+func (x *ListPageTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,42 +4744,61 @@ func (x *PageContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageContent.ProtoReflect.Descriptor instead.
-func (*PageContent) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListPageTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListPageTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *PageContent) GetBlocks() []*ContentBlock {
+func (x *ListPageTemplatesResponse) GetTemplates() []*PageTemplate {
 	if x != nil {
-		return x.Blocks
+		return x.Templates
 	}
 	return nil
 }
 
-// Content block for flexible page content
-type ContentBlock struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Data          map[string]string      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *ListPageTemplatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPageTemplatesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CreatePageFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Template version to instantiate; 0 uses the latest
+	TemplateVersion int32  `protobuf:"varint,2,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	Title           string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Defaults to a unique slug generated from the title
+	Slug string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Overrides the template's default meta field by field when set
+	Meta          *PageMeta `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContentBlock) Reset() {
-	*x = ContentBlock{}
-	mi := &file_content_v1_content_proto_msgTypes[2]
+func (x *CreatePageFromTemplateRequest) Reset() {
+	*x = CreatePageFromTemplateRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContentBlock) String() string {
+func (x *CreatePageFromTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContentBlock) ProtoMessage() {}
+func (*CreatePageFromTemplateRequest) ProtoMessage() {}
 
-func (x *ContentBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[2]
+func (x *CreatePageFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,50 +4809,79 @@ func (x *ContentBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContentBlock.ProtoReflect.Descriptor instead.
-func (*ContentBlock) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreatePageFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreatePageFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ContentBlock) GetType() string {
+func (x *CreatePageFromTemplateRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Type
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *ContentBlock) GetData() map[string]string {
+func (x *CreatePageFromTemplateRequest) GetTemplateVersion() int32 {
 	if x != nil {
-		return x.Data
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *CreatePageFromTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePageFromTemplateRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreatePageFromTemplateRequest) GetMeta() *PageMeta {
+	if x != nil {
+		return x.Meta
 	}
 	return nil
 }
 
-// Page metadata for SEO
-type PageMeta struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Keywords      []string               `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
+// Collection is an ordered list of blog posts
+type Collection struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug        string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Kind        CollectionKind         `protobuf:"varint,5,opt,name=kind,proto3,enum=content.v1.CollectionKind" json:"kind,omitempty"`
+	// Ordered post IDs including drafts; only returned to signed-in users
+	PostIds []string `protobuf:"bytes,6,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	// Published posts in collection order
+	Posts         []*BlogPost            `protobuf:"bytes,7,rep,name=posts,proto3" json:"posts,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageMeta) Reset() {
-	*x = PageMeta{}
-	mi := &file_content_v1_content_proto_msgTypes[3]
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_content_v1_content_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageMeta) String() string {
+func (x *Collection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageMeta) ProtoMessage() {}
+func (*Collection) ProtoMessage() {}
 
-func (x *PageMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[3]
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,59 +4892,101 @@ func (x *PageMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageMeta.ProtoReflect.Descriptor instead.
-func (*PageMeta) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PageMeta) GetTitle() string {
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Collection) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *PageMeta) GetDescription() string {
+func (x *Collection) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *PageMeta) GetKeywords() []string {
+func (x *Collection) GetKind() CollectionKind {
 	if x != nil {
-		return x.Keywords
+		return x.Kind
+	}
+	return CollectionKind_COLLECTION_KIND_UNSPECIFIED
+}
+
+func (x *Collection) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
 	}
 	return nil
 }
 
-// Request messages
-type CreatePageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	Content       *PageContent           `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Meta          *PageMeta              `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        PageStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+func (x *Collection) GetPosts() []*BlogPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateCollectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Defaults to a slug generated from the title
+	Slug          string         `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Kind          CollectionKind `protobuf:"varint,4,opt,name=kind,proto3,enum=content.v1.CollectionKind" json:"kind,omitempty"`
+	PostIds       []string       `protobuf:"bytes,5,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePageRequest) Reset() {
-	*x = CreatePageRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[4]
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePageRequest) String() string {
+func (x *CreateCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePageRequest) ProtoMessage() {}
+func (*CreateCollectionRequest) ProtoMessage() {}
 
-func (x *CreatePageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[4]
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,68 +4997,69 @@ func (x *CreatePageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePageRequest.ProtoReflect.Descriptor instead.
-func (*CreatePageRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *CreatePageRequest) GetTitle() string {
+func (x *CreateCollectionRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CreatePageRequest) GetSlug() string {
+func (x *CreateCollectionRequest) GetSlug() string {
 	if x != nil {
 		return x.Slug
 	}
 	return ""
 }
 
-func (x *CreatePageRequest) GetContent() *PageContent {
+func (x *CreateCollectionRequest) GetDescription() string {
 	if x != nil {
-		return x.Content
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatePageRequest) GetMeta() *PageMeta {
+func (x *CreateCollectionRequest) GetKind() CollectionKind {
 	if x != nil {
-		return x.Meta
+		return x.Kind
 	}
-	return nil
+	return CollectionKind_COLLECTION_KIND_UNSPECIFIED
 }
 
-func (x *CreatePageRequest) GetStatus() PageStatus {
+func (x *CreateCollectionRequest) GetPostIds() []string {
 	if x != nil {
-		return x.Status
+		return x.PostIds
 	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
+	return nil
 }
 
-type GetPageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type GetCollectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Collection ID or slug
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPageRequest) Reset() {
-	*x = GetPageRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[5]
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPageRequest) String() string {
+func (x *GetCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPageRequest) ProtoMessage() {}
+func (*GetCollectionRequest) ProtoMessage() {}
 
-func (x *GetPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[5]
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,45 +5070,43 @@ func (x *GetPageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPageRequest.ProtoReflect.Descriptor instead.
-func (*GetPageRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetPageRequest) GetId() string {
+func (x *GetCollectionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdatePageRequest struct {
+type UpdateCollectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Content       *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Meta          *PageMeta              `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        PageStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePageRequest) Reset() {
-	*x = UpdatePageRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[6]
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePageRequest) String() string {
+func (x *UpdateCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePageRequest) ProtoMessage() {}
+func (*UpdateCollectionRequest) ProtoMessage() {}
 
-func (x *UpdatePageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[6]
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,75 +5117,61 @@ func (x *UpdatePageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePageRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePageRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdatePageRequest) GetId() string {
+func (x *UpdateCollectionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdatePageRequest) GetTitle() string {
+func (x *UpdateCollectionRequest) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *UpdatePageRequest) GetSlug() string {
+func (x *UpdateCollectionRequest) GetSlug() string {
 	if x != nil {
 		return x.Slug
 	}
 	return ""
 }
 
-func (x *UpdatePageRequest) GetContent() *PageContent {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *UpdatePageRequest) GetMeta() *PageMeta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *UpdatePageRequest) GetStatus() PageStatus {
+func (x *UpdateCollectionRequest) GetDescription() string {
 	if x != nil {
-		return x.Status
+		return x.Description
 	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
+	return ""
 }
 
-type DeletePageRequest struct {
+type DeleteCollectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePageRequest) Reset() {
-	*x = DeletePageRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[7]
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePageRequest) String() string {
+func (x *DeleteCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePageRequest) ProtoMessage() {}
+func (*DeleteCollectionRequest) ProtoMessage() {}
 
-func (x *DeletePageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[7]
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,43 +5182,43 @@ func (x *DeletePageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePageRequest.ProtoReflect.Descriptor instead.
-func (*DeletePageRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeletePageRequest) GetId() string {
+func (x *DeleteCollectionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListPagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Status        PageStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
-	Search        string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+type ListCollectionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional filter; unspecified lists all kinds
+	Kind          CollectionKind `protobuf:"varint,3,opt,name=kind,proto3,enum=content.v1.CollectionKind" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPagesRequest) Reset() {
-	*x = ListPagesRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[8]
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPagesRequest) String() string {
+func (x *ListCollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPagesRequest) ProtoMessage() {}
+func (*ListCollectionsRequest) ProtoMessage() {}
 
-func (x *ListPagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[8]
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,63 +5229,56 @@ func (x *ListPagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPagesRequest.ProtoReflect.Descriptor instead.
-func (*ListPagesRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListPagesRequest) GetPageSize() int32 {
+func (x *ListCollectionsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
-	return 0
-}
-
-func (x *ListPagesRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
+	return 0
 }
 
-func (x *ListPagesRequest) GetStatus() PageStatus {
+func (x *ListCollectionsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Status
+		return x.PageToken
 	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *ListPagesRequest) GetSearch() string {
+func (x *ListCollectionsRequest) GetKind() CollectionKind {
 	if x != nil {
-		return x.Search
+		return x.Kind
 	}
-	return ""
+	return CollectionKind_COLLECTION_KIND_UNSPECIFIED
 }
 
-type ListPagesResponse struct {
+type ListCollectionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pages         []*Page                `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPagesResponse) Reset() {
-	*x = ListPagesResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[9]
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPagesResponse) String() string {
+func (x *ListCollectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPagesResponse) ProtoMessage() {}
+func (*ListCollectionsResponse) ProtoMessage() {}
 
-func (x *ListPagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[9]
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,68 +5289,56 @@ func (x *ListPagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPagesResponse.ProtoReflect.Descriptor instead.
-func (*ListPagesResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListPagesResponse) GetPages() []*Page {
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
 	if x != nil {
-		return x.Pages
+		return x.Collections
 	}
 	return nil
 }
 
-func (x *ListPagesResponse) GetNextPageToken() string {
+func (x *ListCollectionsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ListPagesResponse) GetTotalCount() int32 {
+func (x *ListCollectionsResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-// BlogPost represents a blog post
-type BlogPost struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Excerpt       string                 `protobuf:"bytes,4,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
-	Content       *PageContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Meta          *PageMeta              `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        PageStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
-	Author        string                 `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
-	Categories    []string               `protobuf:"bytes,9,rep,name=categories,proto3" json:"categories,omitempty"`
-	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
-	FeaturedImage string                 `protobuf:"bytes,11,opt,name=featured_image,json=featuredImage,proto3" json:"featured_image,omitempty"`
-	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type SetCollectionPostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Post IDs in display order
+	PostIds       []string `protobuf:"bytes,2,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlogPost) Reset() {
-	*x = BlogPost{}
-	mi := &file_content_v1_content_proto_msgTypes[10]
+func (x *SetCollectionPostsRequest) Reset() {
+	*x = SetCollectionPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlogPost) String() string {
+func (x *SetCollectionPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlogPost) ProtoMessage() {}
+func (*SetCollectionPostsRequest) ProtoMessage() {}
 
-func (x *BlogPost) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[10]
+func (x *SetCollectionPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,142 +5349,114 @@ func (x *BlogPost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlogPost.ProtoReflect.Descriptor instead.
-func (*BlogPost) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SetCollectionPostsRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *BlogPost) GetId() string {
+func (x *SetCollectionPostsRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *BlogPost) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *BlogPost) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
-}
-
-func (x *BlogPost) GetExcerpt() string {
-	if x != nil {
-		return x.Excerpt
-	}
-	return ""
-}
-
-func (x *BlogPost) GetContent() *PageContent {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *BlogPost) GetMeta() *PageMeta {
+func (x *SetCollectionPostsRequest) GetPostIds() []string {
 	if x != nil {
-		return x.Meta
+		return x.PostIds
 	}
 	return nil
 }
 
-func (x *BlogPost) GetStatus() PageStatus {
-	if x != nil {
-		return x.Status
-	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
+type ReorderCollectionPostsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Post IDs of the collection in their new order; posts left out keep their
+	// relative order after the listed ones
+	PostIds       []string `protobuf:"bytes,2,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlogPost) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
+func (x *ReorderCollectionPostsRequest) Reset() {
+	*x = ReorderCollectionPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlogPost) GetCategories() []string {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
+func (x *ReorderCollectionPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BlogPost) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
+func (*ReorderCollectionPostsRequest) ProtoMessage() {}
 
-func (x *BlogPost) GetFeaturedImage() string {
+func (x *ReorderCollectionPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[67]
 	if x != nil {
-		return x.FeaturedImage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BlogPost) GetPublishedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.PublishedAt
-	}
-	return nil
+// Deprecated: Use ReorderCollectionPostsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *BlogPost) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ReorderCollectionPostsRequest) GetId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *BlogPost) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ReorderCollectionPostsRequest) GetPostIds() []string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.PostIds
 	}
 	return nil
 }
 
-// Blog post request messages
-type CreateBlogPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	Excerpt       string                 `protobuf:"bytes,3,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
-	Content       *PageContent           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Meta          *PageMeta              `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        PageStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
-	Author        string                 `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
-	Categories    []string               `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
-	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	FeaturedImage string                 `protobuf:"bytes,10,opt,name=featured_image,json=featuredImage,proto3" json:"featured_image,omitempty"`
-	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+// LinkIssue is a broken reference inside a page or post
+type LinkIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "page" or "blog_post"
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ContentId   string `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ContentSlug string `protobuf:"bytes,3,opt,name=content_slug,json=contentSlug,proto3" json:"content_slug,omitempty"`
+	// Zero-based content block index; -1 for fields outside the blocks such as the featured image
+	BlockIndex int32 `protobuf:"varint,4,opt,name=block_index,json=blockIndex,proto3" json:"block_index,omitempty"`
+	// Block data key holding the reference, e.g. "ctaLink"
+	Field string        `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Url   string        `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Kind  LinkIssueKind `protobuf:"varint,7,opt,name=kind,proto3,enum=content.v1.LinkIssueKind" json:"kind,omitempty"`
+	// HTTP status of a failing external URL; 0 when the request itself failed
+	StatusCode    int32  `protobuf:"varint,8,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Detail        string `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBlogPostRequest) Reset() {
-	*x = CreateBlogPostRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[11]
+func (x *LinkIssue) Reset() {
+	*x = LinkIssue{}
+	mi := &file_content_v1_content_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBlogPostRequest) String() string {
+func (x *LinkIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBlogPostRequest) ProtoMessage() {}
+func (*LinkIssue) ProtoMessage() {}
 
-func (x *CreateBlogPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[11]
+func (x *LinkIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,286 +5467,208 @@ func (x *CreateBlogPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBlogPostRequest.ProtoReflect.Descriptor instead.
-func (*CreateBlogPostRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LinkIssue.ProtoReflect.Descriptor instead.
+func (*LinkIssue) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateBlogPostRequest) GetTitle() string {
+func (x *LinkIssue) GetContentType() string {
 	if x != nil {
-		return x.Title
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *CreateBlogPostRequest) GetSlug() string {
+func (x *LinkIssue) GetContentId() string {
 	if x != nil {
-		return x.Slug
+		return x.ContentId
 	}
 	return ""
 }
 
-func (x *CreateBlogPostRequest) GetExcerpt() string {
+func (x *LinkIssue) GetContentSlug() string {
 	if x != nil {
-		return x.Excerpt
+		return x.ContentSlug
 	}
 	return ""
 }
 
-func (x *CreateBlogPostRequest) GetContent() *PageContent {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *CreateBlogPostRequest) GetMeta() *PageMeta {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *CreateBlogPostRequest) GetStatus() PageStatus {
+func (x *LinkIssue) GetBlockIndex() int32 {
 	if x != nil {
-		return x.Status
+		return x.BlockIndex
 	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *CreateBlogPostRequest) GetAuthor() string {
+func (x *LinkIssue) GetField() string {
 	if x != nil {
-		return x.Author
+		return x.Field
 	}
 	return ""
 }
 
-func (x *CreateBlogPostRequest) GetCategories() []string {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
-}
-
-func (x *CreateBlogPostRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *CreateBlogPostRequest) GetFeaturedImage() string {
+func (x *LinkIssue) GetUrl() string {
 	if x != nil {
-		return x.FeaturedImage
+		return x.Url
 	}
 	return ""
 }
 
-func (x *CreateBlogPostRequest) GetPublishedAt() *timestamppb.Timestamp {
+func (x *LinkIssue) GetKind() LinkIssueKind {
 	if x != nil {
-		return x.PublishedAt
+		return x.Kind
 	}
-	return nil
-}
-
-type GetBlogPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetBlogPostRequest) Reset() {
-	*x = GetBlogPostRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetBlogPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return LinkIssueKind_LINK_ISSUE_KIND_UNSPECIFIED
 }
 
-func (*GetBlogPostRequest) ProtoMessage() {}
-
-func (x *GetBlogPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[12]
+func (x *LinkIssue) GetStatusCode() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StatusCode
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetBlogPostRequest.ProtoReflect.Descriptor instead.
-func (*GetBlogPostRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{12}
+	return 0
 }
 
-func (x *GetBlogPostRequest) GetId() string {
+func (x *LinkIssue) GetDetail() string {
 	if x != nil {
-		return x.Id
+		return x.Detail
 	}
 	return ""
 }
 
-type UpdateBlogPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Excerpt       string                 `protobuf:"bytes,4,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
-	Content       *PageContent           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Meta          *PageMeta              `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
-	Status        PageStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
-	Author        string                 `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
-	Categories    []string               `protobuf:"bytes,9,rep,name=categories,proto3" json:"categories,omitempty"`
-	Tags          []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
-	FeaturedImage string                 `protobuf:"bytes,11,opt,name=featured_image,json=featuredImage,proto3" json:"featured_image,omitempty"`
-	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateBlogPostRequest) Reset() {
-	*x = UpdateBlogPostRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[13]
+// LinkReport is the stored result of a link-integrity scan
+type LinkReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "scheduled" or "manual"
+	Trigger      string `protobuf:"bytes,2,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	PagesScanned int32  `protobuf:"varint,3,opt,name=pages_scanned,json=pagesScanned,proto3" json:"pages_scanned,omitempty"`
+	PostsScanned int32  `protobuf:"varint,4,opt,name=posts_scanned,json=postsScanned,proto3" json:"posts_scanned,omitempty"`
+	LinksChecked int32  `protobuf:"varint,5,opt,name=links_checked,json=linksChecked,proto3" json:"links_checked,omitempty"`
+	// Whether external URLs were requested during the scan
+	ExternalChecked bool                   `protobuf:"varint,6,opt,name=external_checked,json=externalChecked,proto3" json:"external_checked,omitempty"`
+	Issues          []*LinkIssue           `protobuf:"bytes,7,rep,name=issues,proto3" json:"issues,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LinkReport) Reset() {
+	*x = LinkReport{}
+	mi := &file_content_v1_content_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBlogPostRequest) String() string {
+func (x *LinkReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBlogPostRequest) ProtoMessage() {}
+func (*LinkReport) ProtoMessage() {}
 
-func (x *UpdateBlogPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[13]
+func (x *LinkReport) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateBlogPostRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBlogPostRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *UpdateBlogPostRequest) GetId() string {
-	if x != nil {
-		return x.Id
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateBlogPostRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use LinkReport.ProtoReflect.Descriptor instead.
+func (*LinkReport) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateBlogPostRequest) GetSlug() string {
+func (x *LinkReport) GetId() string {
 	if x != nil {
-		return x.Slug
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateBlogPostRequest) GetExcerpt() string {
+func (x *LinkReport) GetTrigger() string {
 	if x != nil {
-		return x.Excerpt
+		return x.Trigger
 	}
 	return ""
 }
 
-func (x *UpdateBlogPostRequest) GetContent() *PageContent {
+func (x *LinkReport) GetPagesScanned() int32 {
 	if x != nil {
-		return x.Content
+		return x.PagesScanned
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateBlogPostRequest) GetMeta() *PageMeta {
+func (x *LinkReport) GetPostsScanned() int32 {
 	if x != nil {
-		return x.Meta
+		return x.PostsScanned
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateBlogPostRequest) GetStatus() PageStatus {
+func (x *LinkReport) GetLinksChecked() int32 {
 	if x != nil {
-		return x.Status
+		return x.LinksChecked
 	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateBlogPostRequest) GetAuthor() string {
+func (x *LinkReport) GetExternalChecked() bool {
 	if x != nil {
-		return x.Author
+		return x.ExternalChecked
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateBlogPostRequest) GetCategories() []string {
+func (x *LinkReport) GetIssues() []*LinkIssue {
 	if x != nil {
-		return x.Categories
+		return x.Issues
 	}
 	return nil
 }
 
-func (x *UpdateBlogPostRequest) GetTags() []string {
+func (x *LinkReport) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Tags
+		return x.StartedAt
 	}
 	return nil
 }
 
-func (x *UpdateBlogPostRequest) GetFeaturedImage() string {
-	if x != nil {
-		return x.FeaturedImage
-	}
-	return ""
-}
-
-func (x *UpdateBlogPostRequest) GetPublishedAt() *timestamppb.Timestamp {
+func (x *LinkReport) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PublishedAt
+		return x.FinishedAt
 	}
 	return nil
 }
 
-type DeleteBlogPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type GetLinkReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Report ID; empty returns the latest report
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBlogPostRequest) Reset() {
-	*x = DeleteBlogPostRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[14]
+func (x *GetLinkReportRequest) Reset() {
+	*x = GetLinkReportRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBlogPostRequest) String() string {
+func (x *GetLinkReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBlogPostRequest) ProtoMessage() {}
+func (*GetLinkReportRequest) ProtoMessage() {}
 
-func (x *DeleteBlogPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[14]
+func (x *GetLinkReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,45 +5679,39 @@ func (x *DeleteBlogPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBlogPostRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBlogPostRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetLinkReportRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkReportRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteBlogPostRequest) GetId() string {
+func (x *GetLinkReportRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListBlogPostsRequest struct {
+type RunLinkScanRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Status        PageStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=content.v1.PageStatus" json:"status,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
-	Author        string                 `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBlogPostsRequest) Reset() {
-	*x = ListBlogPostsRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[15]
+func (x *RunLinkScanRequest) Reset() {
+	*x = RunLinkScanRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBlogPostsRequest) String() string {
+func (x *RunLinkScanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBlogPostsRequest) ProtoMessage() {}
+func (*RunLinkScanRequest) ProtoMessage() {}
 
-func (x *ListBlogPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[15]
+func (x *RunLinkScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,77 +5722,39 @@ func (x *ListBlogPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBlogPostsRequest.ProtoReflect.Descriptor instead.
-func (*ListBlogPostsRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListBlogPostsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListBlogPostsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListBlogPostsRequest) GetStatus() PageStatus {
-	if x != nil {
-		return x.Status
-	}
-	return PageStatus_PAGE_STATUS_UNSPECIFIED
-}
-
-func (x *ListBlogPostsRequest) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *ListBlogPostsRequest) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
-
-func (x *ListBlogPostsRequest) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
+// Deprecated: Use RunLinkScanRequest.ProtoReflect.Descriptor instead.
+func (*RunLinkScanRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{71}
 }
 
-type ListBlogPostsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*BlogPost            `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+// SEOFinding is a single actionable SEO problem
+type SEOFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable check identifier, e.g. "meta_description_missing"
+	Check    string      `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Severity SEOSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=content.v1.SEOSeverity" json:"severity,omitempty"`
+	Message  string      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Zero-based content block the finding refers to; -1 when it applies to the whole item
+	BlockIndex    int32 `protobuf:"varint,4,opt,name=block_index,json=blockIndex,proto3" json:"block_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBlogPostsResponse) Reset() {
-	*x = ListBlogPostsResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[16]
+func (x *SEOFinding) Reset() {
+	*x = SEOFinding{}
+	mi := &file_content_v1_content_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBlogPostsResponse) String() string {
+func (x *SEOFinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBlogPostsResponse) ProtoMessage() {}
+func (*SEOFinding) ProtoMessage() {}
 
-func (x *ListBlogPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[16]
+func (x *SEOFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,58 +5765,69 @@ func (x *ListBlogPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBlogPostsResponse.ProtoReflect.Descriptor instead.
-func (*ListBlogPostsResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SEOFinding.ProtoReflect.Descriptor instead.
+func (*SEOFinding) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListBlogPostsResponse) GetPosts() []*BlogPost {
+func (x *SEOFinding) GetCheck() string {
 	if x != nil {
-		return x.Posts
+		return x.Check
 	}
-	return nil
+	return ""
 }
 
-func (x *ListBlogPostsResponse) GetNextPageToken() string {
+func (x *SEOFinding) GetSeverity() SEOSeverity {
 	if x != nil {
-		return x.NextPageToken
+		return x.Severity
+	}
+	return SEOSeverity_SEO_SEVERITY_UNSPECIFIED
+}
+
+func (x *SEOFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ListBlogPostsResponse) GetTotalCount() int32 {
+func (x *SEOFinding) GetBlockIndex() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.BlockIndex
 	}
 	return 0
 }
 
-type SearchBlogPostsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+// SEOReport scores one page or post
+type SEOReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "page" or "blog_post"
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ContentId   string `protobuf:"bytes,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Slug        string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// 0-100; every finding lowers the score according to its severity
+	Score         int32         `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Findings      []*SEOFinding `protobuf:"bytes,6,rep,name=findings,proto3" json:"findings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchBlogPostsRequest) Reset() {
-	*x = SearchBlogPostsRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[17]
+func (x *SEOReport) Reset() {
+	*x = SEOReport{}
+	mi := &file_content_v1_content_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchBlogPostsRequest) String() string {
+func (x *SEOReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBlogPostsRequest) ProtoMessage() {}
+func (*SEOReport) ProtoMessage() {}
 
-func (x *SearchBlogPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[17]
+func (x *SEOReport) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,70 +5838,77 @@ func (x *SearchBlogPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBlogPostsRequest.ProtoReflect.Descriptor instead.
-func (*SearchBlogPostsRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SEOReport.ProtoReflect.Descriptor instead.
+func (*SEOReport) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SearchBlogPostsRequest) GetQuery() string {
+func (x *SEOReport) GetContentType() string {
 	if x != nil {
-		return x.Query
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *SearchBlogPostsRequest) GetPageSize() int32 {
+func (x *SEOReport) GetContentId() string {
 	if x != nil {
-		return x.PageSize
+		return x.ContentId
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchBlogPostsRequest) GetPageToken() string {
+func (x *SEOReport) GetSlug() string {
 	if x != nil {
-		return x.PageToken
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *SearchBlogPostsRequest) GetCategory() string {
+func (x *SEOReport) GetTitle() string {
 	if x != nil {
-		return x.Category
+		return x.Title
 	}
 	return ""
 }
 
-func (x *SearchBlogPostsRequest) GetTag() string {
+func (x *SEOReport) GetScore() int32 {
 	if x != nil {
-		return x.Tag
+		return x.Score
 	}
-	return ""
+	return 0
 }
 
-type SearchBlogPostsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*BlogPost            `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+func (x *SEOReport) GetFindings() []*SEOFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type AnalyzeSEORequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "page" or "blog_post"
+	ContentType   string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchBlogPostsResponse) Reset() {
-	*x = SearchBlogPostsResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[18]
+func (x *AnalyzeSEORequest) Reset() {
+	*x = AnalyzeSEORequest{}
+	mi := &file_content_v1_content_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchBlogPostsResponse) String() string {
+func (x *AnalyzeSEORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBlogPostsResponse) ProtoMessage() {}
+func (*AnalyzeSEORequest) ProtoMessage() {}
 
-func (x *SearchBlogPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[18]
+func (x *AnalyzeSEORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,53 +5919,52 @@ func (x *SearchBlogPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBlogPostsResponse.ProtoReflect.Descriptor instead.
-func (*SearchBlogPostsResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *SearchBlogPostsResponse) GetPosts() []*BlogPost {
-	if x != nil {
-		return x.Posts
-	}
-	return nil
+// Deprecated: Use AnalyzeSEORequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeSEORequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *SearchBlogPostsResponse) GetNextPageToken() string {
+func (x *AnalyzeSEORequest) GetContentType() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *SearchBlogPostsResponse) GetTotalCount() int32 {
+func (x *AnalyzeSEORequest) GetId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type GetBlogCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ListSEOIssuesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Lowest severity to report; defaults to warnings
+	MinSeverity SEOSeverity `protobuf:"varint,3,opt,name=min_severity,json=minSeverity,proto3,enum=content.v1.SEOSeverity" json:"min_severity,omitempty"`
+	// Optional filter: "page" or "blog_post"
+	ContentType   string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlogCategoriesRequest) Reset() {
-	*x = GetBlogCategoriesRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[19]
+func (x *ListSEOIssuesRequest) Reset() {
+	*x = ListSEOIssuesRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlogCategoriesRequest) String() string {
+func (x *ListSEOIssuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlogCategoriesRequest) ProtoMessage() {}
+func (*ListSEOIssuesRequest) ProtoMessage() {}
 
-func (x *GetBlogCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[19]
+func (x *ListSEOIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,79 +5975,64 @@ func (x *GetBlogCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlogCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*GetBlogCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{19}
-}
-
-type GetBlogCategoriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*BlogCategory        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetBlogCategoriesResponse) Reset() {
-	*x = GetBlogCategoriesResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use ListSEOIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListSEOIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetBlogCategoriesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListSEOIssuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (*GetBlogCategoriesResponse) ProtoMessage() {}
-
-func (x *GetBlogCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[20]
+func (x *ListSEOIssuesRequest) GetPageToken() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PageToken
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetBlogCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*GetBlogCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{20}
+func (x *ListSEOIssuesRequest) GetMinSeverity() SEOSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return SEOSeverity_SEO_SEVERITY_UNSPECIFIED
 }
 
-func (x *GetBlogCategoriesResponse) GetCategories() []*BlogCategory {
+func (x *ListSEOIssuesRequest) GetContentType() string {
 	if x != nil {
-		return x.Categories
+		return x.ContentType
 	}
-	return nil
+	return ""
 }
 
-type BlogCategory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	PostCount     int32                  `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+type ListSEOIssuesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Items with at least one finding at or above min_severity; findings below it are omitted
+	Reports       []*SEOReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32        `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlogCategory) Reset() {
-	*x = BlogCategory{}
-	mi := &file_content_v1_content_proto_msgTypes[21]
+func (x *ListSEOIssuesResponse) Reset() {
+	*x = ListSEOIssuesResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlogCategory) String() string {
+func (x *ListSEOIssuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlogCategory) ProtoMessage() {}
+func (*ListSEOIssuesResponse) ProtoMessage() {}
 
-func (x *BlogCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[21]
+func (x *ListSEOIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,53 +6043,61 @@ func (x *BlogCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlogCategory.ProtoReflect.Descriptor instead.
-func (*BlogCategory) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListSEOIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListSEOIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *BlogCategory) GetName() string {
+func (x *ListSEOIssuesResponse) GetReports() []*SEOReport {
 	if x != nil {
-		return x.Name
+		return x.Reports
 	}
-	return ""
+	return nil
 }
 
-func (x *BlogCategory) GetSlug() string {
+func (x *ListSEOIssuesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Slug
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *BlogCategory) GetPostCount() int32 {
+func (x *ListSEOIssuesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.PostCount
+		return x.TotalCount
 	}
 	return 0
 }
 
-type GetBlogTagsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// PublishViolation is a quality gate rule a page or post fails when being published.
+// Blocked updates fail with FailedPrecondition and carry a PublishGateReport as error detail.
+type PublishViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rule identifier, e.g. "meta_description"
+	Rule     string              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Severity PublishRuleSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=content.v1.PublishRuleSeverity" json:"severity,omitempty"`
+	Message  string              `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Zero-based content block the violation refers to; -1 when it applies to the whole item
+	BlockIndex    int32 `protobuf:"varint,4,opt,name=block_index,json=blockIndex,proto3" json:"block_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlogTagsRequest) Reset() {
-	*x = GetBlogTagsRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[22]
+func (x *PublishViolation) Reset() {
+	*x = PublishViolation{}
+	mi := &file_content_v1_content_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlogTagsRequest) String() string {
+func (x *PublishViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlogTagsRequest) ProtoMessage() {}
+func (*PublishViolation) ProtoMessage() {}
 
-func (x *GetBlogTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[22]
+func (x *PublishViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,33 +6108,62 @@ func (x *GetBlogTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlogTagsRequest.ProtoReflect.Descriptor instead.
-func (*GetBlogTagsRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PublishViolation.ProtoReflect.Descriptor instead.
+func (*PublishViolation) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{77}
 }
 
-type GetBlogTagsResponse struct {
+func (x *PublishViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PublishViolation) GetSeverity() PublishRuleSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return PublishRuleSeverity_PUBLISH_RULE_SEVERITY_UNSPECIFIED
+}
+
+func (x *PublishViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PublishViolation) GetBlockIndex() int32 {
+	if x != nil {
+		return x.BlockIndex
+	}
+	return 0
+}
+
+// PublishGateReport lists the violations that blocked publishing
+type PublishGateReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*BlogTag             `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Violations    []*PublishViolation    `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlogTagsResponse) Reset() {
-	*x = GetBlogTagsResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[23]
+func (x *PublishGateReport) Reset() {
+	*x = PublishGateReport{}
+	mi := &file_content_v1_content_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlogTagsResponse) String() string {
+func (x *PublishGateReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlogTagsResponse) ProtoMessage() {}
+func (*PublishGateReport) ProtoMessage() {}
 
-func (x *GetBlogTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[23]
+func (x *PublishGateReport) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,42 +6174,45 @@ func (x *GetBlogTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlogTagsResponse.ProtoReflect.Descriptor instead.
-func (*GetBlogTagsResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PublishGateReport.ProtoReflect.Descriptor instead.
+func (*PublishGateReport) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetBlogTagsResponse) GetTags() []*BlogTag {
+func (x *PublishGateReport) GetViolations() []*PublishViolation {
 	if x != nil {
-		return x.Tags
+		return x.Violations
 	}
 	return nil
 }
 
-type BlogTag struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	PostCount     int32                  `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+// PublishOverride records an admin publishing despite blocking violations
+type PublishOverride struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Reason       string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OverriddenAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=overridden_at,json=overriddenAt,proto3" json:"overridden_at,omitempty"`
+	// Rules that were overridden
+	Rules         []string `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlogTag) Reset() {
-	*x = BlogTag{}
-	mi := &file_content_v1_content_proto_msgTypes[24]
+func (x *PublishOverride) Reset() {
+	*x = PublishOverride{}
+	mi := &file_content_v1_content_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlogTag) String() string {
+func (x *PublishOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlogTag) ProtoMessage() {}
+func (*PublishOverride) ProtoMessage() {}
 
-func (x *BlogTag) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[24]
+func (x *PublishOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,53 +6223,65 @@ func (x *BlogTag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlogTag.ProtoReflect.Descriptor instead.
-func (*BlogTag) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PublishOverride.ProtoReflect.Descriptor instead.
+func (*PublishOverride) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *BlogTag) GetName() string {
+func (x *PublishOverride) GetReason() string {
 	if x != nil {
-		return x.Name
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *BlogTag) GetSlug() string {
+func (x *PublishOverride) GetUserId() string {
 	if x != nil {
-		return x.Slug
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *BlogTag) GetPostCount() int32 {
+func (x *PublishOverride) GetOverriddenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PostCount
+		return x.OverriddenAt
 	}
-	return 0
+	return nil
 }
 
-type GetRSSFeedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *PublishOverride) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type WatchContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resume after this sequence number: events still in the replay log are sent first.
+	// 0 streams new events only.
+	SinceSequence uint64 `protobuf:"varint,1,opt,name=since_sequence,json=sinceSequence,proto3" json:"since_sequence,omitempty"`
+	// Optional filter; empty streams every resource type
+	ResourceTypes []ContentResourceType `protobuf:"varint,2,rep,packed,name=resource_types,json=resourceTypes,proto3,enum=content.v1.ContentResourceType" json:"resource_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRSSFeedRequest) Reset() {
-	*x = GetRSSFeedRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[25]
+func (x *WatchContentRequest) Reset() {
+	*x = WatchContentRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRSSFeedRequest) String() string {
+func (x *WatchContentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRSSFeedRequest) ProtoMessage() {}
+func (*WatchContentRequest) ProtoMessage() {}
 
-func (x *GetRSSFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[25]
+func (x *WatchContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,34 +6292,64 @@ func (x *GetRSSFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRSSFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetRSSFeedRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use WatchContentRequest.ProtoReflect.Descriptor instead.
+func (*WatchContentRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{80}
 }
 
-type GetRSSFeedResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	XmlContent    string                 `protobuf:"bytes,1,opt,name=xml_content,json=xmlContent,proto3" json:"xml_content,omitempty"`
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+func (x *WatchContentRequest) GetSinceSequence() uint64 {
+	if x != nil {
+		return x.SinceSequence
+	}
+	return 0
+}
+
+func (x *WatchContentRequest) GetResourceTypes() []ContentResourceType {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+// ContentEvent is one change in the content feed
+type ContentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Increases by one per event; pass the last one seen as since_sequence to resume
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Event type as used by webhooks, e.g. "post.published"
+	Type         string              `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ResourceType ContentResourceType `protobuf:"varint,3,opt,name=resource_type,json=resourceType,proto3,enum=content.v1.ContentResourceType" json:"resource_type,omitempty"`
+	Action       ContentEventAction  `protobuf:"varint,4,opt,name=action,proto3,enum=content.v1.ContentEventAction" json:"action,omitempty"`
+	Id           string              `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Slug of a page or post, filename of a media file
+	Slug       string                 `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// The resource after the change; unset for deletes and media
+	//
+	// Types that are valid to be assigned to Resource:
+	//
+	//	*ContentEvent_Page
+	//	*ContentEvent_BlogPost
+	Resource      isContentEvent_Resource `protobuf_oneof:"resource"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRSSFeedResponse) Reset() {
-	*x = GetRSSFeedResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[26]
+func (x *ContentEvent) Reset() {
+	*x = ContentEvent{}
+	mi := &file_content_v1_content_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRSSFeedResponse) String() string {
+func (x *ContentEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRSSFeedResponse) ProtoMessage() {}
+func (*ContentEvent) ProtoMessage() {}
 
-func (x *GetRSSFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[26]
+func (x *ContentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1838,31 +6360,107 @@ func (x *GetRSSFeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRSSFeedResponse.ProtoReflect.Descriptor instead.
-func (*GetRSSFeedResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ContentEvent.ProtoReflect.Descriptor instead.
+func (*ContentEvent) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetRSSFeedResponse) GetXmlContent() string {
+func (x *ContentEvent) GetSequence() uint64 {
 	if x != nil {
-		return x.XmlContent
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ContentEvent) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetRSSFeedResponse) GetContentType() string {
+func (x *ContentEvent) GetResourceType() ContentResourceType {
 	if x != nil {
-		return x.ContentType
+		return x.ResourceType
+	}
+	return ContentResourceType_CONTENT_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *ContentEvent) GetAction() ContentEventAction {
+	if x != nil {
+		return x.Action
+	}
+	return ContentEventAction_CONTENT_EVENT_ACTION_UNSPECIFIED
+}
+
+func (x *ContentEvent) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
+func (x *ContentEvent) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ContentEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *ContentEvent) GetResource() isContentEvent_Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ContentEvent) GetPage() *Page {
+	if x != nil {
+		if x, ok := x.Resource.(*ContentEvent_Page); ok {
+			return x.Page
+		}
+	}
+	return nil
+}
+
+func (x *ContentEvent) GetBlogPost() *BlogPost {
+	if x != nil {
+		if x, ok := x.Resource.(*ContentEvent_BlogPost); ok {
+			return x.BlogPost
+		}
+	}
+	return nil
+}
+
+type isContentEvent_Resource interface {
+	isContentEvent_Resource()
+}
+
+type ContentEvent_Page struct {
+	Page *Page `protobuf:"bytes,8,opt,name=page,proto3,oneof"`
+}
+
+type ContentEvent_BlogPost struct {
+	BlogPost *BlogPost `protobuf:"bytes,9,opt,name=blog_post,json=blogPost,proto3,oneof"`
+}
+
+func (*ContentEvent_Page) isContentEvent_Resource() {}
+
+func (*ContentEvent_BlogPost) isContentEvent_Resource() {}
+
 var File_content_v1_content_proto protoreflect.FileDescriptor
 
 const file_content_v1_content_proto_rawDesc = "" +
 	"\n" +
 	"\x18content/v1/content.proto\x12\n" +
-	"content.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc3\x02\n" +
+	"content.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xbd\x04\n" +
 	"\x04Page\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -1873,34 +6471,77 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"?\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
+	"\ajson_ld\x18\t \x01(\tR\x06jsonLd\x126\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\v2\x16.content.v1.VisibilityR\n" +
+	"visibility\x12\x16\n" +
+	"\x06teaser\x18\v \x01(\bR\x06teaser\x12G\n" +
+	"\x10publish_warnings\x18\f \x03(\v2\x1c.content.v1.PublishViolationR\x0fpublishWarnings\x12F\n" +
+	"\x10publish_override\x18\r \x01(\v2\x1b.content.v1.PublishOverrideR\x0fpublishOverride\"?\n" +
 	"\vPageContent\x120\n" +
-	"\x06blocks\x18\x01 \x03(\v2\x18.content.v1.ContentBlockR\x06blocks\"\x93\x01\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x18.content.v1.ContentBlockR\x06blocks\"\xff\x01\n" +
 	"\fContentBlock\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x126\n" +
-	"\x04data\x18\x02 \x03(\v2\".content.v1.ContentBlock.DataEntryR\x04data\x1a7\n" +
+	"\x04data\x18\x02 \x03(\v2\".content.v1.ContentBlock.DataEntryR\x04data\x12A\n" +
+	"\x0fresolved_blocks\x18\x03 \x03(\v2\x18.content.v1.ContentBlockR\x0eresolvedBlocks\x12'\n" +
+	"\x05embed\x18\x04 \x01(\v2\x11.content.v1.EmbedR\x05embed\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"^\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xde\x01\n" +
+	"\x05Embed\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\rprovider_name\x18\x02 \x01(\tR\fproviderName\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x12\n" +
+	"\x04html\x18\b \x01(\tR\x04html\"\xda\x02\n" +
 	"\bPageMeta\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bkeywords\x18\x03 \x03(\tR\bkeywords\"\xca\x01\n" +
+	"\bkeywords\x18\x03 \x03(\tR\bkeywords\x12\x19\n" +
+	"\bog_title\x18\x04 \x01(\tR\aogTitle\x12%\n" +
+	"\x0eog_description\x18\x05 \x01(\tR\rogDescription\x12\x19\n" +
+	"\bog_image\x18\x06 \x01(\tR\aogImage\x12 \n" +
+	"\fog_image_url\x18\a \x01(\tR\n" +
+	"ogImageUrl\x12#\n" +
+	"\rcanonical_url\x18\b \x01(\tR\fcanonicalUrl\x12\x16\n" +
+	"\x06robots\x18\t \x01(\tR\x06robots\x12>\n" +
+	"\ftwitter_card\x18\n" +
+	" \x01(\x0e2\x1b.content.v1.TwitterCardTypeR\vtwitterCard\"\x92\x01\n" +
+	"\n" +
+	"Visibility\x121\n" +
+	"\x05level\x18\x01 \x01(\x0e2\x1b.content.v1.VisibilityLevelR\x05level\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\x12\x16\n" +
+	"\x06groups\x18\x03 \x03(\tR\x06groups\x12#\n" +
+	"\rteaser_blocks\x18\x04 \x01(\x05R\fteaserBlocks\"\xba\x02\n" +
 	"\x11CreatePageRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\x121\n" +
 	"\acontent\x18\x03 \x01(\v2\x17.content.v1.PageContentR\acontent\x12(\n" +
 	"\x04meta\x18\x04 \x01(\v2\x14.content.v1.PageMetaR\x04meta\x12.\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x16.content.v1.PageStatusR\x06status\" \n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.content.v1.PageStatusR\x06status\x126\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\v2\x16.content.v1.VisibilityR\n" +
+	"visibility\x126\n" +
+	"\x17publish_override_reason\x18\a \x01(\tR\x15publishOverrideReason\" \n" +
 	"\x0eGetPageRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xda\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xca\x02\n" +
 	"\x11UpdatePageRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
 	"\x04slug\x18\x03 \x01(\tR\x04slug\x121\n" +
 	"\acontent\x18\x04 \x01(\v2\x17.content.v1.PageContentR\acontent\x12(\n" +
 	"\x04meta\x18\x05 \x01(\v2\x14.content.v1.PageMetaR\x04meta\x12.\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x16.content.v1.PageStatusR\x06status\"#\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.content.v1.PageStatusR\x06status\x126\n" +
+	"\n" +
+	"visibility\x18\a \x01(\v2\x16.content.v1.VisibilityR\n" +
+	"visibility\x126\n" +
+	"\x17publish_override_reason\x18\b \x01(\tR\x15publishOverrideReason\"#\n" +
 	"\x11DeletePageRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x96\x01\n" +
 	"\x10ListPagesRequest\x12\x1b\n" +
@@ -1913,7 +6554,7 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\x05pages\x18\x01 \x03(\v2\x10.content.v1.PageR\x05pages\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x03 \x01(\x05R\n" +
-	"totalCount\"\x93\x04\n" +
+	"totalCount\"\xd5\b\n" +
 	"\bBlogPost\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -1933,7 +6574,48 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9a\x03\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12)\n" +
+	"\x10comments_enabled\x18\x0f \x01(\bR\x0fcommentsEnabled\x12#\n" +
+	"\rcomment_count\x18\x10 \x01(\x05R\fcommentCount\x124\n" +
+	"\x06series\x18\x11 \x03(\v2\x1c.content.v1.SeriesNavigationR\x06series\x12\x17\n" +
+	"\ajson_ld\x18\x12 \x01(\tR\x06jsonLd\x12H\n" +
+	"\x14featured_image_media\x18\x13 \x01(\v2\x16.content.v1.ImageAssetR\x12featuredImageMedia\x126\n" +
+	"\n" +
+	"visibility\x18\x14 \x01(\v2\x16.content.v1.VisibilityR\n" +
+	"visibility\x12\x16\n" +
+	"\x06teaser\x18\x15 \x01(\bR\x06teaser\x12G\n" +
+	"\x10publish_warnings\x18\x19 \x03(\v2\x1c.content.v1.PublishViolationR\x0fpublishWarnings\x12F\n" +
+	"\x10publish_override\x18\x1a \x01(\v2\x1b.content.v1.PublishOverrideR\x0fpublishOverride\x12\x16\n" +
+	"\x06pinned\x18\x16 \x01(\bR\x06pinned\x12=\n" +
+	"\fpinned_until\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\vpinnedUntil\x12\x1f\n" +
+	"\vsort_weight\x18\x18 \x01(\x05R\n" +
+	"sortWeight\"\xca\x01\n" +
+	"\n" +
+	"ImageAsset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12\x19\n" +
+	"\balt_text\x18\x05 \x01(\tR\aaltText\x12\x1b\n" +
+	"\tmime_type\x18\x06 \x01(\tR\bmimeType\x124\n" +
+	"\bvariants\x18\a \x03(\v2\x18.content.v1.ImageVariantR\bvariants\"b\n" +
+	"\fImageVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\"\xe7\x01\n" +
+	"\x10SeriesNavigation\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x120\n" +
+	"\bprevious\x18\x06 \x01(\v2\x14.content.v1.PostLinkR\bprevious\x12(\n" +
+	"\x04next\x18\a \x01(\v2\x14.content.v1.PostLinkR\x04next\"D\n" +
+	"\bPostLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\"\xc7\x05\n" +
 	"\x15CreateBlogPostRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x18\n" +
@@ -1948,9 +6630,19 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\x04tags\x18\t \x03(\tR\x04tags\x12%\n" +
 	"\x0efeatured_image\x18\n" +
 	" \x01(\tR\rfeaturedImage\x12=\n" +
-	"\fpublished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"$\n" +
+	"\fpublished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12.\n" +
+	"\x10comments_enabled\x18\f \x01(\bH\x00R\x0fcommentsEnabled\x88\x01\x01\x126\n" +
+	"\n" +
+	"visibility\x18\r \x01(\v2\x16.content.v1.VisibilityR\n" +
+	"visibility\x12\x16\n" +
+	"\x06pinned\x18\x0e \x01(\bR\x06pinned\x12=\n" +
+	"\fpinned_until\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vpinnedUntil\x12\x1f\n" +
+	"\vsort_weight\x18\x10 \x01(\x05R\n" +
+	"sortWeight\x126\n" +
+	"\x17publish_override_reason\x18\x11 \x01(\tR\x15publishOverrideReasonB\x13\n" +
+	"\x11_comments_enabled\"$\n" +
 	"\x12GetBlogPostRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xaa\x03\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xfc\x05\n" +
 	"\x15UpdateBlogPostRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -1966,9 +6658,21 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\x04tags\x18\n" +
 	" \x03(\tR\x04tags\x12%\n" +
 	"\x0efeatured_image\x18\v \x01(\tR\rfeaturedImage\x12=\n" +
-	"\fpublished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"'\n" +
+	"\fpublished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12.\n" +
+	"\x10comments_enabled\x18\r \x01(\bH\x00R\x0fcommentsEnabled\x88\x01\x01\x126\n" +
+	"\n" +
+	"visibility\x18\x0e \x01(\v2\x16.content.v1.VisibilityR\n" +
+	"visibility\x12\x1b\n" +
+	"\x06pinned\x18\x0f \x01(\bH\x01R\x06pinned\x88\x01\x01\x12=\n" +
+	"\fpinned_until\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vpinnedUntil\x12$\n" +
+	"\vsort_weight\x18\x11 \x01(\x05H\x02R\n" +
+	"sortWeight\x88\x01\x01\x126\n" +
+	"\x17publish_override_reason\x18\x12 \x01(\tR\x15publishOverrideReasonB\x13\n" +
+	"\x11_comments_enabledB\t\n" +
+	"\a_pinnedB\x0e\n" +
+	"\f_sort_weight\"'\n" +
 	"\x15DeleteBlogPostRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xc8\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x82\x03\n" +
 	"\x14ListBlogPostsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -1976,7 +6680,10 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\x0e2\x16.content.v1.PageStatusR\x06status\x12\x1a\n" +
 	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x10\n" +
 	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x16\n" +
-	"\x06author\x18\x06 \x01(\tR\x06author\"\x8c\x01\n" +
+	"\x06author\x18\x06 \x01(\tR\x06author\x12C\n" +
+	"\x0fpublished_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0epublishedAfter\x12E\n" +
+	"\x10published_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0fpublishedBefore\x12,\n" +
+	"\x04sort\x18\t \x01(\x0e2\x18.content.v1.BlogPostSortR\x04sort\"\x8c\x01\n" +
 	"\x15ListBlogPostsResponse\x12*\n" +
 	"\x05posts\x18\x01 \x03(\v2\x14.content.v1.BlogPostR\x05posts\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -2006,23 +6713,321 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"post_count\x18\x03 \x01(\x05R\tpostCount\"\x14\n" +
 	"\x12GetBlogTagsRequest\">\n" +
 	"\x13GetBlogTagsResponse\x12'\n" +
-	"\x04tags\x18\x01 \x03(\v2\x13.content.v1.BlogTagR\x04tags\"P\n" +
+	"\x04tags\x18\x01 \x03(\v2\x13.content.v1.BlogTagR\x04tags\"\x17\n" +
+	"\x15GetBlogArchiveRequest\"K\n" +
+	"\x16GetBlogArchiveResponse\x121\n" +
+	"\x05years\x18\x01 \x03(\v2\x1b.content.v1.BlogArchiveYearR\x05years\"z\n" +
+	"\x0fBlogArchiveYear\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05R\tpostCount\x124\n" +
+	"\x06months\x18\x03 \x03(\v2\x1c.content.v1.BlogArchiveMonthR\x06months\"G\n" +
+	"\x10BlogArchiveMonth\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\x05R\x05month\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05R\tpostCount\"P\n" +
 	"\aBlogTag\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x1d\n" +
 	"\n" +
-	"post_count\x18\x03 \x01(\x05R\tpostCount\"\x13\n" +
+	"post_count\x18\x03 \x01(\x05R\tpostCount\">\n" +
+	"\x16GetRelatedPostsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"E\n" +
+	"\x17GetRelatedPostsResponse\x12*\n" +
+	"\x05posts\x18\x01 \x03(\v2\x14.content.v1.BlogPostR\x05posts\"\x13\n" +
 	"\x11GetRSSFeedRequest\"X\n" +
 	"\x12GetRSSFeedResponse\x12\x1f\n" +
 	"\vxml_content\x18\x01 \x01(\tR\n" +
 	"xmlContent\x12!\n" +
-	"\fcontent_type\x18\x02 \x01(\tR\vcontentType*u\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xb3\x02\n" +
+	"\rReusableBlock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\acontent\x18\x05 \x01(\v2\x17.content.v1.PageContentR\acontent\x12\x1f\n" +
+	"\vusage_count\x18\x06 \x01(\x05R\n" +
+	"usageCount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x99\x01\n" +
+	"\x1aCreateReusableBlockRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\acontent\x18\x04 \x01(\v2\x17.content.v1.PageContentR\acontent\")\n" +
+	"\x17GetReusableBlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa9\x01\n" +
+	"\x1aUpdateReusableBlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\acontent\x18\x05 \x01(\v2\x17.content.v1.PageContentR\acontent\"B\n" +
+	"\x1aDeleteReusableBlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"o\n" +
+	"\x19ListReusableBlocksRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\"\x98\x01\n" +
+	"\x1aListReusableBlocksResponse\x121\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x19.content.v1.ReusableBlockR\x06blocks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"0\n" +
+	"\x1eListReusableBlockUsagesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x80\x01\n" +
+	"\x12ReusableBlockUsage\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\tcontentId\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"Y\n" +
+	"\x1fListReusableBlockUsagesResponse\x126\n" +
+	"\x06usages\x18\x01 \x03(\v2\x1e.content.v1.ReusableBlockUsageR\x06usages\"P\n" +
+	"\x14DuplicatePageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\"T\n" +
+	"\x18DuplicateBlogPostRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\"\xd5\x02\n" +
+	"\fPageTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x121\n" +
+	"\acontent\x18\x06 \x01(\v2\x17.content.v1.PageContentR\acontent\x12(\n" +
+	"\x04meta\x18\a \x01(\v2\x14.content.v1.PageMetaR\x04meta\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc2\x01\n" +
+	"\x19CreatePageTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\acontent\x18\x04 \x01(\v2\x17.content.v1.PageContentR\acontent\x12(\n" +
+	"\x04meta\x18\x05 \x01(\v2\x14.content.v1.PageMetaR\x04meta\"B\n" +
+	"\x16GetPageTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\xd2\x01\n" +
+	"\x19UpdatePageTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x121\n" +
+	"\acontent\x18\x05 \x01(\v2\x17.content.v1.PageContentR\acontent\x12(\n" +
+	"\x04meta\x18\x06 \x01(\v2\x14.content.v1.PageMetaR\x04meta\"+\n" +
+	"\x19DeletePageTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"n\n" +
+	"\x18ListPageTemplatesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\"\x9c\x01\n" +
+	"\x19ListPageTemplatesResponse\x126\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x18.content.v1.PageTemplateR\ttemplates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xbf\x01\n" +
+	"\x1dCreatePageFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12)\n" +
+	"\x10template_version\x18\x02 \x01(\x05R\x0ftemplateVersion\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12(\n" +
+	"\x04meta\x18\x05 \x01(\v2\x14.content.v1.PageMetaR\x04meta\"\xd5\x02\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12.\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x1a.content.v1.CollectionKindR\x04kind\x12\x19\n" +
+	"\bpost_ids\x18\x06 \x03(\tR\apostIds\x12*\n" +
+	"\x05posts\x18\a \x03(\v2\x14.content.v1.BlogPostR\x05posts\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb0\x01\n" +
+	"\x17CreateCollectionRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1a.content.v1.CollectionKindR\x04kind\x12\x19\n" +
+	"\bpost_ids\x18\x05 \x03(\tR\apostIds\"&\n" +
+	"\x14GetCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"u\n" +
+	"\x17UpdateCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\")\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x84\x01\n" +
+	"\x16ListCollectionsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12.\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1a.content.v1.CollectionKindR\x04kind\"\x9c\x01\n" +
+	"\x17ListCollectionsResponse\x128\n" +
+	"\vcollections\x18\x01 \x03(\v2\x16.content.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"F\n" +
+	"\x19SetCollectionPostsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bpost_ids\x18\x02 \x03(\tR\apostIds\"J\n" +
+	"\x1dReorderCollectionPostsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bpost_ids\x18\x02 \x03(\tR\apostIds\"\xa1\x02\n" +
+	"\tLinkIssue\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\tcontentId\x12!\n" +
+	"\fcontent_slug\x18\x03 \x01(\tR\vcontentSlug\x12\x1f\n" +
+	"\vblock_index\x18\x04 \x01(\x05R\n" +
+	"blockIndex\x12\x14\n" +
+	"\x05field\x18\x05 \x01(\tR\x05field\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12-\n" +
+	"\x04kind\x18\a \x01(\x0e2\x19.content.v1.LinkIssueKindR\x04kind\x12\x1f\n" +
+	"\vstatus_code\x18\b \x01(\x05R\n" +
+	"statusCode\x12\x16\n" +
+	"\x06detail\x18\t \x01(\tR\x06detail\"\xf7\x02\n" +
+	"\n" +
+	"LinkReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\atrigger\x18\x02 \x01(\tR\atrigger\x12#\n" +
+	"\rpages_scanned\x18\x03 \x01(\x05R\fpagesScanned\x12#\n" +
+	"\rposts_scanned\x18\x04 \x01(\x05R\fpostsScanned\x12#\n" +
+	"\rlinks_checked\x18\x05 \x01(\x05R\flinksChecked\x12)\n" +
+	"\x10external_checked\x18\x06 \x01(\bR\x0fexternalChecked\x12-\n" +
+	"\x06issues\x18\a \x03(\v2\x15.content.v1.LinkIssueR\x06issues\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"&\n" +
+	"\x14GetLinkReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12RunLinkScanRequest\"\x92\x01\n" +
+	"\n" +
+	"SEOFinding\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x123\n" +
+	"\bseverity\x18\x02 \x01(\x0e2\x17.content.v1.SEOSeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
+	"\vblock_index\x18\x04 \x01(\x05R\n" +
+	"blockIndex\"\xc1\x01\n" +
+	"\tSEOReport\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x02 \x01(\tR\tcontentId\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x122\n" +
+	"\bfindings\x18\x06 \x03(\v2\x16.content.v1.SEOFindingR\bfindings\"F\n" +
+	"\x11AnalyzeSEORequest\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xb1\x01\n" +
+	"\x14ListSEOIssuesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12:\n" +
+	"\fmin_severity\x18\x03 \x01(\x0e2\x17.content.v1.SEOSeverityR\vminSeverity\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\"\x91\x01\n" +
+	"\x15ListSEOIssuesResponse\x12/\n" +
+	"\areports\x18\x01 \x03(\v2\x15.content.v1.SEOReportR\areports\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x9e\x01\n" +
+	"\x10PublishViolation\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12;\n" +
+	"\bseverity\x18\x02 \x01(\x0e2\x1f.content.v1.PublishRuleSeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
+	"\vblock_index\x18\x04 \x01(\x05R\n" +
+	"blockIndex\"Q\n" +
+	"\x11PublishGateReport\x12<\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2\x1c.content.v1.PublishViolationR\n" +
+	"violations\"\x99\x01\n" +
+	"\x0fPublishOverride\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12?\n" +
+	"\roverridden_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\foverriddenAt\x12\x14\n" +
+	"\x05rules\x18\x04 \x03(\tR\x05rules\"\x84\x01\n" +
+	"\x13WatchContentRequest\x12%\n" +
+	"\x0esince_sequence\x18\x01 \x01(\x04R\rsinceSequence\x12F\n" +
+	"\x0eresource_types\x18\x02 \x03(\x0e2\x1f.content.v1.ContentResourceTypeR\rresourceTypes\"\x86\x03\n" +
+	"\fContentEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12D\n" +
+	"\rresource_type\x18\x03 \x01(\x0e2\x1f.content.v1.ContentResourceTypeR\fresourceType\x126\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1e.content.v1.ContentEventActionR\x06action\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x06 \x01(\tR\x04slug\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12&\n" +
+	"\x04page\x18\b \x01(\v2\x10.content.v1.PageH\x00R\x04page\x123\n" +
+	"\tblog_post\x18\t \x01(\v2\x14.content.v1.BlogPostH\x00R\bblogPostB\n" +
+	"\n" +
+	"\bresource*~\n" +
+	"\x0fTwitterCardType\x12!\n" +
+	"\x1dTWITTER_CARD_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TWITTER_CARD_TYPE_SUMMARY\x10\x01\x12)\n" +
+	"%TWITTER_CARD_TYPE_SUMMARY_LARGE_IMAGE\x10\x02*u\n" +
 	"\n" +
 	"PageStatus\x12\x1b\n" +
 	"\x17PAGE_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11PAGE_STATUS_DRAFT\x10\x01\x12\x19\n" +
 	"\x15PAGE_STATUS_PUBLISHED\x10\x02\x12\x18\n" +
-	"\x14PAGE_STATUS_ARCHIVED\x10\x032\xae\v\n" +
+	"\x14PAGE_STATUS_ARCHIVED\x10\x03*\x95\x01\n" +
+	"\x0fVisibilityLevel\x12 \n" +
+	"\x1cVISIBILITY_LEVEL_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17VISIBILITY_LEVEL_PUBLIC\x10\x01\x12\"\n" +
+	"\x1eVISIBILITY_LEVEL_AUTHENTICATED\x10\x02\x12\x1f\n" +
+	"\x1bVISIBILITY_LEVEL_RESTRICTED\x10\x03*\xa3\x01\n" +
+	"\fBlogPostSort\x12\x1e\n" +
+	"\x1aBLOG_POST_SORT_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bBLOG_POST_SORT_PUBLISHED_AT\x10\x01\x12\x1d\n" +
+	"\x19BLOG_POST_SORT_UPDATED_AT\x10\x02\x12\x18\n" +
+	"\x14BLOG_POST_SORT_TITLE\x10\x03\x12\x19\n" +
+	"\x15BLOG_POST_SORT_MANUAL\x10\x04*j\n" +
+	"\x0eCollectionKind\x12\x1f\n" +
+	"\x1bCOLLECTION_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COLLECTION_KIND_SERIES\x10\x01\x12\x1b\n" +
+	"\x17COLLECTION_KIND_CURATED\x10\x02*\xbc\x01\n" +
+	"\rLinkIssueKind\x12\x1f\n" +
+	"\x1bLINK_ISSUE_KIND_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cLINK_ISSUE_KIND_MISSING_PAGE\x10\x01\x12 \n" +
+	"\x1cLINK_ISSUE_KIND_MISSING_POST\x10\x02\x12!\n" +
+	"\x1dLINK_ISSUE_KIND_MISSING_MEDIA\x10\x03\x12#\n" +
+	"\x1fLINK_ISSUE_KIND_BROKEN_EXTERNAL\x10\x04*t\n" +
+	"\vSEOSeverity\x12\x1c\n" +
+	"\x18SEO_SEVERITY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SEO_SEVERITY_INFO\x10\x01\x12\x18\n" +
+	"\x14SEO_SEVERITY_WARNING\x10\x02\x12\x16\n" +
+	"\x12SEO_SEVERITY_ERROR\x10\x03*\x80\x01\n" +
+	"\x13PublishRuleSeverity\x12%\n" +
+	"!PUBLISH_RULE_SEVERITY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPUBLISH_RULE_SEVERITY_WARNING\x10\x01\x12\x1f\n" +
+	"\x1bPUBLISH_RULE_SEVERITY_ERROR\x10\x02*\xa2\x01\n" +
+	"\x13ContentResourceType\x12%\n" +
+	"!CONTENT_RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aCONTENT_RESOURCE_TYPE_PAGE\x10\x01\x12#\n" +
+	"\x1fCONTENT_RESOURCE_TYPE_BLOG_POST\x10\x02\x12\x1f\n" +
+	"\x1bCONTENT_RESOURCE_TYPE_MEDIA\x10\x03*\xc4\x01\n" +
+	"\x12ContentEventAction\x12$\n" +
+	" CONTENT_EVENT_ACTION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCONTENT_EVENT_ACTION_CREATED\x10\x01\x12 \n" +
+	"\x1cCONTENT_EVENT_ACTION_UPDATED\x10\x02\x12\"\n" +
+	"\x1eCONTENT_EVENT_ACTION_PUBLISHED\x10\x03\x12 \n" +
+	"\x1cCONTENT_EVENT_ACTION_DELETED\x10\x042\xac%\n" +
 	"\x0eContentService\x12W\n" +
 	"\n" +
 	"CreatePage\x12\x1d.content.v1.CreatePageRequest\x1a\x10.content.v1.Page\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/pages\x12S\n" +
@@ -2039,9 +7044,38 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\rListBlogPosts\x12 .content.v1.ListBlogPostsRequest\x1a!.content.v1.ListBlogPostsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/blog\x12w\n" +
 	"\x0fSearchBlogPosts\x12\".content.v1.SearchBlogPostsRequest\x1a#.content.v1.SearchBlogPostsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/blog/search\x12\x81\x01\n" +
 	"\x11GetBlogCategories\x12$.content.v1.GetBlogCategoriesRequest\x1a%.content.v1.GetBlogCategoriesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/blog/categories\x12i\n" +
-	"\vGetBlogTags\x12\x1e.content.v1.GetBlogTagsRequest\x1a\x1f.content.v1.GetBlogTagsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/blog/tags\x12e\n" +
+	"\vGetBlogTags\x12\x1e.content.v1.GetBlogTagsRequest\x1a\x1f.content.v1.GetBlogTagsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/blog/tags\x12u\n" +
+	"\x0eGetBlogArchive\x12!.content.v1.GetBlogArchiveRequest\x1a\".content.v1.GetBlogArchiveResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/blog/archive\x12}\n" +
+	"\x0fGetRelatedPosts\x12\".content.v1.GetRelatedPostsRequest\x1a#.content.v1.GetRelatedPostsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/blog/{id}/related\x12e\n" +
 	"\n" +
-	"GetRSSFeed\x12\x1d.content.v1.GetRSSFeedRequest\x1a\x1e.content.v1.GetRSSFeedResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/blog/rssBCZAgithub.com/7-solutions/saas-platformbackend/gen/content/v1;contentv1b\x06proto3"
+	"GetRSSFeed\x12\x1d.content.v1.GetRSSFeedRequest\x1a\x1e.content.v1.GetRSSFeedResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/blog/rss\x12s\n" +
+	"\x13CreateReusableBlock\x12&.content.v1.CreateReusableBlockRequest\x1a\x19.content.v1.ReusableBlock\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/blocks\x12o\n" +
+	"\x10GetReusableBlock\x12#.content.v1.GetReusableBlockRequest\x1a\x19.content.v1.ReusableBlock\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/blocks/{id}\x12x\n" +
+	"\x13UpdateReusableBlock\x12&.content.v1.UpdateReusableBlockRequest\x1a\x19.content.v1.ReusableBlock\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/blocks/{id}\x12r\n" +
+	"\x13DeleteReusableBlock\x12&.content.v1.DeleteReusableBlockRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/blocks/{id}\x12{\n" +
+	"\x12ListReusableBlocks\x12%.content.v1.ListReusableBlocksRequest\x1a&.content.v1.ListReusableBlocksResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/blocks\x12\x96\x01\n" +
+	"\x17ListReusableBlockUsages\x12*.content.v1.ListReusableBlockUsagesRequest\x1a+.content.v1.ListReusableBlockUsagesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/blocks/{id}/usages\x12l\n" +
+	"\rDuplicatePage\x12 .content.v1.DuplicatePageRequest\x1a\x10.content.v1.Page\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/pages/{id}/duplicate\x12w\n" +
+	"\x11DuplicateBlogPost\x12$.content.v1.DuplicateBlogPostRequest\x1a\x14.content.v1.BlogPost\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/blog/{id}/duplicate\x12x\n" +
+	"\x12CreatePageTemplate\x12%.content.v1.CreatePageTemplateRequest\x1a\x18.content.v1.PageTemplate\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/page-templates\x12t\n" +
+	"\x0fGetPageTemplate\x12\".content.v1.GetPageTemplateRequest\x1a\x18.content.v1.PageTemplate\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/page-templates/{id}\x12}\n" +
+	"\x12UpdatePageTemplate\x12%.content.v1.UpdatePageTemplateRequest\x1a\x18.content.v1.PageTemplate\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/api/v1/page-templates/{id}\x12x\n" +
+	"\x12DeletePageTemplate\x12%.content.v1.DeletePageTemplateRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/api/v1/page-templates/{id}\x12\x80\x01\n" +
+	"\x11ListPageTemplates\x12$.content.v1.ListPageTemplatesRequest\x1a%.content.v1.ListPageTemplatesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/page-templates\x12\x8c\x01\n" +
+	"\x16CreatePageFromTemplate\x12).content.v1.CreatePageFromTemplateRequest\x1a\x10.content.v1.Page\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/page-templates/{template_id}/pages\x12o\n" +
+	"\x10CreateCollection\x12#.content.v1.CreateCollectionRequest\x1a\x16.content.v1.Collection\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/collections\x12k\n" +
+	"\rGetCollection\x12 .content.v1.GetCollectionRequest\x1a\x16.content.v1.Collection\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/collections/{id}\x12t\n" +
+	"\x10UpdateCollection\x12#.content.v1.UpdateCollectionRequest\x1a\x16.content.v1.Collection\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/api/v1/collections/{id}\x12q\n" +
+	"\x10DeleteCollection\x12#.content.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/collections/{id}\x12w\n" +
+	"\x0fListCollections\x12\".content.v1.ListCollectionsRequest\x1a#.content.v1.ListCollectionsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/collections\x12~\n" +
+	"\x12SetCollectionPosts\x12%.content.v1.SetCollectionPostsRequest\x1a\x16.content.v1.Collection\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/collections/{id}/posts\x12\x88\x01\n" +
+	"\x16ReorderCollectionPosts\x12).content.v1.ReorderCollectionPostsRequest\x1a\x16.content.v1.Collection\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/collections/{id}/reorder\x12g\n" +
+	"\rGetLinkReport\x12 .content.v1.GetLinkReportRequest\x1a\x16.content.v1.LinkReport\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/link-reports\x12f\n" +
+	"\vRunLinkScan\x12\x1e.content.v1.RunLinkScanRequest\x1a\x16.content.v1.LinkReport\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/link-reports\x12_\n" +
+	"\n" +
+	"AnalyzeSEO\x12\x1d.content.v1.AnalyzeSEORequest\x1a\x15.content.v1.SEOReport\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/seo/analyze\x12p\n" +
+	"\rListSEOIssues\x12 .content.v1.ListSEOIssuesRequest\x1a!.content.v1.ListSEOIssuesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/seo/issues\x12K\n" +
+	"\fWatchContent\x12\x1f.content.v1.WatchContentRequest\x1a\x18.content.v1.ContentEvent0\x01BFZDgithub.com/7-solutions/saas-platformbackend/gen/content/v1;contentv1b\x06proto3"
 
 var (
 	file_content_v1_content_proto_rawDescOnce sync.Once
@@ -2055,109 +7089,298 @@ func file_content_v1_content_proto_rawDescGZIP() []byte {
 	return file_content_v1_content_proto_rawDescData
 }
 
-var file_content_v1_content_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_content_v1_content_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_content_v1_content_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_content_v1_content_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_content_v1_content_proto_goTypes = []any{
-	(PageStatus)(0),                   // 0: content.v1.PageStatus
-	(*Page)(nil),                      // 1: content.v1.Page
-	(*PageContent)(nil),               // 2: content.v1.PageContent
-	(*ContentBlock)(nil),              // 3: content.v1.ContentBlock
-	(*PageMeta)(nil),                  // 4: content.v1.PageMeta
-	(*CreatePageRequest)(nil),         // 5: content.v1.CreatePageRequest
-	(*GetPageRequest)(nil),            // 6: content.v1.GetPageRequest
-	(*UpdatePageRequest)(nil),         // 7: content.v1.UpdatePageRequest
-	(*DeletePageRequest)(nil),         // 8: content.v1.DeletePageRequest
-	(*ListPagesRequest)(nil),          // 9: content.v1.ListPagesRequest
-	(*ListPagesResponse)(nil),         // 10: content.v1.ListPagesResponse
-	(*BlogPost)(nil),                  // 11: content.v1.BlogPost
-	(*CreateBlogPostRequest)(nil),     // 12: content.v1.CreateBlogPostRequest
-	(*GetBlogPostRequest)(nil),        // 13: content.v1.GetBlogPostRequest
-	(*UpdateBlogPostRequest)(nil),     // 14: content.v1.UpdateBlogPostRequest
-	(*DeleteBlogPostRequest)(nil),     // 15: content.v1.DeleteBlogPostRequest
-	(*ListBlogPostsRequest)(nil),      // 16: content.v1.ListBlogPostsRequest
-	(*ListBlogPostsResponse)(nil),     // 17: content.v1.ListBlogPostsResponse
-	(*SearchBlogPostsRequest)(nil),    // 18: content.v1.SearchBlogPostsRequest
-	(*SearchBlogPostsResponse)(nil),   // 19: content.v1.SearchBlogPostsResponse
-	(*GetBlogCategoriesRequest)(nil),  // 20: content.v1.GetBlogCategoriesRequest
-	(*GetBlogCategoriesResponse)(nil), // 21: content.v1.GetBlogCategoriesResponse
-	(*BlogCategory)(nil),              // 22: content.v1.BlogCategory
-	(*GetBlogTagsRequest)(nil),        // 23: content.v1.GetBlogTagsRequest
-	(*GetBlogTagsResponse)(nil),       // 24: content.v1.GetBlogTagsResponse
-	(*BlogTag)(nil),                   // 25: content.v1.BlogTag
-	(*GetRSSFeedRequest)(nil),         // 26: content.v1.GetRSSFeedRequest
-	(*GetRSSFeedResponse)(nil),        // 27: content.v1.GetRSSFeedResponse
-	nil,                               // 28: content.v1.ContentBlock.DataEntry
-	(*timestamppb.Timestamp)(nil),     // 29: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),             // 30: google.protobuf.Empty
+	(TwitterCardType)(0),                    // 0: content.v1.TwitterCardType
+	(PageStatus)(0),                         // 1: content.v1.PageStatus
+	(VisibilityLevel)(0),                    // 2: content.v1.VisibilityLevel
+	(BlogPostSort)(0),                       // 3: content.v1.BlogPostSort
+	(CollectionKind)(0),                     // 4: content.v1.CollectionKind
+	(LinkIssueKind)(0),                      // 5: content.v1.LinkIssueKind
+	(SEOSeverity)(0),                        // 6: content.v1.SEOSeverity
+	(PublishRuleSeverity)(0),                // 7: content.v1.PublishRuleSeverity
+	(ContentResourceType)(0),                // 8: content.v1.ContentResourceType
+	(ContentEventAction)(0),                 // 9: content.v1.ContentEventAction
+	(*Page)(nil),                            // 10: content.v1.Page
+	(*PageContent)(nil),                     // 11: content.v1.PageContent
+	(*ContentBlock)(nil),                    // 12: content.v1.ContentBlock
+	(*Embed)(nil),                           // 13: content.v1.Embed
+	(*PageMeta)(nil),                        // 14: content.v1.PageMeta
+	(*Visibility)(nil),                      // 15: content.v1.Visibility
+	(*CreatePageRequest)(nil),               // 16: content.v1.CreatePageRequest
+	(*GetPageRequest)(nil),                  // 17: content.v1.GetPageRequest
+	(*UpdatePageRequest)(nil),               // 18: content.v1.UpdatePageRequest
+	(*DeletePageRequest)(nil),               // 19: content.v1.DeletePageRequest
+	(*ListPagesRequest)(nil),                // 20: content.v1.ListPagesRequest
+	(*ListPagesResponse)(nil),               // 21: content.v1.ListPagesResponse
+	(*BlogPost)(nil),                        // 22: content.v1.BlogPost
+	(*ImageAsset)(nil),                      // 23: content.v1.ImageAsset
+	(*ImageVariant)(nil),                    // 24: content.v1.ImageVariant
+	(*SeriesNavigation)(nil),                // 25: content.v1.SeriesNavigation
+	(*PostLink)(nil),                        // 26: content.v1.PostLink
+	(*CreateBlogPostRequest)(nil),           // 27: content.v1.CreateBlogPostRequest
+	(*GetBlogPostRequest)(nil),              // 28: content.v1.GetBlogPostRequest
+	(*UpdateBlogPostRequest)(nil),           // 29: content.v1.UpdateBlogPostRequest
+	(*DeleteBlogPostRequest)(nil),           // 30: content.v1.DeleteBlogPostRequest
+	(*ListBlogPostsRequest)(nil),            // 31: content.v1.ListBlogPostsRequest
+	(*ListBlogPostsResponse)(nil),           // 32: content.v1.ListBlogPostsResponse
+	(*SearchBlogPostsRequest)(nil),          // 33: content.v1.SearchBlogPostsRequest
+	(*SearchBlogPostsResponse)(nil),         // 34: content.v1.SearchBlogPostsResponse
+	(*GetBlogCategoriesRequest)(nil),        // 35: content.v1.GetBlogCategoriesRequest
+	(*GetBlogCategoriesResponse)(nil),       // 36: content.v1.GetBlogCategoriesResponse
+	(*BlogCategory)(nil),                    // 37: content.v1.BlogCategory
+	(*GetBlogTagsRequest)(nil),              // 38: content.v1.GetBlogTagsRequest
+	(*GetBlogTagsResponse)(nil),             // 39: content.v1.GetBlogTagsResponse
+	(*GetBlogArchiveRequest)(nil),           // 40: content.v1.GetBlogArchiveRequest
+	(*GetBlogArchiveResponse)(nil),          // 41: content.v1.GetBlogArchiveResponse
+	(*BlogArchiveYear)(nil),                 // 42: content.v1.BlogArchiveYear
+	(*BlogArchiveMonth)(nil),                // 43: content.v1.BlogArchiveMonth
+	(*BlogTag)(nil),                         // 44: content.v1.BlogTag
+	(*GetRelatedPostsRequest)(nil),          // 45: content.v1.GetRelatedPostsRequest
+	(*GetRelatedPostsResponse)(nil),         // 46: content.v1.GetRelatedPostsResponse
+	(*GetRSSFeedRequest)(nil),               // 47: content.v1.GetRSSFeedRequest
+	(*GetRSSFeedResponse)(nil),              // 48: content.v1.GetRSSFeedResponse
+	(*ReusableBlock)(nil),                   // 49: content.v1.ReusableBlock
+	(*CreateReusableBlockRequest)(nil),      // 50: content.v1.CreateReusableBlockRequest
+	(*GetReusableBlockRequest)(nil),         // 51: content.v1.GetReusableBlockRequest
+	(*UpdateReusableBlockRequest)(nil),      // 52: content.v1.UpdateReusableBlockRequest
+	(*DeleteReusableBlockRequest)(nil),      // 53: content.v1.DeleteReusableBlockRequest
+	(*ListReusableBlocksRequest)(nil),       // 54: content.v1.ListReusableBlocksRequest
+	(*ListReusableBlocksResponse)(nil),      // 55: content.v1.ListReusableBlocksResponse
+	(*ListReusableBlockUsagesRequest)(nil),  // 56: content.v1.ListReusableBlockUsagesRequest
+	(*ReusableBlockUsage)(nil),              // 57: content.v1.ReusableBlockUsage
+	(*ListReusableBlockUsagesResponse)(nil), // 58: content.v1.ListReusableBlockUsagesResponse
+	(*DuplicatePageRequest)(nil),            // 59: content.v1.DuplicatePageRequest
+	(*DuplicateBlogPostRequest)(nil),        // 60: content.v1.DuplicateBlogPostRequest
+	(*PageTemplate)(nil),                    // 61: content.v1.PageTemplate
+	(*CreatePageTemplateRequest)(nil),       // 62: content.v1.CreatePageTemplateRequest
+	(*GetPageTemplateRequest)(nil),          // 63: content.v1.GetPageTemplateRequest
+	(*UpdatePageTemplateRequest)(nil),       // 64: content.v1.UpdatePageTemplateRequest
+	(*DeletePageTemplateRequest)(nil),       // 65: content.v1.DeletePageTemplateRequest
+	(*ListPageTemplatesRequest)(nil),        // 66: content.v1.ListPageTemplatesRequest
+	(*ListPageTemplatesResponse)(nil),       // 67: content.v1.ListPageTemplatesResponse
+	(*CreatePageFromTemplateRequest)(nil),   // 68: content.v1.CreatePageFromTemplateRequest
+	(*Collection)(nil),                      // 69: content.v1.Collection
+	(*CreateCollectionRequest)(nil),         // 70: content.v1.CreateCollectionRequest
+	(*GetCollectionRequest)(nil),            // 71: content.v1.GetCollectionRequest
+	(*UpdateCollectionRequest)(nil),         // 72: content.v1.UpdateCollectionRequest
+	(*DeleteCollectionRequest)(nil),         // 73: content.v1.DeleteCollectionRequest
+	(*ListCollectionsRequest)(nil),          // 74: content.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),         // 75: content.v1.ListCollectionsResponse
+	(*SetCollectionPostsRequest)(nil),       // 76: content.v1.SetCollectionPostsRequest
+	(*ReorderCollectionPostsRequest)(nil),   // 77: content.v1.ReorderCollectionPostsRequest
+	(*LinkIssue)(nil),                       // 78: content.v1.LinkIssue
+	(*LinkReport)(nil),                      // 79: content.v1.LinkReport
+	(*GetLinkReportRequest)(nil),            // 80: content.v1.GetLinkReportRequest
+	(*RunLinkScanRequest)(nil),              // 81: content.v1.RunLinkScanRequest
+	(*SEOFinding)(nil),                      // 82: content.v1.SEOFinding
+	(*SEOReport)(nil),                       // 83: content.v1.SEOReport
+	(*AnalyzeSEORequest)(nil),               // 84: content.v1.AnalyzeSEORequest
+	(*ListSEOIssuesRequest)(nil),            // 85: content.v1.ListSEOIssuesRequest
+	(*ListSEOIssuesResponse)(nil),           // 86: content.v1.ListSEOIssuesResponse
+	(*PublishViolation)(nil),                // 87: content.v1.PublishViolation
+	(*PublishGateReport)(nil),               // 88: content.v1.PublishGateReport
+	(*PublishOverride)(nil),                 // 89: content.v1.PublishOverride
+	(*WatchContentRequest)(nil),             // 90: content.v1.WatchContentRequest
+	(*ContentEvent)(nil),                    // 91: content.v1.ContentEvent
+	nil,                                     // 92: content.v1.ContentBlock.DataEntry
+	(*timestamppb.Timestamp)(nil),           // 93: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 94: google.protobuf.Empty
 }
 var file_content_v1_content_proto_depIdxs = []int32{
-	2,  // 0: content.v1.Page.content:type_name -> content.v1.PageContent
-	4,  // 1: content.v1.Page.meta:type_name -> content.v1.PageMeta
-	0,  // 2: content.v1.Page.status:type_name -> content.v1.PageStatus
-	29, // 3: content.v1.Page.created_at:type_name -> google.protobuf.Timestamp
-	29, // 4: content.v1.Page.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 5: content.v1.PageContent.blocks:type_name -> content.v1.ContentBlock
-	28, // 6: content.v1.ContentBlock.data:type_name -> content.v1.ContentBlock.DataEntry
-	2,  // 7: content.v1.CreatePageRequest.content:type_name -> content.v1.PageContent
-	4,  // 8: content.v1.CreatePageRequest.meta:type_name -> content.v1.PageMeta
-	0,  // 9: content.v1.CreatePageRequest.status:type_name -> content.v1.PageStatus
-	2,  // 10: content.v1.UpdatePageRequest.content:type_name -> content.v1.PageContent
-	4,  // 11: content.v1.UpdatePageRequest.meta:type_name -> content.v1.PageMeta
-	0,  // 12: content.v1.UpdatePageRequest.status:type_name -> content.v1.PageStatus
-	0,  // 13: content.v1.ListPagesRequest.status:type_name -> content.v1.PageStatus
-	1,  // 14: content.v1.ListPagesResponse.pages:type_name -> content.v1.Page
-	2,  // 15: content.v1.BlogPost.content:type_name -> content.v1.PageContent
-	4,  // 16: content.v1.BlogPost.meta:type_name -> content.v1.PageMeta
-	0,  // 17: content.v1.BlogPost.status:type_name -> content.v1.PageStatus
-	29, // 18: content.v1.BlogPost.published_at:type_name -> google.protobuf.Timestamp
-	29, // 19: content.v1.BlogPost.created_at:type_name -> google.protobuf.Timestamp
-	29, // 20: content.v1.BlogPost.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 21: content.v1.CreateBlogPostRequest.content:type_name -> content.v1.PageContent
-	4,  // 22: content.v1.CreateBlogPostRequest.meta:type_name -> content.v1.PageMeta
-	0,  // 23: content.v1.CreateBlogPostRequest.status:type_name -> content.v1.PageStatus
-	29, // 24: content.v1.CreateBlogPostRequest.published_at:type_name -> google.protobuf.Timestamp
-	2,  // 25: content.v1.UpdateBlogPostRequest.content:type_name -> content.v1.PageContent
-	4,  // 26: content.v1.UpdateBlogPostRequest.meta:type_name -> content.v1.PageMeta
-	0,  // 27: content.v1.UpdateBlogPostRequest.status:type_name -> content.v1.PageStatus
-	29, // 28: content.v1.UpdateBlogPostRequest.published_at:type_name -> google.protobuf.Timestamp
-	0,  // 29: content.v1.ListBlogPostsRequest.status:type_name -> content.v1.PageStatus
-	11, // 30: content.v1.ListBlogPostsResponse.posts:type_name -> content.v1.BlogPost
-	11, // 31: content.v1.SearchBlogPostsResponse.posts:type_name -> content.v1.BlogPost
-	22, // 32: content.v1.GetBlogCategoriesResponse.categories:type_name -> content.v1.BlogCategory
-	25, // 33: content.v1.GetBlogTagsResponse.tags:type_name -> content.v1.BlogTag
-	5,  // 34: content.v1.ContentService.CreatePage:input_type -> content.v1.CreatePageRequest
-	6,  // 35: content.v1.ContentService.GetPage:input_type -> content.v1.GetPageRequest
-	7,  // 36: content.v1.ContentService.UpdatePage:input_type -> content.v1.UpdatePageRequest
-	8,  // 37: content.v1.ContentService.DeletePage:input_type -> content.v1.DeletePageRequest
-	9,  // 38: content.v1.ContentService.ListPages:input_type -> content.v1.ListPagesRequest
-	12, // 39: content.v1.ContentService.CreateBlogPost:input_type -> content.v1.CreateBlogPostRequest
-	13, // 40: content.v1.ContentService.GetBlogPost:input_type -> content.v1.GetBlogPostRequest
-	14, // 41: content.v1.ContentService.UpdateBlogPost:input_type -> content.v1.UpdateBlogPostRequest
-	15, // 42: content.v1.ContentService.DeleteBlogPost:input_type -> content.v1.DeleteBlogPostRequest
-	16, // 43: content.v1.ContentService.ListBlogPosts:input_type -> content.v1.ListBlogPostsRequest
-	18, // 44: content.v1.ContentService.SearchBlogPosts:input_type -> content.v1.SearchBlogPostsRequest
-	20, // 45: content.v1.ContentService.GetBlogCategories:input_type -> content.v1.GetBlogCategoriesRequest
-	23, // 46: content.v1.ContentService.GetBlogTags:input_type -> content.v1.GetBlogTagsRequest
-	26, // 47: content.v1.ContentService.GetRSSFeed:input_type -> content.v1.GetRSSFeedRequest
-	1,  // 48: content.v1.ContentService.CreatePage:output_type -> content.v1.Page
-	1,  // 49: content.v1.ContentService.GetPage:output_type -> content.v1.Page
-	1,  // 50: content.v1.ContentService.UpdatePage:output_type -> content.v1.Page
-	30, // 51: content.v1.ContentService.DeletePage:output_type -> google.protobuf.Empty
-	10, // 52: content.v1.ContentService.ListPages:output_type -> content.v1.ListPagesResponse
-	11, // 53: content.v1.ContentService.CreateBlogPost:output_type -> content.v1.BlogPost
-	11, // 54: content.v1.ContentService.GetBlogPost:output_type -> content.v1.BlogPost
-	11, // 55: content.v1.ContentService.UpdateBlogPost:output_type -> content.v1.BlogPost
-	30, // 56: content.v1.ContentService.DeleteBlogPost:output_type -> google.protobuf.Empty
-	17, // 57: content.v1.ContentService.ListBlogPosts:output_type -> content.v1.ListBlogPostsResponse
-	19, // 58: content.v1.ContentService.SearchBlogPosts:output_type -> content.v1.SearchBlogPostsResponse
-	21, // 59: content.v1.ContentService.GetBlogCategories:output_type -> content.v1.GetBlogCategoriesResponse
-	24, // 60: content.v1.ContentService.GetBlogTags:output_type -> content.v1.GetBlogTagsResponse
-	27, // 61: content.v1.ContentService.GetRSSFeed:output_type -> content.v1.GetRSSFeedResponse
-	48, // [48:62] is the sub-list for method output_type
-	34, // [34:48] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	11,  // 0: content.v1.Page.content:type_name -> content.v1.PageContent
+	14,  // 1: content.v1.Page.meta:type_name -> content.v1.PageMeta
+	1,   // 2: content.v1.Page.status:type_name -> content.v1.PageStatus
+	93,  // 3: content.v1.Page.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 4: content.v1.Page.updated_at:type_name -> google.protobuf.Timestamp
+	15,  // 5: content.v1.Page.visibility:type_name -> content.v1.Visibility
+	87,  // 6: content.v1.Page.publish_warnings:type_name -> content.v1.PublishViolation
+	89,  // 7: content.v1.Page.publish_override:type_name -> content.v1.PublishOverride
+	12,  // 8: content.v1.PageContent.blocks:type_name -> content.v1.ContentBlock
+	92,  // 9: content.v1.ContentBlock.data:type_name -> content.v1.ContentBlock.DataEntry
+	12,  // 10: content.v1.ContentBlock.resolved_blocks:type_name -> content.v1.ContentBlock
+	13,  // 11: content.v1.ContentBlock.embed:type_name -> content.v1.Embed
+	0,   // 12: content.v1.PageMeta.twitter_card:type_name -> content.v1.TwitterCardType
+	2,   // 13: content.v1.Visibility.level:type_name -> content.v1.VisibilityLevel
+	11,  // 14: content.v1.CreatePageRequest.content:type_name -> content.v1.PageContent
+	14,  // 15: content.v1.CreatePageRequest.meta:type_name -> content.v1.PageMeta
+	1,   // 16: content.v1.CreatePageRequest.status:type_name -> content.v1.PageStatus
+	15,  // 17: content.v1.CreatePageRequest.visibility:type_name -> content.v1.Visibility
+	11,  // 18: content.v1.UpdatePageRequest.content:type_name -> content.v1.PageContent
+	14,  // 19: content.v1.UpdatePageRequest.meta:type_name -> content.v1.PageMeta
+	1,   // 20: content.v1.UpdatePageRequest.status:type_name -> content.v1.PageStatus
+	15,  // 21: content.v1.UpdatePageRequest.visibility:type_name -> content.v1.Visibility
+	1,   // 22: content.v1.ListPagesRequest.status:type_name -> content.v1.PageStatus
+	10,  // 23: content.v1.ListPagesResponse.pages:type_name -> content.v1.Page
+	11,  // 24: content.v1.BlogPost.content:type_name -> content.v1.PageContent
+	14,  // 25: content.v1.BlogPost.meta:type_name -> content.v1.PageMeta
+	1,   // 26: content.v1.BlogPost.status:type_name -> content.v1.PageStatus
+	93,  // 27: content.v1.BlogPost.published_at:type_name -> google.protobuf.Timestamp
+	93,  // 28: content.v1.BlogPost.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 29: content.v1.BlogPost.updated_at:type_name -> google.protobuf.Timestamp
+	25,  // 30: content.v1.BlogPost.series:type_name -> content.v1.SeriesNavigation
+	23,  // 31: content.v1.BlogPost.featured_image_media:type_name -> content.v1.ImageAsset
+	15,  // 32: content.v1.BlogPost.visibility:type_name -> content.v1.Visibility
+	87,  // 33: content.v1.BlogPost.publish_warnings:type_name -> content.v1.PublishViolation
+	89,  // 34: content.v1.BlogPost.publish_override:type_name -> content.v1.PublishOverride
+	93,  // 35: content.v1.BlogPost.pinned_until:type_name -> google.protobuf.Timestamp
+	24,  // 36: content.v1.ImageAsset.variants:type_name -> content.v1.ImageVariant
+	26,  // 37: content.v1.SeriesNavigation.previous:type_name -> content.v1.PostLink
+	26,  // 38: content.v1.SeriesNavigation.next:type_name -> content.v1.PostLink
+	11,  // 39: content.v1.CreateBlogPostRequest.content:type_name -> content.v1.PageContent
+	14,  // 40: content.v1.CreateBlogPostRequest.meta:type_name -> content.v1.PageMeta
+	1,   // 41: content.v1.CreateBlogPostRequest.status:type_name -> content.v1.PageStatus
+	93,  // 42: content.v1.CreateBlogPostRequest.published_at:type_name -> google.protobuf.Timestamp
+	15,  // 43: content.v1.CreateBlogPostRequest.visibility:type_name -> content.v1.Visibility
+	93,  // 44: content.v1.CreateBlogPostRequest.pinned_until:type_name -> google.protobuf.Timestamp
+	11,  // 45: content.v1.UpdateBlogPostRequest.content:type_name -> content.v1.PageContent
+	14,  // 46: content.v1.UpdateBlogPostRequest.meta:type_name -> content.v1.PageMeta
+	1,   // 47: content.v1.UpdateBlogPostRequest.status:type_name -> content.v1.PageStatus
+	93,  // 48: content.v1.UpdateBlogPostRequest.published_at:type_name -> google.protobuf.Timestamp
+	15,  // 49: content.v1.UpdateBlogPostRequest.visibility:type_name -> content.v1.Visibility
+	93,  // 50: content.v1.UpdateBlogPostRequest.pinned_until:type_name -> google.protobuf.Timestamp
+	1,   // 51: content.v1.ListBlogPostsRequest.status:type_name -> content.v1.PageStatus
+	93,  // 52: content.v1.ListBlogPostsRequest.published_after:type_name -> google.protobuf.Timestamp
+	93,  // 53: content.v1.ListBlogPostsRequest.published_before:type_name -> google.protobuf.Timestamp
+	3,   // 54: content.v1.ListBlogPostsRequest.sort:type_name -> content.v1.BlogPostSort
+	22,  // 55: content.v1.ListBlogPostsResponse.posts:type_name -> content.v1.BlogPost
+	22,  // 56: content.v1.SearchBlogPostsResponse.posts:type_name -> content.v1.BlogPost
+	37,  // 57: content.v1.GetBlogCategoriesResponse.categories:type_name -> content.v1.BlogCategory
+	44,  // 58: content.v1.GetBlogTagsResponse.tags:type_name -> content.v1.BlogTag
+	42,  // 59: content.v1.GetBlogArchiveResponse.years:type_name -> content.v1.BlogArchiveYear
+	43,  // 60: content.v1.BlogArchiveYear.months:type_name -> content.v1.BlogArchiveMonth
+	22,  // 61: content.v1.GetRelatedPostsResponse.posts:type_name -> content.v1.BlogPost
+	11,  // 62: content.v1.ReusableBlock.content:type_name -> content.v1.PageContent
+	93,  // 63: content.v1.ReusableBlock.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 64: content.v1.ReusableBlock.updated_at:type_name -> google.protobuf.Timestamp
+	11,  // 65: content.v1.CreateReusableBlockRequest.content:type_name -> content.v1.PageContent
+	11,  // 66: content.v1.UpdateReusableBlockRequest.content:type_name -> content.v1.PageContent
+	49,  // 67: content.v1.ListReusableBlocksResponse.blocks:type_name -> content.v1.ReusableBlock
+	57,  // 68: content.v1.ListReusableBlockUsagesResponse.usages:type_name -> content.v1.ReusableBlockUsage
+	11,  // 69: content.v1.PageTemplate.content:type_name -> content.v1.PageContent
+	14,  // 70: content.v1.PageTemplate.meta:type_name -> content.v1.PageMeta
+	93,  // 71: content.v1.PageTemplate.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 72: content.v1.PageTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	11,  // 73: content.v1.CreatePageTemplateRequest.content:type_name -> content.v1.PageContent
+	14,  // 74: content.v1.CreatePageTemplateRequest.meta:type_name -> content.v1.PageMeta
+	11,  // 75: content.v1.UpdatePageTemplateRequest.content:type_name -> content.v1.PageContent
+	14,  // 76: content.v1.UpdatePageTemplateRequest.meta:type_name -> content.v1.PageMeta
+	61,  // 77: content.v1.ListPageTemplatesResponse.templates:type_name -> content.v1.PageTemplate
+	14,  // 78: content.v1.CreatePageFromTemplateRequest.meta:type_name -> content.v1.PageMeta
+	4,   // 79: content.v1.Collection.kind:type_name -> content.v1.CollectionKind
+	22,  // 80: content.v1.Collection.posts:type_name -> content.v1.BlogPost
+	93,  // 81: content.v1.Collection.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 82: content.v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 83: content.v1.CreateCollectionRequest.kind:type_name -> content.v1.CollectionKind
+	4,   // 84: content.v1.ListCollectionsRequest.kind:type_name -> content.v1.CollectionKind
+	69,  // 85: content.v1.ListCollectionsResponse.collections:type_name -> content.v1.Collection
+	5,   // 86: content.v1.LinkIssue.kind:type_name -> content.v1.LinkIssueKind
+	78,  // 87: content.v1.LinkReport.issues:type_name -> content.v1.LinkIssue
+	93,  // 88: content.v1.LinkReport.started_at:type_name -> google.protobuf.Timestamp
+	93,  // 89: content.v1.LinkReport.finished_at:type_name -> google.protobuf.Timestamp
+	6,   // 90: content.v1.SEOFinding.severity:type_name -> content.v1.SEOSeverity
+	82,  // 91: content.v1.SEOReport.findings:type_name -> content.v1.SEOFinding
+	6,   // 92: content.v1.ListSEOIssuesRequest.min_severity:type_name -> content.v1.SEOSeverity
+	83,  // 93: content.v1.ListSEOIssuesResponse.reports:type_name -> content.v1.SEOReport
+	7,   // 94: content.v1.PublishViolation.severity:type_name -> content.v1.PublishRuleSeverity
+	87,  // 95: content.v1.PublishGateReport.violations:type_name -> content.v1.PublishViolation
+	93,  // 96: content.v1.PublishOverride.overridden_at:type_name -> google.protobuf.Timestamp
+	8,   // 97: content.v1.WatchContentRequest.resource_types:type_name -> content.v1.ContentResourceType
+	8,   // 98: content.v1.ContentEvent.resource_type:type_name -> content.v1.ContentResourceType
+	9,   // 99: content.v1.ContentEvent.action:type_name -> content.v1.ContentEventAction
+	93,  // 100: content.v1.ContentEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	10,  // 101: content.v1.ContentEvent.page:type_name -> content.v1.Page
+	22,  // 102: content.v1.ContentEvent.blog_post:type_name -> content.v1.BlogPost
+	16,  // 103: content.v1.ContentService.CreatePage:input_type -> content.v1.CreatePageRequest
+	17,  // 104: content.v1.ContentService.GetPage:input_type -> content.v1.GetPageRequest
+	18,  // 105: content.v1.ContentService.UpdatePage:input_type -> content.v1.UpdatePageRequest
+	19,  // 106: content.v1.ContentService.DeletePage:input_type -> content.v1.DeletePageRequest
+	20,  // 107: content.v1.ContentService.ListPages:input_type -> content.v1.ListPagesRequest
+	27,  // 108: content.v1.ContentService.CreateBlogPost:input_type -> content.v1.CreateBlogPostRequest
+	28,  // 109: content.v1.ContentService.GetBlogPost:input_type -> content.v1.GetBlogPostRequest
+	29,  // 110: content.v1.ContentService.UpdateBlogPost:input_type -> content.v1.UpdateBlogPostRequest
+	30,  // 111: content.v1.ContentService.DeleteBlogPost:input_type -> content.v1.DeleteBlogPostRequest
+	31,  // 112: content.v1.ContentService.ListBlogPosts:input_type -> content.v1.ListBlogPostsRequest
+	33,  // 113: content.v1.ContentService.SearchBlogPosts:input_type -> content.v1.SearchBlogPostsRequest
+	35,  // 114: content.v1.ContentService.GetBlogCategories:input_type -> content.v1.GetBlogCategoriesRequest
+	38,  // 115: content.v1.ContentService.GetBlogTags:input_type -> content.v1.GetBlogTagsRequest
+	40,  // 116: content.v1.ContentService.GetBlogArchive:input_type -> content.v1.GetBlogArchiveRequest
+	45,  // 117: content.v1.ContentService.GetRelatedPosts:input_type -> content.v1.GetRelatedPostsRequest
+	47,  // 118: content.v1.ContentService.GetRSSFeed:input_type -> content.v1.GetRSSFeedRequest
+	50,  // 119: content.v1.ContentService.CreateReusableBlock:input_type -> content.v1.CreateReusableBlockRequest
+	51,  // 120: content.v1.ContentService.GetReusableBlock:input_type -> content.v1.GetReusableBlockRequest
+	52,  // 121: content.v1.ContentService.UpdateReusableBlock:input_type -> content.v1.UpdateReusableBlockRequest
+	53,  // 122: content.v1.ContentService.DeleteReusableBlock:input_type -> content.v1.DeleteReusableBlockRequest
+	54,  // 123: content.v1.ContentService.ListReusableBlocks:input_type -> content.v1.ListReusableBlocksRequest
+	56,  // 124: content.v1.ContentService.ListReusableBlockUsages:input_type -> content.v1.ListReusableBlockUsagesRequest
+	59,  // 125: content.v1.ContentService.DuplicatePage:input_type -> content.v1.DuplicatePageRequest
+	60,  // 126: content.v1.ContentService.DuplicateBlogPost:input_type -> content.v1.DuplicateBlogPostRequest
+	62,  // 127: content.v1.ContentService.CreatePageTemplate:input_type -> content.v1.CreatePageTemplateRequest
+	63,  // 128: content.v1.ContentService.GetPageTemplate:input_type -> content.v1.GetPageTemplateRequest
+	64,  // 129: content.v1.ContentService.UpdatePageTemplate:input_type -> content.v1.UpdatePageTemplateRequest
+	65,  // 130: content.v1.ContentService.DeletePageTemplate:input_type -> content.v1.DeletePageTemplateRequest
+	66,  // 131: content.v1.ContentService.ListPageTemplates:input_type -> content.v1.ListPageTemplatesRequest
+	68,  // 132: content.v1.ContentService.CreatePageFromTemplate:input_type -> content.v1.CreatePageFromTemplateRequest
+	70,  // 133: content.v1.ContentService.CreateCollection:input_type -> content.v1.CreateCollectionRequest
+	71,  // 134: content.v1.ContentService.GetCollection:input_type -> content.v1.GetCollectionRequest
+	72,  // 135: content.v1.ContentService.UpdateCollection:input_type -> content.v1.UpdateCollectionRequest
+	73,  // 136: content.v1.ContentService.DeleteCollection:input_type -> content.v1.DeleteCollectionRequest
+	74,  // 137: content.v1.ContentService.ListCollections:input_type -> content.v1.ListCollectionsRequest
+	76,  // 138: content.v1.ContentService.SetCollectionPosts:input_type -> content.v1.SetCollectionPostsRequest
+	77,  // 139: content.v1.ContentService.ReorderCollectionPosts:input_type -> content.v1.ReorderCollectionPostsRequest
+	80,  // 140: content.v1.ContentService.GetLinkReport:input_type -> content.v1.GetLinkReportRequest
+	81,  // 141: content.v1.ContentService.RunLinkScan:input_type -> content.v1.RunLinkScanRequest
+	84,  // 142: content.v1.ContentService.AnalyzeSEO:input_type -> content.v1.AnalyzeSEORequest
+	85,  // 143: content.v1.ContentService.ListSEOIssues:input_type -> content.v1.ListSEOIssuesRequest
+	90,  // 144: content.v1.ContentService.WatchContent:input_type -> content.v1.WatchContentRequest
+	10,  // 145: content.v1.ContentService.CreatePage:output_type -> content.v1.Page
+	10,  // 146: content.v1.ContentService.GetPage:output_type -> content.v1.Page
+	10,  // 147: content.v1.ContentService.UpdatePage:output_type -> content.v1.Page
+	94,  // 148: content.v1.ContentService.DeletePage:output_type -> google.protobuf.Empty
+	21,  // 149: content.v1.ContentService.ListPages:output_type -> content.v1.ListPagesResponse
+	22,  // 150: content.v1.ContentService.CreateBlogPost:output_type -> content.v1.BlogPost
+	22,  // 151: content.v1.ContentService.GetBlogPost:output_type -> content.v1.BlogPost
+	22,  // 152: content.v1.ContentService.UpdateBlogPost:output_type -> content.v1.BlogPost
+	94,  // 153: content.v1.ContentService.DeleteBlogPost:output_type -> google.protobuf.Empty
+	32,  // 154: content.v1.ContentService.ListBlogPosts:output_type -> content.v1.ListBlogPostsResponse
+	34,  // 155: content.v1.ContentService.SearchBlogPosts:output_type -> content.v1.SearchBlogPostsResponse
+	36,  // 156: content.v1.ContentService.GetBlogCategories:output_type -> content.v1.GetBlogCategoriesResponse
+	39,  // 157: content.v1.ContentService.GetBlogTags:output_type -> content.v1.GetBlogTagsResponse
+	41,  // 158: content.v1.ContentService.GetBlogArchive:output_type -> content.v1.GetBlogArchiveResponse
+	46,  // 159: content.v1.ContentService.GetRelatedPosts:output_type -> content.v1.GetRelatedPostsResponse
+	48,  // 160: content.v1.ContentService.GetRSSFeed:output_type -> content.v1.GetRSSFeedResponse
+	49,  // 161: content.v1.ContentService.CreateReusableBlock:output_type -> content.v1.ReusableBlock
+	49,  // 162: content.v1.ContentService.GetReusableBlock:output_type -> content.v1.ReusableBlock
+	49,  // 163: content.v1.ContentService.UpdateReusableBlock:output_type -> content.v1.ReusableBlock
+	94,  // 164: content.v1.ContentService.DeleteReusableBlock:output_type -> google.protobuf.Empty
+	55,  // 165: content.v1.ContentService.ListReusableBlocks:output_type -> content.v1.ListReusableBlocksResponse
+	58,  // 166: content.v1.ContentService.ListReusableBlockUsages:output_type -> content.v1.ListReusableBlockUsagesResponse
+	10,  // 167: content.v1.ContentService.DuplicatePage:output_type -> content.v1.Page
+	22,  // 168: content.v1.ContentService.DuplicateBlogPost:output_type -> content.v1.BlogPost
+	61,  // 169: content.v1.ContentService.CreatePageTemplate:output_type -> content.v1.PageTemplate
+	61,  // 170: content.v1.ContentService.GetPageTemplate:output_type -> content.v1.PageTemplate
+	61,  // 171: content.v1.ContentService.UpdatePageTemplate:output_type -> content.v1.PageTemplate
+	94,  // 172: content.v1.ContentService.DeletePageTemplate:output_type -> google.protobuf.Empty
+	67,  // 173: content.v1.ContentService.ListPageTemplates:output_type -> content.v1.ListPageTemplatesResponse
+	10,  // 174: content.v1.ContentService.CreatePageFromTemplate:output_type -> content.v1.Page
+	69,  // 175: content.v1.ContentService.CreateCollection:output_type -> content.v1.Collection
+	69,  // 176: content.v1.ContentService.GetCollection:output_type -> content.v1.Collection
+	69,  // 177: content.v1.ContentService.UpdateCollection:output_type -> content.v1.Collection
+	94,  // 178: content.v1.ContentService.DeleteCollection:output_type -> google.protobuf.Empty
+	75,  // 179: content.v1.ContentService.ListCollections:output_type -> content.v1.ListCollectionsResponse
+	69,  // 180: content.v1.ContentService.SetCollectionPosts:output_type -> content.v1.Collection
+	69,  // 181: content.v1.ContentService.ReorderCollectionPosts:output_type -> content.v1.Collection
+	79,  // 182: content.v1.ContentService.GetLinkReport:output_type -> content.v1.LinkReport
+	79,  // 183: content.v1.ContentService.RunLinkScan:output_type -> content.v1.LinkReport
+	83,  // 184: content.v1.ContentService.AnalyzeSEO:output_type -> content.v1.SEOReport
+	86,  // 185: content.v1.ContentService.ListSEOIssues:output_type -> content.v1.ListSEOIssuesResponse
+	91,  // 186: content.v1.ContentService.WatchContent:output_type -> content.v1.ContentEvent
+	145, // [145:187] is the sub-list for method output_type
+	103, // [103:145] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
 }
 
 func init() { file_content_v1_content_proto_init() }
@@ -2165,13 +7388,19 @@ func file_content_v1_content_proto_init() {
 	if File_content_v1_content_proto != nil {
 		return
 	}
+	file_content_v1_content_proto_msgTypes[17].OneofWrappers = []any{}
+	file_content_v1_content_proto_msgTypes[19].OneofWrappers = []any{}
+	file_content_v1_content_proto_msgTypes[81].OneofWrappers = []any{
+		(*ContentEvent_Page)(nil),
+		(*ContentEvent_BlogPost)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_content_v1_content_proto_rawDesc), len(file_content_v1_content_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   28,
+			NumEnums:      10,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
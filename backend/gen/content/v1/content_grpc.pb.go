@@ -20,20 +20,48 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ContentService_CreatePage_FullMethodName        = "/content.v1.ContentService/CreatePage"
-	ContentService_GetPage_FullMethodName           = "/content.v1.ContentService/GetPage"
-	ContentService_UpdatePage_FullMethodName        = "/content.v1.ContentService/UpdatePage"
-	ContentService_DeletePage_FullMethodName        = "/content.v1.ContentService/DeletePage"
-	ContentService_ListPages_FullMethodName         = "/content.v1.ContentService/ListPages"
-	ContentService_CreateBlogPost_FullMethodName    = "/content.v1.ContentService/CreateBlogPost"
-	ContentService_GetBlogPost_FullMethodName       = "/content.v1.ContentService/GetBlogPost"
-	ContentService_UpdateBlogPost_FullMethodName    = "/content.v1.ContentService/UpdateBlogPost"
-	ContentService_DeleteBlogPost_FullMethodName    = "/content.v1.ContentService/DeleteBlogPost"
-	ContentService_ListBlogPosts_FullMethodName     = "/content.v1.ContentService/ListBlogPosts"
-	ContentService_SearchBlogPosts_FullMethodName   = "/content.v1.ContentService/SearchBlogPosts"
-	ContentService_GetBlogCategories_FullMethodName = "/content.v1.ContentService/GetBlogCategories"
-	ContentService_GetBlogTags_FullMethodName       = "/content.v1.ContentService/GetBlogTags"
-	ContentService_GetRSSFeed_FullMethodName        = "/content.v1.ContentService/GetRSSFeed"
+	ContentService_CreatePage_FullMethodName              = "/content.v1.ContentService/CreatePage"
+	ContentService_GetPage_FullMethodName                 = "/content.v1.ContentService/GetPage"
+	ContentService_UpdatePage_FullMethodName              = "/content.v1.ContentService/UpdatePage"
+	ContentService_DeletePage_FullMethodName              = "/content.v1.ContentService/DeletePage"
+	ContentService_ListPages_FullMethodName               = "/content.v1.ContentService/ListPages"
+	ContentService_CreateBlogPost_FullMethodName          = "/content.v1.ContentService/CreateBlogPost"
+	ContentService_GetBlogPost_FullMethodName             = "/content.v1.ContentService/GetBlogPost"
+	ContentService_UpdateBlogPost_FullMethodName          = "/content.v1.ContentService/UpdateBlogPost"
+	ContentService_DeleteBlogPost_FullMethodName          = "/content.v1.ContentService/DeleteBlogPost"
+	ContentService_ListBlogPosts_FullMethodName           = "/content.v1.ContentService/ListBlogPosts"
+	ContentService_SearchBlogPosts_FullMethodName         = "/content.v1.ContentService/SearchBlogPosts"
+	ContentService_GetBlogCategories_FullMethodName       = "/content.v1.ContentService/GetBlogCategories"
+	ContentService_GetBlogTags_FullMethodName             = "/content.v1.ContentService/GetBlogTags"
+	ContentService_GetBlogArchive_FullMethodName          = "/content.v1.ContentService/GetBlogArchive"
+	ContentService_GetRelatedPosts_FullMethodName         = "/content.v1.ContentService/GetRelatedPosts"
+	ContentService_GetRSSFeed_FullMethodName              = "/content.v1.ContentService/GetRSSFeed"
+	ContentService_CreateReusableBlock_FullMethodName     = "/content.v1.ContentService/CreateReusableBlock"
+	ContentService_GetReusableBlock_FullMethodName        = "/content.v1.ContentService/GetReusableBlock"
+	ContentService_UpdateReusableBlock_FullMethodName     = "/content.v1.ContentService/UpdateReusableBlock"
+	ContentService_DeleteReusableBlock_FullMethodName     = "/content.v1.ContentService/DeleteReusableBlock"
+	ContentService_ListReusableBlocks_FullMethodName      = "/content.v1.ContentService/ListReusableBlocks"
+	ContentService_ListReusableBlockUsages_FullMethodName = "/content.v1.ContentService/ListReusableBlockUsages"
+	ContentService_DuplicatePage_FullMethodName           = "/content.v1.ContentService/DuplicatePage"
+	ContentService_DuplicateBlogPost_FullMethodName       = "/content.v1.ContentService/DuplicateBlogPost"
+	ContentService_CreatePageTemplate_FullMethodName      = "/content.v1.ContentService/CreatePageTemplate"
+	ContentService_GetPageTemplate_FullMethodName         = "/content.v1.ContentService/GetPageTemplate"
+	ContentService_UpdatePageTemplate_FullMethodName      = "/content.v1.ContentService/UpdatePageTemplate"
+	ContentService_DeletePageTemplate_FullMethodName      = "/content.v1.ContentService/DeletePageTemplate"
+	ContentService_ListPageTemplates_FullMethodName       = "/content.v1.ContentService/ListPageTemplates"
+	ContentService_CreatePageFromTemplate_FullMethodName  = "/content.v1.ContentService/CreatePageFromTemplate"
+	ContentService_CreateCollection_FullMethodName        = "/content.v1.ContentService/CreateCollection"
+	ContentService_GetCollection_FullMethodName           = "/content.v1.ContentService/GetCollection"
+	ContentService_UpdateCollection_FullMethodName        = "/content.v1.ContentService/UpdateCollection"
+	ContentService_DeleteCollection_FullMethodName        = "/content.v1.ContentService/DeleteCollection"
+	ContentService_ListCollections_FullMethodName         = "/content.v1.ContentService/ListCollections"
+	ContentService_SetCollectionPosts_FullMethodName      = "/content.v1.ContentService/SetCollectionPosts"
+	ContentService_ReorderCollectionPosts_FullMethodName  = "/content.v1.ContentService/ReorderCollectionPosts"
+	ContentService_GetLinkReport_FullMethodName           = "/content.v1.ContentService/GetLinkReport"
+	ContentService_RunLinkScan_FullMethodName             = "/content.v1.ContentService/RunLinkScan"
+	ContentService_AnalyzeSEO_FullMethodName              = "/content.v1.ContentService/AnalyzeSEO"
+	ContentService_ListSEOIssues_FullMethodName           = "/content.v1.ContentService/ListSEOIssues"
+	ContentService_WatchContent_FullMethodName            = "/content.v1.ContentService/WatchContent"
 )
 
 // ContentServiceClient is the client API for ContentService service.
@@ -68,8 +96,65 @@ type ContentServiceClient interface {
 	GetBlogCategories(ctx context.Context, in *GetBlogCategoriesRequest, opts ...grpc.CallOption) (*GetBlogCategoriesResponse, error)
 	// Get blog tags
 	GetBlogTags(ctx context.Context, in *GetBlogTagsRequest, opts ...grpc.CallOption) (*GetBlogTagsResponse, error)
+	// Count published posts by year and month (UTC), newest first
+	GetBlogArchive(ctx context.Context, in *GetBlogArchiveRequest, opts ...grpc.CallOption) (*GetBlogArchiveResponse, error)
+	// Get published posts related to a blog post by shared taxonomy, title similarity and recency
+	GetRelatedPosts(ctx context.Context, in *GetRelatedPostsRequest, opts ...grpc.CallOption) (*GetRelatedPostsResponse, error)
 	// Generate RSS feed
 	GetRSSFeed(ctx context.Context, in *GetRSSFeedRequest, opts ...grpc.CallOption) (*GetRSSFeedResponse, error)
+	// Create a reusable content block
+	CreateReusableBlock(ctx context.Context, in *CreateReusableBlockRequest, opts ...grpc.CallOption) (*ReusableBlock, error)
+	// Get a reusable content block by ID
+	GetReusableBlock(ctx context.Context, in *GetReusableBlockRequest, opts ...grpc.CallOption) (*ReusableBlock, error)
+	// Update a reusable content block
+	UpdateReusableBlock(ctx context.Context, in *UpdateReusableBlockRequest, opts ...grpc.CallOption) (*ReusableBlock, error)
+	// Delete a reusable content block
+	DeleteReusableBlock(ctx context.Context, in *DeleteReusableBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List reusable content blocks
+	ListReusableBlocks(ctx context.Context, in *ListReusableBlocksRequest, opts ...grpc.CallOption) (*ListReusableBlocksResponse, error)
+	// List pages and posts that embed a reusable content block
+	ListReusableBlockUsages(ctx context.Context, in *ListReusableBlockUsagesRequest, opts ...grpc.CallOption) (*ListReusableBlockUsagesResponse, error)
+	// Duplicate a page into a new draft with a unique slug
+	DuplicatePage(ctx context.Context, in *DuplicatePageRequest, opts ...grpc.CallOption) (*Page, error)
+	// Duplicate a blog post into a new draft with a unique slug
+	DuplicateBlogPost(ctx context.Context, in *DuplicateBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error)
+	// Create a page template
+	CreatePageTemplate(ctx context.Context, in *CreatePageTemplateRequest, opts ...grpc.CallOption) (*PageTemplate, error)
+	// Get a page template by ID, optionally at a specific version
+	GetPageTemplate(ctx context.Context, in *GetPageTemplateRequest, opts ...grpc.CallOption) (*PageTemplate, error)
+	// Update a page template, creating a new version
+	UpdatePageTemplate(ctx context.Context, in *UpdatePageTemplateRequest, opts ...grpc.CallOption) (*PageTemplate, error)
+	// Delete a page template and all of its versions
+	DeletePageTemplate(ctx context.Context, in *DeletePageTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List page templates
+	ListPageTemplates(ctx context.Context, in *ListPageTemplatesRequest, opts ...grpc.CallOption) (*ListPageTemplatesResponse, error)
+	// Create a new draft page from a page template
+	CreatePageFromTemplate(ctx context.Context, in *CreatePageFromTemplateRequest, opts ...grpc.CallOption) (*Page, error)
+	// Create a series or curated collection
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// Get a collection by ID or slug
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// Update a collection's title, slug and description
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// Delete a collection; its posts are not affected
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List collections
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// Replace the ordered post list of a collection
+	SetCollectionPosts(ctx context.Context, in *SetCollectionPostsRequest, opts ...grpc.CallOption) (*Collection, error)
+	// Reorder the posts of a collection without adding or removing any
+	ReorderCollectionPosts(ctx context.Context, in *ReorderCollectionPostsRequest, opts ...grpc.CallOption) (*Collection, error)
+	// Get the latest link-integrity report, or a specific report by ID
+	GetLinkReport(ctx context.Context, in *GetLinkReportRequest, opts ...grpc.CallOption) (*LinkReport, error)
+	// Scan all pages and posts for broken links now and store the report
+	RunLinkScan(ctx context.Context, in *RunLinkScanRequest, opts ...grpc.CallOption) (*LinkReport, error)
+	// Score a page or post for SEO and list actionable findings
+	AnalyzeSEO(ctx context.Context, in *AnalyzeSEORequest, opts ...grpc.CallOption) (*SEOReport, error)
+	// List SEO findings across all draft and published pages and posts, lowest score first
+	ListSEOIssues(ctx context.Context, in *ListSEOIssuesRequest, opts ...grpc.CallOption) (*ListSEOIssuesResponse, error)
+	// Stream page, post and media changes as they happen. Over HTTP the stream is served
+	// as server-sent events from GET /api/v1/content/watch.
+	WatchContent(ctx context.Context, in *WatchContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContentEvent], error)
 }
 
 type contentServiceClient struct {
@@ -210,6 +295,26 @@ func (c *contentServiceClient) GetBlogTags(ctx context.Context, in *GetBlogTagsR
 	return out, nil
 }
 
+func (c *contentServiceClient) GetBlogArchive(ctx context.Context, in *GetBlogArchiveRequest, opts ...grpc.CallOption) (*GetBlogArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlogArchiveResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetBlogArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetRelatedPosts(ctx context.Context, in *GetRelatedPostsRequest, opts ...grpc.CallOption) (*GetRelatedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedPostsResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetRelatedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contentServiceClient) GetRSSFeed(ctx context.Context, in *GetRSSFeedRequest, opts ...grpc.CallOption) (*GetRSSFeedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRSSFeedResponse)
@@ -220,6 +325,275 @@ func (c *contentServiceClient) GetRSSFeed(ctx context.Context, in *GetRSSFeedReq
 	return out, nil
 }
 
+func (c *contentServiceClient) CreateReusableBlock(ctx context.Context, in *CreateReusableBlockRequest, opts ...grpc.CallOption) (*ReusableBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReusableBlock)
+	err := c.cc.Invoke(ctx, ContentService_CreateReusableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetReusableBlock(ctx context.Context, in *GetReusableBlockRequest, opts ...grpc.CallOption) (*ReusableBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReusableBlock)
+	err := c.cc.Invoke(ctx, ContentService_GetReusableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) UpdateReusableBlock(ctx context.Context, in *UpdateReusableBlockRequest, opts ...grpc.CallOption) (*ReusableBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReusableBlock)
+	err := c.cc.Invoke(ctx, ContentService_UpdateReusableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) DeleteReusableBlock(ctx context.Context, in *DeleteReusableBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ContentService_DeleteReusableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListReusableBlocks(ctx context.Context, in *ListReusableBlocksRequest, opts ...grpc.CallOption) (*ListReusableBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReusableBlocksResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListReusableBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListReusableBlockUsages(ctx context.Context, in *ListReusableBlockUsagesRequest, opts ...grpc.CallOption) (*ListReusableBlockUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReusableBlockUsagesResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListReusableBlockUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) DuplicatePage(ctx context.Context, in *DuplicatePageRequest, opts ...grpc.CallOption) (*Page, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Page)
+	err := c.cc.Invoke(ctx, ContentService_DuplicatePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) DuplicateBlogPost(ctx context.Context, in *DuplicateBlogPostRequest, opts ...grpc.CallOption) (*BlogPost, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlogPost)
+	err := c.cc.Invoke(ctx, ContentService_DuplicateBlogPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) CreatePageTemplate(ctx context.Context, in *CreatePageTemplateRequest, opts ...grpc.CallOption) (*PageTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PageTemplate)
+	err := c.cc.Invoke(ctx, ContentService_CreatePageTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetPageTemplate(ctx context.Context, in *GetPageTemplateRequest, opts ...grpc.CallOption) (*PageTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PageTemplate)
+	err := c.cc.Invoke(ctx, ContentService_GetPageTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) UpdatePageTemplate(ctx context.Context, in *UpdatePageTemplateRequest, opts ...grpc.CallOption) (*PageTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PageTemplate)
+	err := c.cc.Invoke(ctx, ContentService_UpdatePageTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) DeletePageTemplate(ctx context.Context, in *DeletePageTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ContentService_DeletePageTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListPageTemplates(ctx context.Context, in *ListPageTemplatesRequest, opts ...grpc.CallOption) (*ListPageTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPageTemplatesResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListPageTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) CreatePageFromTemplate(ctx context.Context, in *CreatePageFromTemplateRequest, opts ...grpc.CallOption) (*Page, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Page)
+	err := c.cc.Invoke(ctx, ContentService_CreatePageFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ContentService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ContentService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ContentService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ContentService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) SetCollectionPosts(ctx context.Context, in *SetCollectionPostsRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ContentService_SetCollectionPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ReorderCollectionPosts(ctx context.Context, in *ReorderCollectionPostsRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ContentService_ReorderCollectionPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetLinkReport(ctx context.Context, in *GetLinkReportRequest, opts ...grpc.CallOption) (*LinkReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkReport)
+	err := c.cc.Invoke(ctx, ContentService_GetLinkReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) RunLinkScan(ctx context.Context, in *RunLinkScanRequest, opts ...grpc.CallOption) (*LinkReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkReport)
+	err := c.cc.Invoke(ctx, ContentService_RunLinkScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) AnalyzeSEO(ctx context.Context, in *AnalyzeSEORequest, opts ...grpc.CallOption) (*SEOReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SEOReport)
+	err := c.cc.Invoke(ctx, ContentService_AnalyzeSEO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListSEOIssues(ctx context.Context, in *ListSEOIssuesRequest, opts ...grpc.CallOption) (*ListSEOIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSEOIssuesResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListSEOIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) WatchContent(ctx context.Context, in *WatchContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContentEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContentService_ServiceDesc.Streams[0], ContentService_WatchContent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchContentRequest, ContentEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContentService_WatchContentClient = grpc.ServerStreamingClient[ContentEvent]
+
 // ContentServiceServer is the server API for ContentService service.
 // All implementations must embed UnimplementedContentServiceServer
 // for forward compatibility.
@@ -252,8 +626,65 @@ type ContentServiceServer interface {
 	GetBlogCategories(context.Context, *GetBlogCategoriesRequest) (*GetBlogCategoriesResponse, error)
 	// Get blog tags
 	GetBlogTags(context.Context, *GetBlogTagsRequest) (*GetBlogTagsResponse, error)
+	// Count published posts by year and month (UTC), newest first
+	GetBlogArchive(context.Context, *GetBlogArchiveRequest) (*GetBlogArchiveResponse, error)
+	// Get published posts related to a blog post by shared taxonomy, title similarity and recency
+	GetRelatedPosts(context.Context, *GetRelatedPostsRequest) (*GetRelatedPostsResponse, error)
 	// Generate RSS feed
 	GetRSSFeed(context.Context, *GetRSSFeedRequest) (*GetRSSFeedResponse, error)
+	// Create a reusable content block
+	CreateReusableBlock(context.Context, *CreateReusableBlockRequest) (*ReusableBlock, error)
+	// Get a reusable content block by ID
+	GetReusableBlock(context.Context, *GetReusableBlockRequest) (*ReusableBlock, error)
+	// Update a reusable content block
+	UpdateReusableBlock(context.Context, *UpdateReusableBlockRequest) (*ReusableBlock, error)
+	// Delete a reusable content block
+	DeleteReusableBlock(context.Context, *DeleteReusableBlockRequest) (*emptypb.Empty, error)
+	// List reusable content blocks
+	ListReusableBlocks(context.Context, *ListReusableBlocksRequest) (*ListReusableBlocksResponse, error)
+	// List pages and posts that embed a reusable content block
+	ListReusableBlockUsages(context.Context, *ListReusableBlockUsagesRequest) (*ListReusableBlockUsagesResponse, error)
+	// Duplicate a page into a new draft with a unique slug
+	DuplicatePage(context.Context, *DuplicatePageRequest) (*Page, error)
+	// Duplicate a blog post into a new draft with a unique slug
+	DuplicateBlogPost(context.Context, *DuplicateBlogPostRequest) (*BlogPost, error)
+	// Create a page template
+	CreatePageTemplate(context.Context, *CreatePageTemplateRequest) (*PageTemplate, error)
+	// Get a page template by ID, optionally at a specific version
+	GetPageTemplate(context.Context, *GetPageTemplateRequest) (*PageTemplate, error)
+	// Update a page template, creating a new version
+	UpdatePageTemplate(context.Context, *UpdatePageTemplateRequest) (*PageTemplate, error)
+	// Delete a page template and all of its versions
+	DeletePageTemplate(context.Context, *DeletePageTemplateRequest) (*emptypb.Empty, error)
+	// List page templates
+	ListPageTemplates(context.Context, *ListPageTemplatesRequest) (*ListPageTemplatesResponse, error)
+	// Create a new draft page from a page template
+	CreatePageFromTemplate(context.Context, *CreatePageFromTemplateRequest) (*Page, error)
+	// Create a series or curated collection
+	CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error)
+	// Get a collection by ID or slug
+	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
+	// Update a collection's title, slug and description
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
+	// Delete a collection; its posts are not affected
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	// List collections
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// Replace the ordered post list of a collection
+	SetCollectionPosts(context.Context, *SetCollectionPostsRequest) (*Collection, error)
+	// Reorder the posts of a collection without adding or removing any
+	ReorderCollectionPosts(context.Context, *ReorderCollectionPostsRequest) (*Collection, error)
+	// Get the latest link-integrity report, or a specific report by ID
+	GetLinkReport(context.Context, *GetLinkReportRequest) (*LinkReport, error)
+	// Scan all pages and posts for broken links now and store the report
+	RunLinkScan(context.Context, *RunLinkScanRequest) (*LinkReport, error)
+	// Score a page or post for SEO and list actionable findings
+	AnalyzeSEO(context.Context, *AnalyzeSEORequest) (*SEOReport, error)
+	// List SEO findings across all draft and published pages and posts, lowest score first
+	ListSEOIssues(context.Context, *ListSEOIssuesRequest) (*ListSEOIssuesResponse, error)
+	// Stream page, post and media changes as they happen. Over HTTP the stream is served
+	// as server-sent events from GET /api/v1/content/watch.
+	WatchContent(*WatchContentRequest, grpc.ServerStreamingServer[ContentEvent]) error
 	mustEmbedUnimplementedContentServiceServer()
 }
 
@@ -303,9 +734,93 @@ func (UnimplementedContentServiceServer) GetBlogCategories(context.Context, *Get
 func (UnimplementedContentServiceServer) GetBlogTags(context.Context, *GetBlogTagsRequest) (*GetBlogTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlogTags not implemented")
 }
+func (UnimplementedContentServiceServer) GetBlogArchive(context.Context, *GetBlogArchiveRequest) (*GetBlogArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlogArchive not implemented")
+}
+func (UnimplementedContentServiceServer) GetRelatedPosts(context.Context, *GetRelatedPostsRequest) (*GetRelatedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedPosts not implemented")
+}
 func (UnimplementedContentServiceServer) GetRSSFeed(context.Context, *GetRSSFeedRequest) (*GetRSSFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRSSFeed not implemented")
 }
+func (UnimplementedContentServiceServer) CreateReusableBlock(context.Context, *CreateReusableBlockRequest) (*ReusableBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReusableBlock not implemented")
+}
+func (UnimplementedContentServiceServer) GetReusableBlock(context.Context, *GetReusableBlockRequest) (*ReusableBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReusableBlock not implemented")
+}
+func (UnimplementedContentServiceServer) UpdateReusableBlock(context.Context, *UpdateReusableBlockRequest) (*ReusableBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReusableBlock not implemented")
+}
+func (UnimplementedContentServiceServer) DeleteReusableBlock(context.Context, *DeleteReusableBlockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReusableBlock not implemented")
+}
+func (UnimplementedContentServiceServer) ListReusableBlocks(context.Context, *ListReusableBlocksRequest) (*ListReusableBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReusableBlocks not implemented")
+}
+func (UnimplementedContentServiceServer) ListReusableBlockUsages(context.Context, *ListReusableBlockUsagesRequest) (*ListReusableBlockUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReusableBlockUsages not implemented")
+}
+func (UnimplementedContentServiceServer) DuplicatePage(context.Context, *DuplicatePageRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicatePage not implemented")
+}
+func (UnimplementedContentServiceServer) DuplicateBlogPost(context.Context, *DuplicateBlogPostRequest) (*BlogPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateBlogPost not implemented")
+}
+func (UnimplementedContentServiceServer) CreatePageTemplate(context.Context, *CreatePageTemplateRequest) (*PageTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePageTemplate not implemented")
+}
+func (UnimplementedContentServiceServer) GetPageTemplate(context.Context, *GetPageTemplateRequest) (*PageTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPageTemplate not implemented")
+}
+func (UnimplementedContentServiceServer) UpdatePageTemplate(context.Context, *UpdatePageTemplateRequest) (*PageTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePageTemplate not implemented")
+}
+func (UnimplementedContentServiceServer) DeletePageTemplate(context.Context, *DeletePageTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePageTemplate not implemented")
+}
+func (UnimplementedContentServiceServer) ListPageTemplates(context.Context, *ListPageTemplatesRequest) (*ListPageTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPageTemplates not implemented")
+}
+func (UnimplementedContentServiceServer) CreatePageFromTemplate(context.Context, *CreatePageFromTemplateRequest) (*Page, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePageFromTemplate not implemented")
+}
+func (UnimplementedContentServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedContentServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedContentServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedContentServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedContentServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedContentServiceServer) SetCollectionPosts(context.Context, *SetCollectionPostsRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionPosts not implemented")
+}
+func (UnimplementedContentServiceServer) ReorderCollectionPosts(context.Context, *ReorderCollectionPostsRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCollectionPosts not implemented")
+}
+func (UnimplementedContentServiceServer) GetLinkReport(context.Context, *GetLinkReportRequest) (*LinkReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkReport not implemented")
+}
+func (UnimplementedContentServiceServer) RunLinkScan(context.Context, *RunLinkScanRequest) (*LinkReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunLinkScan not implemented")
+}
+func (UnimplementedContentServiceServer) AnalyzeSEO(context.Context, *AnalyzeSEORequest) (*SEOReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeSEO not implemented")
+}
+func (UnimplementedContentServiceServer) ListSEOIssues(context.Context, *ListSEOIssuesRequest) (*ListSEOIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSEOIssues not implemented")
+}
+func (UnimplementedContentServiceServer) WatchContent(*WatchContentRequest, grpc.ServerStreamingServer[ContentEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchContent not implemented")
+}
 func (UnimplementedContentServiceServer) mustEmbedUnimplementedContentServiceServer() {}
 func (UnimplementedContentServiceServer) testEmbeddedByValue()                        {}
 
@@ -561,41 +1076,538 @@ func _ContentService_GetBlogTags_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ContentService_GetRSSFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRSSFeedRequest)
+func _ContentService_GetBlogArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlogArchiveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContentServiceServer).GetRSSFeed(ctx, in)
+		return srv.(ContentServiceServer).GetBlogArchive(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ContentService_GetRSSFeed_FullMethodName,
+		FullMethod: ContentService_GetBlogArchive_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContentServiceServer).GetRSSFeed(ctx, req.(*GetRSSFeedRequest))
+		return srv.(ContentServiceServer).GetBlogArchive(ctx, req.(*GetBlogArchiveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ContentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "content.v1.ContentService",
-	HandlerType: (*ContentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePage",
-			Handler:    _ContentService_CreatePage_Handler,
-		},
-		{
-			MethodName: "GetPage",
-			Handler:    _ContentService_GetPage_Handler,
-		},
-		{
-			MethodName: "UpdatePage",
+func _ContentService_GetRelatedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetRelatedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetRelatedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetRelatedPosts(ctx, req.(*GetRelatedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetRSSFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRSSFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetRSSFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetRSSFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetRSSFeed(ctx, req.(*GetRSSFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_CreateReusableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReusableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).CreateReusableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_CreateReusableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).CreateReusableBlock(ctx, req.(*CreateReusableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetReusableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReusableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetReusableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetReusableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetReusableBlock(ctx, req.(*GetReusableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_UpdateReusableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReusableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).UpdateReusableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_UpdateReusableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).UpdateReusableBlock(ctx, req.(*UpdateReusableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_DeleteReusableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReusableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).DeleteReusableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_DeleteReusableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).DeleteReusableBlock(ctx, req.(*DeleteReusableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListReusableBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReusableBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListReusableBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListReusableBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListReusableBlocks(ctx, req.(*ListReusableBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListReusableBlockUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReusableBlockUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListReusableBlockUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListReusableBlockUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListReusableBlockUsages(ctx, req.(*ListReusableBlockUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_DuplicatePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicatePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).DuplicatePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_DuplicatePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).DuplicatePage(ctx, req.(*DuplicatePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_DuplicateBlogPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateBlogPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).DuplicateBlogPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_DuplicateBlogPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).DuplicateBlogPost(ctx, req.(*DuplicateBlogPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_CreatePageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePageTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).CreatePageTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_CreatePageTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).CreatePageTemplate(ctx, req.(*CreatePageTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetPageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPageTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetPageTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetPageTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetPageTemplate(ctx, req.(*GetPageTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_UpdatePageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePageTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).UpdatePageTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_UpdatePageTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).UpdatePageTemplate(ctx, req.(*UpdatePageTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_DeletePageTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePageTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).DeletePageTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_DeletePageTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).DeletePageTemplate(ctx, req.(*DeletePageTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListPageTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPageTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListPageTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListPageTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListPageTemplates(ctx, req.(*ListPageTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_CreatePageFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePageFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).CreatePageFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_CreatePageFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).CreatePageFromTemplate(ctx, req.(*CreatePageFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_SetCollectionPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).SetCollectionPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_SetCollectionPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).SetCollectionPosts(ctx, req.(*SetCollectionPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ReorderCollectionPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ReorderCollectionPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ReorderCollectionPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ReorderCollectionPosts(ctx, req.(*ReorderCollectionPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetLinkReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetLinkReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetLinkReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetLinkReport(ctx, req.(*GetLinkReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_RunLinkScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunLinkScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).RunLinkScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_RunLinkScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).RunLinkScan(ctx, req.(*RunLinkScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_AnalyzeSEO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeSEORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).AnalyzeSEO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_AnalyzeSEO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).AnalyzeSEO(ctx, req.(*AnalyzeSEORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListSEOIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSEOIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListSEOIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListSEOIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListSEOIssues(ctx, req.(*ListSEOIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_WatchContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchContentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContentServiceServer).WatchContent(m, &grpc.GenericServerStream[WatchContentRequest, ContentEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContentService_WatchContentServer = grpc.ServerStreamingServer[ContentEvent]
+
+// ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ContentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "content.v1.ContentService",
+	HandlerType: (*ContentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePage",
+			Handler:    _ContentService_CreatePage_Handler,
+		},
+		{
+			MethodName: "GetPage",
+			Handler:    _ContentService_GetPage_Handler,
+		},
+		{
+			MethodName: "UpdatePage",
 			Handler:    _ContentService_UpdatePage_Handler,
 		},
 		{
@@ -638,11 +1650,125 @@ var ContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlogTags",
 			Handler:    _ContentService_GetBlogTags_Handler,
 		},
+		{
+			MethodName: "GetBlogArchive",
+			Handler:    _ContentService_GetBlogArchive_Handler,
+		},
+		{
+			MethodName: "GetRelatedPosts",
+			Handler:    _ContentService_GetRelatedPosts_Handler,
+		},
 		{
 			MethodName: "GetRSSFeed",
 			Handler:    _ContentService_GetRSSFeed_Handler,
 		},
+		{
+			MethodName: "CreateReusableBlock",
+			Handler:    _ContentService_CreateReusableBlock_Handler,
+		},
+		{
+			MethodName: "GetReusableBlock",
+			Handler:    _ContentService_GetReusableBlock_Handler,
+		},
+		{
+			MethodName: "UpdateReusableBlock",
+			Handler:    _ContentService_UpdateReusableBlock_Handler,
+		},
+		{
+			MethodName: "DeleteReusableBlock",
+			Handler:    _ContentService_DeleteReusableBlock_Handler,
+		},
+		{
+			MethodName: "ListReusableBlocks",
+			Handler:    _ContentService_ListReusableBlocks_Handler,
+		},
+		{
+			MethodName: "ListReusableBlockUsages",
+			Handler:    _ContentService_ListReusableBlockUsages_Handler,
+		},
+		{
+			MethodName: "DuplicatePage",
+			Handler:    _ContentService_DuplicatePage_Handler,
+		},
+		{
+			MethodName: "DuplicateBlogPost",
+			Handler:    _ContentService_DuplicateBlogPost_Handler,
+		},
+		{
+			MethodName: "CreatePageTemplate",
+			Handler:    _ContentService_CreatePageTemplate_Handler,
+		},
+		{
+			MethodName: "GetPageTemplate",
+			Handler:    _ContentService_GetPageTemplate_Handler,
+		},
+		{
+			MethodName: "UpdatePageTemplate",
+			Handler:    _ContentService_UpdatePageTemplate_Handler,
+		},
+		{
+			MethodName: "DeletePageTemplate",
+			Handler:    _ContentService_DeletePageTemplate_Handler,
+		},
+		{
+			MethodName: "ListPageTemplates",
+			Handler:    _ContentService_ListPageTemplates_Handler,
+		},
+		{
+			MethodName: "CreatePageFromTemplate",
+			Handler:    _ContentService_CreatePageFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _ContentService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _ContentService_GetCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _ContentService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _ContentService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _ContentService_ListCollections_Handler,
+		},
+		{
+			MethodName: "SetCollectionPosts",
+			Handler:    _ContentService_SetCollectionPosts_Handler,
+		},
+		{
+			MethodName: "ReorderCollectionPosts",
+			Handler:    _ContentService_ReorderCollectionPosts_Handler,
+		},
+		{
+			MethodName: "GetLinkReport",
+			Handler:    _ContentService_GetLinkReport_Handler,
+		},
+		{
+			MethodName: "RunLinkScan",
+			Handler:    _ContentService_RunLinkScan_Handler,
+		},
+		{
+			MethodName: "AnalyzeSEO",
+			Handler:    _ContentService_AnalyzeSEO_Handler,
+		},
+		{
+			MethodName: "ListSEOIssues",
+			Handler:    _ContentService_ListSEOIssues_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchContent",
+			Handler:       _ContentService_WatchContent_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "content/v1/content.proto",
 }
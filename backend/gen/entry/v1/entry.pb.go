@@ -0,0 +1,1539 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: entry/v1/entry.proto
+
+package entryv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Field value types
+type FieldType int32
+
+const (
+	FieldType_FIELD_TYPE_UNSPECIFIED FieldType = 0
+	FieldType_FIELD_TYPE_TEXT        FieldType = 1
+	FieldType_FIELD_TYPE_RICH_TEXT   FieldType = 2
+	FieldType_FIELD_TYPE_NUMBER      FieldType = 3
+	// RFC 3339 timestamp or YYYY-MM-DD date
+	FieldType_FIELD_TYPE_DATE    FieldType = 4
+	FieldType_FIELD_TYPE_BOOLEAN FieldType = 5
+	// ID of a media file
+	FieldType_FIELD_TYPE_MEDIA FieldType = 6
+	// ID of an entry of reference_type
+	FieldType_FIELD_TYPE_REFERENCE FieldType = 7
+	// List of item_type values
+	FieldType_FIELD_TYPE_LIST FieldType = 8
+)
+
+// Enum value maps for FieldType.
+var (
+	FieldType_name = map[int32]string{
+		0: "FIELD_TYPE_UNSPECIFIED",
+		1: "FIELD_TYPE_TEXT",
+		2: "FIELD_TYPE_RICH_TEXT",
+		3: "FIELD_TYPE_NUMBER",
+		4: "FIELD_TYPE_DATE",
+		5: "FIELD_TYPE_BOOLEAN",
+		6: "FIELD_TYPE_MEDIA",
+		7: "FIELD_TYPE_REFERENCE",
+		8: "FIELD_TYPE_LIST",
+	}
+	FieldType_value = map[string]int32{
+		"FIELD_TYPE_UNSPECIFIED": 0,
+		"FIELD_TYPE_TEXT":        1,
+		"FIELD_TYPE_RICH_TEXT":   2,
+		"FIELD_TYPE_NUMBER":      3,
+		"FIELD_TYPE_DATE":        4,
+		"FIELD_TYPE_BOOLEAN":     5,
+		"FIELD_TYPE_MEDIA":       6,
+		"FIELD_TYPE_REFERENCE":   7,
+		"FIELD_TYPE_LIST":        8,
+	}
+)
+
+func (x FieldType) Enum() *FieldType {
+	p := new(FieldType)
+	*p = x
+	return p
+}
+
+func (x FieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_entry_v1_entry_proto_enumTypes[0].Descriptor()
+}
+
+func (FieldType) Type() protoreflect.EnumType {
+	return &file_entry_v1_entry_proto_enumTypes[0]
+}
+
+func (x FieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldType.Descriptor instead.
+func (FieldType) EnumDescriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{0}
+}
+
+// ContentType is an administrator-defined kind of structured content
+type ContentType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Fields        []*ContentTypeField    `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentType) Reset() {
+	*x = ContentType{}
+	mi := &file_entry_v1_entry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentType) ProtoMessage() {}
+
+func (x *ContentType) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentType.ProtoReflect.Descriptor instead.
+func (*ContentType) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContentType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentType) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ContentType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContentType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ContentType) GetFields() []*ContentTypeField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ContentType) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ContentType) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ContentType) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ContentTypeField defines one typed field and its validation
+type ContentTypeField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lower-case key of the value in Entry.data, e.g. "job_title"
+	Key      string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label    string    `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type     FieldType `protobuf:"varint,3,opt,name=type,proto3,enum=entry.v1.FieldType" json:"type,omitempty"`
+	Required bool      `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// Filterable fields can be filtered and sorted on and are indexed
+	Filterable bool `protobuf:"varint,5,opt,name=filterable,proto3" json:"filterable,omitempty"`
+	// Item type of list fields; lists cannot be nested
+	ItemType FieldType `protobuf:"varint,6,opt,name=item_type,json=itemType,proto3,enum=entry.v1.FieldType" json:"item_type,omitempty"`
+	// Content type slug of reference fields and lists of references
+	ReferenceType string `protobuf:"bytes,7,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
+	// Text and rich text length bounds in characters
+	MinLength *int32 `protobuf:"varint,8,opt,name=min_length,json=minLength,proto3,oneof" json:"min_length,omitempty"`
+	MaxLength *int32 `protobuf:"varint,9,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
+	// Number bounds
+	Min *float64 `protobuf:"fixed64,10,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max *float64 `protobuf:"fixed64,11,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// Regular expression text values must match
+	Pattern string `protobuf:"bytes,12,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Allowed text values
+	Options []string `protobuf:"bytes,13,rep,name=options,proto3" json:"options,omitempty"`
+	// List length bounds
+	MinItems      *int32 `protobuf:"varint,14,opt,name=min_items,json=minItems,proto3,oneof" json:"min_items,omitempty"`
+	MaxItems      *int32 `protobuf:"varint,15,opt,name=max_items,json=maxItems,proto3,oneof" json:"max_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentTypeField) Reset() {
+	*x = ContentTypeField{}
+	mi := &file_entry_v1_entry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentTypeField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentTypeField) ProtoMessage() {}
+
+func (x *ContentTypeField) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentTypeField.ProtoReflect.Descriptor instead.
+func (*ContentTypeField) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ContentTypeField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ContentTypeField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ContentTypeField) GetType() FieldType {
+	if x != nil {
+		return x.Type
+	}
+	return FieldType_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *ContentTypeField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ContentTypeField) GetFilterable() bool {
+	if x != nil {
+		return x.Filterable
+	}
+	return false
+}
+
+func (x *ContentTypeField) GetItemType() FieldType {
+	if x != nil {
+		return x.ItemType
+	}
+	return FieldType_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *ContentTypeField) GetReferenceType() string {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ""
+}
+
+func (x *ContentTypeField) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+func (x *ContentTypeField) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *ContentTypeField) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *ContentTypeField) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *ContentTypeField) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ContentTypeField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ContentTypeField) GetMinItems() int32 {
+	if x != nil && x.MinItems != nil {
+		return *x.MinItems
+	}
+	return 0
+}
+
+func (x *ContentTypeField) GetMaxItems() int32 {
+	if x != nil && x.MaxItems != nil {
+		return *x.MaxItems
+	}
+	return 0
+}
+
+// Entry is one item of a content type
+type Entry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentTypeId string                 `protobuf:"bytes,2,opt,name=content_type_id,json=contentTypeId,proto3" json:"content_type_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	// draft, published or archived
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Field values keyed by field key
+	Data          *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	mi := &file_entry_v1_entry_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Entry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Entry) GetContentTypeId() string {
+	if x != nil {
+		return x.ContentTypeId
+	}
+	return ""
+}
+
+func (x *Entry) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Entry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Entry) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Entry) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Entry) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Entry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Entry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateContentTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Fields        []*ContentTypeField    `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContentTypeRequest) Reset() {
+	*x = CreateContentTypeRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContentTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContentTypeRequest) ProtoMessage() {}
+
+func (x *CreateContentTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContentTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateContentTypeRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateContentTypeRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateContentTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateContentTypeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateContentTypeRequest) GetFields() []*ContentTypeField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetContentTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID or slug
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentTypeRequest) Reset() {
+	*x = GetContentTypeRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentTypeRequest) ProtoMessage() {}
+
+func (x *GetContentTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetContentTypeRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetContentTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListContentTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentTypesRequest) Reset() {
+	*x = ListContentTypesRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentTypesRequest) ProtoMessage() {}
+
+func (x *ListContentTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListContentTypesRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListContentTypesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListContentTypesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListContentTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentTypes  []*ContentType         `protobuf:"bytes,1,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentTypesResponse) Reset() {
+	*x = ListContentTypesResponse{}
+	mi := &file_entry_v1_entry_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentTypesResponse) ProtoMessage() {}
+
+func (x *ListContentTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListContentTypesResponse) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListContentTypesResponse) GetContentTypes() []*ContentType {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *ListContentTypesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateContentTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Fields        []*ContentTypeField    `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContentTypeRequest) Reset() {
+	*x = UpdateContentTypeRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContentTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContentTypeRequest) ProtoMessage() {}
+
+func (x *UpdateContentTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContentTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContentTypeRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateContentTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateContentTypeRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateContentTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateContentTypeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateContentTypeRequest) GetFields() []*ContentTypeField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type DeleteContentTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Also delete the entries of the content type
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContentTypeRequest) Reset() {
+	*x = DeleteContentTypeRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContentTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContentTypeRequest) ProtoMessage() {}
+
+func (x *DeleteContentTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContentTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContentTypeRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteContentTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteContentTypeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type CreateEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Content type ID or slug
+	ContentType string           `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Slug        string           `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Data        *structpb.Struct `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Publish immediately
+	Publish       bool `protobuf:"varint,4,opt,name=publish,proto3" json:"publish,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEntryRequest) Reset() {
+	*x = CreateEntryRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntryRequest) ProtoMessage() {}
+
+func (x *CreateEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntryRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateEntryRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateEntryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateEntryRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateEntryRequest) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+type GetEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntryRequest) Reset() {
+	*x = GetEntryRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryRequest) ProtoMessage() {}
+
+func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Content type ID or slug
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	PageSize    int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional status filter; anonymous callers only see published entries
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Exact-match filters on filterable fields, e.g. filters[location]=Berlin
+	Filters map[string]string `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// created_at (default), updated_at, published_at, slug or a filterable field key
+	SortBy string `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// asc or desc (default)
+	Order         string `protobuf:"bytes,7,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesRequest) Reset() {
+	*x = ListEntriesRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesRequest) ProtoMessage() {}
+
+func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListEntriesRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ListEntriesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type ListEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*Entry               `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntriesResponse) Reset() {
+	*x = ListEntriesResponse{}
+	mi := &file_entry_v1_entry_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesResponse) ProtoMessage() {}
+
+func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListEntriesResponse) GetEntries() []*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEntryRequest) Reset() {
+	*x = UpdateEntryRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntryRequest) ProtoMessage() {}
+
+func (x *UpdateEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEntryRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEntryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateEntryRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntryRequest) Reset() {
+	*x = DeleteEntryRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntryRequest) ProtoMessage() {}
+
+func (x *DeleteEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntryRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PublishEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEntryRequest) Reset() {
+	*x = PublishEntryRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEntryRequest) ProtoMessage() {}
+
+func (x *PublishEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEntryRequest.ProtoReflect.Descriptor instead.
+func (*PublishEntryRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PublishEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnpublishEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishEntryRequest) Reset() {
+	*x = UnpublishEntryRequest{}
+	mi := &file_entry_v1_entry_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishEntryRequest) ProtoMessage() {}
+
+func (x *UnpublishEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entry_v1_entry_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishEntryRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishEntryRequest) Descriptor() ([]byte, []int) {
+	return file_entry_v1_entry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnpublishEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_entry_v1_entry_proto protoreflect.FileDescriptor
+
+const file_entry_v1_entry_proto_rawDesc = "" +
+	"\n" +
+	"\x14entry/v1/entry.proto\x12\bentry.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x02\n" +
+	"\vContentType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x122\n" +
+	"\x06fields\x18\x05 \x03(\v2\x1a.entry.v1.ContentTypeFieldR\x06fields\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb0\x04\n" +
+	"\x10ContentTypeField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12'\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x13.entry.v1.FieldTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1e\n" +
+	"\n" +
+	"filterable\x18\x05 \x01(\bR\n" +
+	"filterable\x120\n" +
+	"\titem_type\x18\x06 \x01(\x0e2\x13.entry.v1.FieldTypeR\bitemType\x12%\n" +
+	"\x0ereference_type\x18\a \x01(\tR\rreferenceType\x12\"\n" +
+	"\n" +
+	"min_length\x18\b \x01(\x05H\x00R\tminLength\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_length\x18\t \x01(\x05H\x01R\tmaxLength\x88\x01\x01\x12\x15\n" +
+	"\x03min\x18\n" +
+	" \x01(\x01H\x02R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\v \x01(\x01H\x03R\x03max\x88\x01\x01\x12\x18\n" +
+	"\apattern\x18\f \x01(\tR\apattern\x12\x18\n" +
+	"\aoptions\x18\r \x03(\tR\aoptions\x12 \n" +
+	"\tmin_items\x18\x0e \x01(\x05H\x04R\bminItems\x88\x01\x01\x12 \n" +
+	"\tmax_items\x18\x0f \x01(\x05H\x05R\bmaxItems\x88\x01\x01B\r\n" +
+	"\v_min_lengthB\r\n" +
+	"\v_max_lengthB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_maxB\f\n" +
+	"\n" +
+	"_min_itemsB\f\n" +
+	"\n" +
+	"_max_items\"\xec\x02\n" +
+	"\x05Entry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0fcontent_type_id\x18\x02 \x01(\tR\rcontentTypeId\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12+\n" +
+	"\x04data\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04data\x12=\n" +
+	"\fpublished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x98\x01\n" +
+	"\x18CreateContentTypeRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\x06fields\x18\x04 \x03(\v2\x1a.entry.v1.ContentTypeFieldR\x06fields\"'\n" +
+	"\x15GetContentTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x17ListContentTypesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"~\n" +
+	"\x18ListContentTypesResponse\x12:\n" +
+	"\rcontent_types\x18\x01 \x03(\v2\x15.entry.v1.ContentTypeR\fcontentTypes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa8\x01\n" +
+	"\x18UpdateContentTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x122\n" +
+	"\x06fields\x18\x05 \x03(\v2\x1a.entry.v1.ContentTypeFieldR\x06fields\"@\n" +
+	"\x18DeleteContentTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x92\x01\n" +
+	"\x12CreateEntryRequest\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04data\x12\x18\n" +
+	"\apublish\x18\x04 \x01(\bR\apublish\"!\n" +
+	"\x0fGetEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbb\x02\n" +
+	"\x12ListEntriesRequest\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12C\n" +
+	"\afilters\x18\x05 \x03(\v2).entry.v1.ListEntriesRequest.FiltersEntryR\afilters\x12\x17\n" +
+	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x12\x14\n" +
+	"\x05order\x18\a \x01(\tR\x05order\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
+	"\x13ListEntriesResponse\x12)\n" +
+	"\aentries\x18\x01 \x03(\v2\x0f.entry.v1.EntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"e\n" +
+	"\x12UpdateEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04data\"$\n" +
+	"\x12DeleteEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
+	"\x13PublishEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x15UnpublishEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*\xdf\x01\n" +
+	"\tFieldType\x12\x1a\n" +
+	"\x16FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fFIELD_TYPE_TEXT\x10\x01\x12\x18\n" +
+	"\x14FIELD_TYPE_RICH_TEXT\x10\x02\x12\x15\n" +
+	"\x11FIELD_TYPE_NUMBER\x10\x03\x12\x13\n" +
+	"\x0fFIELD_TYPE_DATE\x10\x04\x12\x16\n" +
+	"\x12FIELD_TYPE_BOOLEAN\x10\x05\x12\x14\n" +
+	"\x10FIELD_TYPE_MEDIA\x10\x06\x12\x18\n" +
+	"\x14FIELD_TYPE_REFERENCE\x10\a\x12\x13\n" +
+	"\x0fFIELD_TYPE_LIST\x10\b2\xbe\n" +
+	"\n" +
+	"\fEntryService\x12p\n" +
+	"\x11CreateContentType\x12\".entry.v1.CreateContentTypeRequest\x1a\x15.entry.v1.ContentType\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/content-types\x12l\n" +
+	"\x0eGetContentType\x12\x1f.entry.v1.GetContentTypeRequest\x1a\x15.entry.v1.ContentType\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/content-types/{id}\x12x\n" +
+	"\x10ListContentTypes\x12!.entry.v1.ListContentTypesRequest\x1a\".entry.v1.ListContentTypesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/content-types\x12u\n" +
+	"\x11UpdateContentType\x12\".entry.v1.UpdateContentTypeRequest\x1a\x15.entry.v1.ContentType\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/v1/content-types/{id}\x12s\n" +
+	"\x11DeleteContentType\x12\".entry.v1.DeleteContentTypeRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/content-types/{id}\x12u\n" +
+	"\vCreateEntry\x12\x1c.entry.v1.CreateEntryRequest\x1a\x0f.entry.v1.Entry\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/content-types/{content_type}/entries\x12T\n" +
+	"\bGetEntry\x12\x19.entry.v1.GetEntryRequest\x1a\x0f.entry.v1.Entry\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/entries/{id}\x12\x80\x01\n" +
+	"\vListEntries\x12\x1c.entry.v1.ListEntriesRequest\x1a\x1d.entry.v1.ListEntriesResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/content-types/{content_type}/entries\x12]\n" +
+	"\vUpdateEntry\x12\x1c.entry.v1.UpdateEntryRequest\x1a\x0f.entry.v1.Entry\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/v1/entries/{id}\x12a\n" +
+	"\vDeleteEntry\x12\x1c.entry.v1.DeleteEntryRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/api/v1/entries/{id}\x12g\n" +
+	"\fPublishEntry\x12\x1d.entry.v1.PublishEntryRequest\x1a\x0f.entry.v1.Entry\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/entries/{id}/publish\x12m\n" +
+	"\x0eUnpublishEntry\x12\x1f.entry.v1.UnpublishEntryRequest\x1a\x0f.entry.v1.Entry\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/entries/{id}/unpublishBBZ@github.com/7-solutions/saas-platformbackend/gen/entry/v1;entryv1b\x06proto3"
+
+var (
+	file_entry_v1_entry_proto_rawDescOnce sync.Once
+	file_entry_v1_entry_proto_rawDescData []byte
+)
+
+func file_entry_v1_entry_proto_rawDescGZIP() []byte {
+	file_entry_v1_entry_proto_rawDescOnce.Do(func() {
+		file_entry_v1_entry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_entry_v1_entry_proto_rawDesc), len(file_entry_v1_entry_proto_rawDesc)))
+	})
+	return file_entry_v1_entry_proto_rawDescData
+}
+
+var file_entry_v1_entry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_entry_v1_entry_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_entry_v1_entry_proto_goTypes = []any{
+	(FieldType)(0),                   // 0: entry.v1.FieldType
+	(*ContentType)(nil),              // 1: entry.v1.ContentType
+	(*ContentTypeField)(nil),         // 2: entry.v1.ContentTypeField
+	(*Entry)(nil),                    // 3: entry.v1.Entry
+	(*CreateContentTypeRequest)(nil), // 4: entry.v1.CreateContentTypeRequest
+	(*GetContentTypeRequest)(nil),    // 5: entry.v1.GetContentTypeRequest
+	(*ListContentTypesRequest)(nil),  // 6: entry.v1.ListContentTypesRequest
+	(*ListContentTypesResponse)(nil), // 7: entry.v1.ListContentTypesResponse
+	(*UpdateContentTypeRequest)(nil), // 8: entry.v1.UpdateContentTypeRequest
+	(*DeleteContentTypeRequest)(nil), // 9: entry.v1.DeleteContentTypeRequest
+	(*CreateEntryRequest)(nil),       // 10: entry.v1.CreateEntryRequest
+	(*GetEntryRequest)(nil),          // 11: entry.v1.GetEntryRequest
+	(*ListEntriesRequest)(nil),       // 12: entry.v1.ListEntriesRequest
+	(*ListEntriesResponse)(nil),      // 13: entry.v1.ListEntriesResponse
+	(*UpdateEntryRequest)(nil),       // 14: entry.v1.UpdateEntryRequest
+	(*DeleteEntryRequest)(nil),       // 15: entry.v1.DeleteEntryRequest
+	(*PublishEntryRequest)(nil),      // 16: entry.v1.PublishEntryRequest
+	(*UnpublishEntryRequest)(nil),    // 17: entry.v1.UnpublishEntryRequest
+	nil,                              // 18: entry.v1.ListEntriesRequest.FiltersEntry
+	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),          // 20: google.protobuf.Struct
+	(*emptypb.Empty)(nil),            // 21: google.protobuf.Empty
+}
+var file_entry_v1_entry_proto_depIdxs = []int32{
+	2,  // 0: entry.v1.ContentType.fields:type_name -> entry.v1.ContentTypeField
+	19, // 1: entry.v1.ContentType.created_at:type_name -> google.protobuf.Timestamp
+	19, // 2: entry.v1.ContentType.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: entry.v1.ContentTypeField.type:type_name -> entry.v1.FieldType
+	0,  // 4: entry.v1.ContentTypeField.item_type:type_name -> entry.v1.FieldType
+	20, // 5: entry.v1.Entry.data:type_name -> google.protobuf.Struct
+	19, // 6: entry.v1.Entry.published_at:type_name -> google.protobuf.Timestamp
+	19, // 7: entry.v1.Entry.created_at:type_name -> google.protobuf.Timestamp
+	19, // 8: entry.v1.Entry.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 9: entry.v1.CreateContentTypeRequest.fields:type_name -> entry.v1.ContentTypeField
+	1,  // 10: entry.v1.ListContentTypesResponse.content_types:type_name -> entry.v1.ContentType
+	2,  // 11: entry.v1.UpdateContentTypeRequest.fields:type_name -> entry.v1.ContentTypeField
+	20, // 12: entry.v1.CreateEntryRequest.data:type_name -> google.protobuf.Struct
+	18, // 13: entry.v1.ListEntriesRequest.filters:type_name -> entry.v1.ListEntriesRequest.FiltersEntry
+	3,  // 14: entry.v1.ListEntriesResponse.entries:type_name -> entry.v1.Entry
+	20, // 15: entry.v1.UpdateEntryRequest.data:type_name -> google.protobuf.Struct
+	4,  // 16: entry.v1.EntryService.CreateContentType:input_type -> entry.v1.CreateContentTypeRequest
+	5,  // 17: entry.v1.EntryService.GetContentType:input_type -> entry.v1.GetContentTypeRequest
+	6,  // 18: entry.v1.EntryService.ListContentTypes:input_type -> entry.v1.ListContentTypesRequest
+	8,  // 19: entry.v1.EntryService.UpdateContentType:input_type -> entry.v1.UpdateContentTypeRequest
+	9,  // 20: entry.v1.EntryService.DeleteContentType:input_type -> entry.v1.DeleteContentTypeRequest
+	10, // 21: entry.v1.EntryService.CreateEntry:input_type -> entry.v1.CreateEntryRequest
+	11, // 22: entry.v1.EntryService.GetEntry:input_type -> entry.v1.GetEntryRequest
+	12, // 23: entry.v1.EntryService.ListEntries:input_type -> entry.v1.ListEntriesRequest
+	14, // 24: entry.v1.EntryService.UpdateEntry:input_type -> entry.v1.UpdateEntryRequest
+	15, // 25: entry.v1.EntryService.DeleteEntry:input_type -> entry.v1.DeleteEntryRequest
+	16, // 26: entry.v1.EntryService.PublishEntry:input_type -> entry.v1.PublishEntryRequest
+	17, // 27: entry.v1.EntryService.UnpublishEntry:input_type -> entry.v1.UnpublishEntryRequest
+	1,  // 28: entry.v1.EntryService.CreateContentType:output_type -> entry.v1.ContentType
+	1,  // 29: entry.v1.EntryService.GetContentType:output_type -> entry.v1.ContentType
+	7,  // 30: entry.v1.EntryService.ListContentTypes:output_type -> entry.v1.ListContentTypesResponse
+	1,  // 31: entry.v1.EntryService.UpdateContentType:output_type -> entry.v1.ContentType
+	21, // 32: entry.v1.EntryService.DeleteContentType:output_type -> google.protobuf.Empty
+	3,  // 33: entry.v1.EntryService.CreateEntry:output_type -> entry.v1.Entry
+	3,  // 34: entry.v1.EntryService.GetEntry:output_type -> entry.v1.Entry
+	13, // 35: entry.v1.EntryService.ListEntries:output_type -> entry.v1.ListEntriesResponse
+	3,  // 36: entry.v1.EntryService.UpdateEntry:output_type -> entry.v1.Entry
+	21, // 37: entry.v1.EntryService.DeleteEntry:output_type -> google.protobuf.Empty
+	3,  // 38: entry.v1.EntryService.PublishEntry:output_type -> entry.v1.Entry
+	3,  // 39: entry.v1.EntryService.UnpublishEntry:output_type -> entry.v1.Entry
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_entry_v1_entry_proto_init() }
+func file_entry_v1_entry_proto_init() {
+	if File_entry_v1_entry_proto != nil {
+		return
+	}
+	file_entry_v1_entry_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_entry_v1_entry_proto_rawDesc), len(file_entry_v1_entry_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_entry_v1_entry_proto_goTypes,
+		DependencyIndexes: file_entry_v1_entry_proto_depIdxs,
+		EnumInfos:         file_entry_v1_entry_proto_enumTypes,
+		MessageInfos:      file_entry_v1_entry_proto_msgTypes,
+	}.Build()
+	File_entry_v1_entry_proto = out.File
+	file_entry_v1_entry_proto_goTypes = nil
+	file_entry_v1_entry_proto_depIdxs = nil
+}
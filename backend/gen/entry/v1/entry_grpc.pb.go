@@ -0,0 +1,568 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: entry/v1/entry.proto
+
+package entryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EntryService_CreateContentType_FullMethodName = "/entry.v1.EntryService/CreateContentType"
+	EntryService_GetContentType_FullMethodName    = "/entry.v1.EntryService/GetContentType"
+	EntryService_ListContentTypes_FullMethodName  = "/entry.v1.EntryService/ListContentTypes"
+	EntryService_UpdateContentType_FullMethodName = "/entry.v1.EntryService/UpdateContentType"
+	EntryService_DeleteContentType_FullMethodName = "/entry.v1.EntryService/DeleteContentType"
+	EntryService_CreateEntry_FullMethodName       = "/entry.v1.EntryService/CreateEntry"
+	EntryService_GetEntry_FullMethodName          = "/entry.v1.EntryService/GetEntry"
+	EntryService_ListEntries_FullMethodName       = "/entry.v1.EntryService/ListEntries"
+	EntryService_UpdateEntry_FullMethodName       = "/entry.v1.EntryService/UpdateEntry"
+	EntryService_DeleteEntry_FullMethodName       = "/entry.v1.EntryService/DeleteEntry"
+	EntryService_PublishEntry_FullMethodName      = "/entry.v1.EntryService/PublishEntry"
+	EntryService_UnpublishEntry_FullMethodName    = "/entry.v1.EntryService/UnpublishEntry"
+)
+
+// EntryServiceClient is the client API for EntryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Entry service for administrator-defined content types and their entries
+type EntryServiceClient interface {
+	// Create a content type (admins only)
+	CreateContentType(ctx context.Context, in *CreateContentTypeRequest, opts ...grpc.CallOption) (*ContentType, error)
+	// Get a content type by ID or slug
+	GetContentType(ctx context.Context, in *GetContentTypeRequest, opts ...grpc.CallOption) (*ContentType, error)
+	// List content types
+	ListContentTypes(ctx context.Context, in *ListContentTypesRequest, opts ...grpc.CallOption) (*ListContentTypesResponse, error)
+	// Update a content type; existing entries are not revalidated
+	UpdateContentType(ctx context.Context, in *UpdateContentTypeRequest, opts ...grpc.CallOption) (*ContentType, error)
+	// Delete a content type; force is required when it still has entries
+	DeleteContentType(ctx context.Context, in *DeleteContentTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Create an entry; data is validated against the fields of its content type
+	CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*Entry, error)
+	// Get an entry by ID
+	GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*Entry, error)
+	// List the entries of a content type, filtered and sorted by filterable fields
+	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
+	// Update the slug and data of an entry
+	UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*Entry, error)
+	// Delete an entry
+	DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Publish an entry
+	PublishEntry(ctx context.Context, in *PublishEntryRequest, opts ...grpc.CallOption) (*Entry, error)
+	// Move a published entry back to draft
+	UnpublishEntry(ctx context.Context, in *UnpublishEntryRequest, opts ...grpc.CallOption) (*Entry, error)
+}
+
+type entryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEntryServiceClient(cc grpc.ClientConnInterface) EntryServiceClient {
+	return &entryServiceClient{cc}
+}
+
+func (c *entryServiceClient) CreateContentType(ctx context.Context, in *CreateContentTypeRequest, opts ...grpc.CallOption) (*ContentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentType)
+	err := c.cc.Invoke(ctx, EntryService_CreateContentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) GetContentType(ctx context.Context, in *GetContentTypeRequest, opts ...grpc.CallOption) (*ContentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentType)
+	err := c.cc.Invoke(ctx, EntryService_GetContentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) ListContentTypes(ctx context.Context, in *ListContentTypesRequest, opts ...grpc.CallOption) (*ListContentTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContentTypesResponse)
+	err := c.cc.Invoke(ctx, EntryService_ListContentTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) UpdateContentType(ctx context.Context, in *UpdateContentTypeRequest, opts ...grpc.CallOption) (*ContentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentType)
+	err := c.cc.Invoke(ctx, EntryService_UpdateContentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) DeleteContentType(ctx context.Context, in *DeleteContentTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EntryService_DeleteContentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*Entry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entry)
+	err := c.cc.Invoke(ctx, EntryService_CreateEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*Entry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entry)
+	err := c.cc.Invoke(ctx, EntryService_GetEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, EntryService_ListEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*Entry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entry)
+	err := c.cc.Invoke(ctx, EntryService_UpdateEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EntryService_DeleteEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) PublishEntry(ctx context.Context, in *PublishEntryRequest, opts ...grpc.CallOption) (*Entry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entry)
+	err := c.cc.Invoke(ctx, EntryService_PublishEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryServiceClient) UnpublishEntry(ctx context.Context, in *UnpublishEntryRequest, opts ...grpc.CallOption) (*Entry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entry)
+	err := c.cc.Invoke(ctx, EntryService_UnpublishEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EntryServiceServer is the server API for EntryService service.
+// All implementations must embed UnimplementedEntryServiceServer
+// for forward compatibility.
+//
+// Entry service for administrator-defined content types and their entries
+type EntryServiceServer interface {
+	// Create a content type (admins only)
+	CreateContentType(context.Context, *CreateContentTypeRequest) (*ContentType, error)
+	// Get a content type by ID or slug
+	GetContentType(context.Context, *GetContentTypeRequest) (*ContentType, error)
+	// List content types
+	ListContentTypes(context.Context, *ListContentTypesRequest) (*ListContentTypesResponse, error)
+	// Update a content type; existing entries are not revalidated
+	UpdateContentType(context.Context, *UpdateContentTypeRequest) (*ContentType, error)
+	// Delete a content type; force is required when it still has entries
+	DeleteContentType(context.Context, *DeleteContentTypeRequest) (*emptypb.Empty, error)
+	// Create an entry; data is validated against the fields of its content type
+	CreateEntry(context.Context, *CreateEntryRequest) (*Entry, error)
+	// Get an entry by ID
+	GetEntry(context.Context, *GetEntryRequest) (*Entry, error)
+	// List the entries of a content type, filtered and sorted by filterable fields
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	// Update the slug and data of an entry
+	UpdateEntry(context.Context, *UpdateEntryRequest) (*Entry, error)
+	// Delete an entry
+	DeleteEntry(context.Context, *DeleteEntryRequest) (*emptypb.Empty, error)
+	// Publish an entry
+	PublishEntry(context.Context, *PublishEntryRequest) (*Entry, error)
+	// Move a published entry back to draft
+	UnpublishEntry(context.Context, *UnpublishEntryRequest) (*Entry, error)
+	mustEmbedUnimplementedEntryServiceServer()
+}
+
+// UnimplementedEntryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEntryServiceServer struct{}
+
+func (UnimplementedEntryServiceServer) CreateContentType(context.Context, *CreateContentTypeRequest) (*ContentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContentType not implemented")
+}
+func (UnimplementedEntryServiceServer) GetContentType(context.Context, *GetContentTypeRequest) (*ContentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentType not implemented")
+}
+func (UnimplementedEntryServiceServer) ListContentTypes(context.Context, *ListContentTypesRequest) (*ListContentTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContentTypes not implemented")
+}
+func (UnimplementedEntryServiceServer) UpdateContentType(context.Context, *UpdateContentTypeRequest) (*ContentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContentType not implemented")
+}
+func (UnimplementedEntryServiceServer) DeleteContentType(context.Context, *DeleteContentTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContentType not implemented")
+}
+func (UnimplementedEntryServiceServer) CreateEntry(context.Context, *CreateEntryRequest) (*Entry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
+}
+func (UnimplementedEntryServiceServer) GetEntry(context.Context, *GetEntryRequest) (*Entry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
+}
+func (UnimplementedEntryServiceServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (UnimplementedEntryServiceServer) UpdateEntry(context.Context, *UpdateEntryRequest) (*Entry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntry not implemented")
+}
+func (UnimplementedEntryServiceServer) DeleteEntry(context.Context, *DeleteEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
+}
+func (UnimplementedEntryServiceServer) PublishEntry(context.Context, *PublishEntryRequest) (*Entry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishEntry not implemented")
+}
+func (UnimplementedEntryServiceServer) UnpublishEntry(context.Context, *UnpublishEntryRequest) (*Entry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishEntry not implemented")
+}
+func (UnimplementedEntryServiceServer) mustEmbedUnimplementedEntryServiceServer() {}
+func (UnimplementedEntryServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEntryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EntryServiceServer will
+// result in compilation errors.
+type UnsafeEntryServiceServer interface {
+	mustEmbedUnimplementedEntryServiceServer()
+}
+
+func RegisterEntryServiceServer(s grpc.ServiceRegistrar, srv EntryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEntryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EntryService_ServiceDesc, srv)
+}
+
+func _EntryService_CreateContentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContentTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).CreateContentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_CreateContentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).CreateContentType(ctx, req.(*CreateContentTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_GetContentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).GetContentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_GetContentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).GetContentType(ctx, req.(*GetContentTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_ListContentTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContentTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).ListContentTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_ListContentTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).ListContentTypes(ctx, req.(*ListContentTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_UpdateContentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContentTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).UpdateContentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_UpdateContentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).UpdateContentType(ctx, req.(*UpdateContentTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_DeleteContentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContentTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).DeleteContentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_DeleteContentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).DeleteContentType(ctx, req.(*DeleteContentTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_CreateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).CreateEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_CreateEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).CreateEntry(ctx, req.(*CreateEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).GetEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_GetEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).GetEntry(ctx, req.(*GetEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_ListEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).ListEntries(ctx, req.(*ListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_UpdateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).UpdateEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_UpdateEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).UpdateEntry(ctx, req.(*UpdateEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_DeleteEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).DeleteEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_DeleteEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).DeleteEntry(ctx, req.(*DeleteEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_PublishEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).PublishEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_PublishEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).PublishEntry(ctx, req.(*PublishEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntryService_UnpublishEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServiceServer).UnpublishEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntryService_UnpublishEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServiceServer).UnpublishEntry(ctx, req.(*UnpublishEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EntryService_ServiceDesc is the grpc.ServiceDesc for EntryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EntryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entry.v1.EntryService",
+	HandlerType: (*EntryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateContentType",
+			Handler:    _EntryService_CreateContentType_Handler,
+		},
+		{
+			MethodName: "GetContentType",
+			Handler:    _EntryService_GetContentType_Handler,
+		},
+		{
+			MethodName: "ListContentTypes",
+			Handler:    _EntryService_ListContentTypes_Handler,
+		},
+		{
+			MethodName: "UpdateContentType",
+			Handler:    _EntryService_UpdateContentType_Handler,
+		},
+		{
+			MethodName: "DeleteContentType",
+			Handler:    _EntryService_DeleteContentType_Handler,
+		},
+		{
+			MethodName: "CreateEntry",
+			Handler:    _EntryService_CreateEntry_Handler,
+		},
+		{
+			MethodName: "GetEntry",
+			Handler:    _EntryService_GetEntry_Handler,
+		},
+		{
+			MethodName: "ListEntries",
+			Handler:    _EntryService_ListEntries_Handler,
+		},
+		{
+			MethodName: "UpdateEntry",
+			Handler:    _EntryService_UpdateEntry_Handler,
+		},
+		{
+			MethodName: "DeleteEntry",
+			Handler:    _EntryService_DeleteEntry_Handler,
+		},
+		{
+			MethodName: "PublishEntry",
+			Handler:    _EntryService_PublishEntry_Handler,
+		},
+		{
+			MethodName: "UnpublishEntry",
+			Handler:    _EntryService_UnpublishEntry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entry/v1/entry.proto",
+}
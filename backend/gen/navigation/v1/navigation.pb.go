@@ -0,0 +1,958 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: navigation/v1/navigation.proto
+
+package navigationv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What a menu item links to
+type LinkType int32
+
+const (
+	LinkType_LINK_TYPE_UNSPECIFIED LinkType = 0
+	// target_id is a page ID
+	LinkType_LINK_TYPE_PAGE LinkType = 1
+	// target_id is a blog post ID
+	LinkType_LINK_TYPE_POST LinkType = 2
+	// target_id is a blog category slug
+	LinkType_LINK_TYPE_CATEGORY LinkType = 3
+	// url is an absolute http(s), mailto: or tel: URL, or a path on the website
+	LinkType_LINK_TYPE_URL LinkType = 4
+	// No link; the item only groups its children
+	LinkType_LINK_TYPE_NONE LinkType = 5
+)
+
+// Enum value maps for LinkType.
+var (
+	LinkType_name = map[int32]string{
+		0: "LINK_TYPE_UNSPECIFIED",
+		1: "LINK_TYPE_PAGE",
+		2: "LINK_TYPE_POST",
+		3: "LINK_TYPE_CATEGORY",
+		4: "LINK_TYPE_URL",
+		5: "LINK_TYPE_NONE",
+	}
+	LinkType_value = map[string]int32{
+		"LINK_TYPE_UNSPECIFIED": 0,
+		"LINK_TYPE_PAGE":        1,
+		"LINK_TYPE_POST":        2,
+		"LINK_TYPE_CATEGORY":    3,
+		"LINK_TYPE_URL":         4,
+		"LINK_TYPE_NONE":        5,
+	}
+)
+
+func (x LinkType) Enum() *LinkType {
+	p := new(LinkType)
+	*p = x
+	return p
+}
+
+func (x LinkType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkType) Descriptor() protoreflect.EnumDescriptor {
+	return file_navigation_v1_navigation_proto_enumTypes[0].Descriptor()
+}
+
+func (LinkType) Type() protoreflect.EnumType {
+	return &file_navigation_v1_navigation_proto_enumTypes[0]
+}
+
+func (x LinkType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkType.Descriptor instead.
+func (LinkType) EnumDescriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{0}
+}
+
+// Menu is a named tree of menu items in display order
+type Menu struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug        string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Items       []*MenuItem            `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	// Locale the labels were picked for; empty for the default labels
+	Locale        string                 `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Menu) Reset() {
+	*x = Menu{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Menu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Menu) ProtoMessage() {}
+
+func (x *Menu) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Menu.ProtoReflect.Descriptor instead.
+func (*Menu) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Menu) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Menu) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Menu) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Menu) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Menu) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Menu) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Menu) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Menu) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// MenuItem is a resolved menu item
+type MenuItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Label in the requested locale, falling back to the default label and then to
+	// the title of the linked page, post or category
+	Label    string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	LinkType LinkType `protobuf:"varint,3,opt,name=link_type,json=linkType,proto3,enum=navigation.v1.LinkType" json:"link_type,omitempty"`
+	TargetId string   `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Resolved link: the website path of a page, post or category, or the stored URL
+	Url          string      `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	OpenInNewTab bool        `protobuf:"varint,6,opt,name=open_in_new_tab,json=openInNewTab,proto3" json:"open_in_new_tab,omitempty"`
+	Children     []*MenuItem `protobuf:"bytes,7,rep,name=children,proto3" json:"children,omitempty"`
+	// The stored default label and labels by locale, for editing
+	DefaultLabel string            `protobuf:"bytes,8,opt,name=default_label,json=defaultLabel,proto3" json:"default_label,omitempty"`
+	Labels       map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Set when the linked content is missing or unpublished, or a group has no visible
+	// children. Hidden items are only returned with include_hidden.
+	Hidden        bool `protobuf:"varint,10,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuItem) Reset() {
+	*x = MenuItem{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItem) ProtoMessage() {}
+
+func (x *MenuItem) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItem.ProtoReflect.Descriptor instead.
+func (*MenuItem) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MenuItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MenuItem) GetLinkType() LinkType {
+	if x != nil {
+		return x.LinkType
+	}
+	return LinkType_LINK_TYPE_UNSPECIFIED
+}
+
+func (x *MenuItem) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *MenuItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MenuItem) GetOpenInNewTab() bool {
+	if x != nil {
+		return x.OpenInNewTab
+	}
+	return false
+}
+
+func (x *MenuItem) GetChildren() []*MenuItem {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *MenuItem) GetDefaultLabel() string {
+	if x != nil {
+		return x.DefaultLabel
+	}
+	return ""
+}
+
+func (x *MenuItem) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *MenuItem) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// MenuItemInput is a menu item as saved by editors
+type MenuItemInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keep the ID of existing items; new items get one assigned
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Default label; optional for page, post and category links
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Labels by locale, e.g. {"de": "Über uns", "pt-BR": "Sobre"}
+	Labels   map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	LinkType LinkType          `protobuf:"varint,4,opt,name=link_type,json=linkType,proto3,enum=navigation.v1.LinkType" json:"link_type,omitempty"`
+	// Page ID, post ID or category slug for content links
+	TargetId string `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// URL of LINK_TYPE_URL items
+	Url           string           `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	OpenInNewTab  bool             `protobuf:"varint,7,opt,name=open_in_new_tab,json=openInNewTab,proto3" json:"open_in_new_tab,omitempty"`
+	Children      []*MenuItemInput `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuItemInput) Reset() {
+	*x = MenuItemInput{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItemInput) ProtoMessage() {}
+
+func (x *MenuItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItemInput.ProtoReflect.Descriptor instead.
+func (*MenuItemInput) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MenuItemInput) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuItemInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MenuItemInput) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *MenuItemInput) GetLinkType() LinkType {
+	if x != nil {
+		return x.LinkType
+	}
+	return LinkType_LINK_TYPE_UNSPECIFIED
+}
+
+func (x *MenuItemInput) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *MenuItemInput) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MenuItemInput) GetOpenInNewTab() bool {
+	if x != nil {
+		return x.OpenInNewTab
+	}
+	return false
+}
+
+func (x *MenuItemInput) GetChildren() []*MenuItemInput {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type CreateMenuRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Generated from the name when empty
+	Slug          string           `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string           `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Items         []*MenuItemInput `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMenuRequest) Reset() {
+	*x = CreateMenuRequest{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuRequest) ProtoMessage() {}
+
+func (x *CreateMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuRequest.ProtoReflect.Descriptor instead.
+func (*CreateMenuRequest) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateMenuRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMenuRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateMenuRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMenuRequest) GetItems() []*MenuItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetMenuRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Menu ID or slug
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Locale of the labels, e.g. "de-AT"; falls back to "de", then to the default labels
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Include hidden items, flagged with hidden (editors and admins only)
+	IncludeHidden bool `protobuf:"varint,3,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuRequest) Reset() {
+	*x = GetMenuRequest{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuRequest) ProtoMessage() {}
+
+func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuRequest) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMenuRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMenuRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetMenuRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type ListMenusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMenusRequest) Reset() {
+	*x = ListMenusRequest{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMenusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMenusRequest) ProtoMessage() {}
+
+func (x *ListMenusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMenusRequest.ProtoReflect.Descriptor instead.
+func (*ListMenusRequest) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMenusRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMenusRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMenusRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListMenusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Menus by name, with hidden items included
+	Menus         []*Menu `protobuf:"bytes,1,rep,name=menus,proto3" json:"menus,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMenusResponse) Reset() {
+	*x = ListMenusResponse{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMenusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMenusResponse) ProtoMessage() {}
+
+func (x *ListMenusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMenusResponse.ProtoReflect.Descriptor instead.
+func (*ListMenusResponse) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMenusResponse) GetMenus() []*Menu {
+	if x != nil {
+		return x.Menus
+	}
+	return nil
+}
+
+func (x *ListMenusResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateMenuRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Generated from the name when empty
+	Slug        string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// The complete item tree; items left out are removed
+	Items         []*MenuItemInput `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMenuRequest) Reset() {
+	*x = UpdateMenuRequest{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuRequest) ProtoMessage() {}
+
+func (x *UpdateMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMenuRequest) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateMenuRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMenuRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMenuRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateMenuRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMenuRequest) GetItems() []*MenuItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuRequest) Reset() {
+	*x = DeleteMenuRequest{}
+	mi := &file_navigation_v1_navigation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuRequest) ProtoMessage() {}
+
+func (x *DeleteMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_navigation_v1_navigation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMenuRequest) Descriptor() ([]byte, []int) {
+	return file_navigation_v1_navigation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteMenuRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_navigation_v1_navigation_proto protoreflect.FileDescriptor
+
+const file_navigation_v1_navigation_proto_rawDesc = "" +
+	"\n" +
+	"\x1enavigation/v1/navigation.proto\x12\rnavigation.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9d\x02\n" +
+	"\x04Menu\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12-\n" +
+	"\x05items\x18\x05 \x03(\v2\x17.navigation.v1.MenuItemR\x05items\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa6\x03\n" +
+	"\bMenuItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x124\n" +
+	"\tlink_type\x18\x03 \x01(\x0e2\x17.navigation.v1.LinkTypeR\blinkType\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12%\n" +
+	"\x0fopen_in_new_tab\x18\x06 \x01(\bR\fopenInNewTab\x123\n" +
+	"\bchildren\x18\a \x03(\v2\x17.navigation.v1.MenuItemR\bchildren\x12#\n" +
+	"\rdefault_label\x18\b \x01(\tR\fdefaultLabel\x12;\n" +
+	"\x06labels\x18\t \x03(\v2#.navigation.v1.MenuItem.LabelsEntryR\x06labels\x12\x16\n" +
+	"\x06hidden\x18\n" +
+	" \x01(\bR\x06hidden\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf8\x02\n" +
+	"\rMenuItemInput\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12@\n" +
+	"\x06labels\x18\x03 \x03(\v2(.navigation.v1.MenuItemInput.LabelsEntryR\x06labels\x124\n" +
+	"\tlink_type\x18\x04 \x01(\x0e2\x17.navigation.v1.LinkTypeR\blinkType\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12%\n" +
+	"\x0fopen_in_new_tab\x18\a \x01(\bR\fopenInNewTab\x128\n" +
+	"\bchildren\x18\b \x03(\v2\x1c.navigation.v1.MenuItemInputR\bchildren\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x01\n" +
+	"\x11CreateMenuRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
+	"\x05items\x18\x04 \x03(\v2\x1c.navigation.v1.MenuItemInputR\x05items\"_\n" +
+	"\x0eGetMenuRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12%\n" +
+	"\x0einclude_hidden\x18\x03 \x01(\bR\rincludeHidden\"f\n" +
+	"\x10ListMenusRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\"f\n" +
+	"\x11ListMenusResponse\x12)\n" +
+	"\x05menus\x18\x01 \x03(\v2\x13.navigation.v1.MenuR\x05menus\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa1\x01\n" +
+	"\x11UpdateMenuRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x122\n" +
+	"\x05items\x18\x05 \x03(\v2\x1c.navigation.v1.MenuItemInputR\x05items\"#\n" +
+	"\x11DeleteMenuRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*\x8c\x01\n" +
+	"\bLinkType\x12\x19\n" +
+	"\x15LINK_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eLINK_TYPE_PAGE\x10\x01\x12\x12\n" +
+	"\x0eLINK_TYPE_POST\x10\x02\x12\x16\n" +
+	"\x12LINK_TYPE_CATEGORY\x10\x03\x12\x11\n" +
+	"\rLINK_TYPE_URL\x10\x04\x12\x12\n" +
+	"\x0eLINK_TYPE_NONE\x10\x052\xb3\x04\n" +
+	"\x11NavigationService\x12h\n" +
+	"\n" +
+	"CreateMenu\x12 .navigation.v1.CreateMenuRequest\x1a\x13.navigation.v1.Menu\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/navigation/menus\x12d\n" +
+	"\aGetMenu\x12\x1d.navigation.v1.GetMenuRequest\x1a\x13.navigation.v1.Menu\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/navigation/menus/{id}\x12p\n" +
+	"\tListMenus\x12\x1f.navigation.v1.ListMenusRequest\x1a .navigation.v1.ListMenusResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/navigation/menus\x12m\n" +
+	"\n" +
+	"UpdateMenu\x12 .navigation.v1.UpdateMenuRequest\x1a\x13.navigation.v1.Menu\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/navigation/menus/{id}\x12m\n" +
+	"\n" +
+	"DeleteMenu\x12 .navigation.v1.DeleteMenuRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/navigation/menus/{id}BLZJgithub.com/7-solutions/saas-platformbackend/gen/navigation/v1;navigationv1b\x06proto3"
+
+var (
+	file_navigation_v1_navigation_proto_rawDescOnce sync.Once
+	file_navigation_v1_navigation_proto_rawDescData []byte
+)
+
+func file_navigation_v1_navigation_proto_rawDescGZIP() []byte {
+	file_navigation_v1_navigation_proto_rawDescOnce.Do(func() {
+		file_navigation_v1_navigation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_navigation_v1_navigation_proto_rawDesc), len(file_navigation_v1_navigation_proto_rawDesc)))
+	})
+	return file_navigation_v1_navigation_proto_rawDescData
+}
+
+var file_navigation_v1_navigation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_navigation_v1_navigation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_navigation_v1_navigation_proto_goTypes = []any{
+	(LinkType)(0),                 // 0: navigation.v1.LinkType
+	(*Menu)(nil),                  // 1: navigation.v1.Menu
+	(*MenuItem)(nil),              // 2: navigation.v1.MenuItem
+	(*MenuItemInput)(nil),         // 3: navigation.v1.MenuItemInput
+	(*CreateMenuRequest)(nil),     // 4: navigation.v1.CreateMenuRequest
+	(*GetMenuRequest)(nil),        // 5: navigation.v1.GetMenuRequest
+	(*ListMenusRequest)(nil),      // 6: navigation.v1.ListMenusRequest
+	(*ListMenusResponse)(nil),     // 7: navigation.v1.ListMenusResponse
+	(*UpdateMenuRequest)(nil),     // 8: navigation.v1.UpdateMenuRequest
+	(*DeleteMenuRequest)(nil),     // 9: navigation.v1.DeleteMenuRequest
+	nil,                           // 10: navigation.v1.MenuItem.LabelsEntry
+	nil,                           // 11: navigation.v1.MenuItemInput.LabelsEntry
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+}
+var file_navigation_v1_navigation_proto_depIdxs = []int32{
+	2,  // 0: navigation.v1.Menu.items:type_name -> navigation.v1.MenuItem
+	12, // 1: navigation.v1.Menu.created_at:type_name -> google.protobuf.Timestamp
+	12, // 2: navigation.v1.Menu.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: navigation.v1.MenuItem.link_type:type_name -> navigation.v1.LinkType
+	2,  // 4: navigation.v1.MenuItem.children:type_name -> navigation.v1.MenuItem
+	10, // 5: navigation.v1.MenuItem.labels:type_name -> navigation.v1.MenuItem.LabelsEntry
+	11, // 6: navigation.v1.MenuItemInput.labels:type_name -> navigation.v1.MenuItemInput.LabelsEntry
+	0,  // 7: navigation.v1.MenuItemInput.link_type:type_name -> navigation.v1.LinkType
+	3,  // 8: navigation.v1.MenuItemInput.children:type_name -> navigation.v1.MenuItemInput
+	3,  // 9: navigation.v1.CreateMenuRequest.items:type_name -> navigation.v1.MenuItemInput
+	1,  // 10: navigation.v1.ListMenusResponse.menus:type_name -> navigation.v1.Menu
+	3,  // 11: navigation.v1.UpdateMenuRequest.items:type_name -> navigation.v1.MenuItemInput
+	4,  // 12: navigation.v1.NavigationService.CreateMenu:input_type -> navigation.v1.CreateMenuRequest
+	5,  // 13: navigation.v1.NavigationService.GetMenu:input_type -> navigation.v1.GetMenuRequest
+	6,  // 14: navigation.v1.NavigationService.ListMenus:input_type -> navigation.v1.ListMenusRequest
+	8,  // 15: navigation.v1.NavigationService.UpdateMenu:input_type -> navigation.v1.UpdateMenuRequest
+	9,  // 16: navigation.v1.NavigationService.DeleteMenu:input_type -> navigation.v1.DeleteMenuRequest
+	1,  // 17: navigation.v1.NavigationService.CreateMenu:output_type -> navigation.v1.Menu
+	1,  // 18: navigation.v1.NavigationService.GetMenu:output_type -> navigation.v1.Menu
+	7,  // 19: navigation.v1.NavigationService.ListMenus:output_type -> navigation.v1.ListMenusResponse
+	1,  // 20: navigation.v1.NavigationService.UpdateMenu:output_type -> navigation.v1.Menu
+	13, // 21: navigation.v1.NavigationService.DeleteMenu:output_type -> google.protobuf.Empty
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_navigation_v1_navigation_proto_init() }
+func file_navigation_v1_navigation_proto_init() {
+	if File_navigation_v1_navigation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_navigation_v1_navigation_proto_rawDesc), len(file_navigation_v1_navigation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_navigation_v1_navigation_proto_goTypes,
+		DependencyIndexes: file_navigation_v1_navigation_proto_depIdxs,
+		EnumInfos:         file_navigation_v1_navigation_proto_enumTypes,
+		MessageInfos:      file_navigation_v1_navigation_proto_msgTypes,
+	}.Build()
+	File_navigation_v1_navigation_proto = out.File
+	file_navigation_v1_navigation_proto_goTypes = nil
+	file_navigation_v1_navigation_proto_depIdxs = nil
+}
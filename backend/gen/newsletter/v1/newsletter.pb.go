@@ -0,0 +1,1038 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: newsletter/v1/newsletter.proto
+
+package newsletterv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Subscription state
+type SubscriberStatus int32
+
+const (
+	SubscriberStatus_SUBSCRIBER_STATUS_UNSPECIFIED SubscriberStatus = 0
+	// Waiting for the address to be confirmed
+	SubscriberStatus_SUBSCRIBER_STATUS_PENDING SubscriberStatus = 1
+	// Receives digests
+	SubscriberStatus_SUBSCRIBER_STATUS_ACTIVE       SubscriberStatus = 2
+	SubscriberStatus_SUBSCRIBER_STATUS_UNSUBSCRIBED SubscriberStatus = 3
+	// Mail to the address bounced permanently
+	SubscriberStatus_SUBSCRIBER_STATUS_BOUNCED SubscriberStatus = 4
+	// The recipient reported the newsletter as spam
+	SubscriberStatus_SUBSCRIBER_STATUS_COMPLAINED SubscriberStatus = 5
+)
+
+// Enum value maps for SubscriberStatus.
+var (
+	SubscriberStatus_name = map[int32]string{
+		0: "SUBSCRIBER_STATUS_UNSPECIFIED",
+		1: "SUBSCRIBER_STATUS_PENDING",
+		2: "SUBSCRIBER_STATUS_ACTIVE",
+		3: "SUBSCRIBER_STATUS_UNSUBSCRIBED",
+		4: "SUBSCRIBER_STATUS_BOUNCED",
+		5: "SUBSCRIBER_STATUS_COMPLAINED",
+	}
+	SubscriberStatus_value = map[string]int32{
+		"SUBSCRIBER_STATUS_UNSPECIFIED":  0,
+		"SUBSCRIBER_STATUS_PENDING":      1,
+		"SUBSCRIBER_STATUS_ACTIVE":       2,
+		"SUBSCRIBER_STATUS_UNSUBSCRIBED": 3,
+		"SUBSCRIBER_STATUS_BOUNCED":      4,
+		"SUBSCRIBER_STATUS_COMPLAINED":   5,
+	}
+)
+
+func (x SubscriberStatus) Enum() *SubscriberStatus {
+	p := new(SubscriberStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_newsletter_v1_newsletter_proto_enumTypes[0].Descriptor()
+}
+
+func (SubscriberStatus) Type() protoreflect.EnumType {
+	return &file_newsletter_v1_newsletter_proto_enumTypes[0]
+}
+
+func (x SubscriberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriberStatus.Descriptor instead.
+func (SubscriberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{0}
+}
+
+// Subscriber is one email address signed up for the newsletter
+type Subscriber struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status SubscriberStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=newsletter.v1.SubscriberStatus" json:"status,omitempty"`
+	// Category slugs; empty means every category
+	Topics        []string               `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
+	ConfirmedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscriber) Reset() {
+	*x = Subscriber{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscriber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscriber) ProtoMessage() {}
+
+func (x *Subscriber) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscriber.ProtoReflect.Descriptor instead.
+func (*Subscriber) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subscriber) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscriber) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Subscriber) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subscriber) GetStatus() SubscriberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriberStatus_SUBSCRIBER_STATUS_UNSPECIFIED
+}
+
+func (x *Subscriber) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *Subscriber) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *Subscriber) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscriber) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Topic is a blog category subscribers can choose
+type Topic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+func (x *Topic) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Topic) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Topic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Digest is one digest run
+type Digest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Posts published in [period_start, period_end) were included
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	PostIds       []string               `protobuf:"bytes,4,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	Recipients    int32                  `protobuf:"varint,5,opt,name=recipients,proto3" json:"recipients,omitempty"`
+	Failures      int32                  `protobuf:"varint,6,opt,name=failures,proto3" json:"failures,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Digest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Digest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Digest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Digest) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+func (x *Digest) GetRecipients() int32 {
+	if x != nil {
+		return x.Recipients
+	}
+	return 0
+}
+
+func (x *Digest) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Digest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Category slugs; empty subscribes to every category
+	Topics        []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubscribeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The same message is returned whether or not the address was already subscribed
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscribeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConfirmSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmSubscriptionRequest) Reset() {
+	*x = ConfirmSubscriptionRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSubscriptionRequest) ProtoMessage() {}
+
+func (x *ConfirmSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfirmSubscriptionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPreferencesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdatePreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Category slugs; empty subscribes to every category
+	Topics        []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePreferencesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdatePreferencesRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type UnsubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnsubscribeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListTopicsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicsRequest) Reset() {
+	*x = ListTopicsRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicsRequest) ProtoMessage() {}
+
+func (x *ListTopicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopicsRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{9}
+}
+
+type ListTopicsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []*Topic               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopicsResponse) Reset() {
+	*x = ListTopicsResponse{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicsResponse) ProtoMessage() {}
+
+func (x *ListTopicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicsResponse.ProtoReflect.Descriptor instead.
+func (*ListTopicsResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTopicsResponse) GetTopics() []*Topic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type ListSubscribersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional status filter
+	Status        SubscriberStatus `protobuf:"varint,3,opt,name=status,proto3,enum=newsletter.v1.SubscriberStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribersRequest) Reset() {
+	*x = ListSubscribersRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribersRequest) ProtoMessage() {}
+
+func (x *ListSubscribersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribersRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribersRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSubscribersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSubscribersRequest) GetStatus() SubscriberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriberStatus_SUBSCRIBER_STATUS_UNSPECIFIED
+}
+
+type ListSubscribersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscribers   []*Subscriber          `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribersResponse) Reset() {
+	*x = ListSubscribersResponse{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribersResponse) ProtoMessage() {}
+
+func (x *ListSubscribersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribersResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribersResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSubscribersResponse) GetSubscribers() []*Subscriber {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+func (x *ListSubscribersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSubscribersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type SendDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendDigestRequest) Reset() {
+	*x = SendDigestRequest{}
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDigestRequest) ProtoMessage() {}
+
+func (x *SendDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_v1_newsletter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDigestRequest.ProtoReflect.Descriptor instead.
+func (*SendDigestRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_v1_newsletter_proto_rawDescGZIP(), []int{13}
+}
+
+var File_newsletter_v1_newsletter_proto protoreflect.FileDescriptor
+
+const file_newsletter_v1_newsletter_proto_rawDesc = "" +
+	"\n" +
+	"\x1enewsletter/v1/newsletter.proto\x12\rnewsletter.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcc\x02\n" +
+	"\n" +
+	"Subscriber\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.newsletter.v1.SubscriberStatusR\x06status\x12\x16\n" +
+	"\x06topics\x18\x05 \x03(\tR\x06topics\x12=\n" +
+	"\fconfirmed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"/\n" +
+	"\x05Topic\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa4\x02\n" +
+	"\x06Digest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x19\n" +
+	"\bpost_ids\x18\x04 \x03(\tR\apostIds\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x05 \x01(\x05R\n" +
+	"recipients\x12\x1a\n" +
+	"\bfailures\x18\x06 \x01(\x05R\bfailures\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"T\n" +
+	"\x10SubscribeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06topics\x18\x03 \x03(\tR\x06topics\"-\n" +
+	"\x11SubscribeResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"2\n" +
+	"\x1aConfirmSubscriptionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"-\n" +
+	"\x15GetPreferencesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"H\n" +
+	"\x18UpdatePreferencesRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06topics\x18\x02 \x03(\tR\x06topics\"*\n" +
+	"\x12UnsubscribeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x13\n" +
+	"\x11ListTopicsRequest\"B\n" +
+	"\x12ListTopicsResponse\x12,\n" +
+	"\x06topics\x18\x01 \x03(\v2\x14.newsletter.v1.TopicR\x06topics\"\x8d\x01\n" +
+	"\x16ListSubscribersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.newsletter.v1.SubscriberStatusR\x06status\"\x9f\x01\n" +
+	"\x17ListSubscribersResponse\x12;\n" +
+	"\vsubscribers\x18\x01 \x03(\v2\x19.newsletter.v1.SubscriberR\vsubscribers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x13\n" +
+	"\x11SendDigestRequest*\xd7\x01\n" +
+	"\x10SubscriberStatus\x12!\n" +
+	"\x1dSUBSCRIBER_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SUBSCRIBER_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18SUBSCRIBER_STATUS_ACTIVE\x10\x02\x12\"\n" +
+	"\x1eSUBSCRIBER_STATUS_UNSUBSCRIBED\x10\x03\x12\x1d\n" +
+	"\x19SUBSCRIBER_STATUS_BOUNCED\x10\x04\x12 \n" +
+	"\x1cSUBSCRIBER_STATUS_COMPLAINED\x10\x052\xf5\a\n" +
+	"\x11NewsletterService\x12w\n" +
+	"\tSubscribe\x12\x1f.newsletter.v1.SubscribeRequest\x1a .newsletter.v1.SubscribeResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/newsletter/subscribe\x12\x82\x01\n" +
+	"\x13ConfirmSubscription\x12).newsletter.v1.ConfirmSubscriptionRequest\x1a\x19.newsletter.v1.Subscriber\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/newsletter/confirm\x12y\n" +
+	"\x0eGetPreferences\x12$.newsletter.v1.GetPreferencesRequest\x1a\x19.newsletter.v1.Subscriber\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/newsletter/preferences\x12\x82\x01\n" +
+	"\x11UpdatePreferences\x12'.newsletter.v1.UpdatePreferencesRequest\x1a\x19.newsletter.v1.Subscriber\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/newsletter/preferences\x12s\n" +
+	"\vUnsubscribe\x12!.newsletter.v1.UnsubscribeRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/newsletter/unsubscribe\x12t\n" +
+	"\n" +
+	"ListTopics\x12 .newsletter.v1.ListTopicsRequest\x1a!.newsletter.v1.ListTopicsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/newsletter/topics\x12\x88\x01\n" +
+	"\x0fListSubscribers\x12%.newsletter.v1.ListSubscribersRequest\x1a&.newsletter.v1.ListSubscribersResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/newsletter/subscribers\x12l\n" +
+	"\n" +
+	"SendDigest\x12 .newsletter.v1.SendDigestRequest\x1a\x15.newsletter.v1.Digest\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/newsletter/digestsBLZJgithub.com/7-solutions/saas-platformbackend/gen/newsletter/v1;newsletterv1b\x06proto3"
+
+var (
+	file_newsletter_v1_newsletter_proto_rawDescOnce sync.Once
+	file_newsletter_v1_newsletter_proto_rawDescData []byte
+)
+
+func file_newsletter_v1_newsletter_proto_rawDescGZIP() []byte {
+	file_newsletter_v1_newsletter_proto_rawDescOnce.Do(func() {
+		file_newsletter_v1_newsletter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_newsletter_v1_newsletter_proto_rawDesc), len(file_newsletter_v1_newsletter_proto_rawDesc)))
+	})
+	return file_newsletter_v1_newsletter_proto_rawDescData
+}
+
+var file_newsletter_v1_newsletter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_newsletter_v1_newsletter_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_newsletter_v1_newsletter_proto_goTypes = []any{
+	(SubscriberStatus)(0),              // 0: newsletter.v1.SubscriberStatus
+	(*Subscriber)(nil),                 // 1: newsletter.v1.Subscriber
+	(*Topic)(nil),                      // 2: newsletter.v1.Topic
+	(*Digest)(nil),                     // 3: newsletter.v1.Digest
+	(*SubscribeRequest)(nil),           // 4: newsletter.v1.SubscribeRequest
+	(*SubscribeResponse)(nil),          // 5: newsletter.v1.SubscribeResponse
+	(*ConfirmSubscriptionRequest)(nil), // 6: newsletter.v1.ConfirmSubscriptionRequest
+	(*GetPreferencesRequest)(nil),      // 7: newsletter.v1.GetPreferencesRequest
+	(*UpdatePreferencesRequest)(nil),   // 8: newsletter.v1.UpdatePreferencesRequest
+	(*UnsubscribeRequest)(nil),         // 9: newsletter.v1.UnsubscribeRequest
+	(*ListTopicsRequest)(nil),          // 10: newsletter.v1.ListTopicsRequest
+	(*ListTopicsResponse)(nil),         // 11: newsletter.v1.ListTopicsResponse
+	(*ListSubscribersRequest)(nil),     // 12: newsletter.v1.ListSubscribersRequest
+	(*ListSubscribersResponse)(nil),    // 13: newsletter.v1.ListSubscribersResponse
+	(*SendDigestRequest)(nil),          // 14: newsletter.v1.SendDigestRequest
+	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 16: google.protobuf.Empty
+}
+var file_newsletter_v1_newsletter_proto_depIdxs = []int32{
+	0,  // 0: newsletter.v1.Subscriber.status:type_name -> newsletter.v1.SubscriberStatus
+	15, // 1: newsletter.v1.Subscriber.confirmed_at:type_name -> google.protobuf.Timestamp
+	15, // 2: newsletter.v1.Subscriber.created_at:type_name -> google.protobuf.Timestamp
+	15, // 3: newsletter.v1.Subscriber.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 4: newsletter.v1.Digest.period_start:type_name -> google.protobuf.Timestamp
+	15, // 5: newsletter.v1.Digest.period_end:type_name -> google.protobuf.Timestamp
+	15, // 6: newsletter.v1.Digest.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: newsletter.v1.ListTopicsResponse.topics:type_name -> newsletter.v1.Topic
+	0,  // 8: newsletter.v1.ListSubscribersRequest.status:type_name -> newsletter.v1.SubscriberStatus
+	1,  // 9: newsletter.v1.ListSubscribersResponse.subscribers:type_name -> newsletter.v1.Subscriber
+	4,  // 10: newsletter.v1.NewsletterService.Subscribe:input_type -> newsletter.v1.SubscribeRequest
+	6,  // 11: newsletter.v1.NewsletterService.ConfirmSubscription:input_type -> newsletter.v1.ConfirmSubscriptionRequest
+	7,  // 12: newsletter.v1.NewsletterService.GetPreferences:input_type -> newsletter.v1.GetPreferencesRequest
+	8,  // 13: newsletter.v1.NewsletterService.UpdatePreferences:input_type -> newsletter.v1.UpdatePreferencesRequest
+	9,  // 14: newsletter.v1.NewsletterService.Unsubscribe:input_type -> newsletter.v1.UnsubscribeRequest
+	10, // 15: newsletter.v1.NewsletterService.ListTopics:input_type -> newsletter.v1.ListTopicsRequest
+	12, // 16: newsletter.v1.NewsletterService.ListSubscribers:input_type -> newsletter.v1.ListSubscribersRequest
+	14, // 17: newsletter.v1.NewsletterService.SendDigest:input_type -> newsletter.v1.SendDigestRequest
+	5,  // 18: newsletter.v1.NewsletterService.Subscribe:output_type -> newsletter.v1.SubscribeResponse
+	1,  // 19: newsletter.v1.NewsletterService.ConfirmSubscription:output_type -> newsletter.v1.Subscriber
+	1,  // 20: newsletter.v1.NewsletterService.GetPreferences:output_type -> newsletter.v1.Subscriber
+	1,  // 21: newsletter.v1.NewsletterService.UpdatePreferences:output_type -> newsletter.v1.Subscriber
+	16, // 22: newsletter.v1.NewsletterService.Unsubscribe:output_type -> google.protobuf.Empty
+	11, // 23: newsletter.v1.NewsletterService.ListTopics:output_type -> newsletter.v1.ListTopicsResponse
+	13, // 24: newsletter.v1.NewsletterService.ListSubscribers:output_type -> newsletter.v1.ListSubscribersResponse
+	3,  // 25: newsletter.v1.NewsletterService.SendDigest:output_type -> newsletter.v1.Digest
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_newsletter_v1_newsletter_proto_init() }
+func file_newsletter_v1_newsletter_proto_init() {
+	if File_newsletter_v1_newsletter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_newsletter_v1_newsletter_proto_rawDesc), len(file_newsletter_v1_newsletter_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_newsletter_v1_newsletter_proto_goTypes,
+		DependencyIndexes: file_newsletter_v1_newsletter_proto_depIdxs,
+		EnumInfos:         file_newsletter_v1_newsletter_proto_enumTypes,
+		MessageInfos:      file_newsletter_v1_newsletter_proto_msgTypes,
+	}.Build()
+	File_newsletter_v1_newsletter_proto = out.File
+	file_newsletter_v1_newsletter_proto_goTypes = nil
+	file_newsletter_v1_newsletter_proto_depIdxs = nil
+}
@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: newsletter/v1/newsletter.proto
+
+package newsletterv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NewsletterService_Subscribe_FullMethodName           = "/newsletter.v1.NewsletterService/Subscribe"
+	NewsletterService_ConfirmSubscription_FullMethodName = "/newsletter.v1.NewsletterService/ConfirmSubscription"
+	NewsletterService_GetPreferences_FullMethodName      = "/newsletter.v1.NewsletterService/GetPreferences"
+	NewsletterService_UpdatePreferences_FullMethodName   = "/newsletter.v1.NewsletterService/UpdatePreferences"
+	NewsletterService_Unsubscribe_FullMethodName         = "/newsletter.v1.NewsletterService/Unsubscribe"
+	NewsletterService_ListTopics_FullMethodName          = "/newsletter.v1.NewsletterService/ListTopics"
+	NewsletterService_ListSubscribers_FullMethodName     = "/newsletter.v1.NewsletterService/ListSubscribers"
+	NewsletterService_SendDigest_FullMethodName          = "/newsletter.v1.NewsletterService/SendDigest"
+)
+
+// NewsletterServiceClient is the client API for NewsletterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Newsletter service for double opt-in subscriptions and digests of new posts.
+// Subscribers manage their subscription with the signed token from their emails.
+type NewsletterServiceClient interface {
+	// Start a subscription; nothing is sent but a confirmation email until the address is confirmed
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	// Confirm an address with the token from the confirmation email
+	ConfirmSubscription(ctx context.Context, in *ConfirmSubscriptionRequest, opts ...grpc.CallOption) (*Subscriber, error)
+	// Get the subscription behind a preferences or unsubscribe token
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Subscriber, error)
+	// Change the topics of a subscription
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*Subscriber, error)
+	// Stop all newsletter email to the subscriber
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the topics a subscriber can choose from; one per blog category
+	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
+	// List subscribers, newest first (admins only)
+	ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
+	// Send a digest of the posts published since the last one now, instead of on schedule (admins only)
+	SendDigest(ctx context.Context, in *SendDigestRequest, opts ...grpc.CallOption) (*Digest, error)
+}
+
+type newsletterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNewsletterServiceClient(cc grpc.ClientConnInterface) NewsletterServiceClient {
+	return &newsletterServiceClient{cc}
+}
+
+func (c *newsletterServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_Subscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ConfirmSubscription(ctx context.Context, in *ConfirmSubscriptionRequest, opts ...grpc.CallOption) (*Subscriber, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscriber)
+	err := c.cc.Invoke(ctx, NewsletterService_ConfirmSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Subscriber, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscriber)
+	err := c.cc.Invoke(ctx, NewsletterService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*Subscriber, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscriber)
+	err := c.cc.Invoke(ctx, NewsletterService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NewsletterService_Unsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTopicsResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ListTopics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ListSubscribers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) SendDigest(ctx context.Context, in *SendDigestRequest, opts ...grpc.CallOption) (*Digest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Digest)
+	err := c.cc.Invoke(ctx, NewsletterService_SendDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NewsletterServiceServer is the server API for NewsletterService service.
+// All implementations must embed UnimplementedNewsletterServiceServer
+// for forward compatibility.
+//
+// Newsletter service for double opt-in subscriptions and digests of new posts.
+// Subscribers manage their subscription with the signed token from their emails.
+type NewsletterServiceServer interface {
+	// Start a subscription; nothing is sent but a confirmation email until the address is confirmed
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	// Confirm an address with the token from the confirmation email
+	ConfirmSubscription(context.Context, *ConfirmSubscriptionRequest) (*Subscriber, error)
+	// Get the subscription behind a preferences or unsubscribe token
+	GetPreferences(context.Context, *GetPreferencesRequest) (*Subscriber, error)
+	// Change the topics of a subscription
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*Subscriber, error)
+	// Stop all newsletter email to the subscriber
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*emptypb.Empty, error)
+	// List the topics a subscriber can choose from; one per blog category
+	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
+	// List subscribers, newest first (admins only)
+	ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
+	// Send a digest of the posts published since the last one now, instead of on schedule (admins only)
+	SendDigest(context.Context, *SendDigestRequest) (*Digest, error)
+	mustEmbedUnimplementedNewsletterServiceServer()
+}
+
+// UnimplementedNewsletterServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNewsletterServiceServer struct{}
+
+func (UnimplementedNewsletterServiceServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ConfirmSubscription(context.Context, *ConfirmSubscriptionRequest) (*Subscriber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSubscription not implemented")
+}
+func (UnimplementedNewsletterServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*Subscriber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNewsletterServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*Subscriber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedNewsletterServiceServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribers not implemented")
+}
+func (UnimplementedNewsletterServiceServer) SendDigest(context.Context, *SendDigestRequest) (*Digest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDigest not implemented")
+}
+func (UnimplementedNewsletterServiceServer) mustEmbedUnimplementedNewsletterServiceServer() {}
+func (UnimplementedNewsletterServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeNewsletterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NewsletterServiceServer will
+// result in compilation errors.
+type UnsafeNewsletterServiceServer interface {
+	mustEmbedUnimplementedNewsletterServiceServer()
+}
+
+func RegisterNewsletterServiceServer(s grpc.ServiceRegistrar, srv NewsletterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNewsletterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NewsletterService_ServiceDesc, srv)
+}
+
+func _NewsletterService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ConfirmSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ConfirmSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ConfirmSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ConfirmSubscription(ctx, req.(*ConfirmSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ListTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ListTopics(ctx, req.(*ListTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ListSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ListSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ListSubscribers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ListSubscribers(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_SendDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).SendDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_SendDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).SendDigest(ctx, req.(*SendDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NewsletterService_ServiceDesc is the grpc.ServiceDesc for NewsletterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NewsletterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "newsletter.v1.NewsletterService",
+	HandlerType: (*NewsletterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Subscribe",
+			Handler:    _NewsletterService_Subscribe_Handler,
+		},
+		{
+			MethodName: "ConfirmSubscription",
+			Handler:    _NewsletterService_ConfirmSubscription_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _NewsletterService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _NewsletterService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _NewsletterService_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _NewsletterService_ListTopics_Handler,
+		},
+		{
+			MethodName: "ListSubscribers",
+			Handler:    _NewsletterService_ListSubscribers_Handler,
+		},
+		{
+			MethodName: "SendDigest",
+			Handler:    _NewsletterService_SendDigest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "newsletter/v1/newsletter.proto",
+}
@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: settings/v1/settings.proto
+
+package settingsv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Settings are the effective site-wide values
+type Settings struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SiteName string                 `protobuf:"bytes,1,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	// Public base URL of the website, without a trailing slash
+	SiteUrl         string        `protobuf:"bytes,2,opt,name=site_url,json=siteUrl,proto3" json:"site_url,omitempty"`
+	LogoUrl         string        `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	BlogDescription string        `protobuf:"bytes,4,opt,name=blog_description,json=blogDescription,proto3" json:"blog_description,omitempty"`
+	SocialLinks     []*SocialLink `protobuf:"bytes,5,rep,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty"`
+	// Media ID of the image used when content has no Open Graph image
+	DefaultMetaImage string `protobuf:"bytes,6,opt,name=default_meta_image,json=defaultMetaImage,proto3" json:"default_meta_image,omitempty"`
+	// Absolute URL of default_meta_image
+	DefaultMetaImageUrl string `protobuf:"bytes,7,opt,name=default_meta_image_url,json=defaultMetaImageUrl,proto3" json:"default_meta_image_url,omitempty"`
+	// Addresses notified of contact form submissions (admins only)
+	ContactRecipients []string `protobuf:"bytes,8,rep,name=contact_recipients,json=contactRecipients,proto3" json:"contact_recipients,omitempty"`
+	// Time of the most recent change; unset while every key uses its default
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_settings_v1_settings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Settings) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *Settings) GetSiteUrl() string {
+	if x != nil {
+		return x.SiteUrl
+	}
+	return ""
+}
+
+func (x *Settings) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *Settings) GetBlogDescription() string {
+	if x != nil {
+		return x.BlogDescription
+	}
+	return ""
+}
+
+func (x *Settings) GetSocialLinks() []*SocialLink {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+func (x *Settings) GetDefaultMetaImage() string {
+	if x != nil {
+		return x.DefaultMetaImage
+	}
+	return ""
+}
+
+func (x *Settings) GetDefaultMetaImageUrl() string {
+	if x != nil {
+		return x.DefaultMetaImageUrl
+	}
+	return ""
+}
+
+func (x *Settings) GetContactRecipients() []string {
+	if x != nil {
+		return x.ContactRecipients
+	}
+	return nil
+}
+
+func (x *Settings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SocialLink is a profile of the site on a social network
+type SocialLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowercase slug such as "mastodon" or "github"
+	Network       string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialLink) Reset() {
+	*x = SocialLink{}
+	mi := &file_settings_v1_settings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialLink) ProtoMessage() {}
+
+func (x *SocialLink) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialLink.ProtoReflect.Descriptor instead.
+func (*SocialLink) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SocialLink) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SocialLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_settings_v1_settings_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{2}
+}
+
+// UpdateSettingsRequest changes only the fields that are set
+type UpdateSettingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SiteName        *string                `protobuf:"bytes,1,opt,name=site_name,json=siteName,proto3,oneof" json:"site_name,omitempty"`
+	SiteUrl         *string                `protobuf:"bytes,2,opt,name=site_url,json=siteUrl,proto3,oneof" json:"site_url,omitempty"`
+	LogoUrl         *string                `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	BlogDescription *string                `protobuf:"bytes,4,opt,name=blog_description,json=blogDescription,proto3,oneof" json:"blog_description,omitempty"`
+	// Replaces all social links when set; send an empty list to clear them
+	SocialLinks *SocialLinks `protobuf:"bytes,5,opt,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty"`
+	// Media ID of an image, or empty to clear
+	DefaultMetaImage *string `protobuf:"bytes,6,opt,name=default_meta_image,json=defaultMetaImage,proto3,oneof" json:"default_meta_image,omitempty"`
+	// Replaces the recipients when set; an empty list falls back to the server's admin address
+	ContactRecipients *ContactRecipients `protobuf:"bytes,7,opt,name=contact_recipients,json=contactRecipients,proto3" json:"contact_recipients,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_settings_v1_settings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateSettingsRequest) GetSiteName() string {
+	if x != nil && x.SiteName != nil {
+		return *x.SiteName
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetSiteUrl() string {
+	if x != nil && x.SiteUrl != nil {
+		return *x.SiteUrl
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetBlogDescription() string {
+	if x != nil && x.BlogDescription != nil {
+		return *x.BlogDescription
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetSocialLinks() *SocialLinks {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+func (x *UpdateSettingsRequest) GetDefaultMetaImage() string {
+	if x != nil && x.DefaultMetaImage != nil {
+		return *x.DefaultMetaImage
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetContactRecipients() *ContactRecipients {
+	if x != nil {
+		return x.ContactRecipients
+	}
+	return nil
+}
+
+type SocialLinks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*SocialLink          `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialLinks) Reset() {
+	*x = SocialLinks{}
+	mi := &file_settings_v1_settings_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialLinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialLinks) ProtoMessage() {}
+
+func (x *SocialLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialLinks.ProtoReflect.Descriptor instead.
+func (*SocialLinks) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SocialLinks) GetLinks() []*SocialLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type ContactRecipients struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emails        []string               `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactRecipients) Reset() {
+	*x = ContactRecipients{}
+	mi := &file_settings_v1_settings_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactRecipients) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactRecipients) ProtoMessage() {}
+
+func (x *ContactRecipients) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactRecipients.ProtoReflect.Descriptor instead.
+func (*ContactRecipients) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContactRecipients) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+type ListSettingChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Limit the trail to one setting key, e.g. "site_name"
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingChangesRequest) Reset() {
+	*x = ListSettingChangesRequest{}
+	mi := &file_settings_v1_settings_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingChangesRequest) ProtoMessage() {}
+
+func (x *ListSettingChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListSettingChangesRequest) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSettingChangesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListSettingChangesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSettingChangesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSettingChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*SettingChange       `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingChangesResponse) Reset() {
+	*x = ListSettingChangesResponse{}
+	mi := &file_settings_v1_settings_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingChangesResponse) ProtoMessage() {}
+
+func (x *ListSettingChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListSettingChangesResponse) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSettingChangesResponse) GetChanges() []*SettingChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListSettingChangesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSettingChangesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// SettingChange records one key changed by an update
+type SettingChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key   string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Value before the change; null when the key used its default
+	OldValue      *structpb.Value        `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      *structpb.Value        `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettingChange) Reset() {
+	*x = SettingChange{}
+	mi := &file_settings_v1_settings_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingChange) ProtoMessage() {}
+
+func (x *SettingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_v1_settings_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingChange.ProtoReflect.Descriptor instead.
+func (*SettingChange) Descriptor() ([]byte, []int) {
+	return file_settings_v1_settings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SettingChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SettingChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SettingChange) GetOldValue() *structpb.Value {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *SettingChange) GetNewValue() *structpb.Value {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+func (x *SettingChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *SettingChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_settings_v1_settings_proto protoreflect.FileDescriptor
+
+const file_settings_v1_settings_proto_rawDesc = "" +
+	"\n" +
+	"\x1asettings/v1/settings.proto\x12\vsettings.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x03\n" +
+	"\bSettings\x12\x1b\n" +
+	"\tsite_name\x18\x01 \x01(\tR\bsiteName\x12\x19\n" +
+	"\bsite_url\x18\x02 \x01(\tR\asiteUrl\x12\x19\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x12)\n" +
+	"\x10blog_description\x18\x04 \x01(\tR\x0fblogDescription\x12:\n" +
+	"\fsocial_links\x18\x05 \x03(\v2\x17.settings.v1.SocialLinkR\vsocialLinks\x12,\n" +
+	"\x12default_meta_image\x18\x06 \x01(\tR\x10defaultMetaImage\x123\n" +
+	"\x16default_meta_image_url\x18\a \x01(\tR\x13defaultMetaImageUrl\x12-\n" +
+	"\x12contact_recipients\x18\b \x03(\tR\x11contactRecipients\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"8\n" +
+	"\n" +
+	"SocialLink\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x14\n" +
+	"\x12GetSettingsRequest\"\xbc\x03\n" +
+	"\x15UpdateSettingsRequest\x12 \n" +
+	"\tsite_name\x18\x01 \x01(\tH\x00R\bsiteName\x88\x01\x01\x12\x1e\n" +
+	"\bsite_url\x18\x02 \x01(\tH\x01R\asiteUrl\x88\x01\x01\x12\x1e\n" +
+	"\blogo_url\x18\x03 \x01(\tH\x02R\alogoUrl\x88\x01\x01\x12.\n" +
+	"\x10blog_description\x18\x04 \x01(\tH\x03R\x0fblogDescription\x88\x01\x01\x12;\n" +
+	"\fsocial_links\x18\x05 \x01(\v2\x18.settings.v1.SocialLinksR\vsocialLinks\x121\n" +
+	"\x12default_meta_image\x18\x06 \x01(\tH\x04R\x10defaultMetaImage\x88\x01\x01\x12M\n" +
+	"\x12contact_recipients\x18\a \x01(\v2\x1e.settings.v1.ContactRecipientsR\x11contactRecipientsB\f\n" +
+	"\n" +
+	"_site_nameB\v\n" +
+	"\t_site_urlB\v\n" +
+	"\t_logo_urlB\x13\n" +
+	"\x11_blog_descriptionB\x15\n" +
+	"\x13_default_meta_image\"<\n" +
+	"\vSocialLinks\x12-\n" +
+	"\x05links\x18\x01 \x03(\v2\x17.settings.v1.SocialLinkR\x05links\"+\n" +
+	"\x11ContactRecipients\x12\x16\n" +
+	"\x06emails\x18\x01 \x03(\tR\x06emails\"i\n" +
+	"\x19ListSettingChangesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9b\x01\n" +
+	"\x1aListSettingChangesResponse\x124\n" +
+	"\achanges\x18\x01 \x03(\v2\x1a.settings.v1.SettingChangeR\achanges\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xf5\x01\n" +
+	"\rSettingChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x123\n" +
+	"\told_value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\boldValue\x123\n" +
+	"\tnew_value\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\bnewValue\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tR\tchangedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt2\xe6\x02\n" +
+	"\x0fSettingsService\x12_\n" +
+	"\vGetSettings\x12\x1f.settings.v1.GetSettingsRequest\x1a\x15.settings.v1.Settings\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/settings\x12h\n" +
+	"\x0eUpdateSettings\x12\".settings.v1.UpdateSettingsRequest\x1a\x15.settings.v1.Settings\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/api/v1/settings\x12\x87\x01\n" +
+	"\x12ListSettingChanges\x12&.settings.v1.ListSettingChangesRequest\x1a'.settings.v1.ListSettingChangesResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/settings/changesBHZFgithub.com/7-solutions/saas-platformbackend/gen/settings/v1;settingsv1b\x06proto3"
+
+var (
+	file_settings_v1_settings_proto_rawDescOnce sync.Once
+	file_settings_v1_settings_proto_rawDescData []byte
+)
+
+func file_settings_v1_settings_proto_rawDescGZIP() []byte {
+	file_settings_v1_settings_proto_rawDescOnce.Do(func() {
+		file_settings_v1_settings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_settings_v1_settings_proto_rawDesc), len(file_settings_v1_settings_proto_rawDesc)))
+	})
+	return file_settings_v1_settings_proto_rawDescData
+}
+
+var file_settings_v1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_settings_v1_settings_proto_goTypes = []any{
+	(*Settings)(nil),                   // 0: settings.v1.Settings
+	(*SocialLink)(nil),                 // 1: settings.v1.SocialLink
+	(*GetSettingsRequest)(nil),         // 2: settings.v1.GetSettingsRequest
+	(*UpdateSettingsRequest)(nil),      // 3: settings.v1.UpdateSettingsRequest
+	(*SocialLinks)(nil),                // 4: settings.v1.SocialLinks
+	(*ContactRecipients)(nil),          // 5: settings.v1.ContactRecipients
+	(*ListSettingChangesRequest)(nil),  // 6: settings.v1.ListSettingChangesRequest
+	(*ListSettingChangesResponse)(nil), // 7: settings.v1.ListSettingChangesResponse
+	(*SettingChange)(nil),              // 8: settings.v1.SettingChange
+	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
+	(*structpb.Value)(nil),             // 10: google.protobuf.Value
+}
+var file_settings_v1_settings_proto_depIdxs = []int32{
+	1,  // 0: settings.v1.Settings.social_links:type_name -> settings.v1.SocialLink
+	9,  // 1: settings.v1.Settings.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: settings.v1.UpdateSettingsRequest.social_links:type_name -> settings.v1.SocialLinks
+	5,  // 3: settings.v1.UpdateSettingsRequest.contact_recipients:type_name -> settings.v1.ContactRecipients
+	1,  // 4: settings.v1.SocialLinks.links:type_name -> settings.v1.SocialLink
+	8,  // 5: settings.v1.ListSettingChangesResponse.changes:type_name -> settings.v1.SettingChange
+	10, // 6: settings.v1.SettingChange.old_value:type_name -> google.protobuf.Value
+	10, // 7: settings.v1.SettingChange.new_value:type_name -> google.protobuf.Value
+	9,  // 8: settings.v1.SettingChange.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 9: settings.v1.SettingsService.GetSettings:input_type -> settings.v1.GetSettingsRequest
+	3,  // 10: settings.v1.SettingsService.UpdateSettings:input_type -> settings.v1.UpdateSettingsRequest
+	6,  // 11: settings.v1.SettingsService.ListSettingChanges:input_type -> settings.v1.ListSettingChangesRequest
+	0,  // 12: settings.v1.SettingsService.GetSettings:output_type -> settings.v1.Settings
+	0,  // 13: settings.v1.SettingsService.UpdateSettings:output_type -> settings.v1.Settings
+	7,  // 14: settings.v1.SettingsService.ListSettingChanges:output_type -> settings.v1.ListSettingChangesResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_settings_v1_settings_proto_init() }
+func file_settings_v1_settings_proto_init() {
+	if File_settings_v1_settings_proto != nil {
+		return
+	}
+	file_settings_v1_settings_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_settings_v1_settings_proto_rawDesc), len(file_settings_v1_settings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_settings_v1_settings_proto_goTypes,
+		DependencyIndexes: file_settings_v1_settings_proto_depIdxs,
+		MessageInfos:      file_settings_v1_settings_proto_msgTypes,
+	}.Build()
+	File_settings_v1_settings_proto = out.File
+	file_settings_v1_settings_proto_goTypes = nil
+	file_settings_v1_settings_proto_depIdxs = nil
+}
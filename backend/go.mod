@@ -6,6 +6,7 @@ toolchain go1.24.5
 
 require (
 	github.com/golang-jwt/jwt/v5 v5.2.3
+	github.com/graphql-go/graphql v0.8.1
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.1
 	github.com/jackc/pgconn v1.14.3
 	github.com/jackc/pgx/v5 v5.7.5
@@ -15,7 +15,9 @@ func NewPostgresClient(pool *pgxpool.Pool) *PostgresClient {
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -324,4 +326,26 @@ func (c *Client) Upsert(ctx context.Context, id string, doc interface{}) (*Docum
 // NewTestClient creates a new test client with a unique test database
 func NewTestClient() (*Client, error) {
 	return &Client{httpClient: &http.Client{Timeout: 30 * time.Second}}, nil
-}
\ No newline at end of file
+}
+
+// AllDocs fetches the documents with the given IDs in one request. Rows of missing
+// or deleted documents have an empty Doc.
+func (c *Client) AllDocs(ctx context.Context, ids []string) (*QueryResult, error) {
+	resp, err := c.makeRequest(ctx, "POST", "_all_docs?include_docs=true", map[string]interface{}{"keys": ids})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to fetch documents: %s", string(body))
+	}
+
+	var result QueryResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -47,6 +47,44 @@ func (q *Queries) AddPostTag(ctx context.Context, arg AddPostTagParams) error {
 	return err
 }
 
+const countPostsForListing = `-- name: CountPostsForListing :one
+SELECT COUNT(*)::int
+FROM blog_posts p
+WHERE ($1::text IS NULL OR p.status::text = $1::text)
+  AND ($2::uuid IS NULL OR p.author_id = $2::uuid)
+  AND ($3::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+    WHERE pc.post_id = p.id AND c.slug = $3::text))
+  AND ($4::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+    WHERE pt.post_id = p.id AND t.slug = $4::text))
+  AND ($5::timestamptz IS NULL OR p.published_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR p.published_at < $6::timestamptz)
+`
+
+type CountPostsForListingParams struct {
+	Status          *string            `json:"status"`
+	AuthorID        pgtype.UUID        `json:"author_id"`
+	Category        *string            `json:"category"`
+	Tag             *string            `json:"tag"`
+	PublishedAfter  pgtype.Timestamptz `json:"published_after"`
+	PublishedBefore pgtype.Timestamptz `json:"published_before"`
+}
+
+func (q *Queries) CountPostsForListing(ctx context.Context, arg CountPostsForListingParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countPostsForListing,
+		arg.Status,
+		arg.AuthorID,
+		arg.Category,
+		arg.Tag,
+		arg.PublishedAfter,
+		arg.PublishedBefore,
+	)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const deletePostByID = `-- name: DeletePostByID :exec
 DELETE FROM blog_posts
 WHERE id = $1
@@ -118,8 +156,44 @@ func (q *Queries) GetCategoryCounts(ctx context.Context) ([]GetCategoryCountsRow
 	return items, nil
 }
 
+const getPostArchive = `-- name: GetPostArchive :many
+SELECT EXTRACT(YEAR FROM published_at AT TIME ZONE 'UTC')::int AS year,
+       EXTRACT(MONTH FROM published_at AT TIME ZONE 'UTC')::int AS month,
+       COUNT(*)::int AS post_count
+FROM blog_posts
+WHERE status = 'published' AND published_at <= now()
+GROUP BY year, month
+ORDER BY year DESC, month DESC
+`
+
+type GetPostArchiveRow struct {
+	Year      int32 `json:"year"`
+	Month     int32 `json:"month"`
+	PostCount int32 `json:"post_count"`
+}
+
+func (q *Queries) GetPostArchive(ctx context.Context) ([]GetPostArchiveRow, error) {
+	rows, err := q.db.Query(ctx, getPostArchive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPostArchiveRow
+	for rows.Next() {
+		var i GetPostArchiveRow
+		if err := rows.Scan(&i.Year, &i.Month, &i.PostCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPostBySlug = `-- name: GetPostBySlug :one
-SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 FROM blog_posts
 WHERE slug = $1
 LIMIT 1
@@ -140,6 +214,9 @@ func (q *Queries) GetPostBySlug(ctx context.Context, slug string) (BlogPost, err
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.SearchTsv,
+		&i.Pinned,
+		&i.PinnedUntil,
+		&i.SortWeight,
 	)
 	return i, err
 }
@@ -238,7 +315,7 @@ INSERT INTO blog_posts (
 ) VALUES (
   $1, $2, $3, $4, $5, $6, $7
 )
-RETURNING id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+RETURNING id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 `
 
 type InsertPostParams struct {
@@ -274,6 +351,9 @@ func (q *Queries) InsertPost(ctx context.Context, arg InsertPostParams) (BlogPos
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.SearchTsv,
+		&i.Pinned,
+		&i.PinnedUntil,
+		&i.SortWeight,
 	)
 	return i, err
 }
@@ -305,7 +385,7 @@ func (q *Queries) InsertTag(ctx context.Context, arg InsertTagParams) (Tag, erro
 }
 
 const listPostsAll = `-- name: ListPostsAll :many
-SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 FROM blog_posts
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
@@ -337,6 +417,9 @@ func (q *Queries) ListPostsAll(ctx context.Context, arg ListPostsAllParams) ([]B
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -349,7 +432,7 @@ func (q *Queries) ListPostsAll(ctx context.Context, arg ListPostsAllParams) ([]B
 }
 
 const listPostsByAuthor = `-- name: ListPostsByAuthor :many
-SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 FROM blog_posts
 WHERE author_id = $1
 ORDER BY COALESCE(published_at, created_at) DESC, created_at DESC
@@ -383,6 +466,9 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -395,7 +481,7 @@ func (q *Queries) ListPostsByAuthor(ctx context.Context, arg ListPostsByAuthorPa
 }
 
 const listPostsByCategorySlug = `-- name: ListPostsByCategorySlug :many
-SELECT p.id, p.slug, p.title, p.excerpt, p.content, p.status, p.author_id, p.published_at, p.created_at, p.updated_at, p.search_tsv
+SELECT p.id, p.slug, p.title, p.excerpt, p.content, p.status, p.author_id, p.published_at, p.created_at, p.updated_at, p.search_tsv, p.pinned, p.pinned_until, p.sort_weight
 FROM blog_posts p
 JOIN blog_post_categories pc ON pc.post_id = p.id
 JOIN categories c ON c.id = pc.category_id
@@ -431,6 +517,50 @@ func (q *Queries) ListPostsByCategorySlug(ctx context.Context, arg ListPostsByCa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsBySlugs = `-- name: ListPostsBySlugs :many
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
+FROM blog_posts
+WHERE slug = ANY($1::text[])
+`
+
+func (q *Queries) ListPostsBySlugs(ctx context.Context, slugs []string) ([]BlogPost, error) {
+	rows, err := q.db.Query(ctx, listPostsBySlugs, slugs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BlogPost
+	for rows.Next() {
+		var i BlogPost
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Title,
+			&i.Excerpt,
+			&i.Content,
+			&i.Status,
+			&i.AuthorID,
+			&i.PublishedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -443,7 +573,7 @@ func (q *Queries) ListPostsByCategorySlug(ctx context.Context, arg ListPostsByCa
 }
 
 const listPostsByStatus = `-- name: ListPostsByStatus :many
-SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 FROM blog_posts
 WHERE status = $1
 ORDER BY COALESCE(published_at, created_at) DESC, created_at DESC
@@ -477,6 +607,9 @@ func (q *Queries) ListPostsByStatus(ctx context.Context, arg ListPostsByStatusPa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -489,7 +622,7 @@ func (q *Queries) ListPostsByStatus(ctx context.Context, arg ListPostsByStatusPa
 }
 
 const listPostsByTagSlug = `-- name: ListPostsByTagSlug :many
-SELECT p.id, p.slug, p.title, p.excerpt, p.content, p.status, p.author_id, p.published_at, p.created_at, p.updated_at, p.search_tsv
+SELECT p.id, p.slug, p.title, p.excerpt, p.content, p.status, p.author_id, p.published_at, p.created_at, p.updated_at, p.search_tsv, p.pinned, p.pinned_until, p.sort_weight
 FROM blog_posts p
 JOIN blog_post_tags pt ON pt.post_id = p.id
 JOIN tags t ON t.id = pt.tag_id
@@ -525,6 +658,92 @@ func (q *Queries) ListPostsByTagSlug(ctx context.Context, arg ListPostsByTagSlug
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsForListing = `-- name: ListPostsForListing :many
+SELECT p.id, p.slug, p.title, p.excerpt, p.content, p.status, p.author_id, p.published_at, p.created_at, p.updated_at, p.search_tsv, p.pinned, p.pinned_until, p.sort_weight
+FROM blog_posts p
+WHERE ($1::text IS NULL OR p.status::text = $1::text)
+  AND ($2::uuid IS NULL OR p.author_id = $2::uuid)
+  AND ($3::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+    WHERE pc.post_id = p.id AND c.slug = $3::text))
+  AND ($4::text IS NULL OR EXISTS (
+    SELECT 1 FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+    WHERE pt.post_id = p.id AND t.slug = $4::text))
+  AND ($5::timestamptz IS NULL OR p.published_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR p.published_at < $6::timestamptz)
+ORDER BY
+  (p.pinned AND (p.pinned_until IS NULL OR p.pinned_until > now())) DESC,
+  CASE WHEN $7::text = 'updated_at' THEN p.updated_at END DESC,
+  CASE WHEN $7::text = 'title' THEN lower(p.title) END ASC,
+  CASE WHEN $7::text = 'manual' THEN p.sort_weight END ASC,
+  COALESCE(p.published_at, p.created_at) DESC,
+  p.created_at DESC
+LIMIT $9 OFFSET $8
+`
+
+type ListPostsForListingParams struct {
+	Status          *string            `json:"status"`
+	AuthorID        pgtype.UUID        `json:"author_id"`
+	Category        *string            `json:"category"`
+	Tag             *string            `json:"tag"`
+	PublishedAfter  pgtype.Timestamptz `json:"published_after"`
+	PublishedBefore pgtype.Timestamptz `json:"published_before"`
+	SortBy          string             `json:"sort_by"`
+	SkipRows        int32              `json:"skip_rows"`
+	MaxRows         int32              `json:"max_rows"`
+}
+
+// Posts pinned at now() first, then by @sort_by: published_at (newest first, drafts by
+// creation date), updated_at (newest first), title or manual (ascending sort_weight, then
+// newest first). A NULL filter matches every post.
+func (q *Queries) ListPostsForListing(ctx context.Context, arg ListPostsForListingParams) ([]BlogPost, error) {
+	rows, err := q.db.Query(ctx, listPostsForListing,
+		arg.Status,
+		arg.AuthorID,
+		arg.Category,
+		arg.Tag,
+		arg.PublishedAfter,
+		arg.PublishedBefore,
+		arg.SortBy,
+		arg.SkipRows,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BlogPost
+	for rows.Next() {
+		var i BlogPost
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Title,
+			&i.Excerpt,
+			&i.Content,
+			&i.Status,
+			&i.AuthorID,
+			&i.PublishedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -537,7 +756,7 @@ func (q *Queries) ListPostsByTagSlug(ctx context.Context, arg ListPostsByTagSlug
 }
 
 const listPublishedPosts = `-- name: ListPublishedPosts :many
-SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 FROM blog_posts
 WHERE status = 'published'
 ORDER BY COALESCE(published_at, created_at) DESC, created_at DESC
@@ -570,6 +789,161 @@ func (q *Queries) ListPublishedPosts(ctx context.Context, arg ListPublishedPosts
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPublishedPostsBetween = `-- name: ListPublishedPostsBetween :many
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
+FROM blog_posts
+WHERE status = 'published'
+  AND published_at >= $1::timestamptz
+  AND published_at < $2::timestamptz
+ORDER BY published_at DESC, created_at DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListPublishedPostsBetweenParams struct {
+	PublishedAfter  pgtype.Timestamptz `json:"published_after"`
+	PublishedBefore pgtype.Timestamptz `json:"published_before"`
+	SkipRows        int32              `json:"skip_rows"`
+	MaxRows         int32              `json:"max_rows"`
+}
+
+func (q *Queries) ListPublishedPostsBetween(ctx context.Context, arg ListPublishedPostsBetweenParams) ([]BlogPost, error) {
+	rows, err := q.db.Query(ctx, listPublishedPostsBetween,
+		arg.PublishedAfter,
+		arg.PublishedBefore,
+		arg.SkipRows,
+		arg.MaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BlogPost
+	for rows.Next() {
+		var i BlogPost
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Title,
+			&i.Excerpt,
+			&i.Content,
+			&i.Status,
+			&i.AuthorID,
+			&i.PublishedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRelatedPostCandidates = `-- name: ListRelatedPostCandidates :many
+SELECT p.id, p.slug, p.title, p.excerpt, p.content, p.status, p.author_id, p.published_at, p.created_at, p.updated_at, p.search_tsv, p.pinned, p.pinned_until, p.sort_weight,
+  (SELECT COUNT(*) FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+    WHERE pc.post_id = p.id AND c.name = ANY($1::text[]))::int AS shared_categories,
+  (SELECT COUNT(*) FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+    WHERE pt.post_id = p.id AND t.name = ANY($2::text[]))::int AS shared_tags,
+  similarity(p.title, $3::text)::float8 AS title_similarity
+FROM blog_posts p
+WHERE p.status = 'published'
+  AND p.slug <> $4::text
+  AND (
+    p.title % $3::text
+    OR EXISTS (SELECT 1 FROM blog_post_categories pc JOIN categories c ON c.id = pc.category_id
+      WHERE pc.post_id = p.id AND c.name = ANY($1::text[]))
+    OR EXISTS (SELECT 1 FROM blog_post_tags pt JOIN tags t ON t.id = pt.tag_id
+      WHERE pt.post_id = p.id AND t.name = ANY($2::text[]))
+  )
+ORDER BY COALESCE(p.published_at, p.created_at) DESC
+LIMIT $5
+`
+
+type ListRelatedPostCandidatesParams struct {
+	Categories    []string `json:"categories"`
+	Tags          []string `json:"tags"`
+	Title         string   `json:"title"`
+	Slug          string   `json:"slug"`
+	MaxCandidates int32    `json:"max_candidates"`
+}
+
+type ListRelatedPostCandidatesRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	Slug             string             `json:"slug"`
+	Title            string             `json:"title"`
+	Excerpt          *string            `json:"excerpt"`
+	Content          string             `json:"content"`
+	Status           string             `json:"status"`
+	AuthorID         pgtype.UUID        `json:"author_id"`
+	PublishedAt      pgtype.Timestamptz `json:"published_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	SearchTsv        interface{}        `json:"search_tsv"`
+	Pinned           bool               `json:"pinned"`
+	PinnedUntil      pgtype.Timestamptz `json:"pinned_until"`
+	SortWeight       int32              `json:"sort_weight"`
+	SharedCategories int32              `json:"shared_categories"`
+	SharedTags       int32              `json:"shared_tags"`
+	TitleSimilarity  float64            `json:"title_similarity"`
+}
+
+// Published posts sharing a category or tag with the post, or with a similar title
+// (pg_trgm %, served by blog_posts_title_trgm_idx), newest first
+func (q *Queries) ListRelatedPostCandidates(ctx context.Context, arg ListRelatedPostCandidatesParams) ([]ListRelatedPostCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listRelatedPostCandidates,
+		arg.Categories,
+		arg.Tags,
+		arg.Title,
+		arg.Slug,
+		arg.MaxCandidates,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRelatedPostCandidatesRow
+	for rows.Next() {
+		var i ListRelatedPostCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Title,
+			&i.Excerpt,
+			&i.Content,
+			&i.Status,
+			&i.AuthorID,
+			&i.PublishedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
+			&i.SharedCategories,
+			&i.SharedTags,
+			&i.TitleSimilarity,
 		); err != nil {
 			return nil, err
 		}
@@ -612,7 +986,7 @@ func (q *Queries) RemovePostTag(ctx context.Context, arg RemovePostTagParams) er
 }
 
 const searchPosts = `-- name: SearchPosts :many
-SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+SELECT id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 FROM blog_posts
 WHERE search_tsv @@ to_tsquery('simple', $1)
 ORDER BY ts_rank_cd(search_tsv, to_tsquery('simple', $1)) DESC,
@@ -648,6 +1022,9 @@ func (q *Queries) SearchPosts(ctx context.Context, arg SearchPostsParams) ([]Blo
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SearchTsv,
+			&i.Pinned,
+			&i.PinnedUntil,
+			&i.SortWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -659,6 +1036,29 @@ func (q *Queries) SearchPosts(ctx context.Context, arg SearchPostsParams) ([]Blo
 	return items, nil
 }
 
+const setPostOrdering = `-- name: SetPostOrdering :exec
+UPDATE blog_posts
+SET pinned = $1, pinned_until = $2, sort_weight = $3
+WHERE id = $4
+`
+
+type SetPostOrderingParams struct {
+	Pinned      bool               `json:"pinned"`
+	PinnedUntil pgtype.Timestamptz `json:"pinned_until"`
+	SortWeight  int32              `json:"sort_weight"`
+	ID          pgtype.UUID        `json:"id"`
+}
+
+func (q *Queries) SetPostOrdering(ctx context.Context, arg SetPostOrderingParams) error {
+	_, err := q.db.Exec(ctx, setPostOrdering,
+		arg.Pinned,
+		arg.PinnedUntil,
+		arg.SortWeight,
+		arg.ID,
+	)
+	return err
+}
+
 const updatePost = `-- name: UpdatePost :one
 UPDATE blog_posts
 SET
@@ -670,7 +1070,7 @@ SET
   author_id = COALESCE($7, author_id),
   published_at = COALESCE($8, published_at)
 WHERE id = $1
-RETURNING id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv
+RETURNING id, slug, title, excerpt, content, status, author_id, published_at, created_at, updated_at, search_tsv, pinned, pinned_until, sort_weight
 `
 
 type UpdatePostParams struct {
@@ -708,6 +1108,9 @@ func (q *Queries) UpdatePost(ctx context.Context, arg UpdatePostParams) (BlogPos
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.SearchTsv,
+		&i.Pinned,
+		&i.PinnedUntil,
+		&i.SortWeight,
 	)
 	return i, err
 }
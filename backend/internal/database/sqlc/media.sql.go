@@ -124,6 +124,41 @@ func (q *Queries) ListMediaAll(ctx context.Context, arg ListMediaAllParams) ([]M
 	return items, nil
 }
 
+const listMediaByFilenames = `-- name: ListMediaByFilenames :many
+SELECT id, filename, path, mime_type, size_bytes, uploader_id, created_at, updated_at
+FROM media
+WHERE filename = ANY($1::text[])
+`
+
+func (q *Queries) ListMediaByFilenames(ctx context.Context, filenames []string) ([]Medium, error) {
+	rows, err := q.db.Query(ctx, listMediaByFilenames, filenames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Medium
+	for rows.Next() {
+		var i Medium
+		if err := rows.Scan(
+			&i.ID,
+			&i.Filename,
+			&i.Path,
+			&i.MimeType,
+			&i.SizeBytes,
+			&i.UploaderID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMediaByUploader = `-- name: ListMediaByUploader :many
 SELECT id, filename, path, mime_type, size_bytes, uploader_id, created_at, updated_at
 FROM media
@@ -8,6 +8,37 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ActivitypubDelivery struct {
+	ID            pgtype.UUID        `json:"id"`
+	Actor         string             `json:"actor"`
+	InboxUrl      string             `json:"inbox_url"`
+	ActivityID    string             `json:"activity_id"`
+	Payload       string             `json:"payload"`
+	Status        string             `json:"status"`
+	Attempts      int32              `json:"attempts"`
+	LastError     string             `json:"last_error"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	DeliveredAt   pgtype.Timestamptz `json:"delivered_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type ActivitypubFollower struct {
+	ID             pgtype.UUID        `json:"id"`
+	Actor          string             `json:"actor"`
+	FollowerUri    string             `json:"follower_uri"`
+	InboxUrl       string             `json:"inbox_url"`
+	SharedInboxUrl string             `json:"shared_inbox_url"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ActivitypubKey struct {
+	Actor         string             `json:"actor"`
+	PublicKeyPem  string             `json:"public_key_pem"`
+	PrivateKeyPem string             `json:"private_key_pem"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
 type BlogPost struct {
 	ID          pgtype.UUID        `json:"id"`
 	Slug        string             `json:"slug"`
@@ -20,6 +51,9 @@ type BlogPost struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
 	SearchTsv   interface{}        `json:"search_tsv"`
+	Pinned      bool               `json:"pinned"`
+	PinnedUntil pgtype.Timestamptz `json:"pinned_until"`
+	SortWeight  int32              `json:"sort_weight"`
 }
 
 type BlogPostCategory struct {
@@ -41,6 +75,40 @@ type Category struct {
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
 }
 
+type Collection struct {
+	ID          pgtype.UUID        `json:"id"`
+	Slug        string             `json:"slug"`
+	Title       string             `json:"title"`
+	Description *string            `json:"description"`
+	Kind        string             `json:"kind"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CollectionPost struct {
+	CollectionID pgtype.UUID `json:"collection_id"`
+	PostID       string      `json:"post_id"`
+	Position     int32       `json:"position"`
+}
+
+type Comment struct {
+	ID           pgtype.UUID        `json:"id"`
+	PostID       string             `json:"post_id"`
+	ParentID     pgtype.UUID        `json:"parent_id"`
+	AuthorUserID *string            `json:"author_user_id"`
+	AuthorName   string             `json:"author_name"`
+	AuthorEmail  string             `json:"author_email"`
+	AuthorUrl    *string            `json:"author_url"`
+	Body         string             `json:"body"`
+	Status       string             `json:"status"`
+	IpAddress    *string            `json:"ip_address"`
+	UserAgent    *string            `json:"user_agent"`
+	ModeratedBy  *string            `json:"moderated_by"`
+	ModeratedAt  pgtype.Timestamptz `json:"moderated_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
 type ContactSubmission struct {
 	ID        pgtype.UUID        `json:"id"`
 	Email     string             `json:"email"`
@@ -53,6 +121,81 @@ type ContactSubmission struct {
 	SearchTsv interface{}        `json:"search_tsv"`
 }
 
+type ContentType struct {
+	ID          pgtype.UUID        `json:"id"`
+	Slug        string             `json:"slug"`
+	Name        string             `json:"name"`
+	Description *string            `json:"description"`
+	Fields      []byte             `json:"fields"`
+	CreatedBy   *string            `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ContentViewDaily struct {
+	ContentID      string      `json:"content_id"`
+	Day            pgtype.Date `json:"day"`
+	ContentType    string      `json:"content_type"`
+	Path           string      `json:"path"`
+	Views          int64       `json:"views"`
+	UniqueVisitors int64       `json:"unique_visitors"`
+}
+
+type ContentViewReferrer struct {
+	ContentID string      `json:"content_id"`
+	Day       pgtype.Date `json:"day"`
+	Referrer  string      `json:"referrer"`
+	Views     int64       `json:"views"`
+}
+
+type ContentViewSalt struct {
+	Day  pgtype.Date `json:"day"`
+	Salt []byte      `json:"salt"`
+}
+
+type ContentViewVisitor struct {
+	ContentID   string      `json:"content_id"`
+	Day         pgtype.Date `json:"day"`
+	VisitorHash string      `json:"visitor_hash"`
+}
+
+type Entry struct {
+	ID            pgtype.UUID        `json:"id"`
+	ContentTypeID pgtype.UUID        `json:"content_type_id"`
+	Slug          string             `json:"slug"`
+	Status        string             `json:"status"`
+	Data          []byte             `json:"data"`
+	PublishedAt   pgtype.Timestamptz `json:"published_at"`
+	CreatedBy     *string            `json:"created_by"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type LinkReport struct {
+	ID              pgtype.UUID        `json:"id"`
+	Trigger         string             `json:"trigger"`
+	PagesScanned    int32              `json:"pages_scanned"`
+	PostsScanned    int32              `json:"posts_scanned"`
+	LinksChecked    int32              `json:"links_checked"`
+	ExternalChecked bool               `json:"external_checked"`
+	StartedAt       pgtype.Timestamptz `json:"started_at"`
+	FinishedAt      pgtype.Timestamptz `json:"finished_at"`
+}
+
+type LinkReportIssue struct {
+	ID          int64       `json:"id"`
+	ReportID    pgtype.UUID `json:"report_id"`
+	ContentType string      `json:"content_type"`
+	ContentID   string      `json:"content_id"`
+	ContentSlug string      `json:"content_slug"`
+	BlockIndex  int32       `json:"block_index"`
+	Field       string      `json:"field"`
+	Url         string      `json:"url"`
+	Kind        string      `json:"kind"`
+	StatusCode  int32       `json:"status_code"`
+	Detail      string      `json:"detail"`
+}
+
 type Medium struct {
 	ID         pgtype.UUID        `json:"id"`
 	Filename   string             `json:"filename"`
@@ -64,6 +207,42 @@ type Medium struct {
 	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
 
+type NavigationMenu struct {
+	ID          pgtype.UUID        `json:"id"`
+	Slug        string             `json:"slug"`
+	Name        string             `json:"name"`
+	Description *string            `json:"description"`
+	Items       []byte             `json:"items"`
+	CreatedBy   *string            `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type NewsletterDigest struct {
+	ID          pgtype.UUID        `json:"id"`
+	PeriodStart pgtype.Timestamptz `json:"period_start"`
+	PeriodEnd   pgtype.Timestamptz `json:"period_end"`
+	PostIds     []string           `json:"post_ids"`
+	Recipients  int32              `json:"recipients"`
+	Failures    int32              `json:"failures"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type NewsletterSubscriber struct {
+	ID                 pgtype.UUID        `json:"id"`
+	Email              string             `json:"email"`
+	Name               string             `json:"name"`
+	Status             string             `json:"status"`
+	Topics             []string           `json:"topics"`
+	SoftBounces        int32              `json:"soft_bounces"`
+	IpAddress          *string            `json:"ip_address"`
+	ConfirmationSentAt pgtype.Timestamptz `json:"confirmation_sent_at"`
+	ConfirmedAt        pgtype.Timestamptz `json:"confirmed_at"`
+	UnsubscribedAt     pgtype.Timestamptz `json:"unsubscribed_at"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Page struct {
 	ID          pgtype.UUID        `json:"id"`
 	Slug        string             `json:"slug"`
@@ -77,6 +256,60 @@ type Page struct {
 	SearchTsv   interface{}        `json:"search_tsv"`
 }
 
+type PageTemplate struct {
+	ID          pgtype.UUID        `json:"id"`
+	Slug        string             `json:"slug"`
+	Name        string             `json:"name"`
+	Description *string            `json:"description"`
+	Version     int32              `json:"version"`
+	Content     string             `json:"content"`
+	Meta        string             `json:"meta"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type PageTemplateVersion struct {
+	TemplateID pgtype.UUID        `json:"template_id"`
+	Version    int32              `json:"version"`
+	Content    string             `json:"content"`
+	Meta       string             `json:"meta"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type ReusableBlock struct {
+	ID          pgtype.UUID        `json:"id"`
+	Slug        string             `json:"slug"`
+	Name        string             `json:"name"`
+	Description *string            `json:"description"`
+	Content     string             `json:"content"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ReusableBlockUsage struct {
+	BlockID      pgtype.UUID `json:"block_id"`
+	ContentType  string      `json:"content_type"`
+	ContentID    string      `json:"content_id"`
+	ContentSlug  string      `json:"content_slug"`
+	ContentTitle string      `json:"content_title"`
+}
+
+type SiteSetting struct {
+	Key       string             `json:"key"`
+	Value     []byte             `json:"value"`
+	UpdatedBy *string            `json:"updated_by"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SiteSettingChange struct {
+	ID        pgtype.UUID        `json:"id"`
+	Key       string             `json:"key"`
+	OldValue  []byte             `json:"old_value"`
+	NewValue  []byte             `json:"new_value"`
+	ChangedBy *string            `json:"changed_by"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type Tag struct {
 	ID        pgtype.UUID        `json:"id"`
 	Slug      string             `json:"slug"`
@@ -94,3 +327,47 @@ type User struct {
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
+
+type WebhookDelivery struct {
+	ID             pgtype.UUID        `json:"id"`
+	SubscriptionID pgtype.UUID        `json:"subscription_id"`
+	EventID        pgtype.UUID        `json:"event_id"`
+	EventType      string             `json:"event_type"`
+	Payload        string             `json:"payload"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	ResponseStatus int32              `json:"response_status"`
+	LastError      string             `json:"last_error"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type WebhookSubscription struct {
+	ID         pgtype.UUID        `json:"id"`
+	Name       string             `json:"name"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []string           `json:"event_types"`
+	Active     bool               `json:"active"`
+	CreatedBy  *string            `json:"created_by"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Webmention struct {
+	ID          pgtype.UUID        `json:"id"`
+	PostID      string             `json:"post_id"`
+	Source      string             `json:"source"`
+	Target      string             `json:"target"`
+	Title       string             `json:"title"`
+	AuthorName  string             `json:"author_name"`
+	Status      string             `json:"status"`
+	LastError   string             `json:"last_error"`
+	IpAddress   *string            `json:"ip_address"`
+	VerifiedAt  pgtype.Timestamptz `json:"verified_at"`
+	ModeratedBy *string            `json:"moderated_by"`
+	ModeratedAt pgtype.Timestamptz `json:"moderated_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
@@ -181,6 +181,62 @@ func (c *Client) SetupViews(ctx context.Context) error {
 					}
 				}`,
 			},
+			"by_published_at": View{
+				// Keyed by [year, month, ISO timestamp] in UTC so one index serves date ranges
+				// and, reduced with group_level=2, the monthly archive
+				Map: `function(doc) {
+					if (doc.type === 'blog_post' && doc.status === 'published' && doc.published_at) {
+						var published = new Date(doc.published_at);
+						emit([published.getUTCFullYear(), published.getUTCMonth() + 1, published.toISOString()], null);
+					}
+				}`,
+				Reduce: "_count",
+			},
+			"listing": View{
+				// Keyed by [filter, value, sort, rank, sort keys...] so that every blog listing
+				// is one ascending key range: pinned posts (rank 0) before the others (rank 1),
+				// descending dates as negative milliseconds. Date ranges use the published_at
+				// sort of the published status, or of the published_* filters when combined
+				// with a category, tag or author.
+				Map: `function(doc) {
+					if (doc.type !== 'blog_post') {
+						return;
+					}
+					var published = Date.parse(doc.published_at || doc.created_at);
+					var sorts = {
+						published_at: [-published],
+						updated_at: [-Date.parse(doc.updated_at)],
+						title: [(doc.title || '').toLowerCase()],
+						manual: [doc.sort_weight || 0, -published]
+					};
+					var filters = [['all', ''], ['status', doc.status], ['author', doc.author || '']];
+					var i;
+					for (i = 0; doc.categories && i < doc.categories.length; i++) {
+						filters.push(['category', doc.categories[i]]);
+					}
+					for (i = 0; doc.tags && i < doc.tags.length; i++) {
+						filters.push(['tag', doc.tags[i]]);
+					}
+					var rank = doc.pinned ? 0 : 1;
+					for (i = 0; i < filters.length; i++) {
+						for (var sort in sorts) {
+							emit([filters[i][0], filters[i][1], sort, rank].concat(sorts[sort]), null);
+						}
+						if (doc.status === 'published' && filters[i][0] !== 'all' && filters[i][0] !== 'status') {
+							emit(['published_' + filters[i][0], filters[i][1], 'published_at', rank].concat(sorts.published_at), null);
+						}
+					}
+				}`,
+				Reduce: "_count",
+			},
+			"pin_expiry": View{
+				// Pinned posts with an expiry, keyed by the expiry in milliseconds
+				Map: `function(doc) {
+					if (doc.type === 'blog_post' && doc.pinned && doc.pinned_until) {
+						emit(Date.parse(doc.pinned_until), null);
+					}
+				}`,
+			},
 			"categories": View{
 				Map: `function(doc) {
 					if (doc.type === 'blog_post' && doc.categories && doc.status === 'published') {
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+)
+
+// ActivityPubKey is the RSA key pair an actor signs its deliveries with
+type ActivityPubKey struct {
+	// Actor is the preferred username of the local actor owning the key
+	Actor         string    `json:"actor"`
+	PublicKeyPEM  string    `json:"public_key_pem"`
+	PrivateKeyPEM string    `json:"-"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ActivityPubFollower is a remote actor following one of the local actors
+type ActivityPubFollower struct {
+	ID          string `json:"id"`
+	Actor       string `json:"actor"`
+	FollowerURI string `json:"follower_uri"`
+	InboxURL    string `json:"inbox_url"`
+	// SharedInboxURL is preferred for deliveries when the follower's server offers one
+	SharedInboxURL string    `json:"shared_inbox_url,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// DeliveryInbox returns the inbox activities for the follower are delivered to
+func (f *ActivityPubFollower) DeliveryInbox() string {
+	if f.SharedInboxURL != "" {
+		return f.SharedInboxURL
+	}
+	return f.InboxURL
+}
+
+// ActivityPubDelivery is one activity sent to one remote inbox, including its retry state.
+// Statuses are the WebhookDelivery* constants.
+type ActivityPubDelivery struct {
+	ID string `json:"id"`
+	// Actor is the local actor whose key signs the delivery
+	Actor      string `json:"actor"`
+	InboxURL   string `json:"inbox_url"`
+	ActivityID string `json:"activity_id"`
+	// Payload is the exact JSON activity that is sent
+	Payload   string `json:"payload"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	LastError string `json:"last_error,omitempty"`
+	// NextAttemptAt is set while the delivery is pending
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+)
+
+// ContentView is a single tracked page or post view. It is never stored as-is;
+// repositories fold it into the daily rollups.
+type ContentView struct {
+	ContentID   string    `json:"content_id"`
+	ContentType string    `json:"content_type"`
+	Path        string    `json:"path"`
+	Referrer    string    `json:"referrer"`
+	VisitorHash string    `json:"visitor_hash"`
+	Day         time.Time `json:"day"`
+}
+
+// DailyViews is the view rollup of one UTC day
+type DailyViews struct {
+	Day            time.Time `json:"day"`
+	Views          int64     `json:"views"`
+	UniqueVisitors int64     `json:"unique_visitors"`
+}
+
+// ReferrerViews counts views by referring host; an empty referrer means direct traffic
+type ReferrerViews struct {
+	Referrer string `json:"referrer"`
+	Views    int64  `json:"views"`
+}
+
+// PopularContent is a content item ranked by views over a time window
+type PopularContent struct {
+	ContentID      string `json:"content_id"`
+	ContentType    string `json:"content_type"`
+	Path           string `json:"path"`
+	Views          int64  `json:"views"`
+	UniqueVisitors int64  `json:"unique_visitors"`
+}
+
+// ViewContentType constants
+const (
+	ViewContentTypePage = "page"
+	ViewContentTypePost = "post"
+)
@@ -20,10 +20,33 @@ type BlogPost struct {
 	Tags         []string  `json:"tags"`
 	FeaturedImage string   `json:"featured_image,omitempty"`
 	PublishedAt  *time.Time `json:"published_at,omitempty"`
+	// CommentsDisabled turns off reader comments; comments are on by default
+	CommentsDisabled bool `json:"comments_disabled,omitempty"`
+	// Visibility restricts who may read the post; the zero value is public
+	Visibility Visibility `json:"visibility,omitempty"`
+	// Pinned posts are listed first until PinnedUntil, if set
+	Pinned      bool       `json:"pinned,omitempty"`
+	PinnedUntil *time.Time `json:"pinned_until,omitempty"`
+	// SortWeight positions the post in the manual sort order; lower weights come first
+	SortWeight int `json:"sort_weight,omitempty"`
+	// PublishOverride records an admin publishing despite quality gate errors
+	PublishOverride *PublishOverride `json:"publish_override,omitempty"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+// Sort orders of blog listings; pinned posts come first in each of them
+const (
+	// BlogSortPublishedAt lists the newest publication date first; drafts by creation date
+	BlogSortPublishedAt = "published_at"
+	// BlogSortUpdatedAt lists the most recently updated first
+	BlogSortUpdatedAt = "updated_at"
+	// BlogSortTitle lists alphabetically by title
+	BlogSortTitle = "title"
+	// BlogSortManual lists by ascending sort weight, then newest publication date first
+	BlogSortManual = "manual"
+)
+
 // BlogCategory represents a blog category
 type BlogCategory struct {
 	Name      string `json:"name"`
@@ -38,6 +61,13 @@ type BlogTag struct {
 	PostCount int    `json:"post_count"`
 }
 
+// BlogArchiveMonth counts the posts published in a month (UTC)
+type BlogArchiveMonth struct {
+	Year      int `json:"year"`
+	Month     int `json:"month"`
+	PostCount int `json:"post_count"`
+}
+
 // NewBlogPost creates a new blog post with default values
 func NewBlogPost(title, slug, author string) *BlogPost {
 	now := time.Now()
@@ -62,6 +92,11 @@ func (bp *BlogPost) IsPublished() bool {
 	return bp.Status == PageStatusPublished && bp.PublishedAt != nil && bp.PublishedAt.Before(time.Now())
 }
 
+// IsPinnedAt returns true if the blog post is pinned and its pin has not expired at t
+func (bp *BlogPost) IsPinnedAt(t time.Time) bool {
+	return bp.Pinned && (bp.PinnedUntil == nil || t.Before(*bp.PinnedUntil))
+}
+
 // GetPublishedDate returns the published date or created date if not published
 func (bp *BlogPost) GetPublishedDate() time.Time {
 	if bp.PublishedAt != nil {
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+// Collection is an ordered list of blog posts. Series drive previous/next
+// navigation between posts; curated collections back hand-picked lists such as "featured".
+type Collection struct {
+	ID          string    `json:"id"`
+	Slug        string    `json:"slug"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	Kind        string    `json:"kind"`
+	PostIDs     []string  `json:"post_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CollectionKind constants
+const (
+	CollectionKindSeries  = "series"
+	CollectionKindCurated = "curated"
+)
+
+// NewCollection creates a new empty collection
+func NewCollection(title, slug, kind string) *Collection {
+	now := time.Now()
+	return &Collection{
+		Title:     title,
+		Slug:      slug,
+		Kind:      kind,
+		PostIDs:   []string{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IndexOf returns the zero-based position of a post in the collection, or -1
+func (c *Collection) IndexOf(postID string) int {
+	for i, id := range c.PostIDs {
+		if id == postID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+// Comment represents a reader comment on a blog post
+type Comment struct {
+	ID           string     `json:"id"`
+	PostID       string     `json:"post_id"`
+	ParentID     string     `json:"parent_id,omitempty"`
+	AuthorUserID string     `json:"author_user_id,omitempty"`
+	AuthorName   string     `json:"author_name"`
+	AuthorEmail  string     `json:"author_email"`
+	AuthorURL    string     `json:"author_url,omitempty"`
+	Body         string     `json:"body"`
+	Status       string     `json:"status"`
+	IPAddress    string     `json:"ip_address,omitempty"`
+	UserAgent    string     `json:"user_agent,omitempty"`
+	ModeratedBy  string     `json:"moderated_by,omitempty"`
+	ModeratedAt  *time.Time `json:"moderated_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// CommentStatus constants
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusSpam     = "spam"
+	CommentStatusDeleted  = "deleted"
+)
+
+// NewComment creates a new pending comment
+func NewComment(postID, authorName, authorEmail, body string) *Comment {
+	now := time.Now()
+	return &Comment{
+		PostID:      postID,
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+		Body:        body,
+		Status:      CommentStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsGuest returns true if the comment was not written by a signed-in user
+func (c *Comment) IsGuest() bool {
+	return c.AuthorUserID == ""
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"time"
+)
+
+// ContentType is an administrator-defined kind of structured content, e.g. "case-study",
+// whose entries hold the values of its typed fields
+type ContentType struct {
+	ID          string             `json:"id"`
+	Slug        string             `json:"slug"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Fields      []ContentTypeField `json:"fields"`
+	CreatedBy   string             `json:"created_by,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// ContentTypeField defines one typed field of a content type and its validation
+type ContentTypeField struct {
+	// Key is the name of the value in Entry.Data, e.g. "job_title"
+	Key      string `json:"key"`
+	Label    string `json:"label"`
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+	// Filterable fields can be used to filter and sort entries and are indexed
+	Filterable bool `json:"filterable,omitempty"`
+
+	// ItemType is the type of the items of a list field; lists cannot be nested
+	ItemType string `json:"item_type,omitempty"`
+	// ReferenceType is the slug of the content type a reference field, or list of references, points to
+	ReferenceType string `json:"reference_type,omitempty"`
+
+	// MinLength and MaxLength bound text and rich text values in characters
+	MinLength *int `json:"min_length,omitempty"`
+	MaxLength *int `json:"max_length,omitempty"`
+	// Min and Max bound number values
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// Pattern is a regular expression text values must match
+	Pattern string `json:"pattern,omitempty"`
+	// Options restricts text values to a fixed set
+	Options []string `json:"options,omitempty"`
+	// MinItems and MaxItems bound the length of list values
+	MinItems *int `json:"min_items,omitempty"`
+	MaxItems *int `json:"max_items,omitempty"`
+}
+
+// ContentFieldType constants
+const (
+	ContentFieldTypeText      = "text"
+	ContentFieldTypeRichText  = "rich_text"
+	ContentFieldTypeNumber    = "number"
+	ContentFieldTypeDate      = "date"
+	ContentFieldTypeBoolean   = "boolean"
+	ContentFieldTypeMedia     = "media"
+	ContentFieldTypeReference = "reference"
+	ContentFieldTypeList      = "list"
+)
+
+// Field returns the field with the given key
+func (t *ContentType) Field(key string) (*ContentTypeField, bool) {
+	for i := range t.Fields {
+		if t.Fields[i].Key == key {
+			return &t.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// FilterableKeys returns the keys of the filterable fields in declaration order
+func (t *ContentType) FilterableKeys() []string {
+	keys := []string{}
+	for _, field := range t.Fields {
+		if field.Filterable {
+			keys = append(keys, field.Key)
+		}
+	}
+	return keys
+}
+
+// Entry is one item of a content type. Data maps field keys to values: strings for
+// text, rich text, media IDs, entry IDs and RFC 3339 dates, float64 for numbers,
+// bool for booleans and []interface{} for lists.
+type Entry struct {
+	ID            string                 `json:"id"`
+	ContentTypeID string                 `json:"content_type_id"`
+	Slug          string                 `json:"slug"`
+	Status        string                 `json:"status"`
+	Data          map[string]interface{} `json:"data"`
+	PublishedAt   *time.Time             `json:"published_at,omitempty"`
+	CreatedBy     string                 `json:"created_by,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+// NewEntry creates a new draft entry of a content type
+func NewEntry(contentTypeID, slug string) *Entry {
+	now := time.Now()
+	return &Entry{
+		ContentTypeID: contentTypeID,
+		Slug:          slug,
+		Status:        PageStatusDraft,
+		Data:          map[string]interface{}{},
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// IsPublished returns true if the entry is published
+func (e *Entry) IsPublished() bool {
+	return e.Status == PageStatusPublished
+}
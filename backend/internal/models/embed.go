@@ -0,0 +1,20 @@
+package models
+
+// ContentBlockTypeEmbed marks a content block that embeds third-party content through oEmbed.
+// The embedded page URL is stored in Data[EmbedURLKey].
+const (
+	ContentBlockTypeEmbed = "embed"
+	EmbedURLKey           = "url"
+)
+
+// Embed is the resolved oEmbed data of an embedded URL. HTML is already sanitized.
+type Embed struct {
+	Type         string `json:"type"`
+	ProviderName string `json:"provider_name"`
+	Title        string `json:"title,omitempty"`
+	AuthorName   string `json:"author_name,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
+	HTML         string `json:"html"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+)
+
+// LinkReport is the result of one link-integrity scan over all pages and posts
+type LinkReport struct {
+	ID           string `json:"id"`
+	Trigger      string `json:"trigger"`
+	PagesScanned int    `json:"pages_scanned"`
+	PostsScanned int    `json:"posts_scanned"`
+	LinksChecked int    `json:"links_checked"`
+	// ExternalChecked reports whether external URLs were requested during the scan
+	ExternalChecked bool        `json:"external_checked"`
+	Issues          []LinkIssue `json:"issues"`
+	StartedAt       time.Time   `json:"started_at"`
+	FinishedAt      time.Time   `json:"finished_at"`
+}
+
+// LinkIssue is a broken reference found in a page or post
+type LinkIssue struct {
+	ContentType string `json:"content_type"`
+	ContentID   string `json:"content_id"`
+	ContentSlug string `json:"content_slug"`
+	// BlockIndex is the zero-based content block, or -1 for fields outside the blocks
+	BlockIndex int    `json:"block_index"`
+	Field      string `json:"field"`
+	URL        string `json:"url"`
+	Kind       string `json:"kind"`
+	// StatusCode is the HTTP status of a failing external URL; 0 when the request failed
+	StatusCode int    `json:"status_code,omitempty"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// LinkReportTrigger constants
+const (
+	LinkReportTriggerScheduled = "scheduled"
+	LinkReportTriggerManual    = "manual"
+)
+
+// LinkIssueKind constants
+const (
+	LinkIssueMissingPage    = "missing_page"
+	LinkIssueMissingPost    = "missing_post"
+	LinkIssueMissingMedia   = "missing_media"
+	LinkIssueBrokenExternal = "broken_external"
+)
@@ -17,6 +17,20 @@ type Media struct {
 	AltText      string    `json:"alt_text,omitempty"`
 	UploadedBy   string    `json:"uploaded_by"`
 	CreatedAt    time.Time `json:"created_at"`
+	// Width and Height are the pixel dimensions of images; zero for other files
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+	// Variants are downscaled renditions of an image, smallest first
+	Variants []MediaVariant `json:"variants,omitempty"`
+}
+
+// MediaVariant is a downscaled rendition of an image, stored as its own file
+type MediaVariant struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename"`
+	URL      string `json:"url"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
 }
 
 // NewMedia creates a new media document with default values
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// NavigationMenu is a named menu of the website, e.g. "header" or "footer", holding a
+// tree of items in display order
+type NavigationMenu struct {
+	ID          string           `json:"id"`
+	Slug        string           `json:"slug"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Items       []NavigationItem `json:"items"`
+	CreatedBy   string           `json:"created_by,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// NavigationItem is one entry of a menu. Content links store the page ID, post ID or
+// category slug so that they follow slug changes; their URL is resolved on read.
+type NavigationItem struct {
+	// ID is stable across saves so clients can track items while editing
+	ID string `json:"id"`
+	// Label is the default label; content links fall back to the title of their target
+	Label string `json:"label,omitempty"`
+	// Labels holds translated labels keyed by lowercase locale, e.g. "de" or "pt-br"
+	Labels   map[string]string `json:"labels,omitempty"`
+	LinkType string            `json:"link_type"`
+	// TargetID is the page ID, post ID or category slug of content links
+	TargetID     string           `json:"target_id,omitempty"`
+	URL          string           `json:"url,omitempty"`
+	OpenInNewTab bool             `json:"open_in_new_tab,omitempty"`
+	Children     []NavigationItem `json:"children,omitempty"`
+}
+
+// NavigationLinkType constants
+const (
+	NavigationLinkPage     = "page"
+	NavigationLinkPost     = "post"
+	NavigationLinkCategory = "category"
+	NavigationLinkURL      = "url"
+	// NavigationLinkNone items only group their children, e.g. a dropdown heading
+	NavigationLinkNone = "none"
+)
+
+// LabelFor returns the label for a locale, falling back from a regional locale to its
+// language ("de-at" to "de") and then to the default label
+func (i NavigationItem) LabelFor(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	for locale != "" {
+		if label := i.Labels[locale]; label != "" {
+			return label
+		}
+		cut := strings.LastIndex(locale, "-")
+		if cut < 0 {
+			break
+		}
+		locale = locale[:cut]
+	}
+	return i.Label
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// NewsletterSubscriber is an email address signed up for the post digest
+type NewsletterSubscriber struct {
+	ID string `json:"id"`
+	// Email is stored lowercased and is unique
+	Email  string `json:"email"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status"`
+	// Topics are the category slugs the subscriber wants posts from; empty means all categories
+	Topics []string `json:"topics"`
+	// SoftBounces counts transient delivery failures since the subscription was confirmed
+	SoftBounces        int        `json:"soft_bounces"`
+	IPAddress          string     `json:"ip_address,omitempty"`
+	ConfirmationSentAt *time.Time `json:"confirmation_sent_at,omitempty"`
+	ConfirmedAt        *time.Time `json:"confirmed_at,omitempty"`
+	UnsubscribedAt     *time.Time `json:"unsubscribed_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+// NewsletterSubscriberStatus constants. Subscribers are pending until they confirm their
+// address and only active subscribers receive digests. Bounced and complained addresses
+// are set by the delivery webhook and are never mailed again unless they subscribe anew.
+const (
+	NewsletterStatusPending      = "pending"
+	NewsletterStatusActive       = "active"
+	NewsletterStatusUnsubscribed = "unsubscribed"
+	NewsletterStatusBounced      = "bounced"
+	NewsletterStatusComplained   = "complained"
+)
+
+// NewNewsletterSubscriber creates a new pending subscriber
+func NewNewsletterSubscriber(email, name string, topics []string) *NewsletterSubscriber {
+	now := time.Now()
+	return &NewsletterSubscriber{
+		Email:     strings.ToLower(strings.TrimSpace(email)),
+		Name:      strings.TrimSpace(name),
+		Status:    NewsletterStatusPending,
+		Topics:    topics,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// WantsCategory reports whether a post in the given category slugs belongs in the subscriber's digest
+func (s *NewsletterSubscriber) WantsCategory(slugs []string) bool {
+	if len(s.Topics) == 0 {
+		return true
+	}
+	for _, topic := range s.Topics {
+		for _, slug := range slugs {
+			if topic == slug {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewsletterDigest records one digest run over the posts published in [PeriodStart, PeriodEnd)
+type NewsletterDigest struct {
+	ID          string    `json:"id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	PostIDs     []string  `json:"post_ids"`
+	// Recipients counts the subscribers the digest was sent to; Failures those it could not be sent to
+	Recipients int       `json:"recipients"`
+	Failures   int       `json:"failures"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -14,6 +14,10 @@ type Page struct {
 	Content   Content   `json:"content"`
 	Meta      Meta      `json:"meta"`
 	Status    string    `json:"status"`
+	// Visibility restricts who may read the page; the zero value is public
+	Visibility Visibility `json:"visibility,omitempty"`
+	// PublishOverride records an admin publishing despite quality gate errors
+	PublishOverride *PublishOverride `json:"publish_override,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -34,8 +38,67 @@ type Meta struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Keywords    string `json:"keywords,omitempty"`
+	// Open Graph overrides; empty values fall back to the title and description
+	OGTitle       string `json:"og_title,omitempty"`
+	OGDescription string `json:"og_description,omitempty"`
+	// OGImage is the media ID of the social share image
+	OGImage      string `json:"og_image,omitempty"`
+	CanonicalURL string `json:"canonical_url,omitempty"`
+	// Robots holds robots meta directives, e.g. "noindex, nofollow"
+	Robots      string `json:"robots,omitempty"`
+	TwitterCard string `json:"twitter_card,omitempty"`
 }
 
+// Visibility is the access rule of a page or post
+type Visibility struct {
+	Level string `json:"level,omitempty"`
+	// Roles and Groups grant access to restricted content
+	Roles  []string `json:"roles,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	// TeaserBlocks is how many leading content blocks readers without access get
+	TeaserBlocks int `json:"teaser_blocks,omitempty"`
+}
+
+// Visibility level constants; an empty level is public
+const (
+	VisibilityPublic        = "public"
+	VisibilityAuthenticated = "authenticated"
+	VisibilityRestricted    = "restricted"
+)
+
+// IsPublic reports whether anyone may read the content
+func (v Visibility) IsPublic() bool {
+	return v.Level == "" || v.Level == VisibilityPublic
+}
+
+// Teaser returns the leading blocks of content shown to readers without access
+func (v Visibility) Teaser(content Content) Content {
+	n := v.TeaserBlocks
+	if n > len(content.Blocks) {
+		n = len(content.Blocks)
+	}
+	if n < 0 {
+		n = 0
+	}
+	return Content{Blocks: append([]ContentBlock{}, content.Blocks[:n]...)}
+}
+
+// PublishOverride records who published content despite blocking quality gate
+// violations, and why
+type PublishOverride struct {
+	Reason       string    `json:"reason"`
+	UserID       string    `json:"user_id"`
+	OverriddenAt time.Time `json:"overridden_at"`
+	// Rules lists the overridden quality gate rules
+	Rules []string `json:"rules"`
+}
+
+// TwitterCard constants
+const (
+	TwitterCardSummary           = "summary"
+	TwitterCardSummaryLargeImage = "summary_large_image"
+)
+
 // PageStatus constants
 const (
 	PageStatusDraft     = "draft"
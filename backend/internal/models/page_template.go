@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+// PageTemplate is a named, versioned page skeleton used to instantiate new pages
+type PageTemplate struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	Version     int       `json:"version"`
+	Content     Content   `json:"content"`
+	Meta        Meta      `json:"meta"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewPageTemplate creates a new page template with default values
+func NewPageTemplate(name, slug string) *PageTemplate {
+	now := time.Now()
+	return &PageTemplate{
+		Name:      name,
+		Slug:      slug,
+		Version:   1,
+		Content:   Content{Blocks: []ContentBlock{}},
+		Meta:      Meta{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Clone returns a deep copy of the content so edits to the copy never leak into the source
+func (c Content) Clone() Content {
+	blocks := make([]ContentBlock, len(c.Blocks))
+	for i, block := range c.Blocks {
+		data := make(map[string]interface{}, len(block.Data))
+		for key, value := range block.Data {
+			data[key] = value
+		}
+		blocks[i] = ContentBlock{Type: block.Type, Data: data}
+	}
+	return Content{Blocks: blocks}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+)
+
+// Content type identifiers shared by pages and blog posts
+const (
+	ContentTypePage     = "page"
+	ContentTypeBlogPost = "blog_post"
+)
+
+// ContentBlockTypeReusable marks a content block that embeds a ReusableBlock by reference.
+// The referenced block ID is stored in Data[ReusableBlockRefKey].
+const (
+	ContentBlockTypeReusable = "reusable"
+	ReusableBlockRefKey      = "block_id"
+)
+
+// ReusableBlock is a named set of content blocks stored once and embedded in pages and posts
+type ReusableBlock struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	Content     Content   `json:"content"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ReusableBlockUsage records a page or blog post that embeds a reusable block
+type ReusableBlockUsage struct {
+	BlockID     string `json:"block_id"`
+	ContentType string `json:"content_type"`
+	ContentID   string `json:"content_id"`
+	Slug        string `json:"slug"`
+	Title       string `json:"title"`
+}
+
+// NewReusableBlock creates a new reusable block with default values
+func NewReusableBlock(name, slug string) *ReusableBlock {
+	now := time.Now()
+	return &ReusableBlock{
+		Name:      name,
+		Slug:      slug,
+		Content:   Content{Blocks: []ContentBlock{}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// ReusableBlockRefs returns the IDs of reusable blocks referenced by the content, in order and without duplicates
+func (c Content) ReusableBlockRefs() []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, block := range c.Blocks {
+		if block.Type != ContentBlockTypeReusable {
+			continue
+		}
+		id, _ := block.Data[ReusableBlockRefKey].(string)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,124 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SiteSettings are the site-wide values edited from the CMS. Each field is stored under
+// its own key so that an update only touches, and audits, the keys it changes.
+type SiteSettings struct {
+	SiteName string
+	// SiteURL is the public base URL of the website, without a trailing slash
+	SiteURL         string
+	LogoURL         string
+	BlogDescription string
+	SocialLinks     []SocialLink
+	// DefaultMetaImageID is the media ID of the image used when content has no Open Graph image
+	DefaultMetaImageID string
+	// ContactRecipients receive contact form notifications
+	ContactRecipients []string
+	// UpdatedAt is the time of the most recent stored change, zero while all keys use defaults
+	UpdatedAt time.Time
+}
+
+// SocialLink is a profile of the site on a social network
+type SocialLink struct {
+	// Network is a lowercase slug such as "mastodon" or "github"
+	Network string `json:"network"`
+	URL     string `json:"url"`
+}
+
+// Setting keys
+const (
+	SettingSiteName          = "site_name"
+	SettingSiteURL           = "site_url"
+	SettingLogoURL           = "logo_url"
+	SettingBlogDescription   = "blog_description"
+	SettingSocialLinks       = "social_links"
+	SettingDefaultMetaImage  = "default_meta_image"
+	SettingContactRecipients = "contact_recipients"
+)
+
+// SettingKeys lists every setting key
+var SettingKeys = []string{
+	SettingSiteName,
+	SettingSiteURL,
+	SettingLogoURL,
+	SettingBlogDescription,
+	SettingSocialLinks,
+	SettingDefaultMetaImage,
+	SettingContactRecipients,
+}
+
+// Setting is the stored value of one key
+type Setting struct {
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	UpdatedBy string          `json:"updated_by,omitempty"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// SettingChange is an audit record of one key changed by an update
+type SettingChange struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+	// OldValue is nil when the key used its default before the change
+	OldValue  json.RawMessage `json:"old_value,omitempty"`
+	NewValue  json.RawMessage `json:"new_value"`
+	ChangedBy string          `json:"changed_by,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// Value returns the JSON encoding of the setting stored under key
+func (s *SiteSettings) Value(key string) (json.RawMessage, error) {
+	field, err := s.field(key)
+	if err != nil {
+		return nil, err
+	}
+	switch v := field.(type) {
+	case *[]SocialLink:
+		if *v == nil {
+			return json.RawMessage("[]"), nil
+		}
+	case *[]string:
+		if *v == nil {
+			return json.RawMessage("[]"), nil
+		}
+	}
+	return json.Marshal(field)
+}
+
+// Apply decodes a stored value into the setting with the given key
+func (s *SiteSettings) Apply(key string, value json.RawMessage) error {
+	field, err := s.field(key)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(value, field); err != nil {
+		return fmt.Errorf("invalid value for setting %s: %w", key, err)
+	}
+	return nil
+}
+
+// field returns a pointer to the field stored under key
+func (s *SiteSettings) field(key string) (interface{}, error) {
+	switch key {
+	case SettingSiteName:
+		return &s.SiteName, nil
+	case SettingSiteURL:
+		return &s.SiteURL, nil
+	case SettingLogoURL:
+		return &s.LogoURL, nil
+	case SettingBlogDescription:
+		return &s.BlogDescription, nil
+	case SettingSocialLinks:
+		return &s.SocialLinks, nil
+	case SettingDefaultMetaImage:
+		return &s.DefaultMetaImageID, nil
+	case SettingContactRecipients:
+		return &s.ContactRecipients, nil
+	}
+	return nil, fmt.Errorf("unknown setting %s", key)
+}
@@ -13,6 +13,8 @@ type User struct {
 	PasswordHash string    `json:"password_hash"`
 	Role         string    `json:"role"`
 	Profile      Profile   `json:"profile"`
+	// Groups grant access to content restricted to them
+	Groups       []string  `json:"groups,omitempty"`
 	CreatedAt    time.Time `json:"created_at"`
 	LastLogin    time.Time `json:"last_login,omitempty"`
 }
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+)
+
+// WebhookSubscription is an outbound webhook endpoint and the events it receives
+type WebhookSubscription struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Secret is the HMAC-SHA256 key used to sign payloads
+	Secret     string    `json:"-"`
+	EventTypes []string  `json:"event_types"`
+	Active     bool      `json:"active"`
+	CreatedBy  string    `json:"created_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Subscribes returns true if the subscription is active and receives the event type
+func (s *WebhookSubscription) Subscribes(eventType string) bool {
+	if !s.Active {
+		return false
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is one event sent to one subscription, including its retry state
+type WebhookDelivery struct {
+	ID             string `json:"id"`
+	SubscriptionID string `json:"subscription_id"`
+	// EventID is shared by all deliveries of the same event, including redeliveries
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	// Payload is the exact JSON body that is signed and sent
+	Payload        string `json:"payload"`
+	Status         string `json:"status"`
+	Attempts       int    `json:"attempts"`
+	ResponseStatus int    `json:"response_status,omitempty"`
+	LastError      string `json:"last_error,omitempty"`
+	// NextAttemptAt is set while the delivery is pending
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// WebhookDeliveryStatus constants
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook event types
+const (
+	WebhookEventPageCreated      = "page.created"
+	WebhookEventPageUpdated      = "page.updated"
+	WebhookEventPagePublished    = "page.published"
+	WebhookEventPageDeleted      = "page.deleted"
+	WebhookEventPostCreated      = "post.created"
+	WebhookEventPostUpdated      = "post.updated"
+	WebhookEventPostPublished    = "post.published"
+	WebhookEventPostDeleted      = "post.deleted"
+	WebhookEventMediaUploaded    = "media.uploaded"
+	WebhookEventMediaUpdated     = "media.updated"
+	WebhookEventMediaDeleted     = "media.deleted"
+	WebhookEventContactSubmitted = "contact_submission.created"
+)
+
+// WebhookEventTypes lists every event a subscription can receive
+var WebhookEventTypes = []string{
+	WebhookEventPageCreated,
+	WebhookEventPageUpdated,
+	WebhookEventPagePublished,
+	WebhookEventPageDeleted,
+	WebhookEventPostCreated,
+	WebhookEventPostUpdated,
+	WebhookEventPostPublished,
+	WebhookEventPostDeleted,
+	WebhookEventMediaUploaded,
+	WebhookEventMediaUpdated,
+	WebhookEventMediaDeleted,
+	WebhookEventContactSubmitted,
+}
+
+// IsWebhookEventType returns true if the event type is known
+func IsWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
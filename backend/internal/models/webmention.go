@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+// Webmention is a received mention of a blog post by another site
+type Webmention struct {
+	ID         string `json:"id"`
+	PostID     string `json:"post_id"`
+	Source     string `json:"source"`
+	Target     string `json:"target"`
+	Title      string `json:"title,omitempty"`
+	AuthorName string `json:"author_name,omitempty"`
+	Status     string `json:"status"`
+	// LastError explains why verification rejected the mention
+	LastError   string     `json:"last_error,omitempty"`
+	IPAddress   string     `json:"ip_address,omitempty"`
+	VerifiedAt  *time.Time `json:"verified_at,omitempty"`
+	ModeratedBy string     `json:"moderated_by,omitempty"`
+	ModeratedAt *time.Time `json:"moderated_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// WebmentionStatus constants. Received mentions are pending until the source has been
+// fetched; verified mentions await moderation and only approved ones are shown.
+const (
+	WebmentionStatusPending  = "pending"
+	WebmentionStatusVerified = "verified"
+	WebmentionStatusApproved = "approved"
+	WebmentionStatusRejected = "rejected"
+	WebmentionStatusSpam     = "spam"
+)
+
+// NewWebmention creates a new pending mention of a post
+func NewWebmention(postID, source, target string) *Webmention {
+	now := time.Now()
+	return &Webmention{
+		PostID:    postID,
+		Source:    source,
+		Target:    target,
+		Status:    WebmentionStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
@@ -63,6 +63,8 @@ type PaginationInfo struct {
 // Keep minimal: lookup, list, create.
 type UsersRepository interface {
 	GetByEmail(ctx context.Context, email string) (*User, error)
+	// GetByIDs returns the users with the given IDs in one query; unknown IDs are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*User, error)
 	List(ctx context.Context, opt ListOptions) ([]*User, error)
 	Create(ctx context.Context, u *User) error
 }
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// activityPubRepositorySQL implements ActivityPubRepository (PostgreSQL/sqlc)
+type activityPubRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ ActivityPubRepository = (*activityPubRepositorySQL)(nil)
+
+// NewActivityPubRepositorySQL creates a new SQL-backed ActivityPub repository using the Postgres client
+func NewActivityPubRepositorySQL(c *database.PostgresClient) ActivityPubRepository {
+	return &activityPubRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *activityPubRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// GetKey retrieves the key pair of an actor
+func (r *activityPubRepositorySQL) GetKey(ctx context.Context, actor string) (*models.ActivityPubKey, error) {
+	row, err := r.getQ(ctx).GetActivityPubKey(ctx, actor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activitypub key: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCActivityPubKey(row), nil
+}
+
+// CreateKey stores a key pair unless the actor already has one, and returns the stored key
+func (r *activityPubRepositorySQL) CreateKey(ctx context.Context, key *models.ActivityPubKey) (*models.ActivityPubKey, error) {
+	row, err := r.getQ(ctx).InsertActivityPubKey(ctx, db.InsertActivityPubKeyParams{
+		Actor:         key.Actor,
+		PublicKeyPem:  key.PublicKeyPEM,
+		PrivateKeyPem: key.PrivateKeyPEM,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create activitypub key: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCActivityPubKey(row), nil
+}
+
+// UpsertFollower stores a follower, updating the inboxes of an existing one
+func (r *activityPubRepositorySQL) UpsertFollower(ctx context.Context, follower *models.ActivityPubFollower) error {
+	row, err := r.getQ(ctx).UpsertActivityPubFollower(ctx, db.UpsertActivityPubFollowerParams{
+		Actor:          follower.Actor,
+		FollowerUri:    follower.FollowerURI,
+		InboxUrl:       follower.InboxURL,
+		SharedInboxUrl: follower.SharedInboxURL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store activitypub follower: %w", appErr.MapDBError(err))
+	}
+	*follower = *mapSQLCActivityPubFollower(row)
+	return nil
+}
+
+// DeleteFollower removes a follower of an actor
+func (r *activityPubRepositorySQL) DeleteFollower(ctx context.Context, actor, followerURI string) error {
+	affected, err := r.getQ(ctx).DeleteActivityPubFollower(ctx, db.DeleteActivityPubFollowerParams{
+		Actor:       actor,
+		FollowerUri: followerURI,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete activitypub follower: %w", appErr.MapDBError(err))
+	}
+	if affected == 0 {
+		return appErr.ErrNotFound
+	}
+	return nil
+}
+
+// CountFollowers returns the number of followers of an actor
+func (r *activityPubRepositorySQL) CountFollowers(ctx context.Context, actor string) (int, error) {
+	total, err := r.getQ(ctx).CountActivityPubFollowers(ctx, actor)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count activitypub followers: %w", appErr.MapDBError(err))
+	}
+	return int(total), nil
+}
+
+// ListFollowerInboxes returns the distinct delivery inboxes of an actor's followers
+func (r *activityPubRepositorySQL) ListFollowerInboxes(ctx context.Context, actor string) ([]string, error) {
+	inboxes, err := r.getQ(ctx).ListActivityPubFollowerInboxes(ctx, actor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activitypub follower inboxes: %w", appErr.MapDBError(err))
+	}
+	return inboxes, nil
+}
+
+// CreateDelivery inserts a new pending delivery
+func (r *activityPubRepositorySQL) CreateDelivery(ctx context.Context, delivery *models.ActivityPubDelivery) error {
+	row, err := r.getQ(ctx).InsertActivityPubDelivery(ctx, db.InsertActivityPubDeliveryParams{
+		Actor:         delivery.Actor,
+		InboxUrl:      delivery.InboxURL,
+		ActivityID:    delivery.ActivityID,
+		Payload:       delivery.Payload,
+		NextAttemptAt: timePtrToPgtype(delivery.NextAttemptAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create activitypub delivery: %w", appErr.MapDBError(err))
+	}
+	*delivery = *mapSQLCActivityPubDelivery(row)
+	return nil
+}
+
+// GetDelivery retrieves a delivery by its UUID
+func (r *activityPubRepositorySQL) GetDelivery(ctx context.Context, id string) (*models.ActivityPubDelivery, error) {
+	row, err := r.getQ(ctx).GetActivityPubDeliveryByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activitypub delivery: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCActivityPubDelivery(row), nil
+}
+
+// ListDueDeliveries returns pending deliveries due at or before the given time, most overdue first
+func (r *activityPubRepositorySQL) ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*models.ActivityPubDelivery, error) {
+	rows, err := r.getQ(ctx).ListDueActivityPubDeliveries(ctx, db.ListDueActivityPubDeliveriesParams{
+		NextAttemptAt: pgtype.Timestamptz{Time: before, Valid: true},
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due activitypub deliveries: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.ActivityPubDelivery, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCActivityPubDelivery(row))
+	}
+	return out, nil
+}
+
+// UpdateDelivery saves the outcome of the latest attempt
+func (r *activityPubRepositorySQL) UpdateDelivery(ctx context.Context, delivery *models.ActivityPubDelivery) error {
+	row, err := r.getQ(ctx).UpdateActivityPubDeliveryAttempt(ctx, db.UpdateActivityPubDeliveryAttemptParams{
+		ID:            parseUUIDToPgtype(delivery.ID),
+		Status:        delivery.Status,
+		Attempts:      int32(delivery.Attempts),
+		LastError:     delivery.LastError,
+		NextAttemptAt: timePtrToPgtype(delivery.NextAttemptAt),
+		DeliveredAt:   timePtrToPgtype(delivery.DeliveredAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update activitypub delivery: %w", appErr.MapDBError(err))
+	}
+	*delivery = *mapSQLCActivityPubDelivery(row)
+	return nil
+}
+
+// PruneDeliveries removes settled deliveries created before the given time
+func (r *activityPubRepositorySQL) PruneDeliveries(ctx context.Context, before time.Time) error {
+	if err := r.getQ(ctx).DeleteActivityPubDeliveriesBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true}); err != nil {
+		return fmt.Errorf("failed to prune activitypub deliveries: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// mapSQLCActivityPubKey converts a sqlc row to the outward model
+func mapSQLCActivityPubKey(row db.ActivitypubKey) *models.ActivityPubKey {
+	return &models.ActivityPubKey{
+		Actor:         row.Actor,
+		PublicKeyPEM:  row.PublicKeyPem,
+		PrivateKeyPEM: row.PrivateKeyPem,
+		CreatedAt:     row.CreatedAt.Time,
+	}
+}
+
+// mapSQLCActivityPubFollower converts a sqlc row to the outward model
+func mapSQLCActivityPubFollower(row db.ActivitypubFollower) *models.ActivityPubFollower {
+	return &models.ActivityPubFollower{
+		ID:             row.ID.String(),
+		Actor:          row.Actor,
+		FollowerURI:    row.FollowerUri,
+		InboxURL:       row.InboxUrl,
+		SharedInboxURL: row.SharedInboxUrl,
+		CreatedAt:      row.CreatedAt.Time,
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+}
+
+// mapSQLCActivityPubDelivery converts a sqlc row to the outward model
+func mapSQLCActivityPubDelivery(row db.ActivitypubDelivery) *models.ActivityPubDelivery {
+	return &models.ActivityPubDelivery{
+		ID:            row.ID.String(),
+		Actor:         row.Actor,
+		InboxURL:      row.InboxUrl,
+		ActivityID:    row.ActivityID,
+		Payload:       row.Payload,
+		Status:        row.Status,
+		Attempts:      int(row.Attempts),
+		LastError:     row.LastError,
+		NextAttemptAt: nullableTimePtr(row.NextAttemptAt),
+		DeliveredAt:   nullableTimePtr(row.DeliveredAt),
+		CreatedAt:     row.CreatedAt.Time,
+	}
+}
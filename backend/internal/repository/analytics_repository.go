@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// analyticsRepositorySQL implements AnalyticsRepository (PostgreSQL/sqlc)
+type analyticsRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ AnalyticsRepository = (*analyticsRepositorySQL)(nil)
+
+// NewAnalyticsRepositorySQL creates a new SQL-backed analytics repository using the Postgres client
+func NewAnalyticsRepositorySQL(c *database.PostgresClient) AnalyticsRepository {
+	return &analyticsRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *analyticsRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// RecordView folds a view into the daily and referrer rollups.
+// Callers that need atomicity should run it inside a UnitOfWork.
+func (r *analyticsRepositorySQL) RecordView(ctx context.Context, view *models.ContentView) error {
+	q := r.getQ(ctx)
+	day := dateToPgtype(view.Day)
+
+	newVisitor, err := q.InsertContentViewVisitor(ctx, db.InsertContentViewVisitorParams{
+		ContentID:   view.ContentID,
+		Day:         day,
+		VisitorHash: view.VisitorHash,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record visitor: %w", appErr.MapDBError(err))
+	}
+
+	if err := q.UpsertContentViewDaily(ctx, db.UpsertContentViewDailyParams{
+		ContentID:   view.ContentID,
+		Day:         day,
+		ContentType: view.ContentType,
+		Path:        view.Path,
+		NewVisitor:  newVisitor,
+	}); err != nil {
+		return fmt.Errorf("failed to record daily view: %w", appErr.MapDBError(err))
+	}
+
+	if err := q.UpsertContentViewReferrer(ctx, db.UpsertContentViewReferrerParams{
+		ContentID: view.ContentID,
+		Day:       day,
+		Referrer:  view.Referrer,
+	}); err != nil {
+		return fmt.Errorf("failed to record referrer view: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// ListDailyViews returns one rollup per day that has views, oldest first
+func (r *analyticsRepositorySQL) ListDailyViews(ctx context.Context, contentID string, from, to time.Time) ([]*models.DailyViews, error) {
+	rows, err := r.getQ(ctx).ListContentViewDaily(ctx, db.ListContentViewDailyParams{
+		FromDay:   dateToPgtype(from),
+		ToDay:     dateToPgtype(to),
+		ContentID: nullableStringPtr(contentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daily views: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.DailyViews, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &models.DailyViews{
+			Day:            row.Day.Time,
+			Views:          row.Views,
+			UniqueVisitors: row.UniqueVisitors,
+		})
+	}
+	return out, nil
+}
+
+// ListReferrers returns the top referring hosts by views
+func (r *analyticsRepositorySQL) ListReferrers(ctx context.Context, contentID string, from, to time.Time, limit int) ([]*models.ReferrerViews, error) {
+	rows, err := r.getQ(ctx).ListContentViewReferrers(ctx, db.ListContentViewReferrersParams{
+		FromDay:   dateToPgtype(from),
+		ToDay:     dateToPgtype(to),
+		ContentID: nullableStringPtr(contentID),
+		MaxRows:   int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list referrers: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.ReferrerViews, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &models.ReferrerViews{Referrer: row.Referrer, Views: row.Views})
+	}
+	return out, nil
+}
+
+// ListPopular ranks content by total views in the window
+func (r *analyticsRepositorySQL) ListPopular(ctx context.Context, contentType string, from, to time.Time, limit int) ([]*models.PopularContent, error) {
+	rows, err := r.getQ(ctx).ListPopularContent(ctx, db.ListPopularContentParams{
+		FromDay:     dateToPgtype(from),
+		ToDay:       dateToPgtype(to),
+		ContentType: nullableStringPtr(contentType),
+		MaxRows:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list popular content: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.PopularContent, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &models.PopularContent{
+			ContentID:      row.ContentID,
+			ContentType:    row.ContentType,
+			Path:           row.Path,
+			Views:          row.Views,
+			UniqueVisitors: row.UniqueVisitors,
+		})
+	}
+	return out, nil
+}
+
+// DaySalt returns the salt of a day. The insert waits for a concurrent one of the same day,
+// so the read that follows a lost race sees the winner's salt.
+func (r *analyticsRepositorySQL) DaySalt(ctx context.Context, day time.Time, candidate []byte) ([]byte, bool, error) {
+	q := r.getQ(ctx)
+	inserted, err := q.InsertContentViewSalt(ctx, db.InsertContentViewSaltParams{
+		Day:  dateToPgtype(day),
+		Salt: candidate,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to store visitor salt: %w", appErr.MapDBError(err))
+	}
+	if inserted > 0 {
+		return candidate, true, nil
+	}
+	salt, err := q.GetContentViewSalt(ctx, dateToPgtype(day))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get visitor salt: %w", appErr.MapDBError(err))
+	}
+	return salt, false, nil
+}
+
+// PurgeVisitorsBefore deletes visitor hashes and salts of earlier days
+func (r *analyticsRepositorySQL) PurgeVisitorsBefore(ctx context.Context, day time.Time) error {
+	q := r.getQ(ctx)
+	if err := q.DeleteContentViewVisitorsBefore(ctx, dateToPgtype(day)); err != nil {
+		return fmt.Errorf("failed to purge visitor hashes: %w", appErr.MapDBError(err))
+	}
+	if err := q.DeleteContentViewSaltsBefore(ctx, dateToPgtype(day)); err != nil {
+		return fmt.Errorf("failed to purge visitor salts: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// dateToPgtype converts a time to a DATE value using its UTC calendar day
+func dateToPgtype(t time.Time) pgtype.Date {
+	u := t.UTC()
+	return pgtype.Date{Time: time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.UTC), Valid: true}
+}
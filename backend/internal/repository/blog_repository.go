@@ -91,11 +91,33 @@ func (r *blogRepositorySQL) Create(ctx context.Context, post *models.BlogPost) e
 		return fmt.Errorf("failed to create blog post: %w", err)
 	}
 
+	if err := r.setOrdering(ctx, row.ID, post); err != nil {
+		return err
+	}
+
 	post.CreatedAt = row.CreatedAt.Time
 	post.UpdatedAt = row.UpdatedAt.Time
 	return nil
 }
 
+// setOrdering stores the pin and sort weight of a post (PostgreSQL)
+func (r *blogRepositorySQL) setOrdering(ctx context.Context, id pgtype.UUID, post *models.BlogPost) error {
+	pinnedUntil := pgtype.Timestamptz{Valid: false}
+	if post.PinnedUntil != nil {
+		pinnedUntil = pgtype.Timestamptz{Time: *post.PinnedUntil, Valid: true}
+	}
+	err := r.q.SetPostOrdering(ctx, db.SetPostOrderingParams{
+		ID:          id,
+		Pinned:      post.Pinned,
+		PinnedUntil: pinnedUntil,
+		SortWeight:  int32(post.SortWeight),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store blog post ordering: %w", err)
+	}
+	return nil
+}
+
 // Duplicate SQL Create removed (models.BlogPost does not have AuthorID field)
 
 // GetByID retrieves a blog post by ID (CouchDB)
@@ -114,6 +136,64 @@ func (r *blogRepositorySQL) GetByID(ctx context.Context, id string) (*models.Blo
 	return r.GetBySlug(ctx, slug)
 }
 
+// GetByIDs retrieves the blog posts with the given IDs in one request (CouchDB); missing posts are skipped
+func (r *blogRepository) GetByIDs(ctx context.Context, ids []string) ([]*models.BlogPost, error) {
+	result, err := r.client.AllDocs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blog posts: %w", err)
+	}
+
+	posts := make([]*models.BlogPost, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		if len(row.Doc) == 0 || string(row.Doc) == "null" {
+			continue
+		}
+		var post models.BlogPost
+		if err := json.Unmarshal(row.Doc, &post); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal blog post document: %w", err)
+		}
+		posts = append(posts, &post)
+	}
+
+	return posts, nil
+}
+
+// GetByIDs retrieves the blog posts with the given legacy IDs "blog:{slug}" in one query (SQL)
+func (r *blogRepositorySQL) GetByIDs(ctx context.Context, ids []string) ([]*models.BlogPost, error) {
+	if len(ids) == 0 {
+		return []*models.BlogPost{}, nil
+	}
+	slugs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		slugs = append(slugs, strings.TrimPrefix(id, "blog:"))
+	}
+	rows, err := r.q.ListPostsBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blog posts: %w", err)
+	}
+	posts := make([]*models.BlogPost, 0, len(rows))
+	for _, row := range rows {
+		var content models.Content
+		_ = json.Unmarshal([]byte(row.Content), &content)
+		posts = append(posts, &models.BlogPost{
+			ID:          "blog:" + row.Slug,
+			Type:        "blog_post",
+			Title:       row.Title,
+			Slug:        row.Slug,
+			Excerpt:     derefString(row.Excerpt),
+			Content:     content,
+			Status:      string(row.Status),
+			PublishedAt: nullableTimePtr(row.PublishedAt),
+			Pinned:      row.Pinned,
+			PinnedUntil: nullableTimePtr(row.PinnedUntil),
+			SortWeight:  int(row.SortWeight),
+			CreatedAt:   row.CreatedAt.Time,
+			UpdatedAt:   row.UpdatedAt.Time,
+		})
+	}
+	return posts, nil
+}
+
 // GetBySlug retrieves a blog post by slug (CouchDB)
 func (r *blogRepository) GetBySlug(ctx context.Context, slug string) (*models.BlogPost, error) {
 	// Use the blog_posts/by_slug view
@@ -157,6 +237,9 @@ func (r *blogRepositorySQL) GetBySlug(ctx context.Context, slug string) (*models
 		Content:     content,
 		Status:      string(row.Status),
 		PublishedAt: nullableTimePtr(row.PublishedAt),
+		Pinned:      row.Pinned,
+		PinnedUntil: nullableTimePtr(row.PinnedUntil),
+		SortWeight:  int(row.SortWeight),
 		CreatedAt:   row.CreatedAt.Time,
 		UpdatedAt:   row.UpdatedAt.Time,
 	}
@@ -242,6 +325,9 @@ func (r *blogRepositorySQL) Update(ctx context.Context, post *models.BlogPost) e
 	if err != nil {
 		return fmt.Errorf("failed to update blog post: %w", err)
 	}
+	if err := r.setOrdering(ctx, row.ID, post); err != nil {
+		return err
+	}
 	post.UpdatedAt = updated.UpdatedAt.Time
 	post.CreatedAt = updated.CreatedAt.Time
 	return nil
@@ -619,6 +705,46 @@ func (r *blogRepositorySQL) Search(ctx context.Context, query string, options Li
 	return posts, nil
 }
 
+var _ RelatedPostFinder = (*blogRepositorySQL)(nil)
+
+// FindRelatedPostCandidates returns published posts sharing a category or tag with the post,
+// or whose title is similar by pg_trgm (PostgreSQL)
+func (r *blogRepositorySQL) FindRelatedPostCandidates(ctx context.Context, post *models.BlogPost, limit int) ([]RelatedPostCandidate, error) {
+	rows, err := r.q.ListRelatedPostCandidates(ctx, db.ListRelatedPostCandidatesParams{
+		Categories:    post.Categories,
+		Tags:          post.Tags,
+		Title:         post.Title,
+		Slug:          post.Slug,
+		MaxCandidates: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list related post candidates: %w", err)
+	}
+	candidates := make([]RelatedPostCandidate, 0, len(rows))
+	for _, row := range rows {
+		var content models.Content
+		_ = json.Unmarshal([]byte(row.Content), &content)
+		candidates = append(candidates, RelatedPostCandidate{
+			Post: &models.BlogPost{
+				ID:          "blog:" + row.Slug,
+				Type:        "blog_post",
+				Title:       row.Title,
+				Slug:        row.Slug,
+				Excerpt:     derefString(row.Excerpt),
+				Content:     content,
+				Status:      string(row.Status),
+				PublishedAt: nullableTimePtr(row.PublishedAt),
+				CreatedAt:   row.CreatedAt.Time,
+				UpdatedAt:   row.UpdatedAt.Time,
+			},
+			SharedCategories: int(row.SharedCategories),
+			SharedTags:       int(row.SharedTags),
+			TitleSimilarity:  row.TitleSimilarity,
+		})
+	}
+	return candidates, nil
+}
+
 // GetCategories retrieves all blog categories with post counts (CouchDB)
 func (r *blogRepository) GetCategories(ctx context.Context) ([]*models.BlogCategory, error) {
 	// Use the blog_posts/categories view with reduce
@@ -789,3 +915,350 @@ func (r *blogRepositorySQL) GetPublishedPosts(ctx context.Context, options ListO
 	}
 	return posts, nil
 }
+
+// archiveKeyTime formats a time like the ISO timestamps in the by_published_at view keys
+func archiveKeyTime(t time.Time) []interface{} {
+	t = t.UTC()
+	return []interface{}{t.Year(), int(t.Month()), t.Format("2006-01-02T15:04:05.000Z")}
+}
+
+// publishedRange clamps a published_at range to posts that are already live
+func publishedRange(after, before time.Time) (time.Time, time.Time) {
+	if now := time.Now(); before.IsZero() || before.After(now) {
+		before = now
+	}
+	return after, before
+}
+
+// ListPublishedBetween lists posts published in [after, before) using the by_published_at view (CouchDB)
+func (r *blogRepository) ListPublishedBetween(ctx context.Context, after, before time.Time, options ListOptions) ([]*models.BlogPost, error) {
+	after, before = publishedRange(after, before)
+	if !after.Before(before) {
+		return []*models.BlogPost{}, nil
+	}
+	// Descending ranges run from the start key down to the end key, both inclusive. Keys have
+	// millisecond precision, so the exclusive upper bound is the millisecond before it.
+	params := map[string]interface{}{
+		"reduce":       false,
+		"include_docs": true,
+		"descending":   true,
+		"startkey":     archiveKeyTime(before.Add(-time.Millisecond)),
+		"limit":        options.Limit,
+		"skip":         options.Skip,
+	}
+	if !after.IsZero() {
+		params["endkey"] = archiveKeyTime(after)
+	}
+	result, err := r.client.Query(ctx, "blog_posts", "by_published_at", params)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := []*models.BlogPost{}
+	for _, row := range result.Rows {
+		var post models.BlogPost
+		if err := json.Unmarshal(row.Doc, &post); err != nil {
+			continue
+		}
+		posts = append(posts, &post)
+	}
+	return posts, nil
+}
+
+// ListPublishedBetween lists posts published in [after, before) (PostgreSQL)
+func (r *blogRepositorySQL) ListPublishedBetween(ctx context.Context, after, before time.Time, options ListOptions) ([]*models.BlogPost, error) {
+	after, before = publishedRange(after, before)
+	rows, err := r.q.ListPublishedPostsBetween(ctx, db.ListPublishedPostsBetweenParams{
+		PublishedAfter:  pgtype.Timestamptz{Time: after, Valid: true},
+		PublishedBefore: pgtype.Timestamptz{Time: before, Valid: true},
+		MaxRows:         int32(options.Limit),
+		SkipRows:        int32(options.Skip),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list posts by publication date: %w", err)
+	}
+	posts := make([]*models.BlogPost, 0, len(rows))
+	for _, row := range rows {
+		var content models.Content
+		_ = json.Unmarshal([]byte(row.Content), &content)
+		posts = append(posts, &models.BlogPost{
+			ID:          "blog:" + row.Slug,
+			Type:        "blog_post",
+			Title:       row.Title,
+			Slug:        row.Slug,
+			Excerpt:     derefString(row.Excerpt),
+			Content:     content,
+			Status:      string(row.Status),
+			PublishedAt: nullableTimePtr(row.PublishedAt),
+			CreatedAt:   row.CreatedAt.Time,
+			UpdatedAt:   row.UpdatedAt.Time,
+		})
+	}
+	return posts, nil
+}
+
+// GetArchive counts published posts by month from the by_published_at view (CouchDB)
+func (r *blogRepository) GetArchive(ctx context.Context) ([]*models.BlogArchiveMonth, error) {
+	result, err := r.client.Query(ctx, "blog_posts", "by_published_at", map[string]interface{}{
+		"group_level": 2,
+		"descending":  true,
+		// Scheduled posts are not live yet
+		"startkey": archiveKeyTime(time.Now()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	months := []*models.BlogArchiveMonth{}
+	for _, row := range result.Rows {
+		key, ok := row.Key.([]interface{})
+		if !ok || len(key) < 2 {
+			continue
+		}
+		year, yearOK := key[0].(float64)
+		month, monthOK := key[1].(float64)
+		count, countOK := row.Value.(float64)
+		if yearOK && monthOK && countOK {
+			months = append(months, &models.BlogArchiveMonth{Year: int(year), Month: int(month), PostCount: int(count)})
+		}
+	}
+	return months, nil
+}
+
+// GetArchive counts published posts by month (PostgreSQL)
+func (r *blogRepositorySQL) GetArchive(ctx context.Context) ([]*models.BlogArchiveMonth, error) {
+	rows, err := r.q.GetPostArchive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get post archive: %w", err)
+	}
+	months := make([]*models.BlogArchiveMonth, 0, len(rows))
+	for _, row := range rows {
+		months = append(months, &models.BlogArchiveMonth{Year: int(row.Year), Month: int(row.Month), PostCount: int(row.PostCount)})
+	}
+	return months, nil
+}
+
+// listingRange is a key range of the blog_posts/listing view
+type listingRange struct {
+	start, end []interface{}
+}
+
+// listingKey builds a listing view key from a prefix and further components
+func listingKey(prefix []interface{}, parts ...interface{}) []interface{} {
+	key := make([]interface{}, 0, len(prefix)+len(parts))
+	key = append(key, prefix...)
+	return append(key, parts...)
+}
+
+// ListPosts lists a blog listing from the listing view (CouchDB). The view ranks posts by
+// the stored pin alone; expired pins rank first until ReleaseExpiredPins unpins them.
+func (r *blogRepository) ListPosts(ctx context.Context, query BlogPostQuery, options ListOptions) ([]*models.BlogPost, *PaginationInfo, error) {
+	sortBy := query.Sort
+	if sortBy == "" || query.HasPublishedRange() {
+		sortBy = models.BlogSortPublishedAt
+	}
+	filter, value := "all", ""
+	switch {
+	case query.Status != "" && !query.HasPublishedRange():
+		filter, value = "status", query.Status
+	case query.Category != "":
+		filter, value = "category", query.Category
+	case query.Tag != "":
+		filter, value = "tag", query.Tag
+	case query.Author != "":
+		filter, value = "author", query.Author
+	}
+	if query.HasPublishedRange() {
+		// Date ranges list published posts only, within a category, tag or author if given
+		if filter == "all" {
+			filter, value = "status", models.PageStatusPublished
+		} else {
+			filter = "published_" + filter
+		}
+	}
+	prefix := []interface{}{filter, value, sortBy}
+
+	if !query.HasPublishedRange() {
+		return r.queryListing(ctx, []listingRange{{start: prefix, end: listingKey(prefix, map[string]interface{}{})}}, options)
+	}
+
+	// Publication dates are keyed as negative milliseconds: [after, before) is the key
+	// range from -before (exclusive) to -after, once for pinned posts and once for the rest
+	after, before := publishedRange(query.PublishedAfter, query.PublishedBefore)
+	if !after.Before(before) {
+		return []*models.BlogPost{}, &PaginationInfo{}, nil
+	}
+	var ranges []listingRange
+	for _, rank := range []int{0, 1} {
+		end := listingKey(prefix, rank, map[string]interface{}{})
+		if !after.IsZero() {
+			end = listingKey(prefix, rank, -after.UnixMilli())
+		}
+		ranges = append(ranges, listingRange{start: listingKey(prefix, rank, -before.UnixMilli()+1), end: end})
+	}
+	return r.queryListing(ctx, ranges, options)
+}
+
+// queryListing pages through consecutive ranges of the listing view as if they were one
+// list, counting each range with the view's reduce function
+func (r *blogRepository) queryListing(ctx context.Context, ranges []listingRange, options ListOptions) ([]*models.BlogPost, *PaginationInfo, error) {
+	posts := []*models.BlogPost{}
+	total, skip := 0, options.Skip
+	for _, kr := range ranges {
+		counted, err := r.client.Query(ctx, "blog_posts", "listing", map[string]interface{}{
+			"reduce":   true,
+			"startkey": kr.start,
+			"endkey":   kr.end,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		size := 0
+		if len(counted.Rows) > 0 {
+			if count, ok := counted.Rows[0].Value.(float64); ok {
+				size = int(count)
+			}
+		}
+		total += size
+
+		if skip >= size {
+			skip -= size
+			continue
+		}
+		if options.Limit > 0 && len(posts) >= options.Limit {
+			continue
+		}
+		params := map[string]interface{}{
+			"reduce":       false,
+			"include_docs": true,
+			"startkey":     kr.start,
+			"endkey":       kr.end,
+			"skip":         skip,
+		}
+		if options.Limit > 0 {
+			params["limit"] = options.Limit - len(posts)
+		}
+		skip = 0
+		result, err := r.client.Query(ctx, "blog_posts", "listing", params)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range result.Rows {
+			var post models.BlogPost
+			if err := json.Unmarshal(row.Doc, &post); err != nil {
+				continue
+			}
+			posts = append(posts, &post)
+		}
+	}
+
+	return posts, &PaginationInfo{TotalCount: total, HasMore: options.Skip+len(posts) < total}, nil
+}
+
+// ReleaseExpiredPins unpins the posts whose pin expired by now (CouchDB). The posts keep
+// their updated_at since nobody edited them. A failed write, typically a conflict with an
+// edit made meanwhile, leaves the post to the next run and is reported once all are tried.
+func (r *blogRepository) ReleaseExpiredPins(ctx context.Context, now time.Time) (int, error) {
+	result, err := r.client.Query(ctx, "blog_posts", "pin_expiry", map[string]interface{}{
+		"include_docs": true,
+		"endkey":       now.UnixMilli(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	released, failed := 0, 0
+	var firstErr error
+	for _, row := range result.Rows {
+		var post models.BlogPost
+		if err := json.Unmarshal(row.Doc, &post); err != nil {
+			continue
+		}
+		post.Pinned, post.PinnedUntil = false, nil
+		if _, err := r.client.Put(ctx, post.ID, &post); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed++
+			continue
+		}
+		released++
+	}
+	if firstErr != nil {
+		return released, fmt.Errorf("failed to release %d expired pins: %w", failed, firstErr)
+	}
+	return released, nil
+}
+
+// ReleaseExpiredPins is a no-op (PostgreSQL): the listing query compares pinned_until with
+// now(), and unpinning here would bump updated_at through the trigger.
+func (r *blogRepositorySQL) ReleaseExpiredPins(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
+
+// ListPosts lists a blog listing in one query ordered by the pin and sort field (PostgreSQL)
+func (r *blogRepositorySQL) ListPosts(ctx context.Context, query BlogPostQuery, options ListOptions) ([]*models.BlogPost, *PaginationInfo, error) {
+	sortBy := query.Sort
+	if sortBy == "" || query.HasPublishedRange() {
+		sortBy = models.BlogSortPublishedAt
+	}
+	params := db.ListPostsForListingParams{
+		SortBy:   sortBy,
+		MaxRows:  int32(options.Limit),
+		SkipRows: int32(options.Skip),
+	}
+	if query.HasPublishedRange() {
+		after, before := publishedRange(query.PublishedAfter, query.PublishedBefore)
+		params.Status = nullableStringPtr(models.PageStatusPublished)
+		params.PublishedAfter = pgtype.Timestamptz{Time: after, Valid: !after.IsZero()}
+		params.PublishedBefore = pgtype.Timestamptz{Time: before, Valid: true}
+	}
+	switch {
+	case query.Status != "" && !query.HasPublishedRange():
+		params.Status = nullableStringPtr(query.Status)
+	case query.Category != "":
+		params.Category = nullableStringPtr(query.Category)
+	case query.Tag != "":
+		params.Tag = nullableStringPtr(query.Tag)
+	case query.Author != "":
+		if err := params.AuthorID.Scan(query.Author); err != nil {
+			return nil, nil, fmt.Errorf("invalid author id: %w", err)
+		}
+	}
+
+	total, err := r.q.CountPostsForListing(ctx, db.CountPostsForListingParams{
+		Status:          params.Status,
+		AuthorID:        params.AuthorID,
+		Category:        params.Category,
+		Tag:             params.Tag,
+		PublishedAfter:  params.PublishedAfter,
+		PublishedBefore: params.PublishedBefore,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to count blog posts: %w", err)
+	}
+	rows, err := r.q.ListPostsForListing(ctx, params)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list blog posts: %w", err)
+	}
+	posts := make([]*models.BlogPost, 0, len(rows))
+	for _, row := range rows {
+		var content models.Content
+		_ = json.Unmarshal([]byte(row.Content), &content)
+		posts = append(posts, &models.BlogPost{
+			ID:          "blog:" + row.Slug,
+			Type:        "blog_post",
+			Title:       row.Title,
+			Slug:        row.Slug,
+			Excerpt:     derefString(row.Excerpt),
+			Content:     content,
+			Status:      string(row.Status),
+			PublishedAt: nullableTimePtr(row.PublishedAt),
+			Pinned:      row.Pinned,
+			PinnedUntil: nullableTimePtr(row.PinnedUntil),
+			SortWeight:  int(row.SortWeight),
+			CreatedAt:   row.CreatedAt.Time,
+			UpdatedAt:   row.UpdatedAt.Time,
+		})
+	}
+	return posts, &PaginationInfo{TotalCount: int(total), HasMore: options.Skip+len(posts) < int(total)}, nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// collectionRepositorySQL implements CollectionRepository (PostgreSQL/sqlc)
+type collectionRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ CollectionRepository = (*collectionRepositorySQL)(nil)
+
+// NewCollectionRepositorySQL creates a new SQL-backed collection repository using the Postgres client
+func NewCollectionRepositorySQL(c *database.PostgresClient) CollectionRepository {
+	return &collectionRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *collectionRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// Create inserts a new collection; posts are added separately with SetPosts
+func (r *collectionRepositorySQL) Create(ctx context.Context, collection *models.Collection) error {
+	row, err := r.getQ(ctx).InsertCollection(ctx, db.InsertCollectionParams{
+		Slug:        collection.Slug,
+		Title:       collection.Title,
+		Description: nullableStringPtr(collection.Description),
+		Kind:        collection.Kind,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create collection: %w", appErr.MapDBError(err))
+	}
+
+	postIDs := collection.PostIDs
+	*collection = *mapSQLCCollection(row)
+	if len(postIDs) > 0 {
+		if err := r.SetPosts(ctx, collection.ID, postIDs); err != nil {
+			return err
+		}
+		collection.PostIDs = postIDs
+	}
+	return nil
+}
+
+// GetByID retrieves a collection by its UUID
+func (r *collectionRepositorySQL) GetByID(ctx context.Context, id string) (*models.Collection, error) {
+	row, err := r.getQ(ctx).GetCollectionByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %w", appErr.MapDBError(err))
+	}
+	return r.withPosts(ctx, mapSQLCCollection(row))
+}
+
+// GetBySlug retrieves a collection by its slug
+func (r *collectionRepositorySQL) GetBySlug(ctx context.Context, slug string) (*models.Collection, error) {
+	row, err := r.getQ(ctx).GetCollectionBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection by slug: %w", appErr.MapDBError(err))
+	}
+	return r.withPosts(ctx, mapSQLCCollection(row))
+}
+
+// Update saves the collection's title, slug and description
+func (r *collectionRepositorySQL) Update(ctx context.Context, collection *models.Collection) error {
+	row, err := r.getQ(ctx).UpdateCollection(ctx, db.UpdateCollectionParams{
+		ID:          parseUUIDToPgtype(collection.ID),
+		Slug:        collection.Slug,
+		Title:       collection.Title,
+		Description: nullableStringPtr(collection.Description),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update collection: %w", appErr.MapDBError(err))
+	}
+
+	postIDs := collection.PostIDs
+	*collection = *mapSQLCCollection(row)
+	collection.PostIDs = postIDs
+	return nil
+}
+
+// Delete removes a collection; its post memberships are removed by cascade
+func (r *collectionRepositorySQL) Delete(ctx context.Context, id string) error {
+	if err := r.getQ(ctx).DeleteCollectionByID(ctx, parseUUIDToPgtype(id)); err != nil {
+		return fmt.Errorf("failed to delete collection: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// List returns collections ordered by title, optionally filtered by kind
+func (r *collectionRepositorySQL) List(ctx context.Context, kind string, options ListOptions) ([]*models.Collection, error) {
+	rows, err := r.getQ(ctx).ListCollections(ctx, db.ListCollectionsParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+		Kind:   nullableStringPtr(kind),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", appErr.MapDBError(err))
+	}
+	return r.mapWithPosts(ctx, rows)
+}
+
+// ListByPost returns the collections of the given kind that contain a post
+func (r *collectionRepositorySQL) ListByPost(ctx context.Context, postID, kind string) ([]*models.Collection, error) {
+	rows, err := r.getQ(ctx).ListCollectionsByPost(ctx, db.ListCollectionsByPostParams{
+		PostID: postID,
+		Kind:   kind,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections by post: %w", appErr.MapDBError(err))
+	}
+	return r.mapWithPosts(ctx, rows)
+}
+
+// SetPosts replaces the ordered post list of a collection.
+// Callers that need atomicity should run it inside a UnitOfWork.
+func (r *collectionRepositorySQL) SetPosts(ctx context.Context, id string, postIDs []string) error {
+	q := r.getQ(ctx)
+	collectionID := parseUUIDToPgtype(id)
+	if err := q.DeleteCollectionPosts(ctx, collectionID); err != nil {
+		return fmt.Errorf("failed to clear collection posts: %w", appErr.MapDBError(err))
+	}
+	if len(postIDs) > 0 {
+		if err := q.InsertCollectionPosts(ctx, db.InsertCollectionPostsParams{
+			CollectionID: collectionID,
+			PostIds:      postIDs,
+		}); err != nil {
+			return fmt.Errorf("failed to set collection posts: %w", appErr.MapDBError(err))
+		}
+	}
+	if err := q.TouchCollection(ctx, collectionID); err != nil {
+		return fmt.Errorf("failed to touch collection: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// RemovePost removes a post from every collection
+func (r *collectionRepositorySQL) RemovePost(ctx context.Context, postID string) error {
+	if err := r.getQ(ctx).DeletePostFromCollections(ctx, postID); err != nil {
+		return fmt.Errorf("failed to remove post from collections: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// withPosts loads the ordered post IDs of a single collection
+func (r *collectionRepositorySQL) withPosts(ctx context.Context, collection *models.Collection) (*models.Collection, error) {
+	rows, err := r.getQ(ctx).ListCollectionPosts(ctx, []pgtype.UUID{parseUUIDToPgtype(collection.ID)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collection posts: %w", appErr.MapDBError(err))
+	}
+	for _, row := range rows {
+		collection.PostIDs = append(collection.PostIDs, row.PostID)
+	}
+	return collection, nil
+}
+
+// mapWithPosts converts rows and loads the post IDs of every collection in one query
+func (r *collectionRepositorySQL) mapWithPosts(ctx context.Context, rows []db.Collection) ([]*models.Collection, error) {
+	out := make([]*models.Collection, 0, len(rows))
+	if len(rows) == 0 {
+		return out, nil
+	}
+
+	ids := make([]pgtype.UUID, len(rows))
+	byID := make(map[string]*models.Collection, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+		collection := mapSQLCCollection(row)
+		byID[collection.ID] = collection
+		out = append(out, collection)
+	}
+
+	postRows, err := r.getQ(ctx).ListCollectionPosts(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collection posts: %w", appErr.MapDBError(err))
+	}
+	for _, row := range postRows {
+		if collection, ok := byID[row.CollectionID.String()]; ok {
+			collection.PostIDs = append(collection.PostIDs, row.PostID)
+		}
+	}
+	return out, nil
+}
+
+// mapSQLCCollection converts a sqlc row to the outward model
+func mapSQLCCollection(row db.Collection) *models.Collection {
+	return &models.Collection{
+		ID:          row.ID.String(),
+		Slug:        row.Slug,
+		Title:       row.Title,
+		Description: derefString(row.Description),
+		Kind:        row.Kind,
+		PostIDs:     []string{},
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
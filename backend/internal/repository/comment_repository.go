@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// commentRepositorySQL implements CommentRepository (PostgreSQL/sqlc)
+type commentRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ CommentRepository = (*commentRepositorySQL)(nil)
+
+// NewCommentRepositorySQL creates a new SQL-backed comment repository using the Postgres client
+func NewCommentRepositorySQL(c *database.PostgresClient) CommentRepository {
+	return &commentRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *commentRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// Create inserts a new comment
+func (r *commentRepositorySQL) Create(ctx context.Context, comment *models.Comment) error {
+	row, err := r.getQ(ctx).InsertComment(ctx, db.InsertCommentParams{
+		PostID:       comment.PostID,
+		ParentID:     parseUUIDToPgtype(comment.ParentID),
+		AuthorUserID: nullableStringPtr(comment.AuthorUserID),
+		AuthorName:   comment.AuthorName,
+		AuthorEmail:  comment.AuthorEmail,
+		AuthorUrl:    nullableStringPtr(comment.AuthorURL),
+		Body:         comment.Body,
+		Status:       comment.Status,
+		IpAddress:    nullableStringPtr(comment.IPAddress),
+		UserAgent:    nullableStringPtr(comment.UserAgent),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", appErr.MapDBError(err))
+	}
+
+	*comment = *mapSQLCComment(row)
+	return nil
+}
+
+// GetByID retrieves a comment by its UUID
+func (r *commentRepositorySQL) GetByID(ctx context.Context, id string) (*models.Comment, error) {
+	row, err := r.getQ(ctx).GetCommentByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCComment(row), nil
+}
+
+// ListByPost returns all comments of a post in the given state, oldest first
+func (r *commentRepositorySQL) ListByPost(ctx context.Context, postID, status string) ([]*models.Comment, error) {
+	rows, err := r.getQ(ctx).ListCommentsByPostAndStatus(ctx, db.ListCommentsByPostAndStatusParams{
+		PostID: postID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.Comment, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCComment(row))
+	}
+	return out, nil
+}
+
+// ListByStatus returns a page of comments in the given moderation state, oldest first
+func (r *commentRepositorySQL) ListByStatus(ctx context.Context, status, postID string, options ListOptions) ([]*models.Comment, *PaginationInfo, error) {
+	q := r.getQ(ctx)
+	rows, err := q.ListCommentsByStatus(ctx, db.ListCommentsByStatusParams{
+		Status: status,
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+		PostID: nullableStringPtr(postID),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list comments: %w", appErr.MapDBError(err))
+	}
+	total, err := q.CountCommentsByStatus(ctx, db.CountCommentsByStatusParams{
+		Status: status,
+		PostID: nullableStringPtr(postID),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to count comments: %w", appErr.MapDBError(err))
+	}
+
+	out := make([]*models.Comment, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCComment(row))
+	}
+
+	info := &PaginationInfo{TotalCount: int(total)}
+	if next := options.Skip + len(out); next < int(total) {
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(next)
+	}
+	return out, info, nil
+}
+
+// UpdateStatus moves a comment to a new moderation state
+func (r *commentRepositorySQL) UpdateStatus(ctx context.Context, id, status, moderatedBy string) (*models.Comment, error) {
+	row, err := r.getQ(ctx).UpdateCommentStatus(ctx, db.UpdateCommentStatusParams{
+		ID:          parseUUIDToPgtype(id),
+		Status:      status,
+		ModeratedBy: nullableStringPtr(moderatedBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment status: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCComment(row), nil
+}
+
+// CountApprovedByPosts returns approved comment counts keyed by post ID; posts without comments are omitted
+func (r *commentRepositorySQL) CountApprovedByPosts(ctx context.Context, postIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(postIDs))
+	if len(postIDs) == 0 {
+		return counts, nil
+	}
+	rows, err := r.getQ(ctx).CountApprovedCommentsByPosts(ctx, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", appErr.MapDBError(err))
+	}
+	for _, row := range rows {
+		counts[row.PostID] = int(row.CommentCount)
+	}
+	return counts, nil
+}
+
+// mapSQLCComment converts a sqlc row to the outward model
+func mapSQLCComment(row db.Comment) *models.Comment {
+	c := &models.Comment{
+		ID:           row.ID.String(),
+		PostID:       row.PostID,
+		AuthorUserID: derefString(row.AuthorUserID),
+		AuthorName:   row.AuthorName,
+		AuthorEmail:  row.AuthorEmail,
+		AuthorURL:    derefString(row.AuthorUrl),
+		Body:         row.Body,
+		Status:       row.Status,
+		IPAddress:    derefString(row.IpAddress),
+		UserAgent:    derefString(row.UserAgent),
+		ModeratedBy:  derefString(row.ModeratedBy),
+		ModeratedAt:  nullableTimePtr(row.ModeratedAt),
+		CreatedAt:    row.CreatedAt.Time,
+		UpdatedAt:    row.UpdatedAt.Time,
+	}
+	if row.ParentID.Valid {
+		c.ParentID = row.ParentID.String()
+	}
+	return c
+}
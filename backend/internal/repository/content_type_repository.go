@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// contentTypeRepositorySQL implements ContentTypeRepository (PostgreSQL/sqlc)
+type contentTypeRepositorySQL struct {
+	q *db.Queries
+	// pool runs the entry listing queries sqlc cannot express
+	pool db.DBTX
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ ContentTypeRepository = (*contentTypeRepositorySQL)(nil)
+
+// NewContentTypeRepositorySQL creates a new SQL-backed content type repository using the Postgres client
+func NewContentTypeRepositorySQL(c *database.PostgresClient) ContentTypeRepository {
+	r := &contentTypeRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+	if c != nil && c.Pool() != nil {
+		r.pool = c.Pool()
+	}
+	return r
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *contentTypeRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// CreateType inserts a new content type
+func (r *contentTypeRepositorySQL) CreateType(ctx context.Context, contentType *models.ContentType) error {
+	fields, err := json.Marshal(contentType.Fields)
+	if err != nil {
+		return fmt.Errorf("failed to marshal content type fields: %w", err)
+	}
+
+	row, err := r.getQ(ctx).InsertContentType(ctx, db.InsertContentTypeParams{
+		Slug:        contentType.Slug,
+		Name:        contentType.Name,
+		Description: nullableStringPtr(contentType.Description),
+		Fields:      fields,
+		CreatedBy:   nullableStringPtr(contentType.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create content type: %w", appErr.MapDBError(err))
+	}
+	*contentType = *mapSQLCContentType(row)
+	return nil
+}
+
+// GetType retrieves a content type by its UUID
+func (r *contentTypeRepositorySQL) GetType(ctx context.Context, id string) (*models.ContentType, error) {
+	row, err := r.getQ(ctx).GetContentTypeByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get content type: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCContentType(row), nil
+}
+
+// GetTypeBySlug retrieves a content type by its slug
+func (r *contentTypeRepositorySQL) GetTypeBySlug(ctx context.Context, slug string) (*models.ContentType, error) {
+	row, err := r.getQ(ctx).GetContentTypeBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get content type by slug: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCContentType(row), nil
+}
+
+// ListTypes returns content types ordered by name
+func (r *contentTypeRepositorySQL) ListTypes(ctx context.Context, options ListOptions) ([]*models.ContentType, error) {
+	rows, err := r.getQ(ctx).ListContentTypes(ctx, db.ListContentTypesParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content types: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.ContentType, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCContentType(row))
+	}
+	return out, nil
+}
+
+// UpdateType saves the slug, name, description and fields of a content type
+func (r *contentTypeRepositorySQL) UpdateType(ctx context.Context, contentType *models.ContentType) error {
+	fields, err := json.Marshal(contentType.Fields)
+	if err != nil {
+		return fmt.Errorf("failed to marshal content type fields: %w", err)
+	}
+
+	row, err := r.getQ(ctx).UpdateContentType(ctx, db.UpdateContentTypeParams{
+		ID:          parseUUIDToPgtype(contentType.ID),
+		Slug:        contentType.Slug,
+		Name:        contentType.Name,
+		Description: nullableStringPtr(contentType.Description),
+		Fields:      fields,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update content type: %w", appErr.MapDBError(err))
+	}
+	*contentType = *mapSQLCContentType(row)
+	return nil
+}
+
+// DeleteType drops the field indexes of a content type, then deletes it with its entries
+func (r *contentTypeRepositorySQL) DeleteType(ctx context.Context, id string) error {
+	if err := r.SyncFieldIndexes(ctx, id, nil); err != nil {
+		return err
+	}
+	affected, err := r.getQ(ctx).DeleteContentType(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete content type: %w", appErr.MapDBError(err))
+	}
+	if affected == 0 {
+		return appErr.ErrNotFound
+	}
+	return nil
+}
+
+// SyncFieldIndexes creates the expression indexes of the given fields and drops stale ones
+func (r *contentTypeRepositorySQL) SyncFieldIndexes(ctx context.Context, contentTypeID string, keys []string) error {
+	if keys == nil {
+		keys = []string{}
+	}
+	err := r.getQ(ctx).SyncEntryFieldIndexes(ctx, db.SyncEntryFieldIndexesParams{
+		ContentTypeID: parseUUIDToPgtype(contentTypeID),
+		Fields:        keys,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync entry field indexes: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// CountEntries returns the number of entries of a content type
+func (r *contentTypeRepositorySQL) CountEntries(ctx context.Context, contentTypeID string) (int, error) {
+	count, err := r.getQ(ctx).CountEntriesByContentType(ctx, parseUUIDToPgtype(contentTypeID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count entries: %w", appErr.MapDBError(err))
+	}
+	return int(count), nil
+}
+
+// CreateEntry inserts a new entry
+func (r *contentTypeRepositorySQL) CreateEntry(ctx context.Context, entry *models.Entry) error {
+	data, err := marshalEntryData(entry.Data)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.getQ(ctx).InsertEntry(ctx, db.InsertEntryParams{
+		ContentTypeID: parseUUIDToPgtype(entry.ContentTypeID),
+		Slug:          entry.Slug,
+		Status:        entry.Status,
+		Data:          data,
+		PublishedAt:   timePtrToPgtype(entry.PublishedAt),
+		CreatedBy:     nullableStringPtr(entry.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create entry: %w", appErr.MapDBError(err))
+	}
+	*entry = *mapSQLCEntry(row)
+	return nil
+}
+
+// GetEntry retrieves an entry by its UUID
+func (r *contentTypeRepositorySQL) GetEntry(ctx context.Context, id string) (*models.Entry, error) {
+	row, err := r.getQ(ctx).GetEntryByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entry: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCEntry(row), nil
+}
+
+// GetEntryBySlug retrieves an entry of a content type by its slug
+func (r *contentTypeRepositorySQL) GetEntryBySlug(ctx context.Context, contentTypeID, slug string) (*models.Entry, error) {
+	row, err := r.getQ(ctx).GetEntryBySlug(ctx, db.GetEntryBySlugParams{
+		ContentTypeID: parseUUIDToPgtype(contentTypeID),
+		Slug:          slug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entry by slug: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCEntry(row), nil
+}
+
+// UpdateEntry saves the slug, status, data and publish date of an entry
+func (r *contentTypeRepositorySQL) UpdateEntry(ctx context.Context, entry *models.Entry) error {
+	data, err := marshalEntryData(entry.Data)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.getQ(ctx).UpdateEntry(ctx, db.UpdateEntryParams{
+		ID:          parseUUIDToPgtype(entry.ID),
+		Slug:        entry.Slug,
+		Status:      entry.Status,
+		Data:        data,
+		PublishedAt: timePtrToPgtype(entry.PublishedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update entry: %w", appErr.MapDBError(err))
+	}
+	*entry = *mapSQLCEntry(row)
+	return nil
+}
+
+// DeleteEntry deletes an entry
+func (r *contentTypeRepositorySQL) DeleteEntry(ctx context.Context, id string) error {
+	affected, err := r.getQ(ctx).DeleteEntry(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete entry: %w", appErr.MapDBError(err))
+	}
+	if affected == 0 {
+		return appErr.ErrNotFound
+	}
+	return nil
+}
+
+// entryColumns lists the entries columns in the order scanEntryRow reads them
+const entryColumns = "id, content_type_id, slug, status, data, published_at, created_by, created_at, updated_at"
+
+// ListEntries lists the entries of a content type whose fields contain the filter values.
+// The SQL is built per query because the per-field indexes kept by sync_entry_field_indexes
+// only serve predicates naming the field and content type as literals.
+func (r *contentTypeRepositorySQL) ListEntries(ctx context.Context, query EntryQuery) ([]*models.Entry, *PaginationInfo, error) {
+	if r.pool == nil {
+		return nil, nil, fmt.Errorf("failed to list entries: no database connection")
+	}
+	where, args, err := entryWhereSQL(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	listArgs := append(append([]interface{}{}, args...), int32(query.Options.Limit), int32(query.Options.Skip))
+	rows, err := r.pool.Query(ctx, fmt.Sprintf(
+		"SELECT %s FROM entries WHERE %s ORDER BY %s LIMIT $%d OFFSET $%d",
+		entryColumns, where, entryOrderSQL(query), len(args)+1, len(args)+2,
+	), listArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list entries: %w", appErr.MapDBError(err))
+	}
+	defer rows.Close()
+
+	out := []*models.Entry{}
+	for rows.Next() {
+		var row db.Entry
+		if err := rows.Scan(
+			&row.ID, &row.ContentTypeID, &row.Slug, &row.Status, &row.Data,
+			&row.PublishedAt, &row.CreatedBy, &row.CreatedAt, &row.UpdatedAt,
+		); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan entry: %w", appErr.MapDBError(err))
+		}
+		out = append(out, mapSQLCEntry(row))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list entries: %w", appErr.MapDBError(err))
+	}
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, "SELECT COUNT(*) FROM entries WHERE "+where, args...).Scan(&total); err != nil {
+		return nil, nil, fmt.Errorf("failed to count entries: %w", appErr.MapDBError(err))
+	}
+
+	info := &PaginationInfo{TotalCount: int(total)}
+	if next := query.Options.Skip + len(out); next < int(total) {
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(next)
+	}
+	return out, info, nil
+}
+
+// entryWhereSQL builds the condition of an entry query. Each filter becomes
+// (data -> 'key') @> value, the expression of the field's index; values stay parameters.
+func entryWhereSQL(query EntryQuery) (string, []interface{}, error) {
+	contentTypeID := parseUUIDToPgtype(query.ContentTypeID)
+	if !contentTypeID.Valid {
+		return "", nil, fmt.Errorf("invalid content type ID '%s'", query.ContentTypeID)
+	}
+
+	conditions := []string{"content_type_id = " + quoteSQLLiteral(contentTypeID.String())}
+	var args []interface{}
+	if query.Status != "" {
+		args = append(args, query.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	keys := make([]string, 0, len(query.Filter))
+	for key := range query.Filter {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, err := json.Marshal(query.Filter[key])
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal filter '%s': %w", key, err)
+		}
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf("(data -> %s) @> $%d::jsonb", quoteSQLLiteral(key), len(args)))
+	}
+	return strings.Join(conditions, " AND "), args, nil
+}
+
+// entryOrderSQL builds the ORDER BY of an entry query; ties fall back to newest first
+func entryOrderSQL(query EntryQuery) string {
+	direction := "DESC"
+	if query.Options.Order == "asc" {
+		direction = "ASC"
+	}
+
+	var first string
+	switch {
+	case query.SortField != "":
+		first = fmt.Sprintf("data -> %s %s NULLS LAST", quoteSQLLiteral(query.SortField), direction)
+	case query.Options.SortBy == "slug" || query.Options.SortBy == "updated_at":
+		first = query.Options.SortBy + " " + direction
+	case query.Options.SortBy == "published_at":
+		first = "published_at " + direction + " NULLS LAST"
+	default:
+		first = "created_at " + direction
+	}
+	return first + ", created_at DESC, id ASC"
+}
+
+// quoteSQLLiteral quotes s as a SQL string literal
+func quoteSQLLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func marshalEntryData(data map[string]interface{}) ([]byte, error) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	out, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal entry data: %w", err)
+	}
+	return out, nil
+}
+
+// mapSQLCContentType converts a sqlc row to the outward model
+func mapSQLCContentType(row db.ContentType) *models.ContentType {
+	var fields []models.ContentTypeField
+	if err := json.Unmarshal(row.Fields, &fields); err != nil || fields == nil {
+		fields = []models.ContentTypeField{}
+	}
+	return &models.ContentType{
+		ID:          row.ID.String(),
+		Slug:        row.Slug,
+		Name:        row.Name,
+		Description: derefString(row.Description),
+		Fields:      fields,
+		CreatedBy:   derefString(row.CreatedBy),
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
+
+// mapSQLCEntry converts a sqlc row to the outward model
+func mapSQLCEntry(row db.Entry) *models.Entry {
+	data := map[string]interface{}{}
+	_ = json.Unmarshal(row.Data, &data)
+	return &models.Entry{
+		ID:            row.ID.String(),
+		ContentTypeID: row.ContentTypeID.String(),
+		Slug:          row.Slug,
+		Status:        row.Status,
+		Data:          data,
+		PublishedAt:   nullableTimePtr(row.PublishedAt),
+		CreatedBy:     derefString(row.CreatedBy),
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     row.UpdatedAt.Time,
+	}
+}
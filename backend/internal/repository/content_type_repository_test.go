@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+func TestEntryWhereSQL_FiltersUseFieldIndexExpression(t *testing.T) {
+	where, args, err := entryWhereSQL(EntryQuery{
+		ContentTypeID: "7d3c9b52-6a8e-4c1f-9e2a-0b5f4d8c1a23",
+		Status:        "published",
+		Filter: map[string]interface{}{
+			"remote": true,
+			"skills": []interface{}{"go"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "content_type_id = '7d3c9b52-6a8e-4c1f-9e2a-0b5f4d8c1a23' AND status = $1 AND "+
+		"(data -> 'remote') @> $2::jsonb AND (data -> 'skills') @> $3::jsonb", where)
+	assert.Equal(t, []interface{}{"published", []byte(`true`), []byte(`["go"]`)}, args)
+
+	_, _, err = entryWhereSQL(EntryQuery{ContentTypeID: "job"})
+	assert.Error(t, err)
+}
+
+func TestEntryOrderSQL(t *testing.T) {
+	assert.Equal(t, "created_at DESC, created_at DESC, id ASC", entryOrderSQL(EntryQuery{}))
+	assert.Equal(t, "data -> 'salary' ASC NULLS LAST, created_at DESC, id ASC",
+		entryOrderSQL(EntryQuery{SortField: "salary", Options: ListOptions{Order: "asc"}}))
+	assert.Equal(t, "published_at DESC NULLS LAST, created_at DESC, id ASC",
+		entryOrderSQL(EntryQuery{Options: ListOptions{SortBy: "published_at"}}))
+}
+
+// TestContentTypeRepositorySQL_FieldIndexes checks that filterable fields get an index
+// the ListEntries predicate can use, and that the index follows the field definitions
+func TestContentTypeRepositorySQL_FieldIndexes(t *testing.T) {
+	client := SetupTestPGClient(t)
+	ctx := context.Background()
+	applyMigration(ctx, t, client.Pool(), "000009_content_types.sql")
+
+	repo := NewContentTypeRepositorySQL(client)
+	job := &models.ContentType{Slug: "job-index-test", Name: "Job"}
+	require.NoError(t, repo.CreateType(ctx, job))
+	t.Cleanup(func() { _ = repo.DeleteType(ctx, job.ID) })
+
+	require.NoError(t, repo.SyncFieldIndexes(ctx, job.ID, []string{"level", "remote"}))
+	indexes := entryFieldIndexes(ctx, t, client.Pool(), job.ID)
+	require.Len(t, indexes, 2)
+	assert.Contains(t, indexes["level"].def, "jsonb_path_ops")
+
+	// With sequential scans off the planner must pick the field index if it matches
+	where, args, err := entryWhereSQL(EntryQuery{ContentTypeID: job.ID, Filter: map[string]interface{}{"level": "senior"}})
+	require.NoError(t, err)
+	conn, err := client.Pool().Acquire(ctx)
+	require.NoError(t, err)
+	defer conn.Release()
+	_, err = conn.Exec(ctx, "SET enable_seqscan = off")
+	require.NoError(t, err)
+	defer conn.Exec(ctx, "RESET enable_seqscan")
+	var plan string
+	rows, err := conn.Query(ctx, "EXPLAIN SELECT id FROM entries WHERE "+where, args...)
+	require.NoError(t, err)
+	for rows.Next() {
+		var line string
+		require.NoError(t, rows.Scan(&line))
+		plan += line + "\n"
+	}
+	require.NoError(t, rows.Err())
+	assert.Contains(t, plan, indexes["level"].name)
+
+	// Updating the type drops the indexes of fields that are no longer filterable
+	require.NoError(t, repo.SyncFieldIndexes(ctx, job.ID, []string{"remote"}))
+	indexes = entryFieldIndexes(ctx, t, client.Pool(), job.ID)
+	assert.NotContains(t, indexes, "level")
+	assert.Contains(t, indexes, "remote")
+}
+
+// applyMigration runs a migration file against the test database
+func applyMigration(ctx context.Context, t *testing.T, pool *pgxpool.Pool, name string) {
+	t.Helper()
+	sql, err := os.ReadFile("../../migrations/" + name)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, string(sql))
+	require.NoError(t, err, "failed to apply %s", name)
+}
+
+type entryFieldIndex struct {
+	name string
+	def  string
+}
+
+// entryFieldIndexes maps each indexed field of a content type to its index
+func entryFieldIndexes(ctx context.Context, t *testing.T, pool *pgxpool.Pool, contentTypeID string) map[string]entryFieldIndex {
+	t.Helper()
+	rows, err := pool.Query(ctx, `
+SELECT i.indexname, i.indexdef, f.key
+FROM pg_indexes i
+CROSS JOIN LATERAL (SELECT substring(i.indexdef FROM '\(data -> ''([a-z0-9_]+)''::text\)') AS key) f
+WHERE i.tablename = 'entries' AND i.indexdef LIKE $1`, fmt.Sprintf("%%content_type_id = '%s'::uuid%%", contentTypeID))
+	require.NoError(t, err)
+	defer rows.Close()
+
+	out := map[string]entryFieldIndex{}
+	for rows.Next() {
+		var name, def string
+		var key *string
+		require.NoError(t, rows.Scan(&name, &def, &key))
+		require.NotNil(t, key, "index %s is not a field index: %s", name, def)
+		out[*key] = entryFieldIndex{name: name, def: def}
+	}
+	require.NoError(t, rows.Err())
+	return out
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/7-solutions/saas-platformbackend/internal/models"
 )
@@ -28,6 +30,8 @@ type PageRepository interface {
 type UserRepository interface {
 	Create(ctx context.Context, user *models.User) error
 	GetByID(ctx context.Context, id string) (*models.User, error)
+	// GetByIDs retrieves the users with the given IDs in one call; unknown IDs are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*models.User, error)
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
 	Delete(ctx context.Context, id string) error
@@ -39,6 +43,8 @@ type UserRepository interface {
 type MediaRepository interface {
 	Create(ctx context.Context, media *models.Media) error
 	GetByID(ctx context.Context, id string) (*models.Media, error)
+	// GetByIDs retrieves the media with the given IDs in one call; unknown IDs are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*models.Media, error)
 	GetByFilename(ctx context.Context, filename string) (*models.Media, error)
 	Update(ctx context.Context, media *models.Media) error
 	Delete(ctx context.Context, id string) error
@@ -50,6 +56,8 @@ type MediaRepository interface {
 type BlogRepository interface {
 	Create(ctx context.Context, post *models.BlogPost) error
 	GetByID(ctx context.Context, id string) (*models.BlogPost, error)
+	// GetByIDs retrieves the posts with the given IDs in one call; unknown IDs are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*models.BlogPost, error)
 	GetBySlug(ctx context.Context, slug string) (*models.BlogPost, error)
 	Update(ctx context.Context, post *models.BlogPost) error
 	Delete(ctx context.Context, id string) error
@@ -62,6 +70,53 @@ type BlogRepository interface {
 	GetCategories(ctx context.Context) ([]*models.BlogCategory, error)
 	GetTags(ctx context.Context) ([]*models.BlogTag, error)
 	GetPublishedPosts(ctx context.Context, options ListOptions) ([]*models.BlogPost, error)
+	// ListPublishedBetween lists posts published in [after, before), excluding scheduled posts;
+	// a zero time leaves that end open
+	ListPublishedBetween(ctx context.Context, after, before time.Time, options ListOptions) ([]*models.BlogPost, error)
+	// GetArchive counts published posts by UTC year and month, newest first
+	GetArchive(ctx context.Context) ([]*models.BlogArchiveMonth, error)
+	// ListPosts lists the posts of a blog listing with pinned posts first, then in the
+	// query's sort order. It returns the page and the total count.
+	ListPosts(ctx context.Context, query BlogPostQuery, options ListOptions) ([]*models.BlogPost, *PaginationInfo, error)
+	// ReleaseExpiredPins unpins the posts whose pin expired by now and returns how many it released
+	ReleaseExpiredPins(ctx context.Context, now time.Time) (int, error)
+}
+
+// BlogPostQuery selects and orders the posts of a blog listing. Status, Category, Tag and
+// Author are alternatives, applied in that order of precedence. A publication date range
+// only lists published posts and combines with Category, Tag or Author.
+type BlogPostQuery struct {
+	Status   string
+	Category string
+	Tag      string
+	Author   string
+	// PublishedAfter and PublishedBefore bound the range [after, before) like
+	// ListPublishedBetween; a zero time leaves that end open
+	PublishedAfter  time.Time
+	PublishedBefore time.Time
+	// Sort is one of the models.BlogSort* orders; empty means models.BlogSortPublishedAt.
+	// Date ranges are only listed by publication date.
+	Sort string
+}
+
+// HasPublishedRange reports whether the query restricts the publication date
+func (q BlogPostQuery) HasPublishedRange() bool {
+	return !q.PublishedAfter.IsZero() || !q.PublishedBefore.IsZero()
+}
+
+// RelatedPostCandidate is a published post that shares taxonomy with another post or has a similar title
+type RelatedPostCandidate struct {
+	Post             *models.BlogPost
+	SharedCategories int
+	SharedTags       int
+	// TitleSimilarity is the trigram similarity of the two titles, from 0 to 1
+	TitleSimilarity float64
+}
+
+// RelatedPostFinder is implemented by blog repositories that select related post candidates
+// in the database, newest first
+type RelatedPostFinder interface {
+	FindRelatedPostCandidates(ctx context.Context, post *models.BlogPost, limit int) ([]RelatedPostCandidate, error)
 }
 
 // ContactRepository defines the interface for contact submission data access
@@ -74,6 +129,228 @@ type ContactRepository interface {
 	GetContactSubmissionsByStatus(ctx context.Context, status string) ([]*models.ContactSubmission, error)
 }
 
+// ReusableBlockRepository defines the interface for reusable content block data access
+type ReusableBlockRepository interface {
+	Create(ctx context.Context, block *models.ReusableBlock) error
+	GetByID(ctx context.Context, id string) (*models.ReusableBlock, error)
+	GetBySlug(ctx context.Context, slug string) (*models.ReusableBlock, error)
+	GetByIDs(ctx context.Context, ids []string) ([]*models.ReusableBlock, error)
+	Update(ctx context.Context, block *models.ReusableBlock) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, options ListOptions) ([]*models.ReusableBlock, error)
+	ListUsages(ctx context.Context, blockID string) ([]*models.ReusableBlockUsage, error)
+	CountUsages(ctx context.Context, blockID string) (int, error)
+	// CountUsagesByBlock counts the usages of several blocks in one call; unused blocks are absent
+	CountUsagesByBlock(ctx context.Context, blockIDs []string) (map[string]int, error)
+	// ReplaceUsages overwrites the usage records of one page or post with the given set
+	ReplaceUsages(ctx context.Context, contentType, contentID string, usages []*models.ReusableBlockUsage) error
+}
+
+// PageTemplateRepository defines the interface for page template data access
+type PageTemplateRepository interface {
+	Create(ctx context.Context, template *models.PageTemplate) error
+	GetByID(ctx context.Context, id string) (*models.PageTemplate, error)
+	GetBySlug(ctx context.Context, slug string) (*models.PageTemplate, error)
+	// GetVersion returns the template as it was at the given version
+	GetVersion(ctx context.Context, id string, version int) (*models.PageTemplate, error)
+	// Update stores the template as a new version
+	Update(ctx context.Context, template *models.PageTemplate) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, options ListOptions) ([]*models.PageTemplate, error)
+}
+
+// CommentRepository defines the interface for blog comment data access
+type CommentRepository interface {
+	Create(ctx context.Context, comment *models.Comment) error
+	GetByID(ctx context.Context, id string) (*models.Comment, error)
+	ListByPost(ctx context.Context, postID, status string) ([]*models.Comment, error)
+	// ListByStatus lists comments in a moderation state; an empty postID lists across all posts
+	ListByStatus(ctx context.Context, status, postID string, options ListOptions) ([]*models.Comment, *PaginationInfo, error)
+	UpdateStatus(ctx context.Context, id, status, moderatedBy string) (*models.Comment, error)
+	// CountApprovedByPosts returns the number of approved comments keyed by post ID
+	CountApprovedByPosts(ctx context.Context, postIDs []string) (map[string]int, error)
+}
+
+// WebmentionRepository defines the interface for received Webmention data access
+type WebmentionRepository interface {
+	// Upsert stores a mention, or resets an existing mention of the same source and target
+	// to pending so it is verified again; mentions filed as spam stay spam
+	Upsert(ctx context.Context, mention *models.Webmention) error
+	GetByID(ctx context.Context, id string) (*models.Webmention, error)
+	// UpdateVerification stores the outcome of fetching the source: status, title, author, error and verification time
+	UpdateVerification(ctx context.Context, mention *models.Webmention) error
+	ListByPost(ctx context.Context, postID, status string) ([]*models.Webmention, error)
+	// ListByStatus lists mentions in a moderation state; an empty postID lists across all posts
+	ListByStatus(ctx context.Context, status, postID string, options ListOptions) ([]*models.Webmention, *PaginationInfo, error)
+	UpdateStatus(ctx context.Context, id, status, moderatedBy string) (*models.Webmention, error)
+}
+
+// CollectionRepository defines the interface for series and curated collection data access.
+// Returned collections always carry their ordered post IDs.
+type CollectionRepository interface {
+	Create(ctx context.Context, collection *models.Collection) error
+	GetByID(ctx context.Context, id string) (*models.Collection, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Collection, error)
+	// Update saves title, slug and description; use SetPosts to change membership
+	Update(ctx context.Context, collection *models.Collection) error
+	Delete(ctx context.Context, id string) error
+	// List lists collections by title; an empty kind lists all kinds
+	List(ctx context.Context, kind string, options ListOptions) ([]*models.Collection, error)
+	// ListByPost returns the collections of the given kind that contain a post
+	ListByPost(ctx context.Context, postID, kind string) ([]*models.Collection, error)
+	// SetPosts replaces the ordered post list of a collection
+	SetPosts(ctx context.Context, id string, postIDs []string) error
+	// RemovePost removes a post from every collection
+	RemovePost(ctx context.Context, postID string) error
+}
+
+// AnalyticsRepository defines the interface for view analytics rollups.
+// Date ranges are inclusive UTC days; an empty contentID aggregates the whole site.
+type AnalyticsRepository interface {
+	// RecordView adds a view to the daily rollups, counting the visitor once per content item and day
+	RecordView(ctx context.Context, view *models.ContentView) error
+	ListDailyViews(ctx context.Context, contentID string, from, to time.Time) ([]*models.DailyViews, error)
+	ListReferrers(ctx context.Context, contentID string, from, to time.Time, limit int) ([]*models.ReferrerViews, error)
+	// ListPopular ranks content by views; an empty contentType ranks pages and posts together
+	ListPopular(ctx context.Context, contentType string, from, to time.Time, limit int) ([]*models.PopularContent, error)
+	// DaySalt returns the visitor hash salt of a day, storing candidate if the day has none
+	// yet; created reports whether candidate became the salt
+	DaySalt(ctx context.Context, day time.Time, candidate []byte) (salt []byte, created bool, err error)
+	// PurgeVisitorsBefore deletes the salted visitor hashes and salts of days before the given day
+	PurgeVisitorsBefore(ctx context.Context, day time.Time) error
+}
+
+// LinkReportRepository defines the interface for link-integrity scan reports.
+// Returned reports always carry their issues.
+type LinkReportRepository interface {
+	// Create stores a finished report with its issues and sets its ID
+	Create(ctx context.Context, report *models.LinkReport) error
+	GetByID(ctx context.Context, id string) (*models.LinkReport, error)
+	// GetLatest returns the most recently started report
+	GetLatest(ctx context.Context) (*models.LinkReport, error)
+	// Prune deletes all but the given number of most recent reports
+	Prune(ctx context.Context, keep int) error
+}
+
+// WebhookRepository defines the interface for webhook subscriptions and their delivery log
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id string) (*models.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, options ListOptions) ([]*models.WebhookSubscription, error)
+	// ListSubscriptionsForEvent returns the active subscriptions that receive an event type
+	ListSubscriptionsForEvent(ctx context.Context, eventType string) ([]*models.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id string) error
+
+	CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error)
+	// ListDeliveries lists the deliveries of a subscription, newest first; an empty status lists all
+	ListDeliveries(ctx context.Context, subscriptionID, status string, options ListOptions) ([]*models.WebhookDelivery, *PaginationInfo, error)
+	// ListDueDeliveries returns pending deliveries whose next attempt is at or before the given time
+	ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*models.WebhookDelivery, error)
+	// UpdateDelivery saves the status, attempt count and outcome of the latest attempt
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// PruneDeliveries removes settled deliveries created before the given time
+	PruneDeliveries(ctx context.Context, before time.Time) error
+}
+
+// ActivityPubRepository defines the interface for federation keys, followers and the delivery queue
+type ActivityPubRepository interface {
+	GetKey(ctx context.Context, actor string) (*models.ActivityPubKey, error)
+	// CreateKey stores a key pair unless the actor already has one, and returns the stored key
+	CreateKey(ctx context.Context, key *models.ActivityPubKey) (*models.ActivityPubKey, error)
+
+	// UpsertFollower stores a follower, updating the inboxes of an existing one
+	UpsertFollower(ctx context.Context, follower *models.ActivityPubFollower) error
+	DeleteFollower(ctx context.Context, actor, followerURI string) error
+	CountFollowers(ctx context.Context, actor string) (int, error)
+	// ListFollowerInboxes returns the distinct delivery inboxes of an actor's followers,
+	// preferring shared inboxes
+	ListFollowerInboxes(ctx context.Context, actor string) ([]string, error)
+
+	CreateDelivery(ctx context.Context, delivery *models.ActivityPubDelivery) error
+	GetDelivery(ctx context.Context, id string) (*models.ActivityPubDelivery, error)
+	// ListDueDeliveries returns pending deliveries whose next attempt is at or before the given time
+	ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*models.ActivityPubDelivery, error)
+	// UpdateDelivery saves the status, attempt count and outcome of the latest attempt
+	UpdateDelivery(ctx context.Context, delivery *models.ActivityPubDelivery) error
+	// PruneDeliveries removes settled deliveries created before the given time
+	PruneDeliveries(ctx context.Context, before time.Time) error
+}
+
+// NewsletterRepository defines the interface for newsletter subscribers and the digest log
+type NewsletterRepository interface {
+	CreateSubscriber(ctx context.Context, subscriber *models.NewsletterSubscriber) error
+	GetSubscriber(ctx context.Context, id string) (*models.NewsletterSubscriber, error)
+	// GetSubscriberByEmail looks up a subscriber by lowercased email address
+	GetSubscriberByEmail(ctx context.Context, email string) (*models.NewsletterSubscriber, error)
+	// UpdateSubscriber saves every mutable field of the subscriber
+	UpdateSubscriber(ctx context.Context, subscriber *models.NewsletterSubscriber) error
+	// ListSubscribers lists subscribers newest first; an empty status lists all of them
+	ListSubscribers(ctx context.Context, status string, options ListOptions) ([]*models.NewsletterSubscriber, *PaginationInfo, error)
+	// ListActiveSubscribers returns up to limit active subscribers ordered by ID, starting after afterID
+	ListActiveSubscribers(ctx context.Context, afterID string, limit int) ([]*models.NewsletterSubscriber, error)
+
+	CreateDigest(ctx context.Context, digest *models.NewsletterDigest) error
+	// GetLatestDigest returns the digest with the latest period end, or ErrNotFound before the first one
+	GetLatestDigest(ctx context.Context) (*models.NewsletterDigest, error)
+}
+
+// NavigationRepository defines the interface for navigation menus; items are stored with their menu
+type NavigationRepository interface {
+	Create(ctx context.Context, menu *models.NavigationMenu) error
+	GetByID(ctx context.Context, id string) (*models.NavigationMenu, error)
+	GetBySlug(ctx context.Context, slug string) (*models.NavigationMenu, error)
+	List(ctx context.Context, options ListOptions) ([]*models.NavigationMenu, error)
+	Update(ctx context.Context, menu *models.NavigationMenu) error
+	Delete(ctx context.Context, id string) error
+}
+
+// SettingsRepository defines the interface for site settings and their audit trail
+type SettingsRepository interface {
+	List(ctx context.Context) ([]*models.Setting, error)
+	// Save stores the given values and records one change per key atomically
+	Save(ctx context.Context, values map[string]json.RawMessage, changedBy string) error
+	// ListChanges returns changes newest first, optionally limited to one key
+	ListChanges(ctx context.Context, key string, options ListOptions) ([]*models.SettingChange, *PaginationInfo, error)
+}
+
+// ContentTypeRepository defines the interface for user-defined content types and their entries
+type ContentTypeRepository interface {
+	CreateType(ctx context.Context, contentType *models.ContentType) error
+	GetType(ctx context.Context, id string) (*models.ContentType, error)
+	GetTypeBySlug(ctx context.Context, slug string) (*models.ContentType, error)
+	ListTypes(ctx context.Context, options ListOptions) ([]*models.ContentType, error)
+	UpdateType(ctx context.Context, contentType *models.ContentType) error
+	// DeleteType removes a content type, its entries and its field indexes
+	DeleteType(ctx context.Context, id string) error
+	// SyncFieldIndexes indexes the given fields of a content type and drops the indexes of other fields
+	SyncFieldIndexes(ctx context.Context, contentTypeID string, keys []string) error
+	CountEntries(ctx context.Context, contentTypeID string) (int, error)
+
+	CreateEntry(ctx context.Context, entry *models.Entry) error
+	GetEntry(ctx context.Context, id string) (*models.Entry, error)
+	GetEntryBySlug(ctx context.Context, contentTypeID, slug string) (*models.Entry, error)
+	UpdateEntry(ctx context.Context, entry *models.Entry) error
+	DeleteEntry(ctx context.Context, id string) error
+	// ListEntries lists the entries of a content type matching the query
+	ListEntries(ctx context.Context, query EntryQuery) ([]*models.Entry, *PaginationInfo, error)
+}
+
+// EntryQuery selects and orders the entries of one content type
+type EntryQuery struct {
+	ContentTypeID string
+	// Status filters by entry status; empty lists all
+	Status string
+	// Filter holds field values entries must contain, e.g. {"remote": true}
+	Filter map[string]interface{}
+	// SortField sorts by the value of a field; otherwise Options.SortBy picks
+	// created_at (default), updated_at, published_at or slug
+	SortField string
+	// Options carries pagination, SortBy and Order ("asc" or "desc", default "desc")
+	Options ListOptions
+}
+
 // ListOptions defines options for listing operations
 type ListOptions struct {
 	Limit  int
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// linkReportRepositorySQL implements LinkReportRepository (PostgreSQL/sqlc)
+type linkReportRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ LinkReportRepository = (*linkReportRepositorySQL)(nil)
+
+// NewLinkReportRepositorySQL creates a new SQL-backed link report repository using the Postgres client
+func NewLinkReportRepositorySQL(c *database.PostgresClient) LinkReportRepository {
+	return &linkReportRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *linkReportRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// Create inserts the report and then its issues.
+// Callers that need atomicity should run it inside a UnitOfWork.
+func (r *linkReportRepositorySQL) Create(ctx context.Context, report *models.LinkReport) error {
+	q := r.getQ(ctx)
+	row, err := q.InsertLinkReport(ctx, db.InsertLinkReportParams{
+		Trigger:         report.Trigger,
+		PagesScanned:    int32(report.PagesScanned),
+		PostsScanned:    int32(report.PostsScanned),
+		LinksChecked:    int32(report.LinksChecked),
+		ExternalChecked: report.ExternalChecked,
+		StartedAt:       pgtype.Timestamptz{Time: report.StartedAt, Valid: true},
+		FinishedAt:      pgtype.Timestamptz{Time: report.FinishedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create link report: %w", appErr.MapDBError(err))
+	}
+
+	for _, issue := range report.Issues {
+		if err := q.InsertLinkReportIssue(ctx, db.InsertLinkReportIssueParams{
+			ReportID:    row.ID,
+			ContentType: issue.ContentType,
+			ContentID:   issue.ContentID,
+			ContentSlug: issue.ContentSlug,
+			BlockIndex:  int32(issue.BlockIndex),
+			Field:       issue.Field,
+			Url:         issue.URL,
+			Kind:        issue.Kind,
+			StatusCode:  int32(issue.StatusCode),
+			Detail:      issue.Detail,
+		}); err != nil {
+			return fmt.Errorf("failed to create link report issue: %w", appErr.MapDBError(err))
+		}
+	}
+
+	report.ID = row.ID.String()
+	return nil
+}
+
+// GetByID retrieves a report by its UUID
+func (r *linkReportRepositorySQL) GetByID(ctx context.Context, id string) (*models.LinkReport, error) {
+	row, err := r.getQ(ctx).GetLinkReportByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get link report: %w", appErr.MapDBError(err))
+	}
+	return r.withIssues(ctx, row)
+}
+
+// GetLatest retrieves the most recently started report
+func (r *linkReportRepositorySQL) GetLatest(ctx context.Context) (*models.LinkReport, error) {
+	row, err := r.getQ(ctx).GetLatestLinkReport(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest link report: %w", appErr.MapDBError(err))
+	}
+	return r.withIssues(ctx, row)
+}
+
+// Prune deletes all but the most recent reports; their issues are removed by cascade
+func (r *linkReportRepositorySQL) Prune(ctx context.Context, keep int) error {
+	if err := r.getQ(ctx).DeleteLinkReportsExceptLatest(ctx, int32(keep)); err != nil {
+		return fmt.Errorf("failed to prune link reports: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// withIssues maps a report row and loads its issues
+func (r *linkReportRepositorySQL) withIssues(ctx context.Context, row db.LinkReport) (*models.LinkReport, error) {
+	rows, err := r.getQ(ctx).ListLinkReportIssues(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list link report issues: %w", appErr.MapDBError(err))
+	}
+
+	report := &models.LinkReport{
+		ID:              row.ID.String(),
+		Trigger:         row.Trigger,
+		PagesScanned:    int(row.PagesScanned),
+		PostsScanned:    int(row.PostsScanned),
+		LinksChecked:    int(row.LinksChecked),
+		ExternalChecked: row.ExternalChecked,
+		Issues:          make([]models.LinkIssue, 0, len(rows)),
+		StartedAt:       row.StartedAt.Time,
+		FinishedAt:      row.FinishedAt.Time,
+	}
+	for _, issue := range rows {
+		report.Issues = append(report.Issues, models.LinkIssue{
+			ContentType: issue.ContentType,
+			ContentID:   issue.ContentID,
+			ContentSlug: issue.ContentSlug,
+			BlockIndex:  int(issue.BlockIndex),
+			Field:       issue.Field,
+			URL:         issue.Url,
+			Kind:        issue.Kind,
+			StatusCode:  int(issue.StatusCode),
+			Detail:      issue.Detail,
+		})
+	}
+	return report, nil
+}
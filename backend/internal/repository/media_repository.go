@@ -17,6 +17,8 @@ import (
 type MediaRepository interface {
 	Create(ctx context.Context, media *models.Media) error
 	GetByID(ctx context.Context, id string) (*models.Media, error)
+	// GetByIDs retrieves the media with the given IDs in one call; unknown IDs are skipped
+	GetByIDs(ctx context.Context, ids []string) ([]*models.Media, error)
 	GetByFilename(ctx context.Context, filename string) (*models.Media, error)
 	Update(ctx context.Context, media *models.Media) error
 	Delete(ctx context.Context, id string) error
@@ -103,6 +105,28 @@ func (r *mediaRepository) GetByID(ctx context.Context, id string) (*models.Media
 	return &media, nil
 }
 
+// GetByIDs retrieves the media with the given IDs in one request; missing media are skipped
+func (r *mediaRepository) GetByIDs(ctx context.Context, ids []string) ([]*models.Media, error) {
+	result, err := r.client.AllDocs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media: %w", err)
+	}
+
+	files := make([]*models.Media, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		if len(row.Doc) == 0 || string(row.Doc) == "null" {
+			continue
+		}
+		var media models.Media
+		if err := json.Unmarshal(row.Doc, &media); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal media document: %w", err)
+		}
+		files = append(files, &media)
+	}
+
+	return files, nil
+}
+
 func (r *mediaRepository) GetByFilename(ctx context.Context, filename string) (*models.Media, error) {
 	result, err := r.client.Query(ctx, "media", "by_filename", map[string]interface{}{
 		"key":          filename,
@@ -124,6 +148,33 @@ func (r *mediaRepository) GetByFilename(ctx context.Context, filename string) (*
 	return &media, nil
 }
 
+// GetByIDs retrieves the media with the given IDs in one query (PostgreSQL); IDs are
+// "media:" plus the filename, and unknown IDs are skipped
+func (r *mediaRepositorySQL) GetByIDs(ctx context.Context, ids []string) ([]*models.Media, error) {
+	if len(ids) == 0 {
+		return []*models.Media{}, nil
+	}
+	filenames := make([]string, 0, len(ids))
+	for _, id := range ids {
+		filenames = append(filenames, strings.TrimPrefix(id, "media:"))
+	}
+	rows, err := r.q.ListMediaByFilenames(ctx, filenames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media: %w", err)
+	}
+	out := make([]*models.Media, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &models.Media{
+			ID:        "media:" + row.Filename,
+			Type:      "media",
+			Filename:  row.Filename,
+			MimeType:  row.MimeType,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return out, nil
+}
+
 // GetByFilename retrieves media by filename (PostgreSQL)
 func (r *mediaRepositorySQL) GetByFilename(ctx context.Context, filename string) (*models.Media, error) {
 	row, err := r.q.GetMediaByFilename(ctx, filename)
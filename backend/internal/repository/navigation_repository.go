@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// navigationRepositorySQL implements NavigationRepository (PostgreSQL/sqlc)
+type navigationRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ NavigationRepository = (*navigationRepositorySQL)(nil)
+
+// NewNavigationRepositorySQL creates a new SQL-backed navigation repository using the Postgres client
+func NewNavigationRepositorySQL(c *database.PostgresClient) NavigationRepository {
+	return &navigationRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *navigationRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// Create inserts a new menu with its items
+func (r *navigationRepositorySQL) Create(ctx context.Context, menu *models.NavigationMenu) error {
+	items, err := marshalNavigationItems(menu.Items)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.getQ(ctx).InsertNavigationMenu(ctx, db.InsertNavigationMenuParams{
+		Slug:        menu.Slug,
+		Name:        menu.Name,
+		Description: nullableStringPtr(menu.Description),
+		Items:       items,
+		CreatedBy:   nullableStringPtr(menu.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create navigation menu: %w", appErr.MapDBError(err))
+	}
+	*menu = *mapSQLCNavigationMenu(row)
+	return nil
+}
+
+// GetByID retrieves a menu by its UUID
+func (r *navigationRepositorySQL) GetByID(ctx context.Context, id string) (*models.NavigationMenu, error) {
+	row, err := r.getQ(ctx).GetNavigationMenuByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get navigation menu: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCNavigationMenu(row), nil
+}
+
+// GetBySlug retrieves a menu by its slug
+func (r *navigationRepositorySQL) GetBySlug(ctx context.Context, slug string) (*models.NavigationMenu, error) {
+	row, err := r.getQ(ctx).GetNavigationMenuBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get navigation menu by slug: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCNavigationMenu(row), nil
+}
+
+// List returns menus ordered by name
+func (r *navigationRepositorySQL) List(ctx context.Context, options ListOptions) ([]*models.NavigationMenu, error) {
+	rows, err := r.getQ(ctx).ListNavigationMenus(ctx, db.ListNavigationMenusParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list navigation menus: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.NavigationMenu, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCNavigationMenu(row))
+	}
+	return out, nil
+}
+
+// Update saves the slug, name, description and items of a menu
+func (r *navigationRepositorySQL) Update(ctx context.Context, menu *models.NavigationMenu) error {
+	items, err := marshalNavigationItems(menu.Items)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.getQ(ctx).UpdateNavigationMenu(ctx, db.UpdateNavigationMenuParams{
+		ID:          parseUUIDToPgtype(menu.ID),
+		Slug:        menu.Slug,
+		Name:        menu.Name,
+		Description: nullableStringPtr(menu.Description),
+		Items:       items,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update navigation menu: %w", appErr.MapDBError(err))
+	}
+	*menu = *mapSQLCNavigationMenu(row)
+	return nil
+}
+
+// Delete removes a menu
+func (r *navigationRepositorySQL) Delete(ctx context.Context, id string) error {
+	affected, err := r.getQ(ctx).DeleteNavigationMenu(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete navigation menu: %w", appErr.MapDBError(err))
+	}
+	if affected == 0 {
+		return appErr.ErrNotFound
+	}
+	return nil
+}
+
+func marshalNavigationItems(items []models.NavigationItem) ([]byte, error) {
+	if items == nil {
+		items = []models.NavigationItem{}
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal navigation items: %w", err)
+	}
+	return data, nil
+}
+
+// mapSQLCNavigationMenu converts a sqlc row to the outward model
+func mapSQLCNavigationMenu(row db.NavigationMenu) *models.NavigationMenu {
+	var items []models.NavigationItem
+	if err := json.Unmarshal(row.Items, &items); err != nil || items == nil {
+		items = []models.NavigationItem{}
+	}
+	return &models.NavigationMenu{
+		ID:          row.ID.String(),
+		Slug:        row.Slug,
+		Name:        row.Name,
+		Description: derefString(row.Description),
+		Items:       items,
+		CreatedBy:   derefString(row.CreatedBy),
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
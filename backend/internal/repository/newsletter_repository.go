@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// newsletterRepositorySQL implements NewsletterRepository (PostgreSQL/sqlc)
+type newsletterRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ NewsletterRepository = (*newsletterRepositorySQL)(nil)
+
+// NewNewsletterRepositorySQL creates a new SQL-backed newsletter repository using the Postgres client
+func NewNewsletterRepositorySQL(c *database.PostgresClient) NewsletterRepository {
+	return &newsletterRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *newsletterRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// CreateSubscriber inserts a new subscriber
+func (r *newsletterRepositorySQL) CreateSubscriber(ctx context.Context, subscriber *models.NewsletterSubscriber) error {
+	row, err := r.getQ(ctx).InsertNewsletterSubscriber(ctx, db.InsertNewsletterSubscriberParams{
+		Email:              subscriber.Email,
+		Name:               subscriber.Name,
+		Status:             subscriber.Status,
+		Topics:             nonNilStrings(subscriber.Topics),
+		IpAddress:          nullableStringPtr(subscriber.IPAddress),
+		ConfirmationSentAt: timePtrToPgtype(subscriber.ConfirmationSentAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create newsletter subscriber: %w", appErr.MapDBError(err))
+	}
+	*subscriber = *mapSQLCNewsletterSubscriber(row)
+	return nil
+}
+
+// GetSubscriber retrieves a subscriber by its UUID
+func (r *newsletterRepositorySQL) GetSubscriber(ctx context.Context, id string) (*models.NewsletterSubscriber, error) {
+	row, err := r.getQ(ctx).GetNewsletterSubscriberByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter subscriber: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCNewsletterSubscriber(row), nil
+}
+
+// GetSubscriberByEmail retrieves a subscriber by lowercased email address
+func (r *newsletterRepositorySQL) GetSubscriberByEmail(ctx context.Context, email string) (*models.NewsletterSubscriber, error) {
+	row, err := r.getQ(ctx).GetNewsletterSubscriberByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newsletter subscriber: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCNewsletterSubscriber(row), nil
+}
+
+// UpdateSubscriber saves every mutable field of the subscriber
+func (r *newsletterRepositorySQL) UpdateSubscriber(ctx context.Context, subscriber *models.NewsletterSubscriber) error {
+	row, err := r.getQ(ctx).UpdateNewsletterSubscriber(ctx, db.UpdateNewsletterSubscriberParams{
+		ID:                 parseUUIDToPgtype(subscriber.ID),
+		Name:               subscriber.Name,
+		Status:             subscriber.Status,
+		Topics:             nonNilStrings(subscriber.Topics),
+		SoftBounces:        int32(subscriber.SoftBounces),
+		IpAddress:          nullableStringPtr(subscriber.IPAddress),
+		ConfirmationSentAt: timePtrToPgtype(subscriber.ConfirmationSentAt),
+		ConfirmedAt:        timePtrToPgtype(subscriber.ConfirmedAt),
+		UnsubscribedAt:     timePtrToPgtype(subscriber.UnsubscribedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update newsletter subscriber: %w", appErr.MapDBError(err))
+	}
+	*subscriber = *mapSQLCNewsletterSubscriber(row)
+	return nil
+}
+
+// ListSubscribers returns a page of subscribers, newest first
+func (r *newsletterRepositorySQL) ListSubscribers(ctx context.Context, status string, options ListOptions) ([]*models.NewsletterSubscriber, *PaginationInfo, error) {
+	q := r.getQ(ctx)
+	rows, err := q.ListNewsletterSubscribers(ctx, db.ListNewsletterSubscribersParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+		Status: nullableStringPtr(status),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list newsletter subscribers: %w", appErr.MapDBError(err))
+	}
+	total, err := q.CountNewsletterSubscribers(ctx, nullableStringPtr(status))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to count newsletter subscribers: %w", appErr.MapDBError(err))
+	}
+
+	out := make([]*models.NewsletterSubscriber, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCNewsletterSubscriber(row))
+	}
+
+	info := &PaginationInfo{TotalCount: int(total)}
+	if next := options.Skip + len(out); next < int(total) {
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(next)
+	}
+	return out, info, nil
+}
+
+// ListActiveSubscribers returns up to limit active subscribers ordered by ID, starting after afterID
+func (r *newsletterRepositorySQL) ListActiveSubscribers(ctx context.Context, afterID string, limit int) ([]*models.NewsletterSubscriber, error) {
+	rows, err := r.getQ(ctx).ListActiveNewsletterSubscribers(ctx, db.ListActiveNewsletterSubscribersParams{
+		Limit:   int32(limit),
+		AfterID: parseUUIDToPgtype(afterID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active newsletter subscribers: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.NewsletterSubscriber, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCNewsletterSubscriber(row))
+	}
+	return out, nil
+}
+
+// CreateDigest records a digest run
+func (r *newsletterRepositorySQL) CreateDigest(ctx context.Context, digest *models.NewsletterDigest) error {
+	row, err := r.getQ(ctx).InsertNewsletterDigest(ctx, db.InsertNewsletterDigestParams{
+		PeriodStart: pgtype.Timestamptz{Time: digest.PeriodStart, Valid: true},
+		PeriodEnd:   pgtype.Timestamptz{Time: digest.PeriodEnd, Valid: true},
+		PostIds:     nonNilStrings(digest.PostIDs),
+		Recipients:  int32(digest.Recipients),
+		Failures:    int32(digest.Failures),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create newsletter digest: %w", appErr.MapDBError(err))
+	}
+	*digest = *mapSQLCNewsletterDigest(row)
+	return nil
+}
+
+// GetLatestDigest returns the digest with the latest period end
+func (r *newsletterRepositorySQL) GetLatestDigest(ctx context.Context) (*models.NewsletterDigest, error) {
+	row, err := r.getQ(ctx).GetLatestNewsletterDigest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest newsletter digest: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCNewsletterDigest(row), nil
+}
+
+// nonNilStrings returns an empty slice for nil so TEXT[] NOT NULL columns are never sent NULL
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// mapSQLCNewsletterSubscriber converts a sqlc row to the outward model
+func mapSQLCNewsletterSubscriber(row db.NewsletterSubscriber) *models.NewsletterSubscriber {
+	return &models.NewsletterSubscriber{
+		ID:                 row.ID.String(),
+		Email:              row.Email,
+		Name:               row.Name,
+		Status:             row.Status,
+		Topics:             row.Topics,
+		SoftBounces:        int(row.SoftBounces),
+		IPAddress:          derefString(row.IpAddress),
+		ConfirmationSentAt: nullableTimePtr(row.ConfirmationSentAt),
+		ConfirmedAt:        nullableTimePtr(row.ConfirmedAt),
+		UnsubscribedAt:     nullableTimePtr(row.UnsubscribedAt),
+		CreatedAt:          row.CreatedAt.Time,
+		UpdatedAt:          row.UpdatedAt.Time,
+	}
+}
+
+// mapSQLCNewsletterDigest converts a sqlc row to the outward model
+func mapSQLCNewsletterDigest(row db.NewsletterDigest) *models.NewsletterDigest {
+	return &models.NewsletterDigest{
+		ID:          row.ID.String(),
+		PeriodStart: row.PeriodStart.Time,
+		PeriodEnd:   row.PeriodEnd.Time,
+		PostIDs:     row.PostIds,
+		Recipients:  int(row.Recipients),
+		Failures:    int(row.Failures),
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
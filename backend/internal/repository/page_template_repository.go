@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// pageTemplateRepositorySQL implements PageTemplateRepository (PostgreSQL/sqlc)
+type pageTemplateRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ PageTemplateRepository = (*pageTemplateRepositorySQL)(nil)
+
+// NewPageTemplateRepositorySQL creates a new SQL-backed page template repository using the Postgres client
+func NewPageTemplateRepositorySQL(c *database.PostgresClient) PageTemplateRepository {
+	return &pageTemplateRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *pageTemplateRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// Create inserts a new template and records its first version
+func (r *pageTemplateRepositorySQL) Create(ctx context.Context, template *models.PageTemplate) error {
+	contentJSON, metaJSON, err := marshalTemplateBody(template.Content, template.Meta)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.getQ(ctx).InsertPageTemplate(ctx, db.InsertPageTemplateParams{
+		Slug:        template.Slug,
+		Name:        template.Name,
+		Description: nullableStringPtr(template.Description),
+		Content:     contentJSON,
+		Meta:        metaJSON,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create page template: %w", appErr.MapDBError(err))
+	}
+
+	*template = *mapSQLCPageTemplate(db.PageTemplate(row))
+	return nil
+}
+
+// GetByID retrieves the current version of a template by its UUID
+func (r *pageTemplateRepositorySQL) GetByID(ctx context.Context, id string) (*models.PageTemplate, error) {
+	row, err := r.getQ(ctx).GetPageTemplateByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page template: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCPageTemplate(row), nil
+}
+
+// GetBySlug retrieves the current version of a template by its slug
+func (r *pageTemplateRepositorySQL) GetBySlug(ctx context.Context, slug string) (*models.PageTemplate, error) {
+	row, err := r.getQ(ctx).GetPageTemplateBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page template by slug: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCPageTemplate(row), nil
+}
+
+// GetVersion retrieves a template with the content and meta of a historical version
+func (r *pageTemplateRepositorySQL) GetVersion(ctx context.Context, id string, version int) (*models.PageTemplate, error) {
+	template, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version == template.Version {
+		return template, nil
+	}
+
+	row, err := r.getQ(ctx).GetPageTemplateVersion(ctx, db.GetPageTemplateVersionParams{
+		TemplateID: parseUUIDToPgtype(id),
+		Version:    int32(version),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page template version: %w", appErr.MapDBError(err))
+	}
+
+	template.Version = int(row.Version)
+	template.Content, template.Meta = unmarshalTemplateBody(row.Content, row.Meta)
+	template.UpdatedAt = row.CreatedAt.Time
+	return template, nil
+}
+
+// Update stores the template as a new version; the version number is bumped by the database
+func (r *pageTemplateRepositorySQL) Update(ctx context.Context, template *models.PageTemplate) error {
+	contentJSON, metaJSON, err := marshalTemplateBody(template.Content, template.Meta)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.getQ(ctx).UpdatePageTemplate(ctx, db.UpdatePageTemplateParams{
+		ID:          parseUUIDToPgtype(template.ID),
+		Slug:        template.Slug,
+		Name:        template.Name,
+		Description: nullableStringPtr(template.Description),
+		Content:     contentJSON,
+		Meta:        metaJSON,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update page template: %w", appErr.MapDBError(err))
+	}
+
+	*template = *mapSQLCPageTemplate(db.PageTemplate(row))
+	return nil
+}
+
+// Delete removes a template and all of its versions
+func (r *pageTemplateRepositorySQL) Delete(ctx context.Context, id string) error {
+	if err := r.getQ(ctx).DeletePageTemplateByID(ctx, parseUUIDToPgtype(id)); err != nil {
+		return fmt.Errorf("failed to delete page template: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// List returns templates ordered by name, optionally filtered by options.Search
+func (r *pageTemplateRepositorySQL) List(ctx context.Context, options ListOptions) ([]*models.PageTemplate, error) {
+	rows, err := r.getQ(ctx).ListPageTemplates(ctx, db.ListPageTemplatesParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+		Search: nullableStringPtr(options.Search),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list page templates: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.PageTemplate, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCPageTemplate(row))
+	}
+	return out, nil
+}
+
+func marshalTemplateBody(content models.Content, meta models.Meta) (string, string, error) {
+	contentJSON, err := json.Marshal(content)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal content: %w", err)
+	}
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal meta: %w", err)
+	}
+	return string(contentJSON), string(metaJSON), nil
+}
+
+func unmarshalTemplateBody(contentJSON, metaJSON string) (models.Content, models.Meta) {
+	var content models.Content
+	if err := json.Unmarshal([]byte(contentJSON), &content); err != nil {
+		content = models.Content{Blocks: []models.ContentBlock{}}
+	}
+	var meta models.Meta
+	_ = json.Unmarshal([]byte(metaJSON), &meta)
+	return content, meta
+}
+
+// mapSQLCPageTemplate converts a sqlc row to the outward model
+func mapSQLCPageTemplate(row db.PageTemplate) *models.PageTemplate {
+	content, meta := unmarshalTemplateBody(row.Content, row.Meta)
+	return &models.PageTemplate{
+		ID:          row.ID.String(),
+		Name:        row.Name,
+		Slug:        row.Slug,
+		Description: derefString(row.Description),
+		Version:     int(row.Version),
+		Content:     content,
+		Meta:        meta,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
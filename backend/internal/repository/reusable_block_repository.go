@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// reusableBlockRepositorySQL implements ReusableBlockRepository (PostgreSQL/sqlc)
+type reusableBlockRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ ReusableBlockRepository = (*reusableBlockRepositorySQL)(nil)
+
+// NewReusableBlockRepositorySQL creates a new SQL-backed reusable block repository using the Postgres client
+func NewReusableBlockRepositorySQL(c *database.PostgresClient) ReusableBlockRepository {
+	return &reusableBlockRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *reusableBlockRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// Create inserts a new reusable block
+func (r *reusableBlockRepositorySQL) Create(ctx context.Context, block *models.ReusableBlock) error {
+	contentJSON, err := json.Marshal(block.Content)
+	if err != nil {
+		return fmt.Errorf("failed to marshal content: %w", err)
+	}
+
+	row, err := r.getQ(ctx).InsertReusableBlock(ctx, db.InsertReusableBlockParams{
+		Slug:        block.Slug,
+		Name:        block.Name,
+		Description: nullableStringPtr(block.Description),
+		Content:     string(contentJSON),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create reusable block: %w", appErr.MapDBError(err))
+	}
+
+	block.ID = row.ID.String()
+	block.CreatedAt = row.CreatedAt.Time
+	block.UpdatedAt = row.UpdatedAt.Time
+	return nil
+}
+
+// GetByID retrieves a reusable block by its UUID
+func (r *reusableBlockRepositorySQL) GetByID(ctx context.Context, id string) (*models.ReusableBlock, error) {
+	row, err := r.getQ(ctx).GetReusableBlockByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reusable block: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCReusableBlock(row), nil
+}
+
+// GetBySlug retrieves a reusable block by its slug
+func (r *reusableBlockRepositorySQL) GetBySlug(ctx context.Context, slug string) (*models.ReusableBlock, error) {
+	row, err := r.getQ(ctx).GetReusableBlockBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reusable block by slug: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCReusableBlock(row), nil
+}
+
+// GetByIDs retrieves all reusable blocks matching the given IDs; unknown IDs are skipped
+func (r *reusableBlockRepositorySQL) GetByIDs(ctx context.Context, ids []string) ([]*models.ReusableBlock, error) {
+	if len(ids) == 0 {
+		return []*models.ReusableBlock{}, nil
+	}
+	uuids := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		u := parseUUIDToPgtype(id)
+		if u.Valid {
+			uuids = append(uuids, u)
+		}
+	}
+
+	rows, err := r.getQ(ctx).ListReusableBlocksByIDs(ctx, uuids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reusable blocks: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.ReusableBlock, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCReusableBlock(row))
+	}
+	return out, nil
+}
+
+// Update updates an existing reusable block
+func (r *reusableBlockRepositorySQL) Update(ctx context.Context, block *models.ReusableBlock) error {
+	contentJSON, err := json.Marshal(block.Content)
+	if err != nil {
+		return fmt.Errorf("failed to marshal content: %w", err)
+	}
+
+	row, err := r.getQ(ctx).UpdateReusableBlock(ctx, db.UpdateReusableBlockParams{
+		ID:          parseUUIDToPgtype(block.ID),
+		Slug:        block.Slug,
+		Name:        block.Name,
+		Description: nullableStringPtr(block.Description),
+		Content:     string(contentJSON),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update reusable block: %w", appErr.MapDBError(err))
+	}
+
+	block.CreatedAt = row.CreatedAt.Time
+	block.UpdatedAt = row.UpdatedAt.Time
+	return nil
+}
+
+// Delete removes a reusable block; its usage records are removed by cascade
+func (r *reusableBlockRepositorySQL) Delete(ctx context.Context, id string) error {
+	if err := r.getQ(ctx).DeleteReusableBlockByID(ctx, parseUUIDToPgtype(id)); err != nil {
+		return fmt.Errorf("failed to delete reusable block: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// List returns reusable blocks ordered by name, optionally filtered by options.Search
+func (r *reusableBlockRepositorySQL) List(ctx context.Context, options ListOptions) ([]*models.ReusableBlock, error) {
+	rows, err := r.getQ(ctx).ListReusableBlocks(ctx, db.ListReusableBlocksParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+		Search: nullableStringPtr(options.Search),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reusable blocks: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.ReusableBlock, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCReusableBlock(row))
+	}
+	return out, nil
+}
+
+// ListUsages returns the pages and posts embedding the given block
+func (r *reusableBlockRepositorySQL) ListUsages(ctx context.Context, blockID string) ([]*models.ReusableBlockUsage, error) {
+	rows, err := r.getQ(ctx).ListReusableBlockUsages(ctx, parseUUIDToPgtype(blockID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reusable block usages: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.ReusableBlockUsage, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &models.ReusableBlockUsage{
+			BlockID:     row.BlockID.String(),
+			ContentType: row.ContentType,
+			ContentID:   row.ContentID,
+			Slug:        row.ContentSlug,
+			Title:       row.ContentTitle,
+		})
+	}
+	return out, nil
+}
+
+// CountUsages returns the number of pages and posts embedding the given block
+func (r *reusableBlockRepositorySQL) CountUsages(ctx context.Context, blockID string) (int, error) {
+	count, err := r.getQ(ctx).CountReusableBlockUsages(ctx, parseUUIDToPgtype(blockID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count reusable block usages: %w", appErr.MapDBError(err))
+	}
+	return int(count), nil
+}
+
+// CountUsagesByBlock returns the usage counts of the given blocks, keyed by block ID, in one query
+func (r *reusableBlockRepositorySQL) CountUsagesByBlock(ctx context.Context, blockIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(blockIDs))
+	uuids := make([]pgtype.UUID, 0, len(blockIDs))
+	for _, id := range blockIDs {
+		u := parseUUIDToPgtype(id)
+		if u.Valid {
+			uuids = append(uuids, u)
+		}
+	}
+	if len(uuids) == 0 {
+		return counts, nil
+	}
+
+	rows, err := r.getQ(ctx).CountReusableBlockUsagesByBlock(ctx, uuids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reusable block usages: %w", appErr.MapDBError(err))
+	}
+	for _, row := range rows {
+		counts[row.BlockID.String()] = int(row.UsageCount)
+	}
+	return counts, nil
+}
+
+// ReplaceUsages deletes the usage records of one page or post and inserts the given set.
+// Callers that need atomicity should run it inside a UnitOfWork.
+func (r *reusableBlockRepositorySQL) ReplaceUsages(ctx context.Context, contentType, contentID string, usages []*models.ReusableBlockUsage) error {
+	q := r.getQ(ctx)
+	if err := q.DeleteReusableBlockUsagesForContent(ctx, db.DeleteReusableBlockUsagesForContentParams{
+		ContentType: contentType,
+		ContentID:   contentID,
+	}); err != nil {
+		return fmt.Errorf("failed to clear reusable block usages: %w", appErr.MapDBError(err))
+	}
+
+	for _, u := range usages {
+		if err := q.InsertReusableBlockUsage(ctx, db.InsertReusableBlockUsageParams{
+			BlockID:      parseUUIDToPgtype(u.BlockID),
+			ContentType:  contentType,
+			ContentID:    contentID,
+			ContentSlug:  u.Slug,
+			ContentTitle: u.Title,
+		}); err != nil {
+			return fmt.Errorf("failed to record reusable block usage: %w", appErr.MapDBError(err))
+		}
+	}
+	return nil
+}
+
+// mapSQLCReusableBlock converts a sqlc row to the outward model
+func mapSQLCReusableBlock(row db.ReusableBlock) *models.ReusableBlock {
+	var content models.Content
+	if err := json.Unmarshal([]byte(row.Content), &content); err != nil {
+		content = models.Content{Blocks: []models.ContentBlock{}}
+	}
+	return &models.ReusableBlock{
+		ID:          row.ID.String(),
+		Name:        row.Name,
+		Slug:        row.Slug,
+		Description: derefString(row.Description),
+		Content:     content,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// settingsRepositorySQL implements SettingsRepository (PostgreSQL/sqlc)
+type settingsRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ SettingsRepository = (*settingsRepositorySQL)(nil)
+
+// NewSettingsRepositorySQL creates a new SQL-backed settings repository using the Postgres client
+func NewSettingsRepositorySQL(c *database.PostgresClient) SettingsRepository {
+	return &settingsRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *settingsRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// List returns every stored setting ordered by key
+func (r *settingsRepositorySQL) List(ctx context.Context) ([]*models.Setting, error) {
+	rows, err := r.getQ(ctx).ListSiteSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list site settings: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.Setting, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &models.Setting{
+			Key:       row.Key,
+			Value:     json.RawMessage(row.Value),
+			UpdatedBy: derefString(row.UpdatedBy),
+			UpdatedAt: row.UpdatedAt.Time,
+		})
+	}
+	return out, nil
+}
+
+// Save upserts the values and records their changes in a single statement
+func (r *settingsRepositorySQL) Save(ctx context.Context, values map[string]json.RawMessage, changedBy string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	data := make([][]byte, len(keys))
+	for i, key := range keys {
+		data[i] = values[key]
+	}
+
+	if err := r.getQ(ctx).SaveSiteSettings(ctx, db.SaveSiteSettingsParams{
+		ChangedBy:     nullableStringPtr(changedBy),
+		Keys:          keys,
+		SettingValues: data,
+	}); err != nil {
+		return fmt.Errorf("failed to save site settings: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// ListChanges returns the audit trail newest first
+func (r *settingsRepositorySQL) ListChanges(ctx context.Context, key string, options ListOptions) ([]*models.SettingChange, *PaginationInfo, error) {
+	q := r.getQ(ctx)
+	rows, err := q.ListSiteSettingChanges(ctx, db.ListSiteSettingChangesParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+		Key:    nullableStringPtr(key),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list site setting changes: %w", appErr.MapDBError(err))
+	}
+	total, err := q.CountSiteSettingChanges(ctx, nullableStringPtr(key))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to count site setting changes: %w", appErr.MapDBError(err))
+	}
+
+	out := make([]*models.SettingChange, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &models.SettingChange{
+			ID:        row.ID.String(),
+			Key:       row.Key,
+			OldValue:  json.RawMessage(row.OldValue),
+			NewValue:  json.RawMessage(row.NewValue),
+			ChangedBy: derefString(row.ChangedBy),
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+
+	info := &PaginationInfo{TotalCount: int(total)}
+	if next := options.Skip + len(out); next < int(total) {
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(next)
+	}
+	return out, info, nil
+}
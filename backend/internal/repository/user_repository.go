@@ -49,6 +49,28 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*models.User,
 	return &user, nil
 }
 
+// GetByIDs retrieves the users with the given IDs in one request; missing users are skipped
+func (r *userRepository) GetByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	result, err := r.client.AllDocs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	users := make([]*models.User, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		if len(row.Doc) == 0 || string(row.Doc) == "null" {
+			continue
+		}
+		var user models.User
+		if err := json.Unmarshal(row.Doc, &user); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal user document: %w", err)
+		}
+		users = append(users, &user)
+	}
+
+	return users, nil
+}
+
 // GetByEmail retrieves a user by their email using a view
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	result, err := r.client.Query(ctx, "users", "by_email", map[string]interface{}{
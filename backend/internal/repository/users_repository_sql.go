@@ -4,6 +4,8 @@ import (
 	"context"
 	"log"
 
+	"github.com/jackc/pgx/v5/pgtype"
+
 	dal "github.com/7-solutions/saas-platformbackend/internal/database"
 	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
 	"github.com/7-solutions/saas-platformbackend/internal/ports"
@@ -45,6 +47,29 @@ func (r *usersRepositorySQL) GetByEmail(ctx context.Context, email string) (*por
 	return mapSQLCUser(u), nil
 }
 
+func (r *usersRepositorySQL) GetByIDs(ctx context.Context, ids []string) ([]*ports.User, error) {
+	uuids := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		var u pgtype.UUID
+		if err := u.Scan(id); err == nil {
+			uuids = append(uuids, u)
+		}
+	}
+	if len(uuids) == 0 {
+		return []*ports.User{}, nil
+	}
+	q := r.getQ(ctx)
+	items, err := q.ListUsersByIDs(ctx, uuids)
+	if err != nil {
+		return nil, appErr.MapDBError(err)
+	}
+	out := make([]*ports.User, 0, len(items))
+	for _, it := range items {
+		out = append(out, mapSQLCUser(it))
+	}
+	return out, nil
+}
+
 func (r *usersRepositorySQL) List(ctx context.Context, opt ports.ListOptions) ([]*ports.User, error) {
 	q := r.getQ(ctx)
 	items, err := q.ListUsers(ctx, db.ListUsersParams{
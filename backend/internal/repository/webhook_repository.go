@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// webhookRepositorySQL implements WebhookRepository (PostgreSQL/sqlc)
+type webhookRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ WebhookRepository = (*webhookRepositorySQL)(nil)
+
+// NewWebhookRepositorySQL creates a new SQL-backed webhook repository using the Postgres client
+func NewWebhookRepositorySQL(c *database.PostgresClient) WebhookRepository {
+	return &webhookRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *webhookRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// CreateSubscription inserts a new subscription
+func (r *webhookRepositorySQL) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	row, err := r.getQ(ctx).InsertWebhookSubscription(ctx, db.InsertWebhookSubscriptionParams{
+		Name:       sub.Name,
+		Url:        sub.URL,
+		Secret:     sub.Secret,
+		EventTypes: sub.EventTypes,
+		Active:     sub.Active,
+		CreatedBy:  nullableStringPtr(sub.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", appErr.MapDBError(err))
+	}
+	*sub = *mapSQLCWebhookSubscription(row)
+	return nil
+}
+
+// GetSubscription retrieves a subscription by its UUID
+func (r *webhookRepositorySQL) GetSubscription(ctx context.Context, id string) (*models.WebhookSubscription, error) {
+	row, err := r.getQ(ctx).GetWebhookSubscriptionByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCWebhookSubscription(row), nil
+}
+
+// ListSubscriptions returns subscriptions, oldest first
+func (r *webhookRepositorySQL) ListSubscriptions(ctx context.Context, options ListOptions) ([]*models.WebhookSubscription, error) {
+	rows, err := r.getQ(ctx).ListWebhookSubscriptions(ctx, db.ListWebhookSubscriptionsParams{
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCWebhookSubscription(row))
+	}
+	return out, nil
+}
+
+// ListSubscriptionsForEvent returns the active subscriptions that receive an event type
+func (r *webhookRepositorySQL) ListSubscriptionsForEvent(ctx context.Context, eventType string) ([]*models.WebhookSubscription, error) {
+	rows, err := r.getQ(ctx).ListActiveWebhookSubscriptionsForEvent(ctx, eventType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCWebhookSubscription(row))
+	}
+	return out, nil
+}
+
+// UpdateSubscription saves name, URL, secret, event types and active flag
+func (r *webhookRepositorySQL) UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	row, err := r.getQ(ctx).UpdateWebhookSubscription(ctx, db.UpdateWebhookSubscriptionParams{
+		ID:         parseUUIDToPgtype(sub.ID),
+		Name:       sub.Name,
+		Url:        sub.URL,
+		Secret:     sub.Secret,
+		EventTypes: sub.EventTypes,
+		Active:     sub.Active,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", appErr.MapDBError(err))
+	}
+	*sub = *mapSQLCWebhookSubscription(row)
+	return nil
+}
+
+// DeleteSubscription removes a subscription and its delivery log
+func (r *webhookRepositorySQL) DeleteSubscription(ctx context.Context, id string) error {
+	affected, err := r.getQ(ctx).DeleteWebhookSubscription(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", appErr.MapDBError(err))
+	}
+	if affected == 0 {
+		return appErr.ErrNotFound
+	}
+	return nil
+}
+
+// CreateDelivery inserts a new pending delivery
+func (r *webhookRepositorySQL) CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	row, err := r.getQ(ctx).InsertWebhookDelivery(ctx, db.InsertWebhookDeliveryParams{
+		SubscriptionID: parseUUIDToPgtype(delivery.SubscriptionID),
+		EventID:        parseUUIDToPgtype(delivery.EventID),
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		NextAttemptAt:  timePtrToPgtype(delivery.NextAttemptAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", appErr.MapDBError(err))
+	}
+	*delivery = *mapSQLCWebhookDelivery(row)
+	return nil
+}
+
+// GetDelivery retrieves a delivery by its UUID
+func (r *webhookRepositorySQL) GetDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	row, err := r.getQ(ctx).GetWebhookDeliveryByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCWebhookDelivery(row), nil
+}
+
+// ListDeliveries lists the deliveries of a subscription, newest first
+func (r *webhookRepositorySQL) ListDeliveries(ctx context.Context, subscriptionID, status string, options ListOptions) ([]*models.WebhookDelivery, *PaginationInfo, error) {
+	q := r.getQ(ctx)
+	rows, err := q.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		SubscriptionID: parseUUIDToPgtype(subscriptionID),
+		Limit:          int32(options.Limit),
+		Offset:         int32(options.Skip),
+		Status:         nullableStringPtr(status),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list webhook deliveries: %w", appErr.MapDBError(err))
+	}
+	total, err := q.CountWebhookDeliveries(ctx, db.CountWebhookDeliveriesParams{
+		SubscriptionID: parseUUIDToPgtype(subscriptionID),
+		Status:         nullableStringPtr(status),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to count webhook deliveries: %w", appErr.MapDBError(err))
+	}
+
+	out := make([]*models.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCWebhookDelivery(row))
+	}
+
+	info := &PaginationInfo{TotalCount: int(total)}
+	if next := options.Skip + len(out); next < int(total) {
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(next)
+	}
+	return out, info, nil
+}
+
+// ListDueDeliveries returns pending deliveries due at or before the given time, most overdue first
+func (r *webhookRepositorySQL) ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	rows, err := r.getQ(ctx).ListDueWebhookDeliveries(ctx, db.ListDueWebhookDeliveriesParams{
+		NextAttemptAt: pgtype.Timestamptz{Time: before, Valid: true},
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due webhook deliveries: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCWebhookDelivery(row))
+	}
+	return out, nil
+}
+
+// UpdateDelivery saves the outcome of the latest attempt
+func (r *webhookRepositorySQL) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	row, err := r.getQ(ctx).UpdateWebhookDeliveryAttempt(ctx, db.UpdateWebhookDeliveryAttemptParams{
+		ID:             parseUUIDToPgtype(delivery.ID),
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		ResponseStatus: int32(delivery.ResponseStatus),
+		LastError:      delivery.LastError,
+		NextAttemptAt:  timePtrToPgtype(delivery.NextAttemptAt),
+		DeliveredAt:    timePtrToPgtype(delivery.DeliveredAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", appErr.MapDBError(err))
+	}
+	*delivery = *mapSQLCWebhookDelivery(row)
+	return nil
+}
+
+// PruneDeliveries removes settled deliveries created before the given time
+func (r *webhookRepositorySQL) PruneDeliveries(ctx context.Context, before time.Time) error {
+	if err := r.getQ(ctx).DeleteWebhookDeliveriesBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true}); err != nil {
+		return fmt.Errorf("failed to prune webhook deliveries: %w", appErr.MapDBError(err))
+	}
+	return nil
+}
+
+// timePtrToPgtype converts an optional time to a nullable timestamptz
+func timePtrToPgtype(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{Valid: false}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// mapSQLCWebhookSubscription converts a sqlc row to the outward model
+func mapSQLCWebhookSubscription(row db.WebhookSubscription) *models.WebhookSubscription {
+	return &models.WebhookSubscription{
+		ID:         row.ID.String(),
+		Name:       row.Name,
+		URL:        row.Url,
+		Secret:     row.Secret,
+		EventTypes: row.EventTypes,
+		Active:     row.Active,
+		CreatedBy:  derefString(row.CreatedBy),
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+}
+
+// mapSQLCWebhookDelivery converts a sqlc row to the outward model
+func mapSQLCWebhookDelivery(row db.WebhookDelivery) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:             row.ID.String(),
+		SubscriptionID: row.SubscriptionID.String(),
+		EventID:        row.EventID.String(),
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         row.Status,
+		Attempts:       int(row.Attempts),
+		ResponseStatus: int(row.ResponseStatus),
+		LastError:      row.LastError,
+		NextAttemptAt:  nullableTimePtr(row.NextAttemptAt),
+		DeliveredAt:    nullableTimePtr(row.DeliveredAt),
+		CreatedAt:      row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/7-solutions/saas-platformbackend/internal/database"
+	db "github.com/7-solutions/saas-platformbackend/internal/database/sqlc"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// webmentionRepositorySQL implements WebmentionRepository (PostgreSQL/sqlc)
+type webmentionRepositorySQL struct {
+	q *db.Queries
+}
+
+// Ensure SQL repo implements interface at compile time
+var _ WebmentionRepository = (*webmentionRepositorySQL)(nil)
+
+// NewWebmentionRepositorySQL creates a new SQL-backed Webmention repository using the Postgres client
+func NewWebmentionRepositorySQL(c *database.PostgresClient) WebmentionRepository {
+	return &webmentionRepositorySQL{
+		q: database.NewQueriesFromClient(c),
+	}
+}
+
+// getQ returns tx-bound Queries from context when available, otherwise the base queries.
+func (r *webmentionRepositorySQL) getQ(ctx context.Context) *db.Queries {
+	if q := database.QueriesFromContext(ctx); q != nil {
+		return q
+	}
+	return r.q
+}
+
+// Upsert stores a received mention, resetting a known source and target to pending
+func (r *webmentionRepositorySQL) Upsert(ctx context.Context, mention *models.Webmention) error {
+	row, err := r.getQ(ctx).UpsertWebmention(ctx, db.UpsertWebmentionParams{
+		PostID:    mention.PostID,
+		Source:    mention.Source,
+		Target:    mention.Target,
+		IpAddress: nullableStringPtr(mention.IPAddress),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store webmention: %w", appErr.MapDBError(err))
+	}
+
+	*mention = *mapSQLCWebmention(row)
+	return nil
+}
+
+// GetByID retrieves a mention by its UUID
+func (r *webmentionRepositorySQL) GetByID(ctx context.Context, id string) (*models.Webmention, error) {
+	row, err := r.getQ(ctx).GetWebmentionByID(ctx, parseUUIDToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webmention: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCWebmention(row), nil
+}
+
+// UpdateVerification stores the outcome of verifying the source of a mention
+func (r *webmentionRepositorySQL) UpdateVerification(ctx context.Context, mention *models.Webmention) error {
+	row, err := r.getQ(ctx).UpdateWebmentionVerification(ctx, db.UpdateWebmentionVerificationParams{
+		ID:         parseUUIDToPgtype(mention.ID),
+		Status:     mention.Status,
+		Title:      mention.Title,
+		AuthorName: mention.AuthorName,
+		LastError:  mention.LastError,
+		VerifiedAt: timePtrToPgtype(mention.VerifiedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webmention: %w", appErr.MapDBError(err))
+	}
+
+	*mention = *mapSQLCWebmention(row)
+	return nil
+}
+
+// ListByPost returns all mentions of a post in the given state, oldest first
+func (r *webmentionRepositorySQL) ListByPost(ctx context.Context, postID, status string) ([]*models.Webmention, error) {
+	rows, err := r.getQ(ctx).ListWebmentionsByPostAndStatus(ctx, db.ListWebmentionsByPostAndStatusParams{
+		PostID: postID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webmentions: %w", appErr.MapDBError(err))
+	}
+	out := make([]*models.Webmention, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCWebmention(row))
+	}
+	return out, nil
+}
+
+// ListByStatus returns a page of mentions in the given state, oldest first
+func (r *webmentionRepositorySQL) ListByStatus(ctx context.Context, status, postID string, options ListOptions) ([]*models.Webmention, *PaginationInfo, error) {
+	q := r.getQ(ctx)
+	rows, err := q.ListWebmentionsByStatus(ctx, db.ListWebmentionsByStatusParams{
+		Status: status,
+		Limit:  int32(options.Limit),
+		Offset: int32(options.Skip),
+		PostID: nullableStringPtr(postID),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list webmentions: %w", appErr.MapDBError(err))
+	}
+	total, err := q.CountWebmentionsByStatus(ctx, db.CountWebmentionsByStatusParams{
+		Status: status,
+		PostID: nullableStringPtr(postID),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to count webmentions: %w", appErr.MapDBError(err))
+	}
+
+	out := make([]*models.Webmention, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, mapSQLCWebmention(row))
+	}
+
+	info := &PaginationInfo{TotalCount: int(total)}
+	if next := options.Skip + len(out); next < int(total) {
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(next)
+	}
+	return out, info, nil
+}
+
+// UpdateStatus moves a mention to a new moderation state
+func (r *webmentionRepositorySQL) UpdateStatus(ctx context.Context, id, status, moderatedBy string) (*models.Webmention, error) {
+	row, err := r.getQ(ctx).UpdateWebmentionStatus(ctx, db.UpdateWebmentionStatusParams{
+		ID:          parseUUIDToPgtype(id),
+		Status:      status,
+		ModeratedBy: nullableStringPtr(moderatedBy),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webmention status: %w", appErr.MapDBError(err))
+	}
+	return mapSQLCWebmention(row), nil
+}
+
+// mapSQLCWebmention converts a sqlc row to the outward model
+func mapSQLCWebmention(row db.Webmention) *models.Webmention {
+	return &models.Webmention{
+		ID:          row.ID.String(),
+		PostID:      row.PostID,
+		Source:      row.Source,
+		Target:      row.Target,
+		Title:       row.Title,
+		AuthorName:  row.AuthorName,
+		Status:      row.Status,
+		LastError:   row.LastError,
+		IPAddress:   derefString(row.IpAddress),
+		VerifiedAt:  nullableTimePtr(row.VerifiedAt),
+		ModeratedBy: derefString(row.ModeratedBy),
+		ModeratedAt: nullableTimePtr(row.ModeratedAt),
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
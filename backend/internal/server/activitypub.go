@@ -0,0 +1,104 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	"github.com/7-solutions/saas-platformbackend/internal/services"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// activityPubMaxBodySize bounds the size of an activity POSTed to an inbox
+const activityPubMaxBodySize = 256 << 10
+
+// ActivityPubHandler serves WebFinger and the ActivityPub actors, outboxes, inboxes and
+// post objects of the site
+type ActivityPubHandler struct {
+	federation *services.FederationService
+	mux        *http.ServeMux
+}
+
+// NewActivityPubHandler creates the ActivityPub endpoints; mount it at /.well-known/webfinger and /ap/
+func NewActivityPubHandler(federation *services.FederationService) *ActivityPubHandler {
+	h := &ActivityPubHandler{federation: federation, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET /.well-known/webfinger", h.webFinger)
+	h.mux.HandleFunc("GET /ap/actors/{name}", h.actor)
+	h.mux.HandleFunc("GET /ap/actors/{name}/outbox", h.outbox)
+	h.mux.HandleFunc("GET /ap/actors/{name}/followers", h.followers)
+	h.mux.HandleFunc("POST /ap/actors/{name}/inbox", h.inbox)
+	h.mux.HandleFunc("POST /ap/inbox", h.inbox)
+	h.mux.HandleFunc("GET /ap/posts/{slug}", h.article)
+	return h
+}
+
+// ServeHTTP routes the request to the matching ActivityPub endpoint
+func (h *ActivityPubHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// webFinger handles GET /.well-known/webfinger?resource=acct:<name>@<domain>
+func (h *ActivityPubHandler) webFinger(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.federation.WebFinger(r.Context(), r.URL.Query().Get("resource"))
+	writeActivityPubResponse(w, "application/jrd+json", resp, err)
+}
+
+func (h *ActivityPubHandler) actor(w http.ResponseWriter, r *http.Request) {
+	doc, err := h.federation.Actor(r.Context(), r.PathValue("name"))
+	writeActivityPubResponse(w, services.ActivityPubContentType, doc, err)
+}
+
+// outbox handles GET /ap/actors/{name}/outbox[?page=N]
+func (h *ActivityPubHandler) outbox(w http.ResponseWriter, r *http.Request) {
+	page := 0
+	if v := r.URL.Query().Get("page"); v != "" {
+		var err error
+		if page, err = strconv.Atoi(v); err != nil || page < 1 {
+			http.Error(w, "Invalid page", http.StatusBadRequest)
+			return
+		}
+	}
+	doc, err := h.federation.Outbox(r.Context(), r.PathValue("name"), page)
+	writeActivityPubResponse(w, services.ActivityPubContentType, doc, err)
+}
+
+func (h *ActivityPubHandler) followers(w http.ResponseWriter, r *http.Request) {
+	doc, err := h.federation.Followers(r.Context(), r.PathValue("name"))
+	writeActivityPubResponse(w, services.ActivityPubContentType, doc, err)
+}
+
+func (h *ActivityPubHandler) article(w http.ResponseWriter, r *http.Request) {
+	doc, err := h.federation.Article(r.Context(), r.PathValue("slug"))
+	writeActivityPubResponse(w, services.ActivityPubContentType, doc, err)
+}
+
+// inbox handles POSTs to an actor inbox and to the shared inbox at /ap/inbox
+func (h *ActivityPubHandler) inbox(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, activityPubMaxBodySize))
+	if err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if err := h.federation.ReceiveInbox(r.Context(), r.PathValue("name"), r, body); err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// writeActivityPubResponse writes a JSON document with the given media type, or the error as an HTTP status
+func writeActivityPubResponse(w http.ResponseWriter, contentType string, doc interface{}, err error) {
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if err := json.NewEncoder(w).Encode(doc); err != nil {
+		logger.Error("Failed to write ActivityPub response", err)
+	}
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/services"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/auth"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// contentWatchKeepAlive is how often an idle event stream sends a comment so proxies keep it open
+const contentWatchKeepAlive = 15 * time.Second
+
+// ContentWatchHandler bridges the WatchContent feed to server-sent events.
+// Each event is sent with its sequence number as SSE id, so browsers resume
+// automatically through the Last-Event-ID header when they reconnect.
+type ContentWatchHandler struct {
+	feed      *services.ContentFeed
+	keepAlive time.Duration
+}
+
+// NewContentWatchHandler creates an SSE bridge for the feed
+func NewContentWatchHandler(feed *services.ContentFeed) *ContentWatchHandler {
+	return &ContentWatchHandler{feed: feed, keepAlive: contentWatchKeepAlive}
+}
+
+// ServeHTTP handles GET /api/v1/content/watch. EventSource cannot set headers, so the
+// token is also accepted as ?access_token=. ?since= and ?resource_types= mirror
+// WatchContentRequest; a Last-Event-ID header takes precedence over ?since=.
+func (h *ContentWatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("access_token")
+	if header := r.Header.Get("Authorization"); header != "" {
+		var err error
+		if token, err = auth.ExtractTokenFromHeader(header); err != nil {
+			http.Error(w, "Invalid authorization header", http.StatusUnauthorized)
+			return
+		}
+	}
+	if token == "" {
+		http.Error(w, "Missing authorization", http.StatusUnauthorized)
+		return
+	}
+	claims, err := auth.ValidateToken(token)
+	if err != nil {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+	if !hasRequiredRole(claims.Role, getEndpointRoleRequirement(contentv1.ContentService_WatchContent_FullMethodName)) {
+		http.Error(w, "Insufficient permissions", http.StatusForbidden)
+		return
+	}
+
+	since, err := parseWatchSince(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resourceTypes, err := parseWatchResourceTypes(r.URL.Query().Get("resource_types"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sub, err := h.feed.Subscribe(since, resourceTypes)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	flusher := http.NewResponseController(w)
+	for _, event := range sub.Backlog {
+		if err := writeContentEvent(w, event); err != nil {
+			return
+		}
+	}
+	if err := flusher.Flush(); err != nil {
+		logger.Error("Content watch stream cannot be flushed", err)
+		return
+	}
+
+	ticker := time.NewTicker(h.keepAlive)
+	defer ticker.Stop()
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case <-sub.Lagged():
+			// The browser reconnects with Last-Event-ID and catches up from the replay log
+			fmt.Fprint(w, "event: lagged\ndata: {}\n\n")
+			flusher.Flush()
+			return
+		case event := <-sub.Events():
+			err = writeContentEvent(w, event)
+		case <-ticker.C:
+			_, err = fmt.Fprint(w, ": keepalive\n\n")
+		}
+		if err == nil {
+			err = flusher.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// writeContentEvent writes one event in SSE framing, named after its type, e.g. "post.published"
+func writeContentEvent(w http.ResponseWriter, event *contentv1.ContentEvent) error {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.Type, data)
+	return err
+}
+
+// parseWatchSince returns the sequence to resume after
+func parseWatchSince(r *http.Request) (uint64, error) {
+	value := r.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = r.URL.Query().Get("since")
+	}
+	if value == "" {
+		return 0, nil
+	}
+	since, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sequence %q", value)
+	}
+	return since, nil
+}
+
+// parseWatchResourceTypes parses a comma-separated list such as "page,blog_post" or
+// the full enum names, e.g. "CONTENT_RESOURCE_TYPE_MEDIA"
+func parseWatchResourceTypes(value string) ([]contentv1.ContentResourceType, error) {
+	var types []contentv1.ContentResourceType
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !strings.HasPrefix(name, "CONTENT_RESOURCE_TYPE_") {
+			name = "CONTENT_RESOURCE_TYPE_" + name
+		}
+		t, ok := contentv1.ContentResourceType_value[name]
+		if !ok || t == 0 {
+			return nil, fmt.Errorf("unknown resource type %q", name)
+		}
+		types = append(types, contentv1.ContentResourceType(t))
+	}
+	return types, nil
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/7-solutions/saas-platformbackend/internal/services"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/auth"
+)
+
+const (
+	// graphQLMaxBodySize bounds the size of a GraphQL request body
+	graphQLMaxBodySize = 1 << 20
+	// graphQLMaxBatch bounds the number of operations in one batched request
+	graphQLMaxBatch = 10
+)
+
+// GraphQLHandler serves the read-only GraphQL API over HTTP. A Bearer token is optional;
+// without one only published content is returned.
+type GraphQLHandler struct {
+	service *services.GraphQLService
+}
+
+// NewGraphQLHandler creates an HTTP handler for the GraphQL service
+func NewGraphQLHandler(service *services.GraphQLService) *GraphQLHandler {
+	return &GraphQLHandler{service: service}
+}
+
+// ServeHTTP handles GET /graphql?query=... and POST /graphql with a JSON operation,
+// or a JSON array of up to graphQLMaxBatch operations answered with an array of results
+func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if header := r.Header.Get("Authorization"); header != "" {
+		token, err := auth.ExtractTokenFromHeader(header)
+		if err != nil {
+			http.Error(w, "Invalid authorization header", http.StatusUnauthorized)
+			return
+		}
+		claims, err := auth.ValidateToken(token)
+		if err != nil {
+			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			return
+		}
+		ctx = contextWithClaims(ctx, claims)
+	}
+
+	var requests []services.GraphQLRequest
+	batched := false
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req := services.GraphQLRequest{Query: query.Get("query"), OperationName: query.Get("operationName")}
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				http.Error(w, "Invalid variables", http.StatusBadRequest)
+				return
+			}
+		}
+		requests = append(requests, req)
+	case http.MethodPost:
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, graphQLMaxBodySize))
+		if err != nil {
+			http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		body = bytes.TrimSpace(body)
+		batched = len(body) > 0 && body[0] == '['
+		if batched {
+			err = json.Unmarshal(body, &requests)
+		} else {
+			var req services.GraphQLRequest
+			err = json.Unmarshal(body, &req)
+			requests = append(requests, req)
+		}
+		if err != nil {
+			http.Error(w, "Invalid JSON body", http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if len(requests) == 0 || len(requests) > graphQLMaxBatch {
+		http.Error(w, "A batch must contain between 1 and 10 operations", http.StatusBadRequest)
+		return
+	}
+	for _, req := range requests {
+		if req.Query == "" {
+			http.Error(w, "Missing query", http.StatusBadRequest)
+			return
+		}
+	}
+
+	results := make([]*graphql.Result, 0, len(requests))
+	for _, req := range requests {
+		results = append(results, h.service.Execute(ctx, req))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if batched {
+		_ = json.NewEncoder(w).Encode(results)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(results[0])
+}
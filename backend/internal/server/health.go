@@ -9,6 +9,7 @@ import (
 
 	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
 	"github.com/7-solutions/saas-platformbackend/internal/utils/metrics"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
 )
 
 // HealthStatus represents the health status of a component
@@ -150,6 +151,7 @@ func (hc *HealthChecker) performHealthChecks(ctx context.Context) []HealthCheck
 		hc.checkMemoryUsage(),
 		hc.checkGoroutines(),
 		hc.checkDiskSpace(),
+		hc.checkRevalidation(),
 	}
 }
 
@@ -253,6 +255,48 @@ func (hc *HealthChecker) checkDiskSpace() HealthCheck {
 	return check
 }
 
+// revalidationFailureWindow is how long a permanent revalidation failure degrades health
+const revalidationFailureWindow = 15 * time.Minute
+
+// checkRevalidation checks the frontend revalidation retry queue
+func (hc *HealthChecker) checkRevalidation() HealthCheck {
+	start := time.Now()
+
+	check := HealthCheck{
+		Name:        "revalidation",
+		LastChecked: start,
+		Duration:    time.Since(start).String(),
+		Status:      HealthStatusHealthy,
+	}
+
+	if hc.server.revalidation == nil {
+		check.Message = "Frontend revalidation disabled"
+		return check
+	}
+
+	stats := hc.server.revalidation.Stats()
+	var recent []revalidate.Failure
+	for _, failure := range stats.Failures {
+		if start.Sub(failure.FailedAt) <= revalidationFailureWindow {
+			recent = append(recent, failure)
+		}
+	}
+	check.Details = map[string]interface{}{
+		"queued":          stats.Queued,
+		"retrying":        stats.Retrying,
+		"recent_failures": recent,
+	}
+
+	if len(recent) > 0 {
+		check.Status = HealthStatusDegraded
+		check.Message = "Frontend revalidation failing"
+	} else {
+		check.Message = "Frontend revalidation normal"
+	}
+
+	return check
+}
+
 // determineOverallStatus determines the overall health status
 func (hc *HealthChecker) determineOverallStatus(checks []HealthCheck) HealthStatus {
 	hasUnhealthy := false
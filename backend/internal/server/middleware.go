@@ -68,13 +68,69 @@ func randomString(length int) string {
 	return string(b)
 }
 
-// AuthInterceptor handles authentication for protected endpoints
+// AuthInterceptor handles authentication and role checks for protected endpoints
 func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	// Skip authentication for login and public endpoints
+	// Skip authentication for login and public endpoints. A valid token is still
+	// honoured so public endpoints can tell signed-in callers apart from guests.
 	if isPublicEndpoint(info.FullMethod) {
+		if claims, err := tokenClaimsFromContext(ctx); err == nil {
+			ctx = contextWithClaims(ctx, claims)
+		}
 		return handler(ctx, req)
 	}
 
+	claims, err := tokenClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if requiredRole := getEndpointRoleRequirement(info.FullMethod); !hasRequiredRole(claims.Role, requiredRole) {
+		return nil, status.Errorf(codes.PermissionDenied, "insufficient permissions: required %s, got %s", requiredRole, claims.Role)
+	}
+
+	return handler(contextWithClaims(ctx, claims), req)
+}
+
+// StreamAuthInterceptor handles authentication and role checks for streaming endpoints
+func StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isPublicEndpoint(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	claims, err := tokenClaimsFromContext(ss.Context())
+	if err != nil {
+		return err
+	}
+	if requiredRole := getEndpointRoleRequirement(info.FullMethod); !hasRequiredRole(claims.Role, requiredRole) {
+		return status.Errorf(codes.PermissionDenied, "insufficient permissions: required %s, got %s", requiredRole, claims.Role)
+	}
+
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: contextWithClaims(ss.Context(), claims)})
+}
+
+// StreamPanicRecoveryInterceptor recovers from panics in streaming handlers and logs them
+func StreamPanicRecoveryInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.LogPanic(ss.Context(), r, "gRPC stream handler panic")
+			err = status.Errorf(codes.Internal, "internal server error")
+		}
+	}()
+
+	return handler(srv, ss)
+}
+
+// contextServerStream overrides the context of a server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// tokenClaimsFromContext validates the bearer token found in the request metadata
+func tokenClaimsFromContext(ctx context.Context) (*auth.Claims, error) {
 	// Extract token from metadata
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -98,13 +154,16 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 	}
 
-	// Add user info to context
+	return claims, nil
+}
+
+// contextWithClaims adds user info from validated token claims to the context
+func contextWithClaims(ctx context.Context, claims *auth.Claims) context.Context {
 	ctx = context.WithValue(ctx, "user_id", claims.UserID)
 	ctx = context.WithValue(ctx, "user_email", claims.Email)
 	ctx = context.WithValue(ctx, "user_role", claims.Role)
 	ctx = context.WithValue(ctx, "user_name", claims.Username)
-
-	return handler(ctx, req)
+	return ctx
 }
 
 // AuthorizeRole creates an interceptor that checks if user has required role
@@ -193,7 +252,26 @@ func isPublicEndpoint(method string) bool {
 		"/auth.v1.AuthService/Login",
 		"/content.v1.ContentService/GetPage",
 		"/content.v1.ContentService/ListPages",
+		"/content.v1.ContentService/GetCollection",
+		"/content.v1.ContentService/ListCollections",
+		"/content.v1.ContentService/GetRelatedPosts",
 		"/contact.v1.ContactService/SubmitContactForm",
+		"/comment.v1.CommentService/SubmitComment",
+		"/comment.v1.CommentService/ListComments",
+		"/comment.v1.CommentService/ListWebmentions",
+		"/analytics.v1.AnalyticsService/TrackView",
+		"/entry.v1.EntryService/GetContentType",
+		"/entry.v1.EntryService/ListContentTypes",
+		"/entry.v1.EntryService/GetEntry",
+		"/entry.v1.EntryService/ListEntries",
+		"/newsletter.v1.NewsletterService/Subscribe",
+		"/newsletter.v1.NewsletterService/ConfirmSubscription",
+		"/newsletter.v1.NewsletterService/GetPreferences",
+		"/newsletter.v1.NewsletterService/UpdatePreferences",
+		"/newsletter.v1.NewsletterService/Unsubscribe",
+		"/newsletter.v1.NewsletterService/ListTopics",
+		"/navigation.v1.NavigationService/GetMenu",
+		"/settings.v1.SettingsService/GetSettings",
 	}
 
 	for _, endpoint := range publicEndpoints {
@@ -218,6 +296,73 @@ func getEndpointRoleRequirement(method string) string {
 		"/content.v1.ContentService/UpdatePage": "editor",
 		"/content.v1.ContentService/DeletePage": "admin",
 
+		"/content.v1.ContentService/CreateReusableBlock": "editor",
+		"/content.v1.ContentService/UpdateReusableBlock": "editor",
+		"/content.v1.ContentService/DeleteReusableBlock": "admin",
+
+		"/content.v1.ContentService/CreatePageTemplate":     "editor",
+		"/content.v1.ContentService/UpdatePageTemplate":     "editor",
+		"/content.v1.ContentService/DeletePageTemplate":     "admin",
+		"/content.v1.ContentService/CreatePageFromTemplate": "editor",
+		"/content.v1.ContentService/DuplicatePage":          "editor",
+		"/content.v1.ContentService/DuplicateBlogPost":      "editor",
+
+		"/content.v1.ContentService/CreateCollection":       "editor",
+		"/content.v1.ContentService/UpdateCollection":       "editor",
+		"/content.v1.ContentService/SetCollectionPosts":     "editor",
+		"/content.v1.ContentService/ReorderCollectionPosts": "editor",
+		"/content.v1.ContentService/DeleteCollection":       "admin",
+
+		"/content.v1.ContentService/GetLinkReport": "editor",
+		"/content.v1.ContentService/RunLinkScan":   "editor",
+		"/content.v1.ContentService/AnalyzeSEO":    "editor",
+		"/content.v1.ContentService/ListSEOIssues": "editor",
+		"/content.v1.ContentService/WatchContent":  "editor",
+
+		// Comment moderation endpoints
+		"/comment.v1.CommentService/ListModerationQueue": "editor",
+		"/comment.v1.CommentService/ModerateComment":     "editor",
+		"/comment.v1.CommentService/ListWebmentionQueue": "editor",
+		"/comment.v1.CommentService/ModerateWebmention":  "editor",
+
+		// Analytics endpoints
+		"/analytics.v1.AnalyticsService/GetContentStats":    "editor",
+		"/analytics.v1.AnalyticsService/ListPopularContent": "editor",
+
+		// Webhook endpoints
+		"/webhook.v1.WebhookService/CreateWebhook":         "admin",
+		"/webhook.v1.WebhookService/GetWebhook":            "admin",
+		"/webhook.v1.WebhookService/ListWebhooks":          "admin",
+		"/webhook.v1.WebhookService/UpdateWebhook":         "admin",
+		"/webhook.v1.WebhookService/DeleteWebhook":         "admin",
+		"/webhook.v1.WebhookService/ListWebhookEventTypes": "admin",
+		"/webhook.v1.WebhookService/ListWebhookDeliveries": "admin",
+		"/webhook.v1.WebhookService/RedeliverWebhook":      "admin",
+
+		// Newsletter endpoints
+		"/newsletter.v1.NewsletterService/ListSubscribers": "admin",
+		"/newsletter.v1.NewsletterService/SendDigest":      "admin",
+
+		// Navigation endpoints
+		"/navigation.v1.NavigationService/CreateMenu": "editor",
+		"/navigation.v1.NavigationService/ListMenus":  "editor",
+		"/navigation.v1.NavigationService/UpdateMenu": "editor",
+		"/navigation.v1.NavigationService/DeleteMenu": "admin",
+
+		// Settings endpoints
+		"/settings.v1.SettingsService/UpdateSettings":     "admin",
+		"/settings.v1.SettingsService/ListSettingChanges": "admin",
+
+		// Entry endpoints
+		"/entry.v1.EntryService/CreateContentType": "admin",
+		"/entry.v1.EntryService/UpdateContentType": "admin",
+		"/entry.v1.EntryService/DeleteContentType": "admin",
+		"/entry.v1.EntryService/CreateEntry":       "editor",
+		"/entry.v1.EntryService/UpdateEntry":       "editor",
+		"/entry.v1.EntryService/PublishEntry":      "editor",
+		"/entry.v1.EntryService/UnpublishEntry":    "editor",
+		"/entry.v1.EntryService/DeleteEntry":       "admin",
+
 		// Media endpoints
 		"/media.v1.MediaService/UploadFile": "editor",
 		"/media.v1.MediaService/DeleteFile": "editor",
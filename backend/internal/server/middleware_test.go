@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/7-solutions/saas-platformbackend/internal/utils/auth"
+)
+
+// callAuthInterceptor runs AuthInterceptor for method as a caller with role, or anonymously when role is empty
+func callAuthInterceptor(t *testing.T, method, role string) (context.Context, error) {
+	t.Helper()
+	ctx := context.Background()
+	if role != "" {
+		token, err := auth.GenerateToken("user-1", "user@example.com", role, "User")
+		require.NoError(t, err)
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	var handled context.Context
+	_, err := AuthInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = ctx
+		return nil, nil
+	})
+	return handled, err
+}
+
+func TestAuthInterceptor_EnforcesEndpointRoles(t *testing.T) {
+	tests := []struct {
+		method string
+		role   string
+		want   codes.Code
+	}{
+		{"/content.v1.ContentService/GetPage", "", codes.OK},
+		{"/content.v1.ContentService/CreatePage", "", codes.Unauthenticated},
+		{"/content.v1.ContentService/CreatePage", "viewer", codes.PermissionDenied},
+		{"/content.v1.ContentService/CreatePage", "editor", codes.OK},
+		{"/content.v1.ContentService/DeletePage", "editor", codes.PermissionDenied},
+		{"/content.v1.ContentService/DeletePage", "admin", codes.OK},
+		{"/content.v1.ContentService/CreateReusableBlock", "viewer", codes.PermissionDenied},
+		{"/content.v1.ContentService/CreateReusableBlock", "editor", codes.OK},
+		{"/content.v1.ContentService/DeleteReusableBlock", "editor", codes.PermissionDenied},
+		{"/content.v1.ContentService/DeleteReusableBlock", "admin", codes.OK},
+		{"/webhook.v1.WebhookService/ListWebhooks", "editor", codes.PermissionDenied},
+		{"/webhook.v1.WebhookService/UpdateWebhook", "editor", codes.PermissionDenied},
+		{"/webhook.v1.WebhookService/UpdateWebhook", "admin", codes.OK},
+		{"/entry.v1.EntryService/ListEntries", "", codes.OK},
+		{"/entry.v1.EntryService/CreateContentType", "editor", codes.PermissionDenied},
+		{"/entry.v1.EntryService/CreateContentType", "admin", codes.OK},
+		{"/entry.v1.EntryService/CreateEntry", "editor", codes.OK},
+		{"/newsletter.v1.NewsletterService/Subscribe", "", codes.OK},
+		{"/newsletter.v1.NewsletterService/ListSubscribers", "editor", codes.PermissionDenied},
+		{"/newsletter.v1.NewsletterService/SendDigest", "editor", codes.PermissionDenied},
+		{"/newsletter.v1.NewsletterService/SendDigest", "admin", codes.OK},
+		{"/settings.v1.SettingsService/GetSettings", "", codes.OK},
+		{"/settings.v1.SettingsService/UpdateSettings", "editor", codes.PermissionDenied},
+		{"/settings.v1.SettingsService/UpdateSettings", "admin", codes.OK},
+		{"/settings.v1.SettingsService/ListSettingChanges", "editor", codes.PermissionDenied},
+		{"/media.v1.MediaService/ListFiles", "viewer", codes.OK},
+		{"/media.v1.MediaService/UploadFile", "viewer", codes.PermissionDenied},
+		{"/media.v1.MediaService/UploadFile", "unknown", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" as "+tt.role, func(t *testing.T) {
+			_, err := callAuthInterceptor(t, tt.method, tt.role)
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
+
+func TestAuthInterceptor_AddsClaimsToContext(t *testing.T) {
+	ctx, err := callAuthInterceptor(t, "/content.v1.ContentService/CreatePage", "editor")
+	require.NoError(t, err)
+
+	userID, email, role, name, ok := GetUserFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "user-1", userID)
+	assert.Equal(t, "user@example.com", email)
+	assert.Equal(t, "editor", role)
+	assert.Equal(t, "User", name)
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	"github.com/7-solutions/saas-platformbackend/internal/services"
+)
+
+// newsletterEventsMaxBodySize bounds a batch of delivery events
+const newsletterEventsMaxBodySize = 1 << 20
+
+// NewsletterEventsHandler receives bounce and complaint notifications from the email provider
+type NewsletterEventsHandler struct {
+	newsletter *services.NewsletterService
+	secret     string
+}
+
+// NewNewsletterEventsHandler creates the delivery webhook; requests must authenticate with
+// the shared secret as a bearer token or as the basic auth password
+func NewNewsletterEventsHandler(newsletter *services.NewsletterService, secret string) *NewsletterEventsHandler {
+	return &NewsletterEventsHandler{newsletter: newsletter, secret: secret}
+}
+
+// ServeHTTP handles POST /api/v1/newsletter/events with a JSON body holding either one
+// event or {"events": [...]}, e.g. {"type": "bounce", "email": "...", "bounce_type": "hard"}
+func (h *NewsletterEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="newsletter"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, newsletterEventsMaxBodySize))
+	if err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	events, err := decodeNewsletterEvents(body)
+	if err != nil {
+		http.Error(w, "Invalid JSON body", http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range events {
+		if err := h.newsletter.HandleDeliveryEvent(r.Context(), event); err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorized compares the bearer token or basic auth password with the shared secret
+func (h *NewsletterEventsHandler) authorized(r *http.Request) bool {
+	credential := ""
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		credential = strings.TrimSpace(token)
+	} else if _, password, ok := r.BasicAuth(); ok {
+		credential = password
+	}
+	return credential != "" && subtle.ConstantTimeCompare([]byte(credential), []byte(h.secret)) == 1
+}
+
+// decodeNewsletterEvents accepts a single event or a batch under "events"
+func decodeNewsletterEvents(body []byte) ([]services.NewsletterDeliveryEvent, error) {
+	var payload struct {
+		services.NewsletterDeliveryEvent
+		Events []services.NewsletterDeliveryEvent `json:"events"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Events != nil {
+		return payload.Events, nil
+	}
+	return []services.NewsletterDeliveryEvent{payload.NewsletterDeliveryEvent}, nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	"github.com/7-solutions/saas-platformbackend/internal/services"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// OEmbedHandler is our oEmbed provider endpoint, so published posts embed as cards on other sites
+type OEmbedHandler struct {
+	content *services.ContentService
+}
+
+// NewOEmbedHandler creates the oEmbed provider endpoint
+func NewOEmbedHandler(content *services.ContentService) *OEmbedHandler {
+	return &OEmbedHandler{content: content}
+}
+
+// ServeHTTP handles GET /oembed?url=...&maxwidth=...; only format=json is supported
+func (h *OEmbedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	query := r.URL.Query()
+	if format := query.Get("format"); format != "" && format != "json" {
+		http.Error(w, "Only the json format is supported", http.StatusNotImplemented)
+		return
+	}
+	maxWidth := 0
+	if v := query.Get("maxwidth"); v != "" {
+		var err error
+		if maxWidth, err = strconv.Atoi(v); err != nil {
+			http.Error(w, "Invalid maxwidth", http.StatusBadRequest)
+			return
+		}
+	}
+
+	resp, err := h.content.PostOEmbed(r.Context(), query.Get("url"), maxWidth)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.Error("Failed to write oEmbed response", err)
+	}
+}
@@ -2,24 +2,37 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	analyticsv1 "github.com/7-solutions/saas-platformbackend/gen/analytics/v1"
 	authv1 "github.com/7-solutions/saas-platformbackend/gen/auth/v1"
+	commentv1 "github.com/7-solutions/saas-platformbackend/gen/comment/v1"
 	contactv1 "github.com/7-solutions/saas-platformbackend/gen/contact/v1"
 	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	entryv1 "github.com/7-solutions/saas-platformbackend/gen/entry/v1"
 	mediav1 "github.com/7-solutions/saas-platformbackend/gen/media/v1"
+	navigationv1 "github.com/7-solutions/saas-platformbackend/gen/navigation/v1"
+	newsletterv1 "github.com/7-solutions/saas-platformbackend/gen/newsletter/v1"
+	settingsv1 "github.com/7-solutions/saas-platformbackend/gen/settings/v1"
+	webhookv1 "github.com/7-solutions/saas-platformbackend/gen/webhook/v1"
 	"github.com/7-solutions/saas-platformbackend/internal/database"
 	"github.com/7-solutions/saas-platformbackend/internal/repository"
 	"github.com/7-solutions/saas-platformbackend/internal/services"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/cache"
 	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
 	"github.com/7-solutions/saas-platformbackend/internal/utils/metrics"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
 )
 
 // Server represents the gRPC server
@@ -28,6 +41,7 @@ type Server struct {
 	httpServer    *http.Server
 	metricsServer *http.Server
 	dbClient      *database.Client
+	pgClient      *database.PostgresClient
 	authSvc       *services.AuthService
 	contentSvc    *services.ContentService
 	mediaSvc      *services.MediaService
@@ -36,6 +50,20 @@ type Server struct {
 	errorHandler  *ErrorHandler
 	healthChecker *HealthChecker
 	metrics       *metrics.Metrics
+	contentFeed   *services.ContentFeed
+	graphQL       *services.GraphQLService
+	// webmentions is nil when Postgres is unavailable
+	webmentions *services.CommentService
+	// federation is nil unless ActivityPub is enabled and Postgres is available
+	federation *services.FederationService
+	// newsletter is nil unless NEWSLETTER_SECRET is set and Postgres is available
+	newsletter *services.NewsletterService
+	// newsletterEventsSecret authenticates the bounce and complaint webhook; empty disables it
+	newsletterEventsSecret string
+	// revalidation is nil when REVALIDATION_SECRET is unset
+	revalidation *revalidate.Queue
+	// stopBackground cancels scheduled background jobs
+	stopBackground context.CancelFunc
 }
 
 // NewServer creates a new server instance
@@ -69,13 +97,181 @@ func NewServer() (*Server, error) {
 	// Initialize email service
 	emailSvc := services.NewEmailService()
 
+	// Reverse proxies whose X-Forwarded-For entries identify clients, e.g. "10.0.0.0/8,192.0.2.7"
+	if err := services.SetTrustedProxies(strings.Split(os.Getenv("TRUSTED_PROXIES"), ",")); err != nil {
+		log.Printf("Warning: invalid TRUSTED_PROXIES, only loopback is trusted: %v", err)
+	}
+
 	// Initialize services with repositories
 	authSvc := services.NewAuthService(userRepo)
 	contentSvc := services.NewContentService(pageRepo, blogRepo)
+	contentSvc.SetMediaRepository(mediaRepo)
+	contentSvc.SetUserRepository(userRepo)
+	// Defaults of the site settings; with Postgres they can be changed through the settings service
+	siteInfo := services.SiteInfo{
+		Name:        getEnvOrDefault("SITE_NAME", services.DefaultSiteInfo().Name),
+		URL:         getEnvOrDefault("SITE_URL", services.DefaultSiteInfo().URL),
+		LogoURL:     os.Getenv("SITE_LOGO_URL"),
+		Description: getEnvOrDefault("SITE_DESCRIPTION", services.DefaultSiteInfo().Description),
+	}
+	var site services.SiteInfoSource = siteInfo
+	contentSvc.SetSiteInfo(site)
+	emailSvc.SetSiteInfo(site)
+	contentSvc.SetRelatedPostsCache(cache.NewRedisCache())
+	publishGate := services.DefaultPublishGateConfig()
+	if err := publishGate.ParseRules(os.Getenv("PUBLISH_GATE_RULES")); err != nil {
+		return nil, fmt.Errorf("invalid PUBLISH_GATE_RULES: %w", err)
+	}
+	if v, err := strconv.Atoi(getEnvOrDefault("PUBLISH_GATE_MAX_EXCERPT_LENGTH", strconv.Itoa(publishGate.MaxExcerptLength))); err == nil {
+		publishGate.MaxExcerptLength = v
+	}
+	contentSvc.SetPublishGate(publishGate)
+	oembedResolver := services.NewOEmbedResolver(services.DefaultOEmbedProviders(), cache.NewRedisCache())
+	if ttl, err := time.ParseDuration(getEnvOrDefault("OEMBED_CACHE_TTL", services.DefaultOEmbedCacheTTL.String())); err == nil {
+		oembedResolver.SetCacheTTL(ttl)
+	} else {
+		log.Printf("Warning: invalid OEMBED_CACHE_TTL, using %s: %v", services.DefaultOEmbedCacheTTL, err)
+	}
+	contentSvc.SetOEmbedResolver(oembedResolver)
 	mediaSvc := services.NewMediaService(mediaRepo)
+	mediaSvc.SetFeaturedImageUsages(contentSvc)
 	contactSvc := services.NewContactService(contactRepo, emailSvc)
 	errorSvc := services.NewErrorReportingService(dbClient)
 
+	// Content change feed for WatchContent and its SSE bridge; webhooks are added below
+	replaySize, err := strconv.Atoi(getEnvOrDefault("CONTENT_FEED_REPLAY_SIZE", strconv.Itoa(services.DefaultContentFeedReplaySize)))
+	if err != nil {
+		log.Printf("Warning: invalid CONTENT_FEED_REPLAY_SIZE, using %d: %v", services.DefaultContentFeedReplaySize, err)
+		replaySize = services.DefaultContentFeedReplaySize
+	}
+	contentFeed := services.NewContentFeed(replaySize)
+	contentSvc.SetContentFeed(contentFeed)
+	eventPublishers := services.EventPublishers{contentFeed}
+
+	// Read-only GraphQL API over pages, posts, taxonomy and media
+	graphQLSvc, err := services.NewGraphQLService(pageRepo, blogRepo, userRepo, mediaRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GraphQL schema: %w", err)
+	}
+	graphQLLimits := services.DefaultGraphQLLimits()
+	if v, err := strconv.Atoi(getEnvOrDefault("GRAPHQL_MAX_DEPTH", strconv.Itoa(graphQLLimits.MaxDepth))); err == nil {
+		graphQLLimits.MaxDepth = v
+	}
+	if v, err := strconv.Atoi(getEnvOrDefault("GRAPHQL_MAX_COMPLEXITY", strconv.Itoa(graphQLLimits.MaxComplexity))); err == nil {
+		graphQLLimits.MaxComplexity = v
+	}
+	graphQLSvc.SetLimits(graphQLLimits)
+
+	// Postgres-backed features are optional until the CouchDB migration completes
+	var commentSvc commentv1.CommentServiceServer = commentv1.UnimplementedCommentServiceServer{}
+	var analyticsSvc analyticsv1.AnalyticsServiceServer = analyticsv1.UnimplementedAnalyticsServiceServer{}
+	var webhookSvc webhookv1.WebhookServiceServer = webhookv1.UnimplementedWebhookServiceServer{}
+	var entrySvc entryv1.EntryServiceServer = entryv1.UnimplementedEntryServiceServer{}
+	var newsletterSvc newsletterv1.NewsletterServiceServer = newsletterv1.UnimplementedNewsletterServiceServer{}
+	var navigationSvc navigationv1.NavigationServiceServer = navigationv1.UnimplementedNavigationServiceServer{}
+	var settingsSvc settingsv1.SettingsServiceServer = settingsv1.UnimplementedSettingsServiceServer{}
+	var linkScanner *services.LinkScanner
+	var webhookDispatcher *services.WebhookService
+	var webmentionSvc *services.CommentService
+	var federationSvc *services.FederationService
+	var newsletter *services.NewsletterService
+	var newsletterDigestInterval time.Duration
+	var navigation *services.NavigationService
+	var settings *services.SettingsService
+	pgClient, err := database.NewPostgresClient(ctx)
+	if err != nil {
+		log.Printf("Warning: Postgres unavailable, Postgres-backed content features disabled: %v", err)
+		pgClient = nil
+	} else {
+		// Site settings replace the environment defaults for every service that shows them
+		settings = services.NewSettingsService(repository.NewSettingsRepositorySQL(pgClient), siteInfo)
+		settings.SetMediaRepository(mediaRepo)
+		settingsSvc = settings
+		site = settings
+		contentSvc.SetSiteInfo(site)
+		emailSvc.SetSiteInfo(site)
+		emailSvc.SetContactRecipients(settings)
+
+		contentSvc.SetReusableBlockRepository(repository.NewReusableBlockRepositorySQL(pgClient))
+		contentSvc.SetPageTemplateRepository(repository.NewPageTemplateRepositorySQL(pgClient))
+		contentSvc.SetCollectionRepository(repository.NewCollectionRepositorySQL(pgClient))
+
+		commentRepo := repository.NewCommentRepositorySQL(pgClient)
+		contentSvc.SetCommentRepository(commentRepo)
+		webmentionSvc = services.NewCommentService(commentRepo, blogRepo, userRepo, emailSvc)
+		webmentionSvc.SetWebmentions(repository.NewWebmentionRepositorySQL(pgClient), site)
+		commentSvc = webmentionSvc
+		analyticsSvc = services.NewAnalyticsService(repository.NewAnalyticsRepositorySQL(pgClient), pageRepo, blogRepo)
+
+		linkScanner = services.NewLinkScanner(repository.NewLinkReportRepositorySQL(pgClient), pageRepo, blogRepo, mediaRepo)
+		if os.Getenv("LINK_SCAN_EXTERNAL") == "true" {
+			linkScanner.EnableExternalChecks()
+		}
+		contentSvc.SetLinkScanner(linkScanner)
+
+		webhookDispatcher = services.NewWebhookService(repository.NewWebhookRepositorySQL(pgClient))
+		eventPublishers = append(eventPublishers, webhookDispatcher)
+		webhookSvc = webhookDispatcher
+
+		// ActivityPub actors so the blog can be followed from Mastodon; the website must
+		// route /.well-known/webfinger and /ap/ on ACTIVITYPUB_BASE_URL to this server
+		if getEnvOrDefault("ACTIVITYPUB_ENABLED", "false") == "true" {
+			federationSvc = services.NewFederationService(repository.NewActivityPubRepositorySQL(pgClient), blogRepo, userRepo, services.FederationConfig{
+				BaseURL:      getEnvOrDefault("ACTIVITYPUB_BASE_URL", siteInfo.URL),
+				Site:         site,
+				SiteActor:    getEnvOrDefault("ACTIVITYPUB_ACTOR", services.DefaultActivityPubActor),
+				AuthorActors: os.Getenv("ACTIVITYPUB_AUTHOR_ACTORS") == "true",
+			})
+			federationSvc.SetCommentService(webmentionSvc)
+			eventPublishers = append(eventPublishers, federationSvc)
+		}
+
+		// Newsletter with double opt-in; its emails link to the website's /newsletter/confirm,
+		// /newsletter/preferences and /newsletter/unsubscribe pages, which call the public RPCs
+		if secret := os.Getenv("NEWSLETTER_SECRET"); secret != "" {
+			// NEWSLETTER_DIGEST_INTERVAL=0 leaves only digests sent with SendDigest
+			newsletterDigestInterval, err = time.ParseDuration(getEnvOrDefault("NEWSLETTER_DIGEST_INTERVAL", services.DefaultNewsletterDigestInterval.String()))
+			if err != nil {
+				log.Printf("Warning: invalid NEWSLETTER_DIGEST_INTERVAL, scheduled digests disabled: %v", err)
+				newsletterDigestInterval = 0
+			}
+			newsletter = services.NewNewsletterService(repository.NewNewsletterRepositorySQL(pgClient), contentSvc, emailSvc, services.NewsletterConfig{
+				Site:           site,
+				Secret:         secret,
+				DigestInterval: newsletterDigestInterval,
+			})
+			newsletterSvc = newsletter
+		}
+
+		navigation = services.NewNavigationService(repository.NewNavigationRepositorySQL(pgClient), contentSvc)
+		navigationSvc = navigation
+
+		entryService := services.NewEntryService(repository.NewContentTypeRepositorySQL(pgClient))
+		entryService.SetMediaRepository(mediaRepo)
+		entrySvc = entryService
+	}
+	if getEnvOrDefault("WEBMENTION_SEND", "true") == "true" {
+		eventPublishers = append(eventPublishers, services.NewWebmentionSender(blogRepo, site))
+	}
+	contentSvc.SetEventPublisher(eventPublishers)
+	mediaSvc.SetEventPublisher(eventPublishers)
+	contactSvc.SetEventPublisher(eventPublishers)
+
+	// Frontend ISR revalidation; failed requests are retried with backoff by the queue
+	var revalidationQueue *revalidate.Queue
+	if secret := os.Getenv("REVALIDATION_SECRET"); secret != "" {
+		siteURL := getEnvOrDefault("WEBSITE_URL", "http://localhost:3000")
+		revalidationQueue = revalidate.NewQueue(revalidate.NewNextRevalidatorHTTP(siteURL, secret, nil), revalidate.QueueConfig{})
+		contentSvc.SetRevalidator(revalidationQueue)
+		mediaSvc.SetRevalidator(revalidationQueue, contentSvc)
+		if navigation != nil {
+			navigation.SetRevalidator(revalidationQueue)
+		}
+		if settings != nil {
+			settings.SetRevalidator(revalidationQueue)
+		}
+	}
+
 	// Initialize alerting service
 	webhookURL := getEnvOrDefault("ALERT_WEBHOOK_URL", "http://localhost:8080/api/v1/alerts/webhook")
 	alertingSvc := services.NewAlertingService(webhookURL, emailSvc)
@@ -98,6 +294,10 @@ func NewServer() (*Server, error) {
 			LoggingInterceptor,
 			AuthInterceptor,
 		),
+		grpc.ChainStreamInterceptor(
+			StreamPanicRecoveryInterceptor,
+			StreamAuthInterceptor,
+		),
 	)
 
 	// Register services
@@ -105,16 +305,32 @@ func NewServer() (*Server, error) {
 	contentv1.RegisterContentServiceServer(grpcServer, contentSvc)
 	mediav1.RegisterMediaServiceServer(grpcServer, mediaSvc)
 	contactv1.RegisterContactServiceServer(grpcServer, contactSvc)
+	commentv1.RegisterCommentServiceServer(grpcServer, commentSvc)
+	analyticsv1.RegisterAnalyticsServiceServer(grpcServer, analyticsSvc)
+	webhookv1.RegisterWebhookServiceServer(grpcServer, webhookSvc)
+	entryv1.RegisterEntryServiceServer(grpcServer, entrySvc)
+	newsletterv1.RegisterNewsletterServiceServer(grpcServer, newsletterSvc)
+	navigationv1.RegisterNavigationServiceServer(grpcServer, navigationSvc)
+	settingsv1.RegisterSettingsServiceServer(grpcServer, settingsSvc)
 
 	server := &Server{
-		grpcServer:  grpcServer,
-		dbClient:    dbClient,
-		authSvc:     authSvc,
-		contentSvc:  contentSvc,
-		mediaSvc:    mediaSvc,
-		contactSvc:  contactSvc,
-		alertingSvc: alertingSvc,
-		metrics:     metricsInstance,
+		grpcServer:   grpcServer,
+		dbClient:     dbClient,
+		pgClient:     pgClient,
+		authSvc:      authSvc,
+		contentSvc:   contentSvc,
+		mediaSvc:     mediaSvc,
+		contactSvc:   contactSvc,
+		alertingSvc:  alertingSvc,
+		metrics:      metricsInstance,
+		contentFeed:  contentFeed,
+		graphQL:      graphQLSvc,
+		webmentions:  webmentionSvc,
+		federation:   federationSvc,
+		newsletter:   newsletter,
+		revalidation: revalidationQueue,
+
+		newsletterEventsSecret: os.Getenv("NEWSLETTER_WEBHOOK_SECRET"),
 	}
 
 	// Initialize error handler
@@ -128,6 +344,57 @@ func NewServer() (*Server, error) {
 	metricsCtx := context.Background()
 	metrics.StartSystemMetricsCollection(metricsCtx)
 
+	backgroundCtx, cancel := context.WithCancel(context.Background())
+	server.stopBackground = cancel
+
+	// Scheduled link-integrity scans; LINK_SCAN_INTERVAL=0 disables them
+	if linkScanner != nil {
+		interval, err := time.ParseDuration(getEnvOrDefault("LINK_SCAN_INTERVAL", "24h"))
+		if err != nil {
+			log.Printf("Warning: invalid LINK_SCAN_INTERVAL, scheduled link scans disabled: %v", err)
+		} else if interval > 0 {
+			linkScanner.Start(backgroundCtx, interval)
+		}
+	}
+
+	// Expired post pins are released on a schedule rather than while listing
+	pinReleaseInterval, err := time.ParseDuration(getEnvOrDefault("PIN_RELEASE_INTERVAL", "1m"))
+	if err != nil || pinReleaseInterval <= 0 {
+		log.Printf("Warning: invalid PIN_RELEASE_INTERVAL, using 1m: %v", err)
+		pinReleaseInterval = time.Minute
+	}
+	contentSvc.StartPinRelease(backgroundCtx, pinReleaseInterval)
+
+	// Webhook retries; failed deliveries are retried with exponential backoff
+	if webhookDispatcher != nil {
+		interval, err := time.ParseDuration(getEnvOrDefault("WEBHOOK_RETRY_INTERVAL", "15s"))
+		if err != nil || interval <= 0 {
+			log.Printf("Warning: invalid WEBHOOK_RETRY_INTERVAL, using 15s: %v", err)
+			interval = 15 * time.Second
+		}
+		webhookDispatcher.Start(backgroundCtx, interval)
+	}
+
+	// ActivityPub delivery retries, on the webhook backoff schedule
+	if federationSvc != nil {
+		interval, err := time.ParseDuration(getEnvOrDefault("ACTIVITYPUB_RETRY_INTERVAL", "30s"))
+		if err != nil || interval <= 0 {
+			log.Printf("Warning: invalid ACTIVITYPUB_RETRY_INTERVAL, using 30s: %v", err)
+			interval = 30 * time.Second
+		}
+		federationSvc.Start(backgroundCtx, interval)
+	}
+
+	// Scheduled newsletter digests; checked often so a restart delays a due digest by at most the check interval
+	if newsletter != nil && newsletterDigestInterval > 0 {
+		newsletter.Start(backgroundCtx, 15*time.Minute)
+	}
+
+	if revalidationQueue != nil {
+		revalidationQueue.SetObserver(metricsInstance)
+		revalidationQueue.Start(backgroundCtx)
+	}
+
 	return server, nil
 }
 
@@ -176,12 +443,73 @@ func (s *Server) StartHTTP(grpcPort, httpPort string) error {
 		return err
 	}
 
+	err = commentv1.RegisterCommentServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	err = analyticsv1.RegisterAnalyticsServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	err = webhookv1.RegisterWebhookServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	err = entryv1.RegisterEntryServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	err = newsletterv1.RegisterNewsletterServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	err = navigationv1.RegisterNavigationServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
+	err = settingsv1.RegisterSettingsServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		return err
+	}
+
 	// Create HTTP mux with additional endpoints
 	httpMux := http.NewServeMux()
 
 	// Add gRPC Gateway
 	httpMux.Handle("/api/v1/", mux)
 
+	// Server-sent events bridge for WatchContent
+	httpMux.Handle("/api/v1/content/watch", NewContentWatchHandler(s.contentFeed))
+
+	// Read-only GraphQL API; see services.GraphQLService
+	httpMux.Handle("/api/v1/graphql", NewGraphQLHandler(s.graphQL))
+
+	// oEmbed provider endpoint for our published posts
+	httpMux.Handle("/oembed", NewOEmbedHandler(s.contentSvc))
+
+	// Webmention receiving endpoint; advertised by the website on blog posts
+	if s.webmentions != nil {
+		httpMux.Handle("/webmention", NewWebmentionHandler(s.webmentions))
+	}
+
+	// ActivityPub: WebFinger discovery, actors, outboxes and inboxes
+	if s.federation != nil {
+		activityPub := NewActivityPubHandler(s.federation)
+		httpMux.Handle("/.well-known/webfinger", activityPub)
+		httpMux.Handle("/ap/", activityPub)
+	}
+
+	// Bounce and complaint notifications from the email provider
+	if s.newsletter != nil && s.newsletterEventsSecret != "" {
+		httpMux.Handle("/api/v1/newsletter/events", NewNewsletterEventsHandler(s.newsletter, s.newsletterEventsSecret))
+	}
+
 	// Add health check endpoints
 	httpMux.HandleFunc("/health", s.healthChecker.HandleHealthCheck)
 	httpMux.HandleFunc("/health/live", s.healthChecker.HandleLivenessProbe)
@@ -220,6 +548,9 @@ func (s *Server) StartMetrics(port string) error {
 
 // Stop gracefully stops the server
 func (s *Server) Stop() {
+	if s.stopBackground != nil {
+		s.stopBackground()
+	}
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 	}
@@ -232,6 +563,9 @@ func (s *Server) Stop() {
 	if s.dbClient != nil {
 		s.dbClient.Close()
 	}
+	if s.pgClient != nil {
+		s.pgClient.Close()
+	}
 }
 
 // getEnvOrDefault returns environment variable value or default
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	"github.com/7-solutions/saas-platformbackend/internal/services"
+)
+
+// webmentionMaxBodySize bounds the size of a form-encoded Webmention request
+const webmentionMaxBodySize = 16 << 10
+
+// WebmentionHandler is the Webmention receiving endpoint for blog posts
+type WebmentionHandler struct {
+	comments *services.CommentService
+}
+
+// NewWebmentionHandler creates the Webmention receiving endpoint
+func NewWebmentionHandler(comments *services.CommentService) *WebmentionHandler {
+	return &WebmentionHandler{comments: comments}
+}
+
+// ServeHTTP handles POST /webmention with form-encoded source and target. The source is
+// verified asynchronously, so accepted mentions are answered with 202 Accepted.
+func (h *WebmentionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, webmentionMaxBodySize)
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form body", http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.comments.ReceiveWebmention(r.Context(), r.PostForm.Get("source"), r.PostForm.Get("target"), requestClientIP(r))
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// requestClientIP returns the client address of an HTTP request, preferring proxy headers
+func requestClientIP(r *http.Request) string {
+	if v := r.Header.Get("X-Forwarded-For"); v != "" {
+		return strings.TrimSpace(strings.Split(v, ",")[0])
+	}
+	if v := r.Header.Get("X-Real-IP"); v != "" {
+		return strings.TrimSpace(v)
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
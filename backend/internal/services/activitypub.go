@@ -0,0 +1,1156 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/spam"
+)
+
+const (
+	// ActivityPubContentType is the media type of ActivityPub documents
+	ActivityPubContentType = "application/activity+json"
+	// DefaultActivityPubActor is the preferred username of the site actor
+	DefaultActivityPubActor = "blog"
+
+	activityStreamsContext = "https://www.w3.org/ns/activitystreams"
+	activityStreamsPublic  = "https://www.w3.org/ns/activitystreams#Public"
+	securityContext        = "https://w3id.org/security/v1"
+
+	activityPubOutboxPageSize = 20
+	activityPubKeyBits        = 2048
+	// activityPubMaxClockSkew bounds how far the signed Date of an inbound activity may be off
+	activityPubMaxClockSkew   = time.Hour
+	activityPubRequestTimeout = 10 * time.Second
+	// activityPubMaxDocumentSize bounds remote actor documents
+	activityPubMaxDocumentSize = 1 << 20
+	activityPubUserAgent       = "saas-platform-activitypub/1.0"
+)
+
+// activityPubUsernamePattern matches the characters dropped from author names to form usernames
+var activityPubUsernamePattern = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// FederationConfig configures the ActivityPub actors of the site
+type FederationConfig struct {
+	// BaseURL is the public origin actors and objects are served under, without a trailing
+	// slash; /.well-known/webfinger and /ap/ on that origin must reach the API
+	BaseURL string
+	// Site describes the website; articles link to <site URL>/blog/<slug>.
+	// DefaultSiteInfo is used when nil.
+	Site SiteInfoSource
+	// SiteActor is the preferred username of the site actor, DefaultActivityPubActor when empty
+	SiteActor string
+	// AuthorActors adds one actor per post author, named after their profile name. Posts are
+	// then attributed to their author and announced by the site actor.
+	AuthorActors bool
+}
+
+// FederationService publishes the blog over ActivityPub. The site actor, and optionally one
+// actor per author, can be followed from Mastodon and similar servers; published posts are
+// delivered to followers as Create(Article) activities signed with HTTP Signatures.
+type FederationService struct {
+	repo     repository.ActivityPubRepository
+	blogRepo repository.BlogRepository
+	userRepo repository.UserRepository
+	config   FederationConfig
+	domain   string
+
+	// Replies are optional; see SetCommentService
+	comments *CommentService
+
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PrivateKey
+	inFlight  map[string]bool
+	lastPrune time.Time
+	// pending tracks asynchronous attempts so tests can wait for them
+	pending sync.WaitGroup
+}
+
+// NewFederationService creates the ActivityPub service. userRepo may be nil when author
+// actors are disabled.
+func NewFederationService(
+	repo repository.ActivityPubRepository,
+	blogRepo repository.BlogRepository,
+	userRepo repository.UserRepository,
+	config FederationConfig,
+) *FederationService {
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	if config.Site == nil {
+		config.Site = DefaultSiteInfo()
+	}
+	if config.SiteActor == "" {
+		config.SiteActor = DefaultActivityPubActor
+	}
+	domain := ""
+	if base, err := url.Parse(config.BaseURL); err == nil {
+		domain = strings.ToLower(base.Host)
+	}
+	return &FederationService{
+		repo:       repo,
+		blogRepo:   blogRepo,
+		userRepo:   userRepo,
+		config:     config,
+		domain:     domain,
+		httpClient: newPublicHTTPClient(activityPubRequestTimeout),
+		now:        time.Now,
+		keys:       make(map[string]*rsa.PrivateKey),
+		inFlight:   make(map[string]bool),
+	}
+}
+
+// SetCommentService routes replies to posts into the comment moderation queue
+func (s *FederationService) SetCommentService(comments *CommentService) {
+	s.comments = comments
+}
+
+// SetHTTPClient replaces the client used to fetch remote actors and deliver activities
+func (s *FederationService) SetHTTPClient(client *http.Client) {
+	s.httpClient = client
+}
+
+// Start retries due deliveries every interval until the context is cancelled
+func (s *FederationService) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.processDue(ctx)
+			}
+		}
+	}()
+}
+
+// federationActor is a local actor: the site itself or a post author
+type federationActor struct {
+	name        string
+	displayName string
+	kind        string
+	iconURL     string
+	// userID is the author's user ID; empty for the site actor
+	userID string
+}
+
+// WebFingerLink is one link of a WebFinger response
+type WebFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href"`
+}
+
+// WebFingerResponse is the JSON Resource Descriptor returned for an actor
+type WebFingerResponse struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases,omitempty"`
+	Links   []WebFingerLink `json:"links"`
+}
+
+// WebFinger resolves "acct:<name>@<domain>", or an actor URL, to the actor document
+func (s *FederationService) WebFinger(ctx context.Context, resource string) (*WebFingerResponse, error) {
+	resource = strings.TrimSpace(resource)
+	if resource == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource is required")
+	}
+
+	var name string
+	if strings.HasPrefix(resource, s.config.BaseURL+"/ap/actors/") {
+		name = strings.TrimPrefix(resource, s.config.BaseURL+"/ap/actors/")
+	} else {
+		acct := strings.TrimPrefix(strings.TrimPrefix(resource, "acct:"), "@")
+		user, domain, ok := strings.Cut(acct, "@")
+		if !ok || !strings.EqualFold(domain, s.domain) {
+			return nil, status.Errorf(codes.NotFound, "unknown resource")
+		}
+		name = strings.ToLower(user)
+	}
+
+	actor, err := s.localActor(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	actorURL := s.actorURL(actor.name)
+	return &WebFingerResponse{
+		Subject: "acct:" + actor.name + "@" + s.domain,
+		Aliases: []string{actorURL},
+		Links: []WebFingerLink{
+			{Rel: "self", Type: ActivityPubContentType, Href: actorURL},
+			{Rel: "http://webfinger.net/rel/profile-page", Type: "text/html", Href: s.config.Site.SiteInfo(ctx).URL + "/blog"},
+		},
+	}, nil
+}
+
+// Actor returns the actor document of a local actor, including its public key
+func (s *FederationService) Actor(ctx context.Context, name string) (map[string]interface{}, error) {
+	actor, err := s.localActor(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.actorKey(ctx, actor.name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load actor key: %v", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode actor key: %v", err)
+	}
+
+	id := s.actorURL(actor.name)
+	doc := map[string]interface{}{
+		"@context":                  []interface{}{activityStreamsContext, securityContext},
+		"id":                        id,
+		"type":                      actor.kind,
+		"preferredUsername":         actor.name,
+		"name":                      actor.displayName,
+		"url":                       s.config.Site.SiteInfo(ctx).URL + "/blog",
+		"inbox":                     id + "/inbox",
+		"outbox":                    id + "/outbox",
+		"followers":                 id + "/followers",
+		"manuallyApprovesFollowers": false,
+		"discoverable":              true,
+		"endpoints":                 map[string]interface{}{"sharedInbox": s.config.BaseURL + "/ap/inbox"},
+		"publicKey": map[string]interface{}{
+			"id":           id + "#main-key",
+			"owner":        id,
+			"publicKeyPem": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+		},
+	}
+	if actor.iconURL != "" {
+		doc["icon"] = map[string]interface{}{"type": "Image", "url": absoluteURL(s.config.Site.SiteInfo(ctx).URL, actor.iconURL)}
+	}
+	return doc, nil
+}
+
+// Outbox returns an actor's outbox. Page 0 is the collection itself; pages from 1 list
+// the activities of published posts, newest first.
+func (s *FederationService) Outbox(ctx context.Context, name string, page int) (map[string]interface{}, error) {
+	actor, err := s.localActor(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	outbox := s.actorURL(actor.name) + "/outbox"
+	if page <= 0 {
+		return map[string]interface{}{
+			"@context": activityStreamsContext,
+			"id":       outbox,
+			"type":     "OrderedCollection",
+			"first":    outbox + "?page=1",
+		}, nil
+	}
+
+	skip := (page - 1) * activityPubOutboxPageSize
+	// Fetch one extra post to learn whether another page exists
+	options := repository.ListOptions{Limit: activityPubOutboxPageSize + 1, Skip: skip, Order: "desc"}
+	var posts []*models.BlogPost
+	if actor.userID != "" {
+		posts, err = s.blogRepo.ListByAuthor(ctx, actor.userID, options)
+	} else {
+		posts, err = s.blogRepo.GetPublishedPosts(ctx, options)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list posts: %v", err)
+	}
+
+	hasMore := len(posts) > activityPubOutboxPageSize
+	if hasMore {
+		posts = posts[:activityPubOutboxPageSize]
+	}
+	items := make([]interface{}, 0, len(posts))
+	for _, post := range posts {
+		if !federatedPost(post) {
+			continue
+		}
+		items = append(items, s.postActivity(ctx, post, actor.name))
+	}
+
+	doc := map[string]interface{}{
+		"@context":     activityStreamsContext,
+		"id":           outbox + "?page=" + strconv.Itoa(page),
+		"type":         "OrderedCollectionPage",
+		"partOf":       outbox,
+		"orderedItems": items,
+	}
+	if hasMore {
+		doc["next"] = outbox + "?page=" + strconv.Itoa(page+1)
+	}
+	if page > 1 {
+		doc["prev"] = outbox + "?page=" + strconv.Itoa(page-1)
+	}
+	return doc, nil
+}
+
+// Followers returns the size of an actor's followers collection; members are not listed
+func (s *FederationService) Followers(ctx context.Context, name string) (map[string]interface{}, error) {
+	actor, err := s.localActor(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.repo.CountFollowers(ctx, actor.name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count followers: %v", err)
+	}
+	return map[string]interface{}{
+		"@context":   activityStreamsContext,
+		"id":         s.actorURL(actor.name) + "/followers",
+		"type":       "OrderedCollection",
+		"totalItems": total,
+	}, nil
+}
+
+// Article returns the Article object of a published, public post
+func (s *FederationService) Article(ctx context.Context, slug string) (map[string]interface{}, error) {
+	post, err := s.blogRepo.GetBySlug(ctx, slug)
+	if err != nil || !federatedPost(post) {
+		return nil, status.Errorf(codes.NotFound, "post not found")
+	}
+	article := s.postArticle(ctx, post)
+	article["@context"] = activityStreamsContext
+	return article, nil
+}
+
+// Publish delivers newly published posts to the followers of the site actor and, with
+// author actors, of the post author. Deliveries are stored before returning.
+func (s *FederationService) Publish(ctx context.Context, eventType string, data interface{}) {
+	published, ok := data.(*contentv1.BlogPost)
+	if eventType != models.WebhookEventPostPublished || !ok {
+		return
+	}
+	// The event outlives the request that caused it
+	ctx = context.WithoutCancel(ctx)
+
+	post, err := s.blogRepo.GetByID(ctx, published.Id)
+	if err != nil {
+		logger.Error("Failed to load post for federation", err, "post_id", published.Id)
+		return
+	}
+	if !federatedPost(post) {
+		return
+	}
+
+	actors := []string{s.config.SiteActor}
+	if author := s.postAuthorActor(ctx, post); author != s.config.SiteActor {
+		actors = append(actors, author)
+	}
+	for _, actor := range actors {
+		inboxes, err := s.repo.ListFollowerInboxes(ctx, actor)
+		if err != nil {
+			logger.Error("Failed to list follower inboxes", err, "actor", actor)
+			continue
+		}
+		if len(inboxes) == 0 {
+			continue
+		}
+		activity := s.postActivity(ctx, post, actor)
+		activity["@context"] = activityStreamsContext
+		for _, inbox := range inboxes {
+			s.deliver(ctx, actor, inbox, activity)
+		}
+	}
+}
+
+// federatedPost reports whether a post is published to the fediverse
+func federatedPost(post *models.BlogPost) bool {
+	return post.IsPublished() && post.Visibility.IsPublic()
+}
+
+// localActor resolves a preferred username to the site actor or, when enabled, an author
+func (s *FederationService) localActor(ctx context.Context, name string) (*federationActor, error) {
+	if name == s.config.SiteActor {
+		site := s.config.Site.SiteInfo(ctx)
+		return &federationActor{
+			name:        s.config.SiteActor,
+			displayName: site.Name,
+			kind:        "Organization",
+			iconURL:     site.LogoURL,
+		}, nil
+	}
+	if s.config.AuthorActors && s.userRepo != nil && name != "" {
+		for skip := 0; ; skip += 100 {
+			users, err := s.userRepo.List(ctx, repository.ListOptions{Limit: 100, Skip: skip})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+			}
+			for _, user := range users {
+				if activityPubUsername(user.Profile.Name) == name {
+					return &federationActor{
+						name:        name,
+						displayName: user.Profile.Name,
+						kind:        "Person",
+						iconURL:     user.Profile.Avatar,
+						userID:      user.ID,
+					}, nil
+				}
+			}
+			if len(users) < 100 {
+				break
+			}
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "actor not found")
+}
+
+// postAuthorActor returns the actor a post is attributed to: its author's actor when
+// author actors are enabled and the author has a profile name, otherwise the site actor
+func (s *FederationService) postAuthorActor(ctx context.Context, post *models.BlogPost) string {
+	if !s.config.AuthorActors || s.userRepo == nil || post.Author == "" {
+		return s.config.SiteActor
+	}
+	var user *models.User
+	var err error
+	if strings.Contains(post.Author, "@") {
+		user, err = s.userRepo.GetByEmail(ctx, post.Author)
+	} else {
+		user, err = s.userRepo.GetByID(ctx, post.Author)
+	}
+	if err != nil {
+		return s.config.SiteActor
+	}
+	if name := activityPubUsername(user.Profile.Name); name != "" && name != s.config.SiteActor {
+		return name
+	}
+	return s.config.SiteActor
+}
+
+// activityPubUsername derives a preferred username such as "jane_doe" from a display name
+func activityPubUsername(displayName string) string {
+	name := strings.ToLower(strings.TrimSpace(displayName))
+	name = activityPubUsernamePattern.ReplaceAllString(name, "_")
+	return strings.Trim(name, "_")
+}
+
+// actorURL returns the ID of a local actor
+func (s *FederationService) actorURL(name string) string {
+	return s.config.BaseURL + "/ap/actors/" + name
+}
+
+// actorName returns the local actor a URL identifies, or "" for other URLs
+func (s *FederationService) actorName(actorURL string) string {
+	name, ok := strings.CutPrefix(actorURL, s.config.BaseURL+"/ap/actors/")
+	if !ok || strings.Contains(name, "/") {
+		return ""
+	}
+	return name
+}
+
+// objectURL returns the ID of a post's Article object
+func (s *FederationService) objectURL(post *models.BlogPost) string {
+	return s.config.BaseURL + "/ap/posts/" + post.Slug
+}
+
+// postArticle builds the Article object of a post. Like the RSS feed it carries the
+// excerpt and a link, so servers show a preview that leads readers to the site.
+func (s *FederationService) postArticle(ctx context.Context, post *models.BlogPost) map[string]interface{} {
+	siteURL := s.config.Site.SiteInfo(ctx).URL
+	link := siteURL + "/blog/" + post.Slug
+	author := s.actorURL(s.postAuthorActor(ctx, post))
+	summary := firstNonEmpty(post.Excerpt, post.Meta.Description)
+
+	var content strings.Builder
+	if summary != "" {
+		content.WriteString("<p>" + html.EscapeString(summary) + "</p>")
+	}
+	content.WriteString(fmt.Sprintf(`<p><a href="%s">%s</a></p>`, html.EscapeString(link), html.EscapeString(link)))
+
+	tags := make([]interface{}, 0, len(post.Tags))
+	for _, tag := range post.Tags {
+		name := strings.ReplaceAll(strings.TrimSpace(tag), " ", "")
+		if name == "" {
+			continue
+		}
+		tags = append(tags, map[string]interface{}{
+			"type": "Hashtag",
+			"name": "#" + name,
+			"href": siteURL + "/blog/tag/" + url.PathEscape(tag),
+		})
+	}
+
+	article := map[string]interface{}{
+		"id":           s.objectURL(post),
+		"type":         "Article",
+		"name":         post.Title,
+		"content":      content.String(),
+		"url":          link,
+		"attributedTo": author,
+		"published":    post.GetPublishedDate().UTC().Format(time.RFC3339),
+		"to":           []string{activityStreamsPublic},
+		"cc":           []string{author + "/followers"},
+		"tag":          tags,
+	}
+	if summary != "" {
+		article["summary"] = summary
+	}
+	return article
+}
+
+// postActivity returns the activity an actor publishes a post with: Create for the actor
+// the post is attributed to, and Announce for the site actor sharing an author's post
+func (s *FederationService) postActivity(ctx context.Context, post *models.BlogPost, actorName string) map[string]interface{} {
+	article := s.postArticle(ctx, post)
+	actorURL := s.actorURL(actorName)
+	if article["attributedTo"] == actorURL {
+		return map[string]interface{}{
+			"id":        s.objectURL(post) + "#create",
+			"type":      "Create",
+			"actor":     actorURL,
+			"published": article["published"],
+			"to":        article["to"],
+			"cc":        article["cc"],
+			"object":    article,
+		}
+	}
+	return map[string]interface{}{
+		"id":        s.objectURL(post) + "#announce",
+		"type":      "Announce",
+		"actor":     actorURL,
+		"published": article["published"],
+		"to":        []string{activityStreamsPublic},
+		"cc":        []string{actorURL + "/followers", article["attributedTo"].(string)},
+		"object":    article["id"],
+	}
+}
+
+// actorKey returns an actor's signing key, generating and storing one on first use
+func (s *FederationService) actorKey(ctx context.Context, name string) (*rsa.PrivateKey, error) {
+	s.mu.Lock()
+	key := s.keys[name]
+	s.mu.Unlock()
+	if key != nil {
+		return key, nil
+	}
+
+	stored, err := s.repo.GetKey(ctx, name)
+	if errors.Is(err, appErr.ErrNotFound) {
+		generated, genErr := rsa.GenerateKey(rand.Reader, activityPubKeyBits)
+		if genErr != nil {
+			return nil, genErr
+		}
+		publicKey, genErr := x509.MarshalPKIXPublicKey(&generated.PublicKey)
+		if genErr != nil {
+			return nil, genErr
+		}
+		// Another process may have stored a key first; CreateKey returns whichever key won
+		stored, err = s.repo.CreateKey(ctx, &models.ActivityPubKey{
+			Actor:         name,
+			PublicKeyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+			PrivateKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(generated)})),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode([]byte(stored.PrivateKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("stored key of actor %s is not PEM encoded", name)
+	}
+	key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.keys[name] = key
+	s.mu.Unlock()
+	return key, nil
+}
+
+// remoteActor is the part of a remote actor document needed to verify and answer it
+type remoteActor struct {
+	ID                string      `json:"id"`
+	Name              string      `json:"name"`
+	PreferredUsername string      `json:"preferredUsername"`
+	URL               interface{} `json:"url"`
+	Inbox             string      `json:"inbox"`
+	Endpoints         struct {
+		SharedInbox string `json:"sharedInbox"`
+	} `json:"endpoints"`
+	PublicKey struct {
+		ID           string `json:"id"`
+		Owner        string `json:"owner"`
+		PublicKeyPem string `json:"publicKeyPem"`
+	} `json:"publicKey"`
+}
+
+// displayName returns the name comments from the actor are shown under
+func (a *remoteActor) displayName() string {
+	handle := a.PreferredUsername
+	if u, err := url.Parse(a.ID); err == nil && handle != "" {
+		handle = "@" + handle + "@" + u.Host
+	}
+	return firstNonEmpty(a.Name, handle, a.ID)
+}
+
+// profileURL returns the actor's HTML profile, falling back to its ID
+func (a *remoteActor) profileURL() string {
+	if profile, ok := a.URL.(string); ok {
+		if u, ok := parseHTTPURL(profile); ok {
+			return u.String()
+		}
+	}
+	return a.ID
+}
+
+// inboundActivity is an activity POSTed to an inbox; actor and object may be IDs or embedded objects
+type inboundActivity struct {
+	ID     string          `json:"id"`
+	Type   string          `json:"type"`
+	Actor  json.RawMessage `json:"actor"`
+	Object json.RawMessage `json:"object"`
+}
+
+// inboundNote is the part of a Note needed to turn a reply into a comment
+type inboundNote struct {
+	ID           string          `json:"id"`
+	Type         string          `json:"type"`
+	Content      string          `json:"content"`
+	InReplyTo    string          `json:"inReplyTo"`
+	AttributedTo json.RawMessage `json:"attributedTo"`
+}
+
+// objectID returns the ID of an ActivityStreams reference, which is either the ID itself or
+// an object carrying it
+func objectID(raw json.RawMessage) string {
+	var id string
+	if err := json.Unmarshal(raw, &id); err == nil {
+		return id
+	}
+	var object struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(raw, &object); err == nil {
+		return object.ID
+	}
+	return ""
+}
+
+// ReceiveInbox processes an activity POSTed to an actor's inbox, or to the shared inbox
+// when actor is empty. Follow, Undo(Follow) and replies to posts must carry a valid HTTP
+// Signature of the activity's actor; other activities are accepted and ignored.
+func (s *FederationService) ReceiveInbox(ctx context.Context, actor string, r *http.Request, body []byte) error {
+	if actor != "" {
+		if _, err := s.localActor(ctx, actor); err != nil {
+			return err
+		}
+	}
+	var activity inboundActivity
+	if err := json.Unmarshal(body, &activity); err != nil || activity.Type == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid activity")
+	}
+	switch activity.Type {
+	case "Follow", "Undo", "Create":
+	default:
+		// e.g. the Delete servers send for every removed account, whose key can no longer be fetched
+		return nil
+	}
+
+	sender, err := s.verifySignature(ctx, r, body)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid signature: %v", err)
+	}
+	if objectID(activity.Actor) != sender.ID {
+		return status.Errorf(codes.PermissionDenied, "activity actor does not match the signature")
+	}
+
+	switch activity.Type {
+	case "Follow":
+		return s.handleFollow(ctx, sender, &activity, body)
+	case "Undo":
+		return s.handleUndo(ctx, sender, &activity)
+	default:
+		return s.handleCreate(ctx, sender, &activity, r.UserAgent())
+	}
+}
+
+// handleFollow stores the follower and queues an Accept to its inbox
+func (s *FederationService) handleFollow(ctx context.Context, sender *remoteActor, follow *inboundActivity, body []byte) error {
+	actor, err := s.localActor(ctx, s.actorName(objectID(follow.Object)))
+	if err != nil {
+		return err
+	}
+	if _, ok := parseHTTPURL(sender.Inbox); !ok {
+		return status.Errorf(codes.InvalidArgument, "follower has no inbox")
+	}
+
+	follower := &models.ActivityPubFollower{
+		Actor:       actor.name,
+		FollowerURI: sender.ID,
+		InboxURL:    sender.Inbox,
+	}
+	if shared, ok := parseHTTPURL(sender.Endpoints.SharedInbox); ok {
+		follower.SharedInboxURL = shared.String()
+	}
+	if err := s.repo.UpsertFollower(ctx, follower); err != nil {
+		return status.Errorf(codes.Internal, "failed to store follower: %v", err)
+	}
+	logger.Info("New ActivityPub follower", "actor", actor.name, "follower", sender.ID)
+
+	actorURL := s.actorURL(actor.name)
+	s.deliver(ctx, actor.name, sender.Inbox, map[string]interface{}{
+		"@context": activityStreamsContext,
+		"id":       actorURL + "#accepts/" + newEventID(),
+		"type":     "Accept",
+		"actor":    actorURL,
+		"object":   json.RawMessage(body),
+	})
+	return nil
+}
+
+// handleUndo removes a follower when it undoes its Follow; other undos are ignored
+func (s *FederationService) handleUndo(ctx context.Context, sender *remoteActor, undo *inboundActivity) error {
+	var follow inboundActivity
+	if err := json.Unmarshal(undo.Object, &follow); err != nil || follow.Type != "Follow" {
+		return nil
+	}
+	if objectID(follow.Actor) != sender.ID {
+		return status.Errorf(codes.PermissionDenied, "cannot undo another actor's follow")
+	}
+	name := s.actorName(objectID(follow.Object))
+	if name == "" {
+		return nil
+	}
+	if err := s.repo.DeleteFollower(ctx, name, sender.ID); err != nil && !errors.Is(err, appErr.ErrNotFound) {
+		return status.Errorf(codes.Internal, "failed to remove follower: %v", err)
+	}
+	logger.Info("ActivityPub follower removed", "actor", name, "follower", sender.ID)
+	return nil
+}
+
+// handleCreate queues Notes replying to a post for comment moderation; other objects,
+// such as posts merely mentioning an actor, are ignored
+func (s *FederationService) handleCreate(ctx context.Context, sender *remoteActor, create *inboundActivity, userAgent string) error {
+	var note inboundNote
+	if err := json.Unmarshal(create.Object, &note); err != nil || note.Type != "Note" || s.comments == nil {
+		return nil
+	}
+	if objectID(note.AttributedTo) != sender.ID {
+		return status.Errorf(codes.PermissionDenied, "note is not attributed to the sender")
+	}
+	post := s.repliedPost(ctx, note.InReplyTo)
+	if post == nil {
+		return nil
+	}
+
+	body := htmlToPlainText(note.Content)
+	if body == "" {
+		return nil
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		body = string([]rune(body)[:maxCommentLength])
+	}
+
+	comment := models.NewComment(post.ID, sender.displayName(), "", body)
+	comment.AuthorURL = sender.profileURL()
+	comment.UserAgent = userAgent
+	if err := s.comments.submitFederatedReply(ctx, post, comment); err != nil {
+		return status.Errorf(codes.Internal, "failed to store reply: %v", err)
+	}
+	return nil
+}
+
+// repliedPost returns the commentable post an inReplyTo URL refers to, either by its
+// Article ID or its page URL
+func (s *FederationService) repliedPost(ctx context.Context, inReplyTo string) *models.BlogPost {
+	slug, ok := strings.CutPrefix(inReplyTo, s.config.BaseURL+"/ap/posts/")
+	if !ok {
+		slug, ok = strings.CutPrefix(inReplyTo, s.config.Site.SiteInfo(ctx).URL+"/blog/")
+	}
+	if !ok || slug == "" || strings.Contains(slug, "/") {
+		return nil
+	}
+	post, err := s.blogRepo.GetBySlug(ctx, slug)
+	if err != nil || !federatedPost(post) || post.CommentsDisabled {
+		return nil
+	}
+	return post
+}
+
+// htmlToPlainText converts HTML such as the content of a Note to plain text, keeping paragraph breaks
+func htmlToPlainText(content string) string {
+	content = strings.NewReplacer("<br>", "\n", "<br/>", "\n", "<br />", "\n", "</p>", "\n\n").Replace(content)
+	text := html.UnescapeString(seoHTMLTagPattern.ReplaceAllString(content, ""))
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// submitFederatedReply queues a reply received over ActivityPub for moderation, filing
+// anything matching the spam heuristics as spam
+func (s *CommentService) submitFederatedReply(ctx context.Context, post *models.BlogPost, comment *models.Comment) error {
+	comment.Status = models.CommentStatusPending
+	if spam.IsSpam(comment.Body, comment.AuthorName) {
+		comment.Status = models.CommentStatusSpam
+	}
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		return err
+	}
+	if comment.Status != models.CommentStatusSpam {
+		go s.notifyPostAuthor(post, comment)
+	}
+	return nil
+}
+
+// fetchActor GETs a remote actor document, signing the request so servers requiring
+// authorized fetch answer it
+func (s *FederationService) fetchActor(ctx context.Context, actorURL string) (*remoteActor, error) {
+	target, ok := parseHTTPURL(actorURL)
+	if !ok {
+		return nil, fmt.Errorf("invalid actor URL %q", actorURL)
+	}
+	target.Fragment = ""
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", ActivityPubContentType+`, application/ld+json; profile="https://www.w3.org/ns/activitystreams"`)
+	req.Header.Set("User-Agent", activityPubUserAgent)
+	key, err := s.actorKey(ctx, s.config.SiteActor)
+	if err != nil {
+		return nil, err
+	}
+	if err := signRequest(req, nil, s.actorURL(s.config.SiteActor)+"#main-key", key, s.now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned status %d", target, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, activityPubMaxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > activityPubMaxDocumentSize {
+		return nil, fmt.Errorf("GET %s returned more than %d bytes", target, activityPubMaxDocumentSize)
+	}
+
+	var actor remoteActor
+	if err := json.Unmarshal(body, &actor); err != nil {
+		return nil, fmt.Errorf("invalid actor document: %w", err)
+	}
+	return &actor, nil
+}
+
+// verifySignature checks the draft-cavage HTTP Signature of an inbound request and
+// returns the signing actor. The signature must cover the request target, host, date
+// and body digest, and its key must belong to an actor on the key's own host.
+func (s *FederationService) verifySignature(ctx context.Context, r *http.Request, body []byte) (*remoteActor, error) {
+	params := parseSignatureHeader(r.Header.Get("Signature"))
+	keyID, signature := params["keyId"], params["signature"]
+	if keyID == "" || signature == "" {
+		return nil, fmt.Errorf("missing Signature header")
+	}
+	if alg := params["algorithm"]; alg != "" && alg != "rsa-sha256" && alg != "hs2019" {
+		return nil, fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	headers := strings.Fields(strings.ToLower(params["headers"]))
+	for _, required := range []string{"(request-target)", "host", "date", "digest"} {
+		if !containsString(headers, required) {
+			return nil, fmt.Errorf("signature does not cover %s", required)
+		}
+	}
+
+	date, err := http.ParseTime(r.Header.Get("Date"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Date header")
+	}
+	if skew := s.now().Sub(date); skew > activityPubMaxClockSkew || skew < -activityPubMaxClockSkew {
+		return nil, fmt.Errorf("request date is out of range")
+	}
+	if !digestMatches(r.Header.Get("Digest"), body) {
+		return nil, fmt.Errorf("body digest does not match")
+	}
+
+	// Behind the website proxy the host the sender signed is the forwarded one
+	host := firstNonEmpty(r.Header.Get("X-Forwarded-Host"), r.Host)
+	signed, err := signingString(r.Method, r.URL.RequestURI(), host, r.Header, headers)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature encoding")
+	}
+
+	actor, err := s.fetchActor(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch key: %w", err)
+	}
+	if actor.PublicKey.ID != keyID || actor.PublicKey.Owner != actor.ID {
+		return nil, fmt.Errorf("key %s does not belong to actor %s", keyID, actor.ID)
+	}
+	keyURL, _ := url.Parse(keyID)
+	actorURL, err := url.Parse(actor.ID)
+	if err != nil || !strings.EqualFold(keyURL.Host, actorURL.Host) {
+		return nil, fmt.Errorf("key %s does not belong to actor %s", keyID, actor.ID)
+	}
+
+	block, _ := pem.Decode([]byte(actor.PublicKey.PublicKeyPem))
+	if block == nil {
+		return nil, fmt.Errorf("actor key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid actor key: %w", err)
+	}
+	publicKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("actor key is not an RSA key")
+	}
+	hash := sha256.Sum256([]byte(signed))
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hash[:], sig); err != nil {
+		return nil, fmt.Errorf("signature does not verify")
+	}
+	return actor, nil
+}
+
+// signRequest sets the Date and, for requests with a body, Digest headers and signs them
+// together with the request target and host using rsa-sha256
+func signRequest(req *http.Request, body []byte, keyID string, key *rsa.PrivateKey, now time.Time) error {
+	req.Header.Set("Date", now.UTC().Format(http.TimeFormat))
+	headers := []string{"(request-target)", "host", "date"}
+	if body != nil {
+		sum := sha256.Sum256(body)
+		req.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(sum[:]))
+		headers = append(headers, "digest")
+	}
+
+	signed, err := signingString(req.Method, req.URL.RequestURI(), req.URL.Host, req.Header, headers)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Signature", fmt.Sprintf(`keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(sig)))
+	return nil
+}
+
+// signingString builds the string an HTTP Signature covers from the listed headers
+func signingString(method, requestURI, host string, header http.Header, headers []string) (string, error) {
+	lines := make([]string, 0, len(headers))
+	for _, name := range headers {
+		switch name {
+		case "(request-target)":
+			lines = append(lines, "(request-target): "+strings.ToLower(method)+" "+requestURI)
+		case "host":
+			lines = append(lines, "host: "+host)
+		default:
+			values := header.Values(name)
+			if len(values) == 0 {
+				return "", fmt.Errorf("signed header %s is missing", name)
+			}
+			lines = append(lines, name+": "+strings.Join(values, ", "))
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// digestMatches reports whether a Digest header carries the SHA-256 digest of the body
+func digestMatches(header string, body []byte) bool {
+	sum := sha256.Sum256(body)
+	want := base64.StdEncoding.EncodeToString(sum[:])
+	for _, part := range strings.Split(header, ",") {
+		alg, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok && strings.EqualFold(alg, "SHA-256") && value == want {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSignatureHeader splits a Signature header into its quoted parameters
+func parseSignatureHeader(header string) map[string]string {
+	params := make(map[string]string)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		params[key] = strings.Trim(value, `"`)
+	}
+	return params
+}
+
+// deliver stores a pending delivery of an activity to an inbox and attempts it in the background
+func (s *FederationService) deliver(ctx context.Context, actor, inbox string, activity map[string]interface{}) {
+	payload, err := json.Marshal(activity)
+	if err != nil {
+		logger.Error("Failed to marshal activity", err, "actor", actor)
+		return
+	}
+	now := s.now()
+	delivery := &models.ActivityPubDelivery{
+		Actor:         actor,
+		InboxURL:      inbox,
+		ActivityID:    fmt.Sprint(activity["id"]),
+		Payload:       string(payload),
+		Status:        models.WebhookDeliveryPending,
+		NextAttemptAt: &now,
+	}
+	if err := s.repo.CreateDelivery(ctx, delivery); err != nil {
+		logger.Error("Failed to record activity delivery", err, "actor", actor, "inbox", inbox)
+		return
+	}
+
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), activityPubRequestTimeout+5*time.Second)
+		defer cancel()
+		s.attempt(ctx, delivery)
+	}()
+}
+
+// processDue attempts every delivery whose retry is due and prunes the queue once a day
+func (s *FederationService) processDue(ctx context.Context) {
+	now := s.now()
+	due, err := s.repo.ListDueDeliveries(ctx, now, webhookDispatchBatchSize)
+	if err != nil {
+		logger.Error("Failed to list due activity deliveries", err)
+		return
+	}
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		s.attempt(ctx, delivery)
+	}
+
+	s.mu.Lock()
+	prune := now.Sub(s.lastPrune) >= 24*time.Hour
+	if prune {
+		s.lastPrune = now
+	}
+	s.mu.Unlock()
+	if prune {
+		if err := s.repo.PruneDeliveries(ctx, now.Add(-webhookDeliveryRetention)); err != nil {
+			logger.Error("Failed to prune activity deliveries", err)
+		}
+	}
+}
+
+// attempt sends a delivery once and records the outcome, retrying on the webhook schedule.
+// Deliveries already being attempted by this process, or no longer due, are skipped.
+func (s *FederationService) attempt(ctx context.Context, queued *models.ActivityPubDelivery) {
+	s.mu.Lock()
+	if s.inFlight[queued.ID] {
+		s.mu.Unlock()
+		return
+	}
+	s.inFlight[queued.ID] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.inFlight, queued.ID)
+		s.mu.Unlock()
+	}()
+
+	// Reload so a delivery settled by a concurrent attempt is not sent twice
+	delivery, err := s.repo.GetDelivery(ctx, queued.ID)
+	if err != nil {
+		logger.Error("Failed to load activity delivery", err, "delivery_id", queued.ID)
+		return
+	}
+	if delivery.Status != models.WebhookDeliveryPending || delivery.NextAttemptAt == nil || delivery.NextAttemptAt.After(s.now()) {
+		return
+	}
+
+	delivery.Attempts++
+	err = s.send(ctx, delivery)
+
+	now := s.now()
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= webhookMaxAttempts:
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = nil
+	default:
+		next := now.Add(webhookRetryDelay(delivery.Attempts))
+		delivery.Status = models.WebhookDeliveryPending
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = &next
+	}
+
+	if err := s.repo.UpdateDelivery(ctx, delivery); err != nil {
+		logger.Error("Failed to record activity delivery attempt", err, "delivery_id", delivery.ID)
+	}
+}
+
+// send POSTs the activity signed with the actor's key; non-2xx responses are errors
+func (s *FederationService) send(ctx context.Context, delivery *models.ActivityPubDelivery) error {
+	key, err := s.actorKey(ctx, delivery.Actor)
+	if err != nil {
+		return fmt.Errorf("load key: %w", err)
+	}
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.InboxURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", ActivityPubContentType)
+	req.Header.Set("User-Agent", activityPubUserAgent)
+	if err := signRequest(req, body, s.actorURL(delivery.Actor)+"#main-key", key, s.now()); err != nil {
+		return fmt.Errorf("sign request: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, webhookMaxErrorBody))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
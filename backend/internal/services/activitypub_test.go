@@ -0,0 +1,599 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// memoryActivityPubRepo is an in-memory ActivityPubRepository
+type memoryActivityPubRepo struct {
+	mu         sync.Mutex
+	seq        int
+	keys       map[string]*models.ActivityPubKey
+	followers  []*models.ActivityPubFollower
+	deliveries map[string]*models.ActivityPubDelivery
+}
+
+func newMemoryActivityPubRepo() *memoryActivityPubRepo {
+	return &memoryActivityPubRepo{
+		keys:       map[string]*models.ActivityPubKey{},
+		deliveries: map[string]*models.ActivityPubDelivery{},
+	}
+}
+
+func (r *memoryActivityPubRepo) GetKey(ctx context.Context, actor string) (*models.ActivityPubKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.keys[actor]
+	if !ok {
+		return nil, appErr.ErrNotFound
+	}
+	return key, nil
+}
+
+func (r *memoryActivityPubRepo) CreateKey(ctx context.Context, key *models.ActivityPubKey) (*models.ActivityPubKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.keys[key.Actor]; ok {
+		return existing, nil
+	}
+	r.keys[key.Actor] = key
+	return key, nil
+}
+
+func (r *memoryActivityPubRepo) UpsertFollower(ctx context.Context, follower *models.ActivityPubFollower) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, f := range r.followers {
+		if f.Actor == follower.Actor && f.FollowerURI == follower.FollowerURI {
+			f.InboxURL, f.SharedInboxURL = follower.InboxURL, follower.SharedInboxURL
+			return nil
+		}
+	}
+	r.seq++
+	follower.ID = fmt.Sprintf("follower-%d", r.seq)
+	stored := *follower
+	r.followers = append(r.followers, &stored)
+	return nil
+}
+
+func (r *memoryActivityPubRepo) DeleteFollower(ctx context.Context, actor, followerURI string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, f := range r.followers {
+		if f.Actor == actor && f.FollowerURI == followerURI {
+			r.followers = append(r.followers[:i], r.followers[i+1:]...)
+			return nil
+		}
+	}
+	return appErr.ErrNotFound
+}
+
+func (r *memoryActivityPubRepo) CountFollowers(ctx context.Context, actor string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	count := 0
+	for _, f := range r.followers {
+		if f.Actor == actor {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryActivityPubRepo) ListFollowerInboxes(ctx context.Context, actor string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	seen := map[string]bool{}
+	var inboxes []string
+	for _, f := range r.followers {
+		if f.Actor == actor && !seen[f.DeliveryInbox()] {
+			seen[f.DeliveryInbox()] = true
+			inboxes = append(inboxes, f.DeliveryInbox())
+		}
+	}
+	sort.Strings(inboxes)
+	return inboxes, nil
+}
+
+func (r *memoryActivityPubRepo) CreateDelivery(ctx context.Context, delivery *models.ActivityPubDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	delivery.ID = fmt.Sprintf("delivery-%d", r.seq)
+	stored := *delivery
+	r.deliveries[delivery.ID] = &stored
+	return nil
+}
+
+func (r *memoryActivityPubRepo) GetDelivery(ctx context.Context, id string) (*models.ActivityPubDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, appErr.ErrNotFound
+	}
+	out := *delivery
+	return &out, nil
+}
+
+func (r *memoryActivityPubRepo) ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*models.ActivityPubDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.ActivityPubDelivery
+	for _, d := range r.deliveries {
+		if d.Status == models.WebhookDeliveryPending && d.NextAttemptAt != nil && !d.NextAttemptAt.After(before) {
+			copied := *d
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryActivityPubRepo) UpdateDelivery(ctx context.Context, delivery *models.ActivityPubDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *delivery
+	r.deliveries[delivery.ID] = &stored
+	return nil
+}
+
+func (r *memoryActivityPubRepo) PruneDeliveries(ctx context.Context, before time.Time) error {
+	return nil
+}
+
+func (r *memoryActivityPubRepo) allDeliveries() []*models.ActivityPubDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.ActivityPubDelivery
+	for _, d := range r.deliveries {
+		copied := *d
+		out = append(out, &copied)
+	}
+	return out
+}
+
+// publishedPostsRepo adds GetPublishedPosts to memoryBlogRepo
+type publishedPostsRepo struct {
+	*memoryBlogRepo
+}
+
+func (r publishedPostsRepo) GetPublishedPosts(ctx context.Context, options repository.ListOptions) ([]*models.BlogPost, error) {
+	return r.ListByStatus(ctx, models.PageStatusPublished, options)
+}
+
+func (r *memoryUserRepo) List(ctx context.Context, options repository.ListOptions) ([]*models.User, error) {
+	if options.Skip >= len(r.users) {
+		return nil, nil
+	}
+	out := r.users[options.Skip:]
+	if options.Limit > 0 && len(out) > options.Limit {
+		out = out[:options.Limit]
+	}
+	return out, nil
+}
+
+// remoteInstance is a stub fediverse server hosting the actor alice
+type remoteInstance struct {
+	server  *httptest.Server
+	key     *rsa.PrivateKey
+	status  int
+	mu      sync.Mutex
+	inboxed []*http.Request
+	bodies  [][]byte
+}
+
+func newRemoteInstance(t *testing.T) *remoteInstance {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	remote := &remoteInstance{key: key, status: http.StatusAccepted}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/alice", func(w http.ResponseWriter, r *http.Request) {
+		publicKey, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		id := remote.server.URL + "/users/alice"
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":                id,
+			"type":              "Person",
+			"preferredUsername": "alice",
+			"name":              "Alice",
+			"url":               remote.server.URL + "/@alice",
+			"inbox":             id + "/inbox",
+			"endpoints":         map[string]string{"sharedInbox": remote.server.URL + "/inbox"},
+			"publicKey": map[string]string{
+				"id":           id + "#main-key",
+				"owner":        id,
+				"publicKeyPem": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+			},
+		})
+	})
+	inbox := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		remote.mu.Lock()
+		remote.inboxed = append(remote.inboxed, r)
+		remote.bodies = append(remote.bodies, body)
+		code := remote.status
+		remote.mu.Unlock()
+		w.WriteHeader(code)
+	}
+	mux.HandleFunc("POST /users/alice/inbox", inbox)
+	mux.HandleFunc("POST /inbox", inbox)
+	remote.server = httptest.NewServer(mux)
+	t.Cleanup(remote.server.Close)
+	return remote
+}
+
+func (r *remoteInstance) actorID() string {
+	return r.server.URL + "/users/alice"
+}
+
+// received returns the paths and decoded activities POSTed to the instance's inboxes
+func (r *remoteInstance) received() ([]string, []map[string]interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var paths []string
+	var activities []map[string]interface{}
+	for i, req := range r.inboxed {
+		var activity map[string]interface{}
+		_ = json.Unmarshal(r.bodies[i], &activity)
+		paths = append(paths, req.URL.Path)
+		activities = append(activities, activity)
+	}
+	return paths, activities
+}
+
+// signedInbox builds an inbox request signed by alice
+func (r *remoteInstance) signedInbox(t *testing.T, path string, activity map[string]interface{}) (*http.Request, []byte) {
+	body, err := json.Marshal(activity)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "https://ap.blog.test"+path, bytes.NewReader(body))
+	require.NoError(t, signRequest(req, body, r.actorID()+"#main-key", r.key, time.Now()))
+	return req, body
+}
+
+func newFederationTestService(t *testing.T, remote *remoteInstance) (*FederationService, *memoryActivityPubRepo, *memoryCommentRepo) {
+	hello := models.NewBlogPost("Hello", "hello", "user-1")
+	hello.Excerpt = "Our first post"
+	hello.Tags = []string{"launch"}
+	hello.SetPublished()
+	hello.PublishedAt = timePtr(time.Now().Add(-time.Hour))
+	members := models.NewBlogPost("Members only", "members", "user-1")
+	members.SetPublished()
+	members.PublishedAt = timePtr(time.Now().Add(-time.Hour))
+	members.Visibility = models.Visibility{Level: models.VisibilityAuthenticated}
+	draft := models.NewBlogPost("Draft", "draft", "user-1")
+	blogRepo := &memoryBlogRepo{posts: map[string]*models.BlogPost{hello.ID: hello, members.ID: members, draft.ID: draft}}
+
+	users := &memoryUserRepo{users: []*models.User{{ID: "user-1", Email: "jane@blog.test", Profile: models.Profile{Name: "Jane Doe"}}}}
+	repo := newMemoryActivityPubRepo()
+	service := NewFederationService(repo, publishedPostsRepo{blogRepo}, users, FederationConfig{
+		BaseURL: "https://ap.blog.test/",
+		Site:    SiteInfo{Name: "Test Blog", URL: "https://blog.test"},
+	})
+	commentRepo := &memoryCommentRepo{}
+	service.SetCommentService(NewCommentService(commentRepo, blogRepo, nil, nil))
+	if remote != nil {
+		service.SetHTTPClient(remote.server.Client())
+	}
+	return service, repo, commentRepo
+}
+
+func TestFederationService_WebFinger(t *testing.T) {
+	service, _, _ := newFederationTestService(t, nil)
+	ctx := context.Background()
+
+	for _, resource := range []string{"acct:blog@ap.blog.test", "@blog@AP.blog.test", "https://ap.blog.test/ap/actors/blog"} {
+		resp, err := service.WebFinger(ctx, resource)
+		require.NoError(t, err, resource)
+		assert.Equal(t, "acct:blog@ap.blog.test", resp.Subject)
+		require.NotEmpty(t, resp.Links)
+		assert.Equal(t, WebFingerLink{Rel: "self", Type: ActivityPubContentType, Href: "https://ap.blog.test/ap/actors/blog"}, resp.Links[0])
+	}
+
+	for _, resource := range []string{"acct:blog@other.test", "acct:jane_doe@ap.blog.test", "blog"} {
+		_, err := service.WebFinger(ctx, resource)
+		assert.Equal(t, codes.NotFound, status.Code(err), resource)
+	}
+	_, err := service.WebFinger(ctx, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFederationService_ActorDocument(t *testing.T) {
+	service, repo, _ := newFederationTestService(t, nil)
+	ctx := context.Background()
+
+	doc, err := service.Actor(ctx, "blog")
+	require.NoError(t, err)
+	assert.Equal(t, "https://ap.blog.test/ap/actors/blog", doc["id"])
+	assert.Equal(t, "Test Blog", doc["name"])
+	assert.Equal(t, "https://ap.blog.test/ap/actors/blog/inbox", doc["inbox"])
+	publicKey := doc["publicKey"].(map[string]interface{})
+	assert.Equal(t, "https://ap.blog.test/ap/actors/blog#main-key", publicKey["id"])
+	assert.Contains(t, publicKey["publicKeyPem"], "BEGIN PUBLIC KEY")
+
+	// The key is generated once and reused
+	again, err := service.Actor(ctx, "blog")
+	require.NoError(t, err)
+	assert.Equal(t, publicKey["publicKeyPem"], again["publicKey"].(map[string]interface{})["publicKeyPem"])
+	assert.Len(t, repo.keys, 1)
+
+	_, err = service.Actor(ctx, "jane_doe")
+	assert.Equal(t, codes.NotFound, status.Code(err), "author actors are disabled by default")
+}
+
+func TestFederationService_OutboxListsPublicPosts(t *testing.T) {
+	service, _, _ := newFederationTestService(t, nil)
+	ctx := context.Background()
+
+	collection, err := service.Outbox(ctx, "blog", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "OrderedCollection", collection["type"])
+	assert.Equal(t, "https://ap.blog.test/ap/actors/blog/outbox?page=1", collection["first"])
+
+	page, err := service.Outbox(ctx, "blog", 1)
+	require.NoError(t, err)
+	items := page["orderedItems"].([]interface{})
+	require.Len(t, items, 1, "drafts and members-only posts are not federated")
+	create := items[0].(map[string]interface{})
+	assert.Equal(t, "Create", create["type"])
+	article := create["object"].(map[string]interface{})
+	assert.Equal(t, "https://ap.blog.test/ap/posts/hello", article["id"])
+	assert.Equal(t, "Article", article["type"])
+	assert.Equal(t, "https://blog.test/blog/hello", article["url"])
+	assert.Equal(t, "Our first post", article["summary"])
+	assert.Equal(t, "https://ap.blog.test/ap/actors/blog", article["attributedTo"])
+	assert.NotContains(t, page, "next")
+
+	_, err = service.Article(ctx, "members")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestFederationService_AuthorActors(t *testing.T) {
+	service, _, _ := newFederationTestService(t, nil)
+	service.config.AuthorActors = true
+	ctx := context.Background()
+
+	doc, err := service.Actor(ctx, "jane_doe")
+	require.NoError(t, err)
+	assert.Equal(t, "Person", doc["type"])
+	assert.Equal(t, "Jane Doe", doc["name"])
+
+	post, err := service.blogRepo.GetBySlug(ctx, "hello")
+	require.NoError(t, err)
+	create := service.postActivity(ctx, post, "jane_doe")
+	assert.Equal(t, "Create", create["type"])
+	announce := service.postActivity(ctx, post, "blog")
+	assert.Equal(t, "Announce", announce["type"])
+	assert.Equal(t, "https://ap.blog.test/ap/posts/hello", announce["object"])
+}
+
+func TestFederationService_FollowAndUndo(t *testing.T) {
+	remote := newRemoteInstance(t)
+	service, repo, _ := newFederationTestService(t, remote)
+	ctx := context.Background()
+
+	follow := map[string]interface{}{
+		"id":     remote.actorID() + "#follows/1",
+		"type":   "Follow",
+		"actor":  remote.actorID(),
+		"object": "https://ap.blog.test/ap/actors/blog",
+	}
+	req, body := remote.signedInbox(t, "/ap/actors/blog/inbox", follow)
+	require.NoError(t, service.ReceiveInbox(ctx, "blog", req, body))
+	service.pending.Wait()
+
+	require.Len(t, repo.followers, 1)
+	assert.Equal(t, remote.actorID(), repo.followers[0].FollowerURI)
+	assert.Equal(t, remote.server.URL+"/inbox", repo.followers[0].SharedInboxURL)
+
+	paths, activities := remote.received()
+	require.Len(t, activities, 1)
+	assert.Equal(t, "/users/alice/inbox", paths[0])
+	assert.Equal(t, "Accept", activities[0]["type"])
+	assert.Equal(t, follow["id"], activities[0]["object"].(map[string]interface{})["id"])
+	assert.Contains(t, remote.inboxed[0].Header.Get("Signature"), `keyId="https://ap.blog.test/ap/actors/blog#main-key"`)
+	assert.NotEmpty(t, remote.inboxed[0].Header.Get("Digest"))
+
+	undo := map[string]interface{}{
+		"id":     remote.actorID() + "#follows/1/undo",
+		"type":   "Undo",
+		"actor":  remote.actorID(),
+		"object": follow,
+	}
+	req, body = remote.signedInbox(t, "/ap/inbox", undo)
+	require.NoError(t, service.ReceiveInbox(ctx, "", req, body))
+	assert.Empty(t, repo.followers)
+}
+
+func TestFederationService_InboxRejectsInvalidSignatures(t *testing.T) {
+	remote := newRemoteInstance(t)
+	service, repo, _ := newFederationTestService(t, remote)
+	ctx := context.Background()
+
+	follow := map[string]interface{}{
+		"id":     remote.actorID() + "#follows/1",
+		"type":   "Follow",
+		"actor":  remote.actorID(),
+		"object": "https://ap.blog.test/ap/actors/blog",
+	}
+
+	t.Run("tampered body", func(t *testing.T) {
+		req, _ := remote.signedInbox(t, "/ap/actors/blog/inbox", follow)
+		tampered := []byte(strings.Replace(mustJSON(t, follow), "blog", "news", 1))
+		err := service.ReceiveInbox(ctx, "blog", req, tampered)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("unsigned", func(t *testing.T) {
+		body := []byte(mustJSON(t, follow))
+		req := httptest.NewRequest(http.MethodPost, "https://ap.blog.test/ap/actors/blog/inbox", bytes.NewReader(body))
+		err := service.ReceiveInbox(ctx, "blog", req, body)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("impersonation", func(t *testing.T) {
+		impersonated := map[string]interface{}{
+			"id": "https://other.test/users/bob#follows/1", "type": "Follow",
+			"actor": "https://other.test/users/bob", "object": "https://ap.blog.test/ap/actors/blog",
+		}
+		req, body := remote.signedInbox(t, "/ap/actors/blog/inbox", impersonated)
+		err := service.ReceiveInbox(ctx, "blog", req, body)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("unhandled activity", func(t *testing.T) {
+		body := []byte(`{"type":"Delete","actor":"https://gone.test/users/carol","object":"https://gone.test/users/carol"}`)
+		req := httptest.NewRequest(http.MethodPost, "https://ap.blog.test/ap/inbox", bytes.NewReader(body))
+		assert.NoError(t, service.ReceiveInbox(ctx, "", req, body))
+	})
+	assert.Empty(t, repo.followers)
+}
+
+func TestFederationService_DefaultClientOnlyReachesPublicAddresses(t *testing.T) {
+	// The remote instance listens on loopback, which the default client refuses to dial
+	remote := newRemoteInstance(t)
+	service, repo, _ := newFederationTestService(t, nil)
+	ctx := context.Background()
+
+	follow := map[string]interface{}{
+		"id":     remote.actorID() + "#follows/1",
+		"type":   "Follow",
+		"actor":  remote.actorID(),
+		"object": "https://ap.blog.test/ap/actors/blog",
+	}
+	req, body := remote.signedInbox(t, "/ap/actors/blog/inbox", follow)
+	err := service.ReceiveInbox(ctx, "blog", req, body)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "the actor cannot be fetched")
+	assert.Empty(t, repo.followers)
+
+	err = service.send(ctx, &models.ActivityPubDelivery{
+		Actor:    "blog",
+		InboxURL: remote.server.URL + "/users/alice/inbox",
+		Payload:  `{"type":"Accept"}`,
+	})
+	assert.True(t, errors.Is(err, errNonPublicAddress), "unexpected error: %v", err)
+	paths, _ := remote.received()
+	assert.Empty(t, paths)
+}
+
+func TestFederationService_RepliesEnterModeration(t *testing.T) {
+	remote := newRemoteInstance(t)
+	service, _, commentRepo := newFederationTestService(t, remote)
+	ctx := context.Background()
+
+	reply := func(inReplyTo, content string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":    remote.actorID() + "/statuses/1/activity",
+			"type":  "Create",
+			"actor": remote.actorID(),
+			"object": map[string]interface{}{
+				"id":           remote.actorID() + "/statuses/1",
+				"type":         "Note",
+				"attributedTo": remote.actorID(),
+				"inReplyTo":    inReplyTo,
+				"content":      content,
+			},
+		}
+	}
+
+	req, body := remote.signedInbox(t, "/ap/inbox", reply("https://ap.blog.test/ap/posts/hello", `<p><span class="h-card">@blog</span> Great read &amp; thanks!</p><p>More soon</p>`))
+	require.NoError(t, service.ReceiveInbox(ctx, "", req, body))
+	req, body = remote.signedInbox(t, "/ap/inbox", reply("https://elsewhere.test/notes/1", "<p>Not about us</p>"))
+	require.NoError(t, service.ReceiveInbox(ctx, "", req, body))
+	req, body = remote.signedInbox(t, "/ap/inbox", reply("https://ap.blog.test/ap/posts/members", "<p>Gated</p>"))
+	require.NoError(t, service.ReceiveInbox(ctx, "", req, body))
+
+	require.Len(t, commentRepo.comments, 1)
+	comment := commentRepo.comments[0]
+	assert.Equal(t, "blog:hello", comment.PostID)
+	assert.Equal(t, models.CommentStatusPending, comment.Status)
+	assert.Equal(t, "Alice", comment.AuthorName)
+	assert.Equal(t, remote.server.URL+"/@alice", comment.AuthorURL)
+	assert.Equal(t, "@blog Great read & thanks!\n\nMore soon", comment.Body)
+}
+
+func TestFederationService_PublishQueuesSignedDeliveries(t *testing.T) {
+	remote := newRemoteInstance(t)
+	service, repo, _ := newFederationTestService(t, remote)
+	ctx := context.Background()
+	require.NoError(t, repo.UpsertFollower(ctx, &models.ActivityPubFollower{
+		Actor: "blog", FollowerURI: remote.actorID(), InboxURL: remote.actorID() + "/inbox", SharedInboxURL: remote.server.URL + "/inbox",
+	}))
+	require.NoError(t, repo.UpsertFollower(ctx, &models.ActivityPubFollower{
+		Actor: "blog", FollowerURI: remote.server.URL + "/users/bob", InboxURL: remote.server.URL + "/users/bob/inbox", SharedInboxURL: remote.server.URL + "/inbox",
+	}))
+
+	service.Publish(ctx, models.WebhookEventPostPublished, &contentv1.BlogPost{Id: "blog:hello"})
+	service.Publish(ctx, models.WebhookEventPostPublished, &contentv1.BlogPost{Id: "blog:members"})
+	service.Publish(ctx, models.WebhookEventPostUpdated, &contentv1.BlogPost{Id: "blog:hello"})
+	service.pending.Wait()
+
+	paths, activities := remote.received()
+	require.Len(t, activities, 1, "followers sharing an inbox receive the post once")
+	assert.Equal(t, "/inbox", paths[0])
+	assert.Equal(t, "Create", activities[0]["type"])
+	assert.Equal(t, "https://ap.blog.test/ap/posts/hello", activities[0]["object"].(map[string]interface{})["id"])
+
+	deliveries := repo.allDeliveries()
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, models.WebhookDeliverySucceeded, deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+}
+
+func TestFederationService_RetriesFailedDeliveries(t *testing.T) {
+	remote := newRemoteInstance(t)
+	remote.status = http.StatusServiceUnavailable
+	service, repo, _ := newFederationTestService(t, remote)
+	ctx := context.Background()
+	require.NoError(t, repo.UpsertFollower(ctx, &models.ActivityPubFollower{
+		Actor: "blog", FollowerURI: remote.actorID(), InboxURL: remote.actorID() + "/inbox",
+	}))
+
+	service.Publish(ctx, models.WebhookEventPostPublished, &contentv1.BlogPost{Id: "blog:hello"})
+	service.pending.Wait()
+
+	deliveries := repo.allDeliveries()
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, models.WebhookDeliveryPending, deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Contains(t, deliveries[0].LastError, "unexpected status 503")
+	require.NotNil(t, deliveries[0].NextAttemptAt)
+
+	remote.mu.Lock()
+	remote.status = http.StatusAccepted
+	remote.mu.Unlock()
+	service.now = func() time.Time { return deliveries[0].NextAttemptAt.Add(time.Second) }
+	service.processDue(ctx)
+
+	deliveries = repo.allDeliveries()
+	assert.Equal(t, models.WebhookDeliverySucceeded, deliveries[0].Status)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+}
+
+func mustJSON(t *testing.T, v interface{}) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(data)
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	analyticsv1 "github.com/7-solutions/saas-platformbackend/gen/analytics/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/ratelimit"
+)
+
+const (
+	// analyticsDateLayout is the YYYY-MM-DD format used for stats windows
+	analyticsDateLayout = "2006-01-02"
+
+	defaultStatsWindowDays = 30
+	maxStatsWindowDays     = 366
+	defaultAnalyticsLimit  = 10
+	maxAnalyticsLimit      = 100
+	maxTrackedPathLength   = 2048
+
+	// Views beyond this rate from a single visitor are dropped to keep counts honest
+	defaultViewRateLimit  = 60
+	defaultViewRateWindow = time.Minute
+)
+
+// botUserAgentPatterns identify crawlers whose views are not counted
+var botUserAgentPatterns = []string{"bot", "crawler", "spider", "slurp", "headless", "curl", "wget"}
+
+// AnalyticsService tracks page and post views without cookies. Visitors are identified
+// by a hash of IP address and user agent with a random salt per UTC day. The salt is
+// shared through the analytics repository and purged with the hashes once the day is
+// over, so visitors cannot be followed across days.
+type AnalyticsService struct {
+	analyticsv1.UnimplementedAnalyticsServiceServer
+	analyticsRepo repository.AnalyticsRepository
+	pageRepo      repository.PageRepository
+	blogRepo      repository.BlogRepository
+	limiter       *ratelimit.Limiter
+	now           func() time.Time
+
+	saltMu  sync.Mutex
+	saltDay string
+	salt    []byte
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(
+	analyticsRepo repository.AnalyticsRepository,
+	pageRepo repository.PageRepository,
+	blogRepo repository.BlogRepository,
+) *AnalyticsService {
+	return &AnalyticsService{
+		analyticsRepo: analyticsRepo,
+		pageRepo:      pageRepo,
+		blogRepo:      blogRepo,
+		limiter:       ratelimit.New(defaultViewRateLimit, defaultViewRateWindow),
+		now:           time.Now,
+	}
+}
+
+// TrackView records a view of a published page or post. Views from crawlers and
+// rate-limited visitors are accepted but not counted.
+func (s *AnalyticsService) TrackView(ctx context.Context, req *analyticsv1.TrackViewRequest) (*emptypb.Empty, error) {
+	contentType := s.convertProtoContentTypeToModel(req.ContentType)
+	if contentType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content type is required")
+	}
+	if req.ContentId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content ID is required")
+	}
+
+	ipAddress, userAgent := extractClientInfo(ctx)
+	if isBotUserAgent(userAgent) {
+		return &emptypb.Empty{}, nil
+	}
+
+	if err := s.ensurePublished(ctx, contentType, req.ContentId); err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	visitorHash, err := s.visitorHash(ctx, now, ipAddress, userAgent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to identify visitor: %v", err)
+	}
+	if s.limiter != nil && !s.limiter.Allow(visitorHash) {
+		return &emptypb.Empty{}, nil
+	}
+
+	view := &models.ContentView{
+		ContentID:   req.ContentId,
+		ContentType: contentType,
+		Path:        normalizeTrackedPath(req.Path),
+		Referrer:    normalizeReferrer(req.Referrer),
+		VisitorHash: visitorHash,
+		Day:         now,
+	}
+	if err := s.analyticsRepo.RecordView(ctx, view); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record view: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetContentStats returns daily views and top referrers of one content item, or of the whole site
+func (s *AnalyticsService) GetContentStats(ctx context.Context, req *analyticsv1.GetContentStatsRequest) (*analyticsv1.ContentStats, error) {
+	from, to, err := s.parseStatsWindow(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, err := s.analyticsRepo.ListDailyViews(ctx, req.ContentId, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load daily views: %v", err)
+	}
+	referrers, err := s.analyticsRepo.ListReferrers(ctx, req.ContentId, from, to, clampAnalyticsLimit(req.ReferrerLimit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load referrers: %v", err)
+	}
+
+	stats := &analyticsv1.ContentStats{
+		ContentId: req.ContentId,
+		StartDate: from.Format(analyticsDateLayout),
+		EndDate:   to.Format(analyticsDateLayout),
+	}
+	for _, d := range daily {
+		stats.TotalViews += d.Views
+		stats.UniqueVisitors += d.UniqueVisitors
+		stats.Daily = append(stats.Daily, &analyticsv1.DailyViews{
+			Date:           d.Day.Format(analyticsDateLayout),
+			Views:          d.Views,
+			UniqueVisitors: d.UniqueVisitors,
+		})
+	}
+	for _, r := range referrers {
+		stats.Referrers = append(stats.Referrers, &analyticsv1.ReferrerViews{
+			Referrer: r.Referrer,
+			Views:    r.Views,
+		})
+	}
+
+	return stats, nil
+}
+
+// ListPopularContent ranks pages and posts by views over a time window
+func (s *AnalyticsService) ListPopularContent(ctx context.Context, req *analyticsv1.ListPopularContentRequest) (*analyticsv1.ListPopularContentResponse, error) {
+	from, to, err := s.parseStatsWindow(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.analyticsRepo.ListPopular(ctx, s.convertProtoContentTypeToModel(req.ContentType), from, to, clampAnalyticsLimit(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list popular content: %v", err)
+	}
+
+	resp := &analyticsv1.ListPopularContentResponse{}
+	for _, item := range items {
+		resp.Items = append(resp.Items, &analyticsv1.PopularContent{
+			ContentId:      item.ContentID,
+			ContentType:    s.convertModelContentTypeToProto(item.ContentType),
+			Path:           item.Path,
+			Views:          item.Views,
+			UniqueVisitors: item.UniqueVisitors,
+		})
+	}
+	return resp, nil
+}
+
+// ensurePublished rejects views of unknown or unpublished content
+func (s *AnalyticsService) ensurePublished(ctx context.Context, contentType, contentID string) error {
+	switch contentType {
+	case models.ViewContentTypePage:
+		page, err := s.pageRepo.GetByID(ctx, contentID)
+		if err != nil || page.Status != models.PageStatusPublished {
+			return status.Errorf(codes.NotFound, "page not found")
+		}
+	case models.ViewContentTypePost:
+		post, err := s.blogRepo.GetByID(ctx, contentID)
+		if err != nil || !post.IsPublished() {
+			return status.Errorf(codes.NotFound, "blog post not found")
+		}
+	}
+	return nil
+}
+
+// visitorHash returns the salted visitor identifier for the given day
+func (s *AnalyticsService) visitorHash(ctx context.Context, now time.Time, ipAddress, userAgent string) (string, error) {
+	day := now.Format(analyticsDateLayout)
+	salt, err := s.daySalt(ctx, now, day)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(day + "|" + ipAddress + "|" + userAgent))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// daySalt returns the salt of the day, loading it once per day. The instance that creates
+// a day's salt purges the hashes of earlier days, so restarts do not purge again.
+func (s *AnalyticsService) daySalt(ctx context.Context, now time.Time, day string) ([]byte, error) {
+	s.saltMu.Lock()
+	defer s.saltMu.Unlock()
+	if s.saltDay == day {
+		return s.salt, nil
+	}
+
+	candidate := make([]byte, 32)
+	if _, err := rand.Read(candidate); err != nil {
+		return nil, fmt.Errorf("failed to generate analytics salt: %w", err)
+	}
+	salt, created, err := s.analyticsRepo.DaySalt(ctx, now, candidate)
+	if err != nil {
+		return nil, err
+	}
+	s.salt, s.saltDay = salt, day
+	if created {
+		go s.purgeVisitorHashes(now)
+	}
+	return salt, nil
+}
+
+// purgeVisitorHashes deletes the hashes and salts of previous days
+func (s *AnalyticsService) purgeVisitorHashes(now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := s.analyticsRepo.PurgeVisitorsBefore(ctx, now); err != nil {
+		logger.Error("Failed to purge analytics visitor hashes", err)
+	}
+}
+
+// parseStatsWindow parses an inclusive YYYY-MM-DD window, defaulting to the last 30 days
+func (s *AnalyticsService) parseStatsWindow(startDate, endDate string) (time.Time, time.Time, error) {
+	today := s.now().UTC().Truncate(24 * time.Hour)
+
+	to := today
+	if endDate != "" {
+		parsed, err := time.Parse(analyticsDateLayout, endDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid end date, expected YYYY-MM-DD")
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(defaultStatsWindowDays - 1))
+	if startDate != "" {
+		parsed, err := time.Parse(analyticsDateLayout, startDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid start date, expected YYYY-MM-DD")
+		}
+		from = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "start date must not be after end date")
+	}
+	if to.Sub(from) > (maxStatsWindowDays-1)*24*time.Hour {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "time window must not exceed %d days", maxStatsWindowDays)
+	}
+	return from, to, nil
+}
+
+func clampAnalyticsLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultAnalyticsLimit
+	}
+	if limit > maxAnalyticsLimit {
+		return maxAnalyticsLimit
+	}
+	return int(limit)
+}
+
+// isBotUserAgent reports whether a user agent looks like a crawler or script
+func isBotUserAgent(userAgent string) bool {
+	if userAgent == "" {
+		return true
+	}
+	lower := strings.ToLower(userAgent)
+	for _, pattern := range botUserAgentPatterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeTrackedPath keeps only the path of a tracked URL; query strings and
+// fragments are dropped because they may carry personal data
+func normalizeTrackedPath(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimSpace(path)
+	if path == "" || !strings.HasPrefix(path, "/") {
+		return ""
+	}
+	if len(path) > maxTrackedPathLength {
+		path = path[:maxTrackedPathLength]
+	}
+	return path
+}
+
+// normalizeReferrer reduces a referrer URL to its host, without a leading "www."
+func normalizeReferrer(referrer string) string {
+	u, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// convertProtoContentTypeToModel converts protobuf content type to the model value
+func (s *AnalyticsService) convertProtoContentTypeToModel(contentType analyticsv1.ContentType) string {
+	switch contentType {
+	case analyticsv1.ContentType_CONTENT_TYPE_PAGE:
+		return models.ViewContentTypePage
+	case analyticsv1.ContentType_CONTENT_TYPE_POST:
+		return models.ViewContentTypePost
+	default:
+		return ""
+	}
+}
+
+// convertModelContentTypeToProto converts the model content type to protobuf
+func (s *AnalyticsService) convertModelContentTypeToProto(contentType string) analyticsv1.ContentType {
+	switch contentType {
+	case models.ViewContentTypePage:
+		return analyticsv1.ContentType_CONTENT_TYPE_PAGE
+	case models.ViewContentTypePost:
+		return analyticsv1.ContentType_CONTENT_TYPE_POST
+	default:
+		return analyticsv1.ContentType_CONTENT_TYPE_UNSPECIFIED
+	}
+}
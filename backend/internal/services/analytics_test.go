@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	analyticsv1 "github.com/7-solutions/saas-platformbackend/gen/analytics/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/ratelimit"
+)
+
+// memoryAnalyticsRepo keeps the recorded views and answers queries from them
+type memoryAnalyticsRepo struct {
+	mu       sync.Mutex
+	views    []models.ContentView
+	visitors map[string]bool
+	salts    map[string][]byte
+	purges   int
+}
+
+func newMemoryAnalyticsRepo() *memoryAnalyticsRepo {
+	return &memoryAnalyticsRepo{visitors: map[string]bool{}, salts: map[string][]byte{}}
+}
+
+func (r *memoryAnalyticsRepo) RecordView(ctx context.Context, view *models.ContentView) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.views = append(r.views, *view)
+	return nil
+}
+
+func (r *memoryAnalyticsRepo) inWindow(v models.ContentView, contentID string, from, to time.Time) bool {
+	day := v.Day.Truncate(24 * time.Hour)
+	return (contentID == "" || v.ContentID == contentID) && !day.Before(from) && !day.After(to)
+}
+
+func (r *memoryAnalyticsRepo) ListDailyViews(ctx context.Context, contentID string, from, to time.Time) ([]*models.DailyViews, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.DailyViews
+	byDay := map[string]*models.DailyViews{}
+	seen := map[string]bool{}
+	for _, v := range r.views {
+		if !r.inWindow(v, contentID, from, to) {
+			continue
+		}
+		key := v.Day.Format(analyticsDateLayout)
+		d, ok := byDay[key]
+		if !ok {
+			d = &models.DailyViews{Day: v.Day.Truncate(24 * time.Hour)}
+			byDay[key] = d
+			out = append(out, d)
+		}
+		d.Views++
+		if visitor := v.ContentID + key + v.VisitorHash; !seen[visitor] {
+			seen[visitor] = true
+			d.UniqueVisitors++
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryAnalyticsRepo) ListReferrers(ctx context.Context, contentID string, from, to time.Time, limit int) ([]*models.ReferrerViews, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.ReferrerViews
+	byReferrer := map[string]*models.ReferrerViews{}
+	for _, v := range r.views {
+		if !r.inWindow(v, contentID, from, to) {
+			continue
+		}
+		ref, ok := byReferrer[v.Referrer]
+		if !ok {
+			ref = &models.ReferrerViews{Referrer: v.Referrer}
+			byReferrer[v.Referrer] = ref
+			out = append(out, ref)
+		}
+		ref.Views++
+	}
+	return out, nil
+}
+
+func (r *memoryAnalyticsRepo) ListPopular(ctx context.Context, contentType string, from, to time.Time, limit int) ([]*models.PopularContent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.PopularContent
+	byID := map[string]*models.PopularContent{}
+	for _, v := range r.views {
+		if !r.inWindow(v, "", from, to) || (contentType != "" && v.ContentType != contentType) {
+			continue
+		}
+		item, ok := byID[v.ContentID]
+		if !ok {
+			item = &models.PopularContent{ContentID: v.ContentID, ContentType: v.ContentType, Path: v.Path}
+			byID[v.ContentID] = item
+			out = append(out, item)
+		}
+		item.Views++
+	}
+	return out, nil
+}
+
+func (r *memoryAnalyticsRepo) DaySalt(ctx context.Context, day time.Time, candidate []byte) ([]byte, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := day.Format(analyticsDateLayout)
+	if salt, ok := r.salts[key]; ok {
+		return salt, false, nil
+	}
+	r.salts[key] = candidate
+	return candidate, true, nil
+}
+
+func (r *memoryAnalyticsRepo) PurgeVisitorsBefore(ctx context.Context, day time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.purges++
+	for key := range r.salts {
+		if key < day.Format(analyticsDateLayout) {
+			delete(r.salts, key)
+		}
+	}
+	return nil
+}
+
+func (r *memoryAnalyticsRepo) purgeCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.purges
+}
+
+func newAnalyticsTestService(now *time.Time) (*AnalyticsService, *memoryAnalyticsRepo) {
+	published := models.NewBlogPost("Hello", "hello", "author@example.com")
+	published.SetPublished()
+	published.PublishedAt = timePtr(now.Add(-time.Hour))
+	draft := models.NewBlogPost("Draft", "draft", "author@example.com")
+
+	repo := newMemoryAnalyticsRepo()
+	service := NewAnalyticsService(repo, nil, &memoryBlogRepo{posts: map[string]*models.BlogPost{
+		published.ID: published,
+		draft.ID:     draft,
+	}})
+	service.now = func() time.Time { return *now }
+	return service, repo
+}
+
+func visitorContext(ip, userAgent string) context.Context {
+	return gatewayContext("x-forwarded-for", ip, "grpcgateway-user-agent", userAgent)
+}
+
+func TestAnalyticsService_TrackView(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	service, repo := newAnalyticsTestService(&now)
+	browser := "Mozilla/5.0 (X11; Linux x86_64) Firefox/130.0"
+	view := &analyticsv1.TrackViewRequest{
+		ContentType: analyticsv1.ContentType_CONTENT_TYPE_POST,
+		ContentId:   "blog:hello",
+		Path:        "/blog/hello?utm_source=newsletter&email=me@example.com",
+		Referrer:    "https://www.Google.com/search?q=hello",
+	}
+
+	_, err := service.TrackView(visitorContext("203.0.113.7", browser), view)
+	require.NoError(t, err)
+	_, err = service.TrackView(visitorContext("203.0.113.7", browser), view)
+	require.NoError(t, err)
+	_, err = service.TrackView(visitorContext("198.51.100.1", "Googlebot/2.1"), view)
+	require.NoError(t, err)
+
+	require.Len(t, repo.views, 2, "crawler views are not counted")
+	first := repo.views[0]
+	assert.Equal(t, "/blog/hello", first.Path, "query strings are dropped")
+	assert.Equal(t, "google.com", first.Referrer)
+	assert.Equal(t, first.VisitorHash, repo.views[1].VisitorHash)
+	assert.NotContains(t, first.VisitorHash, "203.0.113.7")
+
+	// The salt rotates with the day, so the same visitor gets an unrelated hash
+	now = now.Add(24 * time.Hour)
+	_, err = service.TrackView(visitorContext("203.0.113.7", browser), view)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.VisitorHash, repo.views[2].VisitorHash)
+
+	draft := &analyticsv1.TrackViewRequest{ContentType: analyticsv1.ContentType_CONTENT_TYPE_POST, ContentId: "blog:draft"}
+	_, err = service.TrackView(visitorContext("203.0.113.7", browser), draft)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.TrackView(visitorContext("203.0.113.7", browser), &analyticsv1.TrackViewRequest{ContentId: "blog:hello"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAnalyticsService_VisitorSaltIsSharedPerDay(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	service, repo := newAnalyticsTestService(&now)
+	view := &analyticsv1.TrackViewRequest{ContentType: analyticsv1.ContentType_CONTENT_TYPE_POST, ContentId: "blog:hello"}
+
+	_, err := service.TrackView(visitorContext("203.0.113.7", "Mozilla/5.0"), view)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return repo.purgeCount() == 1 }, time.Second, 10*time.Millisecond)
+
+	// Another instance, or this one after a restart, hashes with the same salt and leaves
+	// the purge to whoever created the salt
+	restarted := NewAnalyticsService(repo, service.pageRepo, service.blogRepo)
+	restarted.now = service.now
+	_, err = restarted.TrackView(visitorContext("203.0.113.7", "Mozilla/5.0"), view)
+	require.NoError(t, err)
+	require.Len(t, repo.views, 2)
+	assert.Equal(t, repo.views[0].VisitorHash, repo.views[1].VisitorHash)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, 1, repo.purgeCount())
+
+	// The next day's salt purges the previous day's
+	now = now.Add(24 * time.Hour)
+	_, err = restarted.TrackView(visitorContext("203.0.113.7", "Mozilla/5.0"), view)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return repo.purgeCount() == 2 }, time.Second, 10*time.Millisecond)
+	assert.Len(t, repo.salts, 1)
+}
+
+func TestAnalyticsService_TrackViewRateLimit(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	service, repo := newAnalyticsTestService(&now)
+	service.limiter = ratelimit.New(2, time.Minute)
+	view := &analyticsv1.TrackViewRequest{ContentType: analyticsv1.ContentType_CONTENT_TYPE_POST, ContentId: "blog:hello"}
+
+	for i := 0; i < 5; i++ {
+		_, err := service.TrackView(visitorContext("203.0.113.7", "Mozilla/5.0"), view)
+		require.NoError(t, err)
+	}
+	assert.Len(t, repo.views, 2)
+}
+
+func TestAnalyticsService_GetContentStats(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	service, _ := newAnalyticsTestService(&now)
+	ctx := context.Background()
+	track := func(ip, referrer string) {
+		_, err := service.TrackView(visitorContext(ip, "Mozilla/5.0"), &analyticsv1.TrackViewRequest{
+			ContentType: analyticsv1.ContentType_CONTENT_TYPE_POST,
+			ContentId:   "blog:hello",
+			Path:        "/blog/hello",
+			Referrer:    referrer,
+		})
+		require.NoError(t, err)
+	}
+
+	track("203.0.113.1", "https://news.ycombinator.com/item?id=1")
+	track("203.0.113.1", "")
+	track("203.0.113.2", "https://news.ycombinator.com/")
+	now = now.Add(24 * time.Hour)
+	track("203.0.113.1", "")
+
+	stats, err := service.GetContentStats(ctx, &analyticsv1.GetContentStatsRequest{ContentId: "blog:hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-02-10", stats.StartDate)
+	assert.Equal(t, "2026-03-11", stats.EndDate)
+	assert.Equal(t, int64(4), stats.TotalViews)
+	assert.Equal(t, int64(3), stats.UniqueVisitors)
+	require.Len(t, stats.Daily, 2)
+	assert.Equal(t, "2026-03-10", stats.Daily[0].Date)
+	assert.Equal(t, int64(2), stats.Daily[0].UniqueVisitors)
+
+	referrers := map[string]int64{}
+	for _, r := range stats.Referrers {
+		referrers[r.Referrer] = r.Views
+	}
+	assert.Equal(t, map[string]int64{"news.ycombinator.com": 2, "": 2}, referrers)
+
+	single, err := service.GetContentStats(ctx, &analyticsv1.GetContentStatsRequest{StartDate: "2026-03-11", EndDate: "2026-03-11"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), single.TotalViews)
+
+	popular, err := service.ListPopularContent(ctx, &analyticsv1.ListPopularContentRequest{ContentType: analyticsv1.ContentType_CONTENT_TYPE_POST})
+	require.NoError(t, err)
+	require.Len(t, popular.Items, 1)
+	assert.Equal(t, int64(4), popular.Items[0].Views)
+	assert.Equal(t, analyticsv1.ContentType_CONTENT_TYPE_POST, popular.Items[0].ContentType)
+}
+
+func TestAnalyticsService_StatsWindowValidation(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	service, _ := newAnalyticsTestService(&now)
+	ctx := context.Background()
+
+	_, err := service.GetContentStats(ctx, &analyticsv1.GetContentStatsRequest{StartDate: "10/03/2026"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.GetContentStats(ctx, &analyticsv1.GetContentStatsRequest{StartDate: "2026-03-10", EndDate: "2026-03-01"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.ListPopularContent(ctx, &analyticsv1.ListPopularContentRequest{StartDate: "2024-01-01", EndDate: "2026-03-01"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestNormalizeReferrer(t *testing.T) {
+	assert.Equal(t, "example.com", normalizeReferrer("https://www.example.com/a/b?c=d"))
+	assert.Equal(t, "t.co", normalizeReferrer("http://t.co/xyz"))
+	assert.Equal(t, "", normalizeReferrer(""))
+	assert.Equal(t, "", normalizeReferrer("not a url"))
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// archiveBlogRepo records listing queries and adds date ranges and the monthly archive to memoryBlogRepo
+type archiveBlogRepo struct {
+	*memoryBlogRepo
+	query repository.BlogPostQuery
+}
+
+func (r *archiveBlogRepo) ListPosts(ctx context.Context, query repository.BlogPostQuery, options repository.ListOptions) ([]*models.BlogPost, *repository.PaginationInfo, error) {
+	r.query = query
+	return r.memoryBlogRepo.ListPosts(ctx, query, options)
+}
+
+func (r *archiveBlogRepo) ListPublishedBetween(ctx context.Context, after, before time.Time, options repository.ListOptions) ([]*models.BlogPost, error) {
+	posts, _ := r.ListByStatus(ctx, models.PageStatusPublished, repository.ListOptions{})
+	var out []*models.BlogPost
+	for _, post := range posts {
+		if post.PublishedAt.Before(after) || (!before.IsZero() && !post.PublishedAt.Before(before)) {
+			continue
+		}
+		out = append(out, post)
+	}
+	return out, nil
+}
+
+func (r *archiveBlogRepo) GetArchive(ctx context.Context) ([]*models.BlogArchiveMonth, error) {
+	return []*models.BlogArchiveMonth{
+		{Year: 2026, Month: 3, PostCount: 2},
+		{Year: 2026, Month: 1, PostCount: 1},
+		{Year: 2025, Month: 12, PostCount: 4},
+	}, nil
+}
+
+func newArchiveTestService() (*ContentService, *archiveBlogRepo) {
+	posts := map[string]*models.BlogPost{}
+	for slug, published := range map[string]time.Time{
+		"february": time.Date(2026, 2, 28, 23, 59, 0, 0, time.UTC),
+		"march":    time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		"april":    time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		post := models.NewBlogPost(slug, slug, "user-1")
+		post.Status = models.PageStatusPublished
+		post.PublishedAt = timePtr(published)
+		if slug != "march" {
+			post.Categories = []string{"news"}
+		}
+		posts[post.ID] = post
+	}
+	repo := &archiveBlogRepo{memoryBlogRepo: &memoryBlogRepo{posts: posts}}
+	return NewContentService(&memoryPageRepo{}, repo), repo
+}
+
+func TestContentService_GetBlogArchiveGroupsMonthsByYear(t *testing.T) {
+	service, _ := newArchiveTestService()
+
+	resp, err := service.GetBlogArchive(context.Background(), &contentv1.GetBlogArchiveRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Years, 2)
+	assert.Equal(t, int32(2026), resp.Years[0].Year)
+	assert.Equal(t, int32(3), resp.Years[0].PostCount)
+	require.Len(t, resp.Years[0].Months, 2)
+	assert.Equal(t, int32(3), resp.Years[0].Months[0].Month)
+	assert.Equal(t, int32(2), resp.Years[0].Months[0].PostCount)
+	assert.Equal(t, int32(2025), resp.Years[1].Year)
+	assert.Equal(t, int32(4), resp.Years[1].PostCount)
+}
+
+func TestContentService_ListBlogPostsByPublicationDate(t *testing.T) {
+	service, repo := newArchiveTestService()
+	ctx := context.Background()
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{
+		PublishedAfter:  timestamppb.New(march),
+		PublishedBefore: timestamppb.New(april),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Posts, 1)
+	assert.Equal(t, "march", resp.Posts[0].Slug)
+	assert.True(t, repo.query.PublishedAfter.Equal(march))
+	assert.True(t, repo.query.PublishedBefore.Equal(april))
+
+	// An open end is passed as the zero time
+	resp, err = service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{PublishedAfter: timestamppb.New(march)})
+	require.NoError(t, err)
+	assert.Len(t, resp.Posts, 2)
+	assert.True(t, repo.query.PublishedBefore.IsZero())
+
+	_, err = service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{
+		PublishedAfter:  timestamppb.New(april),
+		PublishedBefore: timestamppb.New(march),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{
+		PublishedAfter: timestamppb.New(march),
+		Status:         contentv1.PageStatus_PAGE_STATUS_DRAFT,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{
+		PublishedAfter: timestamppb.New(march),
+		Sort:           contentv1.BlogPostSort_BLOG_POST_SORT_TITLE,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "date ranges are listed by publication date")
+}
+
+func TestContentService_ListBlogPostsByPublicationDateAndCategory(t *testing.T) {
+	service, repo := newArchiveTestService()
+	ctx := context.Background()
+	february := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{
+		PublishedAfter: timestamppb.New(february),
+		Category:       "news",
+	})
+	require.NoError(t, err)
+	var slugs []string
+	for _, post := range resp.Posts {
+		slugs = append(slugs, post.Slug)
+	}
+	assert.Equal(t, []string{"april", "february"}, slugs, "the category applies within the date range")
+	assert.Equal(t, int32(2), resp.TotalCount)
+	assert.Equal(t, "news", repo.query.Category)
+	assert.True(t, repo.query.PublishedAfter.Equal(february))
+}
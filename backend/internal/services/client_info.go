@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+var (
+	trustedProxiesMu sync.RWMutex
+	// trustedProxies are the networks whose X-Forwarded-For entries are believed
+	trustedProxies = loopbackNetworks()
+)
+
+// loopbackNetworks are always trusted: the HTTP gateway dials the gRPC server over loopback
+func loopbackNetworks() []*net.IPNet {
+	return []*net.IPNet{
+		{IP: net.IPv4(127, 0, 0, 0).To4(), Mask: net.CIDRMask(8, 32)},
+		{IP: net.IPv6loopback, Mask: net.CIDRMask(128, 128)},
+	}
+}
+
+// SetTrustedProxies trusts the X-Forwarded-For entries added by the given reverse proxies,
+// each a CIDR or a single address, in addition to loopback
+func SetTrustedProxies(proxies []string) error {
+	networks := loopbackNetworks()
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy '%s'", proxy)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy '%s': %w", proxy, err)
+		}
+		networks = append(networks, network)
+	}
+
+	trustedProxiesMu.Lock()
+	trustedProxies = networks
+	trustedProxiesMu.Unlock()
+	return nil
+}
+
+// isTrustedProxy reports whether addr belongs to a trusted proxy
+func isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	trustedProxiesMu.RLock()
+	defer trustedProxiesMu.RUnlock()
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP resolves the client address of a connection from remote. X-Forwarded-For is
+// read from the right, and an entry is only believed while the hop that appended it is a
+// trusted proxy; the first address not belonging to one is the client.
+func clientIP(remote string, forwardedFor []string) string {
+	if !isTrustedProxy(remote) {
+		return remote
+	}
+	var hops []string
+	for _, header := range forwardedFor {
+		for _, hop := range strings.Split(header, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	client := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		client = hops[i]
+		if !isTrustedProxy(client) {
+			break
+		}
+	}
+	return client
+}
+
+// extractClientInfo returns the client IP and user agent of a request. The IP comes from the
+// connection, or from X-Forwarded-For when the connection is from a trusted proxy.
+func extractClientInfo(ctx context.Context) (string, string) {
+	var remote string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remote = p.Addr.String()
+		if host, _, err := net.SplitHostPort(remote); err == nil {
+			remote = host
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwardedFor := md.Get("x-forwarded-for")
+	if len(forwardedFor) == 0 {
+		forwardedFor = md.Get("x-real-ip")
+	}
+	var userAgent string
+	if v := md.Get("grpcgateway-user-agent"); len(v) > 0 {
+		userAgent = v[0]
+	} else if v := md.Get("user-agent"); len(v) > 0 {
+		userAgent = v[0]
+	}
+	return clientIP(remote, forwardedFor), userAgent
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// gatewayContext mimics a request relayed by the HTTP gateway, which dials the gRPC server
+// over loopback and forwards the request headers as metadata
+func gatewayContext(pairs ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+}
+
+func TestExtractClientInfo(t *testing.T) {
+	require.NoError(t, SetTrustedProxies([]string{"10.1.0.0/16", "192.0.2.7"}))
+	t.Cleanup(func() { _ = SetTrustedProxies(nil) })
+
+	direct := func(addr string, pairs ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+	}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"direct client ignores forwarded headers", direct("203.0.113.9", "x-forwarded-for", "198.51.100.1"), "203.0.113.9"},
+		{"gateway without proxy", gatewayContext("x-forwarded-for", "203.0.113.9"), "203.0.113.9"},
+		{"spoofed entry before an untrusted hop", gatewayContext("x-forwarded-for", "198.51.100.1, 203.0.113.9"), "203.0.113.9"},
+		{"behind trusted proxies", gatewayContext("x-forwarded-for", "198.51.100.1, 203.0.113.9, 10.1.4.2, 192.0.2.7"), "203.0.113.9"},
+		{"real ip header", gatewayContext("x-real-ip", "203.0.113.9"), "203.0.113.9"},
+		{"gateway without headers", gatewayContext(), "127.0.0.1"},
+		{"no peer", metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "198.51.100.1")), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, _ := extractClientInfo(tt.ctx)
+			assert.Equal(t, tt.want, ip)
+		})
+	}
+
+	_, userAgent := extractClientInfo(gatewayContext("grpcgateway-user-agent", "Firefox", "user-agent", "grpc-go"))
+	assert.Equal(t, "Firefox", userAgent)
+	assert.Error(t, SetTrustedProxies([]string{"proxy.internal"}))
+}
@@ -0,0 +1,462 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// maxCollectionPosts bounds the size of a single series or curated list
+const maxCollectionPosts = 200
+
+// SetCollectionRepository enables series and curated collections. When unset, the
+// collection RPCs return FailedPrecondition and posts carry no series navigation.
+func (s *ContentService) SetCollectionRepository(repo repository.CollectionRepository) {
+	s.collectionRepo = repo
+}
+
+// CreateCollection creates a new series or curated collection
+func (s *ContentService) CreateCollection(ctx context.Context, req *contentv1.CreateCollectionRequest) (*contentv1.Collection, error) {
+	if err := s.requireCollectionRepo(); err != nil {
+		return nil, err
+	}
+	if err := s.validateCollectionFields(req.Title, req.Slug); err != nil {
+		return nil, err
+	}
+
+	kind := s.convertProtoCollectionKindToModel(req.Kind)
+	if kind == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "collection kind is required")
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = s.generateSlug(req.Title)
+	} else {
+		slug = s.sanitizeSlug(slug)
+	}
+	if err := s.validateCollectionSlugUniqueness(ctx, slug, ""); err != nil {
+		return nil, err
+	}
+	if err := s.validateCollectionPosts(ctx, req.PostIds); err != nil {
+		return nil, err
+	}
+
+	collection := models.NewCollection(strings.TrimSpace(req.Title), slug, kind)
+	collection.Description = strings.TrimSpace(req.Description)
+	collection.PostIDs = append(collection.PostIDs, req.PostIds...)
+
+	if err := s.collectionRepo.Create(ctx, collection); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create collection: %v", err)
+	}
+
+	return s.convertCollectionToProto(ctx, collection), nil
+}
+
+// GetCollection retrieves a collection by ID or slug with its published posts resolved
+func (s *ContentService) GetCollection(ctx context.Context, req *contentv1.GetCollectionRequest) (*contentv1.Collection, error) {
+	if err := s.requireCollectionRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "collection ID is required")
+	}
+
+	collection, err := s.getCollectionByIDOrSlug(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertCollectionToProto(ctx, collection), nil
+}
+
+// UpdateCollection updates a collection's title, slug and description
+func (s *ContentService) UpdateCollection(ctx context.Context, req *contentv1.UpdateCollectionRequest) (*contentv1.Collection, error) {
+	if err := s.requireCollectionRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "collection ID is required")
+	}
+	if err := s.validateCollectionFields(req.Title, req.Slug); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.collectionRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = s.generateSlug(req.Title)
+	} else {
+		slug = s.sanitizeSlug(slug)
+	}
+	if slug != existing.Slug {
+		if err := s.validateCollectionSlugUniqueness(ctx, slug, existing.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.Title = strings.TrimSpace(req.Title)
+	existing.Slug = slug
+	existing.Description = strings.TrimSpace(req.Description)
+
+	if err := s.collectionRepo.Update(ctx, existing); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update collection: %v", err)
+	}
+
+	return s.convertCollectionToProto(ctx, existing), nil
+}
+
+// DeleteCollection deletes a collection; the posts themselves are left untouched
+func (s *ContentService) DeleteCollection(ctx context.Context, req *contentv1.DeleteCollectionRequest) (*emptypb.Empty, error) {
+	if err := s.requireCollectionRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "collection ID is required")
+	}
+
+	if _, err := s.collectionRepo.GetByID(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+
+	if err := s.collectionRepo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete collection: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListCollections lists collections with an optional kind filter and pagination
+func (s *ContentService) ListCollections(ctx context.Context, req *contentv1.ListCollectionsRequest) (*contentv1.ListCollectionsResponse, error) {
+	if err := s.requireCollectionRepo(); err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	skip := 0
+	if req.PageToken != "" {
+		if parsedSkip, err := strconv.Atoi(req.PageToken); err == nil {
+			skip = parsedSkip
+		}
+	}
+
+	collections, err := s.collectionRepo.List(ctx, s.convertProtoCollectionKindToModel(req.Kind), repository.ListOptions{
+		Limit: int(pageSize),
+		Skip:  skip,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collections: %v", err)
+	}
+
+	protoCollections := make([]*contentv1.Collection, len(collections))
+	for i, collection := range collections {
+		protoCollections[i] = s.convertCollectionToProto(ctx, collection)
+	}
+
+	nextPageToken := ""
+	if len(collections) == int(pageSize) {
+		nextPageToken = strconv.Itoa(skip + int(pageSize))
+	}
+
+	return &contentv1.ListCollectionsResponse{
+		Collections:   protoCollections,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(len(protoCollections)), // This is approximate for this page
+	}, nil
+}
+
+// SetCollectionPosts replaces the ordered post list of a collection
+func (s *ContentService) SetCollectionPosts(ctx context.Context, req *contentv1.SetCollectionPostsRequest) (*contentv1.Collection, error) {
+	if err := s.requireCollectionRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "collection ID is required")
+	}
+
+	collection, err := s.collectionRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+	if err := s.validateCollectionPosts(ctx, req.PostIds); err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.SetPosts(ctx, collection.ID, req.PostIds); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set collection posts: %v", err)
+	}
+	collection.PostIDs = append([]string{}, req.PostIds...)
+
+	return s.convertCollectionToProto(ctx, collection), nil
+}
+
+// ReorderCollectionPosts reorders the posts of a collection. Unlike SetCollectionPosts it
+// never adds or removes posts, so a stale editor view cannot drop posts added meanwhile.
+func (s *ContentService) ReorderCollectionPosts(ctx context.Context, req *contentv1.ReorderCollectionPostsRequest) (*contentv1.Collection, error) {
+	if err := s.requireCollectionRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "collection ID is required")
+	}
+	if len(req.PostIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "post IDs are required")
+	}
+
+	collection, err := s.collectionRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+	postIDs, err := reorderedPostIDs(collection.PostIDs, req.PostIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.SetPosts(ctx, collection.ID, postIDs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reorder collection posts: %v", err)
+	}
+	collection.PostIDs = postIDs
+
+	return s.convertCollectionToProto(ctx, collection), nil
+}
+
+// Collection helpers used by the blog post read/delete paths
+
+func (s *ContentService) requireCollectionRepo() error {
+	if s.collectionRepo == nil {
+		return status.Errorf(codes.FailedPrecondition, "collections are not configured")
+	}
+	return nil
+}
+
+func (s *ContentService) validateCollectionFields(title, slug string) error {
+	if strings.TrimSpace(title) == "" {
+		return status.Errorf(codes.InvalidArgument, "title is required")
+	}
+	if len(title) > 200 {
+		return status.Errorf(codes.InvalidArgument, "title must be less than 200 characters")
+	}
+	if slug != "" && len(slug) > 100 {
+		return status.Errorf(codes.InvalidArgument, "slug must be less than 100 characters")
+	}
+	return nil
+}
+
+func (s *ContentService) validateCollectionSlugUniqueness(ctx context.Context, slug, excludeID string) error {
+	existing, err := s.collectionRepo.GetBySlug(ctx, slug)
+	if err == nil && existing != nil && existing.ID != excludeID {
+		return status.Errorf(codes.AlreadyExists, "collection with slug '%s' already exists", slug)
+	}
+	return nil
+}
+
+// validateCollectionPosts ensures the post list has no duplicates and only references existing posts
+func (s *ContentService) validateCollectionPosts(ctx context.Context, postIDs []string) error {
+	if len(postIDs) > maxCollectionPosts {
+		return status.Errorf(codes.InvalidArgument, "a collection can hold at most %d posts", maxCollectionPosts)
+	}
+
+	seen := make(map[string]bool, len(postIDs))
+	for _, id := range postIDs {
+		if id == "" {
+			return status.Errorf(codes.InvalidArgument, "post ID is required")
+		}
+		if seen[id] {
+			return status.Errorf(codes.InvalidArgument, "post '%s' is listed more than once", id)
+		}
+		seen[id] = true
+	}
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	posts, err := s.blogRepo.GetByIDs(ctx, postIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load collection posts: %v", err)
+	}
+	found := make(map[string]bool, len(posts))
+	for _, post := range posts {
+		found[post.ID] = true
+	}
+	var missing []string
+	for _, id := range postIDs {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	switch len(missing) {
+	case 0:
+		return nil
+	case 1:
+		return status.Errorf(codes.InvalidArgument, "blog post '%s' does not exist", missing[0])
+	default:
+		return status.Errorf(codes.InvalidArgument, "blog posts '%s' do not exist", strings.Join(missing, "', '"))
+	}
+}
+
+// getCollectionByIDOrSlug looks a collection up by slug first, so well-known lists
+// such as "featured" can be fetched without knowing their ID
+func (s *ContentService) getCollectionByIDOrSlug(ctx context.Context, idOrSlug string) (*models.Collection, error) {
+	if collection, err := s.collectionRepo.GetBySlug(ctx, idOrSlug); err == nil {
+		return collection, nil
+	}
+	collection, err := s.collectionRepo.GetByID(ctx, idOrSlug)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+	return collection, nil
+}
+
+// publishedCollectionPosts loads the published posts of a collection in order, in one batch.
+// keepID is included even when unpublished so drafts in a series can preview their navigation.
+func (s *ContentService) publishedCollectionPosts(ctx context.Context, collection *models.Collection, keepID string) []*models.BlogPost {
+	posts := make([]*models.BlogPost, 0, len(collection.PostIDs))
+	if len(collection.PostIDs) == 0 {
+		return posts
+	}
+	loaded, err := s.blogRepo.GetByIDs(ctx, collection.PostIDs)
+	if err != nil {
+		logger.Error("Failed to load collection posts", err, "collection_id", collection.ID)
+		return posts
+	}
+	byID := make(map[string]*models.BlogPost, len(loaded))
+	for _, post := range loaded {
+		byID[post.ID] = post
+	}
+	for _, id := range collection.PostIDs {
+		if post, ok := byID[id]; ok && (post.IsPublished() || post.ID == keepID) {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+// attachSeriesNavigation fills in previous/next navigation for every series containing the post
+func (s *ContentService) attachSeriesNavigation(ctx context.Context, post *contentv1.BlogPost) {
+	if s.collectionRepo == nil {
+		return
+	}
+
+	series, err := s.collectionRepo.ListByPost(ctx, post.Id, models.CollectionKindSeries)
+	if err != nil {
+		logger.Error("Failed to load series for blog post", err, "post_id", post.Id)
+		return
+	}
+
+	for _, collection := range series {
+		posts := s.publishedCollectionPosts(ctx, collection, post.Id)
+
+		index := -1
+		for i, p := range posts {
+			if p.ID == post.Id {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			continue
+		}
+
+		nav := &contentv1.SeriesNavigation{
+			SeriesId: collection.ID,
+			Slug:     collection.Slug,
+			Title:    collection.Title,
+			Position: int32(index + 1),
+			Total:    int32(len(posts)),
+		}
+		if index > 0 {
+			nav.Previous = convertPostLink(posts[index-1])
+		}
+		if index < len(posts)-1 {
+			nav.Next = convertPostLink(posts[index+1])
+		}
+		post.Series = append(post.Series, nav)
+	}
+}
+
+// removePostFromCollections drops a deleted post from every series and curated list
+func (s *ContentService) removePostFromCollections(ctx context.Context, postID string) error {
+	if s.collectionRepo == nil {
+		return nil
+	}
+	if err := s.collectionRepo.RemovePost(ctx, postID); err != nil {
+		return status.Errorf(codes.Internal, "failed to remove post from collections: %v", err)
+	}
+	return nil
+}
+
+func convertPostLink(post *models.BlogPost) *contentv1.PostLink {
+	return &contentv1.PostLink{
+		Id:    post.ID,
+		Title: post.Title,
+		Slug:  post.Slug,
+	}
+}
+
+// convertCollectionToProto converts a collection model to protobuf, resolving its published
+// posts. Raw post IDs, which may include drafts, are only exposed to signed-in callers.
+func (s *ContentService) convertCollectionToProto(ctx context.Context, collection *models.Collection) *contentv1.Collection {
+	out := &contentv1.Collection{
+		Id:          collection.ID,
+		Slug:        collection.Slug,
+		Title:       collection.Title,
+		Description: collection.Description,
+		Kind:        s.convertModelCollectionKindToProto(collection.Kind),
+		CreatedAt:   timestamppb.New(collection.CreatedAt),
+		UpdatedAt:   timestamppb.New(collection.UpdatedAt),
+	}
+	if userID, _ := ctx.Value("user_id").(string); userID != "" {
+		out.PostIds = append([]string{}, collection.PostIDs...)
+	}
+
+	for _, post := range s.publishedCollectionPosts(ctx, collection, "") {
+		out.Posts = append(out.Posts, s.convertBlogModelToProto(post))
+	}
+	s.gatePosts(ctx, out.Posts...)
+	s.attachCommentCounts(ctx, out.Posts...)
+	s.attachFeaturedImages(ctx, out.Posts...)
+	return out
+}
+
+func (s *ContentService) convertModelCollectionKindToProto(kind string) contentv1.CollectionKind {
+	switch kind {
+	case models.CollectionKindSeries:
+		return contentv1.CollectionKind_COLLECTION_KIND_SERIES
+	case models.CollectionKindCurated:
+		return contentv1.CollectionKind_COLLECTION_KIND_CURATED
+	default:
+		return contentv1.CollectionKind_COLLECTION_KIND_UNSPECIFIED
+	}
+}
+
+func (s *ContentService) convertProtoCollectionKindToModel(kind contentv1.CollectionKind) string {
+	switch kind {
+	case contentv1.CollectionKind_COLLECTION_KIND_SERIES:
+		return models.CollectionKindSeries
+	case contentv1.CollectionKind_COLLECTION_KIND_CURATED:
+		return models.CollectionKindCurated
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memoryCollectionRepo is an in-memory CollectionRepository
+type memoryCollectionRepo struct {
+	mu          sync.Mutex
+	seq         int
+	collections map[string]*models.Collection
+}
+
+func newMemoryCollectionRepo() *memoryCollectionRepo {
+	return &memoryCollectionRepo{collections: map[string]*models.Collection{}}
+}
+
+func (r *memoryCollectionRepo) copyOf(c *models.Collection) *models.Collection {
+	out := *c
+	out.PostIDs = append([]string{}, c.PostIDs...)
+	return &out
+}
+
+func (r *memoryCollectionRepo) Create(ctx context.Context, collection *models.Collection) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	collection.ID = fmt.Sprintf("col-%d", r.seq)
+	r.collections[collection.ID] = r.copyOf(collection)
+	return nil
+}
+
+func (r *memoryCollectionRepo) GetByID(ctx context.Context, id string) (*models.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.collections[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return r.copyOf(c), nil
+}
+
+func (r *memoryCollectionRepo) GetBySlug(ctx context.Context, slug string) (*models.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.collections {
+		if c.Slug == slug {
+			return r.copyOf(c), nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryCollectionRepo) Update(ctx context.Context, collection *models.Collection) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collections[collection.ID] = r.copyOf(collection)
+	return nil
+}
+
+func (r *memoryCollectionRepo) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.collections, id)
+	return nil
+}
+
+func (r *memoryCollectionRepo) List(ctx context.Context, kind string, options repository.ListOptions) ([]*models.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.Collection
+	for _, c := range r.collections {
+		if kind == "" || c.Kind == kind {
+			out = append(out, r.copyOf(c))
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryCollectionRepo) ListByPost(ctx context.Context, postID, kind string) ([]*models.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.Collection
+	for _, c := range r.collections {
+		if c.Kind == kind && c.IndexOf(postID) >= 0 {
+			out = append(out, r.copyOf(c))
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryCollectionRepo) SetPosts(ctx context.Context, id string, postIDs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collections[id].PostIDs = append([]string{}, postIDs...)
+	return nil
+}
+
+func (r *memoryCollectionRepo) RemovePost(ctx context.Context, postID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.collections {
+		if i := c.IndexOf(postID); i >= 0 {
+			c.PostIDs = append(c.PostIDs[:i], c.PostIDs[i+1:]...)
+		}
+	}
+	return nil
+}
+
+func newCollectionTestService() *ContentService {
+	posts := map[string]*models.BlogPost{}
+	for _, slug := range []string{"part-1", "part-2", "part-3", "part-4"} {
+		post := models.NewBlogPost("Tutorial "+slug, slug, "author@example.com")
+		if slug != "part-3" {
+			post.SetPublished()
+			post.PublishedAt = timePtr(time.Now().Add(-time.Hour))
+		}
+		posts[post.ID] = post
+	}
+
+	service := NewContentService(nil, &memoryBlogRepo{posts: posts})
+	service.SetCollectionRepository(newMemoryCollectionRepo())
+	return service
+}
+
+func TestContentService_SeriesNavigation(t *testing.T) {
+	service := newCollectionTestService()
+	ctx := context.Background()
+
+	series, err := service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{
+		Title:   "Go Tutorial",
+		Kind:    contentv1.CollectionKind_COLLECTION_KIND_SERIES,
+		PostIds: []string{"blog:part-1", "blog:part-2", "blog:part-3", "blog:part-4"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "go-tutorial", series.Slug)
+	assert.Len(t, series.Posts, 3, "drafts are not listed")
+
+	post := &contentv1.BlogPost{Id: "blog:part-2"}
+	service.attachSeriesNavigation(ctx, post)
+	require.Len(t, post.Series, 1)
+	nav := post.Series[0]
+	assert.Equal(t, int32(2), nav.Position)
+	assert.Equal(t, int32(3), nav.Total)
+	assert.Equal(t, "blog:part-1", nav.Previous.Id)
+	assert.Equal(t, "blog:part-4", nav.Next.Id, "unpublished parts are skipped")
+
+	first := &contentv1.BlogPost{Id: "blog:part-1"}
+	service.attachSeriesNavigation(ctx, first)
+	require.Len(t, first.Series, 1)
+	assert.Nil(t, first.Series[0].Previous)
+
+	// A draft previewing its own navigation still sees its position
+	draft := &contentv1.BlogPost{Id: "blog:part-3"}
+	service.attachSeriesNavigation(ctx, draft)
+	require.Len(t, draft.Series, 1)
+	assert.Equal(t, int32(3), draft.Series[0].Position)
+	assert.Equal(t, int32(4), draft.Series[0].Total)
+}
+
+func TestContentService_CuratedCollection(t *testing.T) {
+	service := newCollectionTestService()
+	ctx := context.Background()
+
+	featured, err := service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{
+		Title: "Featured",
+		Kind:  contentv1.CollectionKind_COLLECTION_KIND_CURATED,
+	})
+	require.NoError(t, err)
+
+	editorCtx := context.WithValue(ctx, "user_id", "user-1")
+	updated, err := service.SetCollectionPosts(editorCtx, &contentv1.SetCollectionPostsRequest{
+		Id:      featured.Id,
+		PostIds: []string{"blog:part-4", "blog:part-3", "blog:part-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"blog:part-4", "blog:part-3", "blog:part-1"}, updated.PostIds)
+
+	public, err := service.GetCollection(ctx, &contentv1.GetCollectionRequest{Id: "featured"})
+	require.NoError(t, err)
+	assert.Empty(t, public.PostIds, "draft IDs are hidden from anonymous readers")
+	require.Len(t, public.Posts, 2)
+	assert.Equal(t, "blog:part-4", public.Posts[0].Id)
+	assert.Equal(t, "blog:part-1", public.Posts[1].Id)
+
+	post := &contentv1.BlogPost{Id: "blog:part-1"}
+	service.attachSeriesNavigation(ctx, post)
+	assert.Empty(t, post.Series, "curated collections do not produce series navigation")
+}
+
+func TestContentService_ReorderCollectionPosts(t *testing.T) {
+	service := newCollectionTestService()
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	landing, err := service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{
+		Title:   "Landing",
+		Kind:    contentv1.CollectionKind_COLLECTION_KIND_CURATED,
+		PostIds: []string{"blog:part-1", "blog:part-2", "blog:part-3", "blog:part-4"},
+	})
+	require.NoError(t, err)
+
+	reordered, err := service.ReorderCollectionPosts(ctx, &contentv1.ReorderCollectionPostsRequest{
+		Id:      landing.Id,
+		PostIds: []string{"blog:part-4", "blog:part-2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"blog:part-4", "blog:part-2", "blog:part-1", "blog:part-3"}, reordered.PostIds)
+
+	stored, err := service.GetCollection(ctx, &contentv1.GetCollectionRequest{Id: landing.Id})
+	require.NoError(t, err)
+	assert.Equal(t, reordered.PostIds, stored.PostIds)
+
+	for name, ids := range map[string][]string{
+		"unknown post":  {"blog:part-4", "blog:nope"},
+		"repeated post": {"blog:part-4", "blog:part-4"},
+		"empty list":    nil,
+	} {
+		_, err := service.ReorderCollectionPosts(ctx, &contentv1.ReorderCollectionPostsRequest{Id: landing.Id, PostIds: ids})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+}
+
+func TestContentService_CollectionValidation(t *testing.T) {
+	service := newCollectionTestService()
+	ctx := context.Background()
+
+	_, err := service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{Title: "No kind"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{
+		Title:   "Dupes",
+		Kind:    contentv1.CollectionKind_COLLECTION_KIND_SERIES,
+		PostIds: []string{"blog:part-1", "blog:part-1"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{
+		Title:   "Missing",
+		Kind:    contentv1.CollectionKind_COLLECTION_KIND_SERIES,
+		PostIds: []string{"blog:nope", "blog:part-1", "blog:gone"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "'blog:nope', 'blog:gone'", "every missing post is reported")
+
+	_, err = service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{Title: "Series", Kind: contentv1.CollectionKind_COLLECTION_KIND_SERIES})
+	require.NoError(t, err)
+	_, err = service.CreateCollection(ctx, &contentv1.CreateCollectionRequest{Title: "Series", Kind: contentv1.CollectionKind_COLLECTION_KIND_CURATED})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	disabled := NewContentService(nil, nil)
+	_, err = disabled.ListCollections(ctx, &contentv1.ListCollectionsRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,397 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commentv1 "github.com/7-solutions/saas-platformbackend/gen/comment/v1"
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/ratelimit"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/spam"
+)
+
+const (
+	// maxCommentLength mirrors the contact form message limit
+	maxCommentLength = 5000
+
+	// Default public submission rate: 5 comments per client per 10 minutes
+	defaultCommentRateLimit  = 5
+	defaultCommentRateWindow = 10 * time.Minute
+)
+
+// CommentService handles reader comments on blog posts and their moderation
+type CommentService struct {
+	commentv1.UnimplementedCommentServiceServer
+	commentRepo  repository.CommentRepository
+	blogRepo     repository.BlogRepository
+	userRepo     repository.UserRepository
+	emailService *EmailService
+	limiter      *ratelimit.Limiter
+
+	// Webmentions are optional; see SetWebmentions
+	webmentionRepo repository.WebmentionRepository
+	site           SiteInfoSource
+	httpClient     *http.Client
+	// pending tracks asynchronous verifications so tests can wait for them
+	pending sync.WaitGroup
+}
+
+// NewCommentService creates a new comment service. userRepo and emailService may be nil,
+// in which case post authors are not notified about new comments.
+func NewCommentService(
+	commentRepo repository.CommentRepository,
+	blogRepo repository.BlogRepository,
+	userRepo repository.UserRepository,
+	emailService *EmailService,
+) *CommentService {
+	return &CommentService{
+		commentRepo:  commentRepo,
+		blogRepo:     blogRepo,
+		userRepo:     userRepo,
+		emailService: emailService,
+		limiter:      ratelimit.New(defaultCommentRateLimit, defaultCommentRateWindow),
+		httpClient:   newPublicHTTPClient(webmentionRequestTimeout),
+	}
+}
+
+// SetRateLimiter replaces the limiter applied to public comment submissions
+func (s *CommentService) SetRateLimiter(limiter *ratelimit.Limiter) {
+	s.limiter = limiter
+}
+
+// SubmitComment stores a new comment. Guest comments are queued for moderation,
+// comments from signed-in users are approved immediately, and anything matching
+// the spam heuristics is filed as spam.
+func (s *CommentService) SubmitComment(ctx context.Context, req *commentv1.SubmitCommentRequest) (*commentv1.Comment, error) {
+	ipAddress, userAgent := extractClientInfo(ctx)
+	if s.limiter != nil && !s.limiter.Allow(ipAddress) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many comments, please try again later")
+	}
+
+	userID, _ := ctx.Value("user_id").(string)
+	authorName := strings.TrimSpace(req.AuthorName)
+	authorEmail := strings.TrimSpace(req.AuthorEmail)
+	if userID != "" {
+		if name, ok := ctx.Value("user_name").(string); ok && name != "" {
+			authorName = name
+		}
+		if email, ok := ctx.Value("user_email").(string); ok && email != "" {
+			authorEmail = email
+		}
+	}
+
+	if err := s.validateSubmitCommentRequest(req, authorName, authorEmail); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	post, err := s.blogRepo.GetByID(ctx, req.PostId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "blog post not found: %v", err)
+	}
+	if !post.IsPublished() {
+		return nil, status.Errorf(codes.NotFound, "blog post not found")
+	}
+	if post.CommentsDisabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "comments are disabled for this post")
+	}
+
+	if req.ParentId != "" {
+		parent, err := s.commentRepo.GetByID(ctx, req.ParentId)
+		if err != nil || parent.PostID != post.ID || parent.Status != models.CommentStatusApproved {
+			return nil, status.Errorf(codes.InvalidArgument, "parent comment not found on this post")
+		}
+	}
+
+	comment := models.NewComment(post.ID, authorName, authorEmail, strings.TrimSpace(req.Body))
+	comment.ParentID = req.ParentId
+	comment.AuthorUserID = userID
+	comment.AuthorURL = strings.TrimSpace(req.AuthorUrl)
+	comment.IPAddress = ipAddress
+	comment.UserAgent = userAgent
+
+	switch {
+	case spam.IsSpam(comment.Body, comment.AuthorName):
+		comment.Status = models.CommentStatusSpam
+	case !comment.IsGuest():
+		comment.Status = models.CommentStatusApproved
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+
+	if comment.Status != models.CommentStatusSpam {
+		go s.notifyPostAuthor(post, comment)
+	}
+
+	return s.modelToProto(comment, false), nil
+}
+
+// ListComments returns the approved comments of a post as a reply tree
+func (s *CommentService) ListComments(ctx context.Context, req *commentv1.ListCommentsRequest) (*commentv1.ListCommentsResponse, error) {
+	if req.PostId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "post ID is required")
+	}
+
+	comments, err := s.commentRepo.ListByPost(ctx, req.PostId, models.CommentStatusApproved)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list comments: %v", err)
+	}
+
+	return &commentv1.ListCommentsResponse{
+		Comments:   s.buildCommentTree(comments),
+		TotalCount: int32(len(comments)),
+	}, nil
+}
+
+// ListModerationQueue lists comments by moderation state, pending by default
+func (s *CommentService) ListModerationQueue(ctx context.Context, req *commentv1.ListModerationQueueRequest) (*commentv1.ListModerationQueueResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 50
+	}
+
+	skip := 0
+	if req.PageToken != "" {
+		var err error
+		skip, err = strconv.Atoi(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	commentStatus := models.CommentStatusPending
+	if req.Status != commentv1.CommentStatus_COMMENT_STATUS_UNSPECIFIED {
+		commentStatus = s.protoStatusToModel(req.Status)
+	}
+
+	comments, pagination, err := s.commentRepo.ListByStatus(ctx, commentStatus, req.PostId, repository.ListOptions{
+		Limit: pageSize,
+		Skip:  skip,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list comments: %v", err)
+	}
+
+	protoComments := make([]*commentv1.Comment, len(comments))
+	for i, comment := range comments {
+		protoComments[i] = s.modelToProto(comment, true)
+	}
+
+	nextPageToken := ""
+	totalCount := int32(len(comments))
+	if pagination != nil {
+		nextPageToken = pagination.NextPageToken
+		totalCount = int32(pagination.TotalCount)
+	}
+
+	return &commentv1.ListModerationQueueResponse{
+		Comments:      protoComments,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// ModerateComment moves a comment to a new moderation state
+func (s *CommentService) ModerateComment(ctx context.Context, req *commentv1.ModerateCommentRequest) (*commentv1.Comment, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "comment ID is required")
+	}
+	if req.Status == commentv1.CommentStatus_COMMENT_STATUS_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "status is required")
+	}
+
+	moderatedBy, _ := ctx.Value("user_id").(string)
+	comment, err := s.commentRepo.UpdateStatus(ctx, req.Id, s.protoStatusToModel(req.Status), moderatedBy)
+	if err != nil {
+		if errors.Is(err, appErr.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "comment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to moderate comment: %v", err)
+	}
+
+	return s.modelToProto(comment, true), nil
+}
+
+// validateSubmitCommentRequest validates a public comment submission
+func (s *CommentService) validateSubmitCommentRequest(req *commentv1.SubmitCommentRequest, authorName, authorEmail string) error {
+	if req.PostId == "" {
+		return fmt.Errorf("post ID is required")
+	}
+
+	if authorName == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(authorName) > 100 {
+		return fmt.Errorf("name too long (max 100 characters)")
+	}
+
+	if authorEmail == "" {
+		return fmt.Errorf("email is required")
+	}
+	if _, err := mail.ParseAddress(authorEmail); err != nil {
+		return fmt.Errorf("invalid email format")
+	}
+
+	if req.AuthorUrl != "" {
+		u, err := url.Parse(strings.TrimSpace(req.AuthorUrl))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid website URL")
+		}
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return fmt.Errorf("comment is required")
+	}
+	if len(body) > maxCommentLength {
+		return fmt.Errorf("comment too long (max %d characters)", maxCommentLength)
+	}
+
+	return nil
+}
+
+// notifyPostAuthor emails the post author about a new comment
+func (s *CommentService) notifyPostAuthor(post *models.BlogPost, comment *models.Comment) {
+	if s.emailService == nil {
+		return
+	}
+
+	recipient := post.Author
+	if !strings.Contains(recipient, "@") {
+		if s.userRepo == nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		user, err := s.userRepo.GetByID(ctx, post.Author)
+		if err != nil {
+			logger.Error("Failed to resolve post author for comment notification", err, "post_id", post.ID)
+			return
+		}
+		recipient = user.Email
+	}
+
+	if recipient == "" || recipient == comment.AuthorEmail {
+		return
+	}
+
+	if err := s.emailService.SendCommentNotification(context.Background(), recipient, post, comment); err != nil {
+		logger.Error("Failed to send comment notification email", err, "post_id", post.ID, "comment_id", comment.ID)
+	}
+}
+
+// buildCommentTree nests replies under their parents. Replies whose parent is not
+// part of the list (e.g. a removed comment) are promoted to the top level.
+func (s *CommentService) buildCommentTree(comments []*models.Comment) []*commentv1.Comment {
+	nodes := make(map[string]*commentv1.Comment, len(comments))
+	for _, comment := range comments {
+		nodes[comment.ID] = s.modelToProto(comment, false)
+	}
+
+	roots := []*commentv1.Comment{}
+	for _, comment := range comments {
+		node := nodes[comment.ID]
+		if parent, ok := nodes[comment.ParentID]; ok && comment.ParentID != comment.ID {
+			parent.Replies = append(parent.Replies, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
+
+// modelToProto converts a comment model to protobuf; private fields are only
+// included for moderators
+func (s *CommentService) modelToProto(comment *models.Comment, includePrivate bool) *commentv1.Comment {
+	out := &commentv1.Comment{
+		Id:            comment.ID,
+		PostId:        comment.PostID,
+		ParentId:      comment.ParentID,
+		AuthorName:    comment.AuthorName,
+		AuthorUrl:     comment.AuthorURL,
+		Authenticated: !comment.IsGuest(),
+		Body:          comment.Body,
+		Status:        s.modelStatusToProto(comment.Status),
+		CreatedAt:     timestamppb.New(comment.CreatedAt),
+		UpdatedAt:     timestamppb.New(comment.UpdatedAt),
+	}
+	if includePrivate {
+		out.AuthorEmail = comment.AuthorEmail
+		out.IpAddress = comment.IPAddress
+		out.UserAgent = comment.UserAgent
+	}
+	return out
+}
+
+// modelStatusToProto converts model status to protobuf status
+func (s *CommentService) modelStatusToProto(status string) commentv1.CommentStatus {
+	switch status {
+	case models.CommentStatusPending:
+		return commentv1.CommentStatus_COMMENT_STATUS_PENDING
+	case models.CommentStatusApproved:
+		return commentv1.CommentStatus_COMMENT_STATUS_APPROVED
+	case models.CommentStatusSpam:
+		return commentv1.CommentStatus_COMMENT_STATUS_SPAM
+	case models.CommentStatusDeleted:
+		return commentv1.CommentStatus_COMMENT_STATUS_DELETED
+	default:
+		return commentv1.CommentStatus_COMMENT_STATUS_UNSPECIFIED
+	}
+}
+
+// protoStatusToModel converts protobuf status to model status
+func (s *CommentService) protoStatusToModel(status commentv1.CommentStatus) string {
+	switch status {
+	case commentv1.CommentStatus_COMMENT_STATUS_APPROVED:
+		return models.CommentStatusApproved
+	case commentv1.CommentStatus_COMMENT_STATUS_SPAM:
+		return models.CommentStatusSpam
+	case commentv1.CommentStatus_COMMENT_STATUS_DELETED:
+		return models.CommentStatusDeleted
+	default:
+		return models.CommentStatusPending
+	}
+}
+
+// SetCommentRepository enables approved comment counts on blog post responses
+func (s *ContentService) SetCommentRepository(repo repository.CommentRepository) {
+	s.commentRepo = repo
+}
+
+// attachCommentCounts fills in the approved comment count of each post in a single query
+func (s *ContentService) attachCommentCounts(ctx context.Context, posts ...*contentv1.BlogPost) {
+	if s.commentRepo == nil || len(posts) == 0 {
+		return
+	}
+
+	ids := make([]string, len(posts))
+	for i, post := range posts {
+		ids[i] = post.Id
+	}
+
+	counts, err := s.commentRepo.CountApprovedByPosts(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to count blog post comments", err)
+		return
+	}
+	for _, post := range posts {
+		post.CommentCount = int32(counts[post.Id])
+	}
+}
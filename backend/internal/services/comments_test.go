@@ -0,0 +1,396 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commentv1 "github.com/7-solutions/saas-platformbackend/gen/comment/v1"
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/ratelimit"
+)
+
+// memoryCommentRepo is an in-memory CommentRepository
+type memoryCommentRepo struct {
+	mu       sync.Mutex
+	seq      int
+	comments []*models.Comment
+}
+
+func (r *memoryCommentRepo) Create(ctx context.Context, comment *models.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	comment.ID = fmt.Sprintf("comment-%d", r.seq)
+	stored := *comment
+	r.comments = append(r.comments, &stored)
+	return nil
+}
+
+func (r *memoryCommentRepo) GetByID(ctx context.Context, id string) (*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.comments {
+		if c.ID == id {
+			out := *c
+			return &out, nil
+		}
+	}
+	return nil, appErr.ErrNotFound
+}
+
+func (r *memoryCommentRepo) ListByPost(ctx context.Context, postID, status string) ([]*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.Comment
+	for _, c := range r.comments {
+		if c.PostID == postID && c.Status == status {
+			copied := *c
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryCommentRepo) ListByStatus(ctx context.Context, status, postID string, options repository.ListOptions) ([]*models.Comment, *repository.PaginationInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.Comment
+	for _, c := range r.comments {
+		if c.Status == status && (postID == "" || c.PostID == postID) {
+			copied := *c
+			out = append(out, &copied)
+		}
+	}
+	return out, &repository.PaginationInfo{TotalCount: len(out)}, nil
+}
+
+func (r *memoryCommentRepo) UpdateStatus(ctx context.Context, id, status, moderatedBy string) (*models.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.comments {
+		if c.ID == id {
+			now := time.Now()
+			c.Status = status
+			c.ModeratedBy = moderatedBy
+			c.ModeratedAt = &now
+			out := *c
+			return &out, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to update comment status: %w", appErr.ErrNotFound)
+}
+
+func (r *memoryCommentRepo) CountApprovedByPosts(ctx context.Context, postIDs []string) (map[string]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := map[string]int{}
+	for _, c := range r.comments {
+		if c.Status == models.CommentStatusApproved {
+			counts[c.PostID]++
+		}
+	}
+	return counts, nil
+}
+
+// memoryBlogRepo serves and updates blog posts by ID, slug and status; methods the tests do not use are left to the embedded interface
+type memoryBlogRepo struct {
+	repository.BlogRepository
+	posts map[string]*models.BlogPost
+}
+
+func (r *memoryBlogRepo) GetByID(ctx context.Context, id string) (*models.BlogPost, error) {
+	post, ok := r.posts[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return post, nil
+}
+
+func (r *memoryBlogRepo) GetByIDs(ctx context.Context, ids []string) ([]*models.BlogPost, error) {
+	var out []*models.BlogPost
+	for _, id := range ids {
+		if post, ok := r.posts[id]; ok {
+			out = append(out, post)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryBlogRepo) GetBySlug(ctx context.Context, slug string) (*models.BlogPost, error) {
+	for _, post := range r.posts {
+		if post.Slug == slug {
+			return post, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryBlogRepo) ListByStatus(ctx context.Context, status string, options repository.ListOptions) ([]*models.BlogPost, error) {
+	var out []*models.BlogPost
+	for _, post := range r.posts {
+		if post.Status == status {
+			out = append(out, post)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	if options.Skip >= len(out) {
+		return nil, nil
+	}
+	out = out[options.Skip:]
+	if options.Limit > 0 && len(out) > options.Limit {
+		out = out[:options.Limit]
+	}
+	return out, nil
+}
+
+func (r *memoryBlogRepo) ReleaseExpiredPins(ctx context.Context, now time.Time) (int, error) {
+	released := 0
+	for _, post := range r.posts {
+		if post.Pinned && !post.IsPinnedAt(now) {
+			post.Pinned, post.PinnedUntil = false, nil
+			released++
+		}
+	}
+	return released, nil
+}
+
+// ListPosts filters like the repositories do and orders pinned posts first, then by the sort field
+func (r *memoryBlogRepo) ListPosts(ctx context.Context, query repository.BlogPostQuery, options repository.ListOptions) ([]*models.BlogPost, *repository.PaginationInfo, error) {
+	now := time.Now()
+	contains := func(values []string, want string) bool {
+		for _, v := range values {
+			if v == want {
+				return true
+			}
+		}
+		return false
+	}
+	var out []*models.BlogPost
+	for _, post := range r.posts {
+		ok := true
+		if query.HasPublishedRange() {
+			ok = post.Status == models.PageStatusPublished && post.PublishedAt != nil && !post.PublishedAt.After(now) &&
+				!post.PublishedAt.Before(query.PublishedAfter) && (query.PublishedBefore.IsZero() || post.PublishedAt.Before(query.PublishedBefore))
+		}
+		switch {
+		case query.Status != "" && !query.HasPublishedRange():
+			ok = post.Status == query.Status
+		case query.Category != "":
+			ok = ok && contains(post.Categories, query.Category)
+		case query.Tag != "":
+			ok = ok && contains(post.Tags, query.Tag)
+		case query.Author != "":
+			ok = ok && post.Author == query.Author
+		}
+		if ok {
+			out = append(out, post)
+		}
+	}
+
+	newest := func(a, b *models.BlogPost) bool { return a.GetPublishedDate().After(b.GetPublishedDate()) }
+	sort.SliceStable(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if pa, pb := a.IsPinnedAt(now), b.IsPinnedAt(now); pa != pb {
+			return pa
+		}
+		switch query.Sort {
+		case models.BlogSortUpdatedAt:
+			return a.UpdatedAt.After(b.UpdatedAt)
+		case models.BlogSortTitle:
+			return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+		case models.BlogSortManual:
+			if a.SortWeight != b.SortWeight {
+				return a.SortWeight < b.SortWeight
+			}
+		}
+		return newest(a, b)
+	})
+
+	total := len(out)
+	if options.Skip >= len(out) {
+		out = nil
+	} else {
+		out = out[options.Skip:]
+	}
+	if options.Limit > 0 && len(out) > options.Limit {
+		out = out[:options.Limit]
+	}
+	return out, &repository.PaginationInfo{TotalCount: total, HasMore: options.Skip+len(out) < total}, nil
+}
+
+func (r *memoryBlogRepo) Create(ctx context.Context, post *models.BlogPost) error {
+	r.posts[post.ID] = post
+	return nil
+}
+
+func (r *memoryBlogRepo) Update(ctx context.Context, post *models.BlogPost) error {
+	if _, ok := r.posts[post.ID]; !ok {
+		return repository.ErrNotFound
+	}
+	r.posts[post.ID] = post
+	return nil
+}
+
+func newCommentTestService() *CommentService {
+	published := models.NewBlogPost("Hello", "hello", "author@example.com")
+	published.SetPublished()
+	published.PublishedAt = timePtr(time.Now().Add(-time.Hour))
+
+	closed := models.NewBlogPost("Closed", "closed", "author@example.com")
+	closed.SetPublished()
+	closed.PublishedAt = timePtr(time.Now().Add(-time.Hour))
+	closed.CommentsDisabled = true
+
+	draft := models.NewBlogPost("Draft", "draft", "author@example.com")
+
+	commentRepo := &memoryCommentRepo{}
+	blogRepo := &memoryBlogRepo{posts: map[string]*models.BlogPost{
+		published.ID: published,
+		closed.ID:    closed,
+		draft.ID:     draft,
+	}}
+	return NewCommentService(commentRepo, blogRepo, nil, nil)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func guestContext(ip string) context.Context {
+	return gatewayContext("x-forwarded-for", ip)
+}
+
+func TestCommentService_SubmitModerationStates(t *testing.T) {
+	service := newCommentTestService()
+
+	guest, err := service.SubmitComment(guestContext("10.0.0.1"), &commentv1.SubmitCommentRequest{
+		PostId:      "blog:hello",
+		AuthorName:  "Guest",
+		AuthorEmail: "guest@example.com",
+		Body:        "Great post!",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, commentv1.CommentStatus_COMMENT_STATUS_PENDING, guest.Status)
+	assert.Empty(t, guest.AuthorEmail)
+
+	spammy, err := service.SubmitComment(guestContext("10.0.0.2"), &commentv1.SubmitCommentRequest{
+		PostId:      "blog:hello",
+		AuthorName:  "Bot",
+		AuthorEmail: "bot@example.com",
+		Body:        "Visit https://casino.example.com for free money",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, commentv1.CommentStatus_COMMENT_STATUS_SPAM, spammy.Status)
+
+	userCtx := context.WithValue(guestContext("10.0.0.3"), "user_id", "user-1")
+	userCtx = context.WithValue(userCtx, "user_name", "Editor")
+	userCtx = context.WithValue(userCtx, "user_email", "editor@example.com")
+	member, err := service.SubmitComment(userCtx, &commentv1.SubmitCommentRequest{PostId: "blog:hello", Body: "Thanks for reading"})
+	require.NoError(t, err)
+	assert.Equal(t, commentv1.CommentStatus_COMMENT_STATUS_APPROVED, member.Status)
+	assert.True(t, member.Authenticated)
+	assert.Equal(t, "Editor", member.AuthorName)
+
+	queue, err := service.ListModerationQueue(context.Background(), &commentv1.ListModerationQueueRequest{})
+	require.NoError(t, err)
+	require.Len(t, queue.Comments, 1)
+	assert.Equal(t, guest.Id, queue.Comments[0].Id)
+	assert.Equal(t, "guest@example.com", queue.Comments[0].AuthorEmail)
+}
+
+func TestCommentService_SubmitRejections(t *testing.T) {
+	service := newCommentTestService()
+	valid := func(postID string) *commentv1.SubmitCommentRequest {
+		return &commentv1.SubmitCommentRequest{PostId: postID, AuthorName: "Guest", AuthorEmail: "guest@example.com", Body: "Hi"}
+	}
+
+	_, err := service.SubmitComment(guestContext("10.0.0.1"), valid("blog:closed"))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = service.SubmitComment(guestContext("10.0.0.1"), valid("blog:draft"))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	invalid := valid("blog:hello")
+	invalid.AuthorEmail = "not-an-email"
+	_, err = service.SubmitComment(guestContext("10.0.0.1"), invalid)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	reply := valid("blog:hello")
+	reply.ParentId = "comment-404"
+	_, err = service.SubmitComment(guestContext("10.0.0.1"), reply)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCommentService_RateLimit(t *testing.T) {
+	service := newCommentTestService()
+	service.SetRateLimiter(ratelimit.New(2, time.Minute))
+	req := &commentv1.SubmitCommentRequest{PostId: "blog:hello", AuthorName: "Guest", AuthorEmail: "guest@example.com", Body: "Hi"}
+
+	for i := 0; i < 2; i++ {
+		_, err := service.SubmitComment(guestContext("10.0.0.1"), req)
+		require.NoError(t, err)
+	}
+	_, err := service.SubmitComment(guestContext("10.0.0.1"), req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = service.SubmitComment(guestContext("10.0.0.2"), req)
+	assert.NoError(t, err)
+}
+
+func TestCommentService_ListCommentsThreads(t *testing.T) {
+	service := newCommentTestService()
+	ctx := context.Background()
+	submit := func(parentID, body string) *commentv1.Comment {
+		c, err := service.SubmitComment(guestContext("10.0.0.1"), &commentv1.SubmitCommentRequest{
+			PostId: "blog:hello", ParentId: parentID, AuthorName: "Guest", AuthorEmail: "guest@example.com", Body: body,
+		})
+		require.NoError(t, err)
+		_, err = service.ModerateComment(ctx, &commentv1.ModerateCommentRequest{Id: c.Id, Status: commentv1.CommentStatus_COMMENT_STATUS_APPROVED})
+		require.NoError(t, err)
+		return c
+	}
+
+	root := submit("", "First")
+	submit(root.Id, "Reply")
+	submit("", "Second")
+
+	resp, err := service.ListComments(ctx, &commentv1.ListCommentsRequest{PostId: "blog:hello"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	require.Len(t, resp.Comments, 2)
+	require.Len(t, resp.Comments[0].Replies, 1)
+	assert.Equal(t, "Reply", resp.Comments[0].Replies[0].Body)
+
+	_, err = service.ModerateComment(ctx, &commentv1.ModerateCommentRequest{Id: "comment-404", Status: commentv1.CommentStatus_COMMENT_STATUS_DELETED})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestContentService_AttachCommentCounts(t *testing.T) {
+	repo := &memoryCommentRepo{}
+	approved := models.NewComment("blog:hello", "Guest", "guest@example.com", "Hi")
+	approved.Status = models.CommentStatusApproved
+	require.NoError(t, repo.Create(context.Background(), approved))
+	require.NoError(t, repo.Create(context.Background(), models.NewComment("blog:hello", "Guest", "guest@example.com", "Pending")))
+
+	service := NewContentService(nil, nil)
+	service.SetCommentRepository(repo)
+
+	posts := []*contentv1.BlogPost{{Id: "blog:hello"}, {Id: "blog:other"}}
+	service.attachCommentCounts(context.Background(), posts...)
+	assert.Equal(t, int32(1), posts[0].CommentCount)
+	assert.Equal(t, int32(0), posts[1].CommentCount)
+}
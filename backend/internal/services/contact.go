@@ -11,6 +11,7 @@ import (
 	"github.com/7-solutions/saas-platformbackend/internal/models"
 	ports "github.com/7-solutions/saas-platformbackend/internal/ports"
 	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/spam"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -21,6 +22,7 @@ type ContactService struct {
 	contactv1.UnimplementedContactServiceServer
 	contactRepo  repository.ContactRepository
 	emailService *EmailService
+	events       EventPublisher
 
 	// Optional future-use dependencies via ports (can be nil; not used yet)
 	contactRepoPort ports.ContactRepository
@@ -63,7 +65,7 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *contactv1.S
 	}
 
 	// Extract client information from context
-	ipAddress, userAgent := s.extractClientInfo(ctx)
+	ipAddress, userAgent := extractClientInfo(ctx)
 
 	// Create contact submission
 	submission := models.NewContactSubmission(
@@ -82,20 +84,24 @@ func (s *ContactService) SubmitContactForm(ctx context.Context, req *contactv1.S
 	}
 
 	// Send email notifications asynchronously
+	emailCtx := context.WithoutCancel(ctx)
 	go func() {
 		// Send notification to admin
-		if err := s.emailService.SendContactNotification(createdSubmission); err != nil {
+		if err := s.emailService.SendContactNotification(emailCtx, createdSubmission); err != nil {
 			fmt.Printf("Failed to send admin notification email: %v\n", err)
 		}
 
 		// Send confirmation to submitter
-		if err := s.emailService.SendContactConfirmation(createdSubmission); err != nil {
+		if err := s.emailService.SendContactConfirmation(emailCtx, createdSubmission); err != nil {
 			fmt.Printf("Failed to send confirmation email: %v\n", err)
 		}
 	}()
 
+	resp := s.modelToProto(createdSubmission)
+	publishEvent(ctx, s.events, models.WebhookEventContactSubmitted, resp)
+
 	// Convert to protobuf response
-	return s.modelToProto(createdSubmission), nil
+	return resp, nil
 }
 
 // ListContactSubmissions lists contact submissions (admin only)
@@ -253,64 +259,9 @@ func (s *ContactService) validateContactFormRequest(req *contactv1.SubmitContact
 	return nil
 }
 
-// isSpamSubmission performs basic spam detection
+// isSpamSubmission performs basic spam detection using the shared heuristics
 func (s *ContactService) isSpamSubmission(req *contactv1.SubmitContactFormRequest) bool {
-	// Check for suspicious patterns
-	suspiciousPatterns := []string{
-		"http://",
-		"https://",
-		"www.",
-		"<a href",
-		"<script",
-		"viagra",
-		"casino",
-		"lottery",
-		"winner",
-		"congratulations",
-	}
-
-	message := strings.ToLower(req.Message)
-	name := strings.ToLower(req.Name)
-
-	for _, pattern := range suspiciousPatterns {
-		if strings.Contains(message, pattern) || strings.Contains(name, pattern) {
-			return true
-		}
-	}
-
-	// Check for excessive repetition
-	words := strings.Fields(message)
-	if len(words) > 10 {
-		wordCount := make(map[string]int)
-		for _, word := range words {
-			wordCount[word]++
-			if wordCount[word] > 5 {
-				return true
-			}
-		}
-	}
-
-	return false
-}
-
-// extractClientInfo extracts IP address and user agent from context
-func (s *ContactService) extractClientInfo(ctx context.Context) (string, string) {
-	// Try to get from HTTP headers if available
-	if md, ok := ctx.Value("metadata").(map[string]string); ok {
-		ipAddress := md["x-forwarded-for"]
-		if ipAddress == "" {
-			ipAddress = md["x-real-ip"]
-		}
-		if ipAddress == "" {
-			ipAddress = md["remote-addr"]
-		}
-
-		userAgent := md["user-agent"]
-
-		return ipAddress, userAgent
-	}
-
-	return "", ""
+	return spam.IsSpam(req.Message, req.Name)
 }
 
 // modelToProto converts a contact submission model to protobuf
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,6 +18,8 @@ import (
 	"github.com/7-solutions/saas-platformbackend/internal/models"
 	ports "github.com/7-solutions/saas-platformbackend/internal/ports"
 	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/cache"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
 )
 
 // ContentService implements the content service
@@ -32,6 +35,23 @@ type ContentService struct {
 	usersRepo   ports.UsersRepository
 	contactRepo ports.ContactRepository
 	uow         ports.UnitOfWork
+
+	// Optional features enabled via setters (nil disables them)
+	blockRepo      repository.ReusableBlockRepository
+	templateRepo   repository.PageTemplateRepository
+	commentRepo    repository.CommentRepository
+	collectionRepo repository.CollectionRepository
+	linkScanner    *LinkScanner
+	mediaRepo      repository.MediaRepository
+	userRepo       repository.UserRepository
+	site           SiteInfoSource
+	revalidator    revalidate.Revalidator
+	events         EventPublisher
+	feed           *ContentFeed
+	relatedCache   cache.Cache
+	relatedWeights *RelatedPostWeights
+	publishGate    *PublishGateConfig
+	oembed         *OEmbedResolver
 }
 
 // NewContentServiceWithPorts constructs ContentService with ports-based dependencies.
@@ -91,14 +111,54 @@ func (s *ContentService) CreatePage(ctx context.Context, req *contentv1.CreatePa
 		Meta:    s.convertProtoMetaToModel(req.Meta),
 		Status:  s.convertProtoStatusToModel(req.Status),
 	}
+	if req.Visibility != nil {
+		page.Visibility = s.convertProtoVisibilityToModel(req.Visibility)
+	}
+
+	var warnings []*contentv1.PublishViolation
+	if page.Status == models.PageStatusPublished {
+		var err error
+		warnings, page.PublishOverride, err = s.enforcePublishGate(ctx, publishItemFromPage(page), req.PublishOverrideReason)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Save to repository
-	if err := s.pageRepo.Create(ctx, page); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create page: %v", err)
+	if err := s.insertPage(ctx, page); err != nil {
+		return nil, err
 	}
 
 	// Convert back to proto and return
-	return s.convertModelToProto(page), nil
+	protoPage := s.convertModelToProto(page)
+	protoPage.PublishWarnings = warnings
+	protoPage.PublishOverride = convertPublishOverrideToProto(ctx, page.PublishOverride)
+	return protoPage, nil
+}
+
+// insertPage validates references and stores a new page
+func (s *ContentService) insertPage(ctx context.Context, page *models.Page) error {
+	if err := s.validateReusableBlockRefs(ctx, page.Content); err != nil {
+		return err
+	}
+	if err := s.validateEmbedBlocks(page.Content); err != nil {
+		return err
+	}
+	if err := s.normalizeMeta(ctx, &page.Meta); err != nil {
+		return err
+	}
+
+	if err := s.pageRepo.Create(ctx, page); err != nil {
+		return status.Errorf(codes.Internal, "failed to create page: %v", err)
+	}
+
+	if err := s.syncReusableBlockUsages(ctx, models.ContentTypePage, page.ID, page.Slug, page.Title, page.Content); err != nil {
+		return err
+	}
+
+	s.publishPageEvents(ctx, models.WebhookEventPageCreated, page, false)
+	revalidateTargets(s.revalidator, pageChangeTargets(nil, page))
+	return nil
 }
 
 // GetPage retrieves a page by ID
@@ -113,7 +173,16 @@ func (s *ContentService) GetPage(ctx context.Context, req *contentv1.GetPageRequ
 		return nil, status.Errorf(codes.NotFound, "page not found: %v", err)
 	}
 
-	return s.convertModelToProto(page), nil
+	protoPage := s.convertModelToProto(page)
+	protoPage.PublishOverride = convertPublishOverrideToProto(ctx, page.PublishOverride)
+	s.gatePages(ctx, protoPage)
+	if err := s.resolveReusableBlocks(ctx, protoPage.Content); err != nil {
+		return nil, err
+	}
+	s.resolveEmbeds(ctx, protoPage.Content)
+	s.attachPageStructuredData(ctx, page, protoPage)
+
+	return protoPage, nil
 }
 
 // UpdatePage updates an existing page
@@ -147,20 +216,54 @@ func (s *ContentService) UpdatePage(ctx context.Context, req *contentv1.UpdatePa
 	// Sanitize content
 	sanitizedContent := s.sanitizeContent(req.Content)
 
+	wasPublished := existingPage.Status == models.PageStatusPublished
+	before := *existingPage
+
 	// Update page model
 	existingPage.Title = strings.TrimSpace(req.Title)
 	existingPage.Slug = slug
 	existingPage.Content = s.convertProtoContentToModel(sanitizedContent)
 	existingPage.Meta = s.convertProtoMetaToModel(req.Meta)
 	existingPage.Status = s.convertProtoStatusToModel(req.Status)
+	if req.Visibility != nil {
+		existingPage.Visibility = s.convertProtoVisibilityToModel(req.Visibility)
+	}
+
+	if err := s.validateReusableBlockRefs(ctx, existingPage.Content); err != nil {
+		return nil, err
+	}
+	if err := s.validateEmbedBlocks(existingPage.Content); err != nil {
+		return nil, err
+	}
+	if err := s.normalizeMeta(ctx, &existingPage.Meta); err != nil {
+		return nil, err
+	}
+
+	var warnings []*contentv1.PublishViolation
+	if !wasPublished && existingPage.Status == models.PageStatusPublished {
+		warnings, existingPage.PublishOverride, err = s.enforcePublishGate(ctx, publishItemFromPage(existingPage), req.PublishOverrideReason)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Save to repository
 	if err := s.pageRepo.Update(ctx, existingPage); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update page: %v", err)
 	}
 
+	if err := s.syncReusableBlockUsages(ctx, models.ContentTypePage, existingPage.ID, existingPage.Slug, existingPage.Title, existingPage.Content); err != nil {
+		return nil, err
+	}
+
+	s.publishPageEvents(ctx, models.WebhookEventPageUpdated, existingPage, wasPublished)
+	revalidateTargets(s.revalidator, pageChangeTargets(&before, existingPage))
+
 	// Convert back to proto and return
-	return s.convertModelToProto(existingPage), nil
+	protoPage := s.convertModelToProto(existingPage)
+	protoPage.PublishWarnings = warnings
+	protoPage.PublishOverride = convertPublishOverrideToProto(ctx, existingPage.PublishOverride)
+	return protoPage, nil
 }
 
 // DeletePage deletes a page
@@ -170,7 +273,7 @@ func (s *ContentService) DeletePage(ctx context.Context, req *contentv1.DeletePa
 	}
 
 	// Check if page exists
-	_, err := s.pageRepo.GetByID(ctx, req.Id)
+	page, err := s.pageRepo.GetByID(ctx, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "page not found: %v", err)
 	}
@@ -180,6 +283,13 @@ func (s *ContentService) DeletePage(ctx context.Context, req *contentv1.DeletePa
 		return nil, status.Errorf(codes.Internal, "failed to delete page: %v", err)
 	}
 
+	if err := s.syncReusableBlockUsages(ctx, models.ContentTypePage, req.Id, "", "", models.Content{}); err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.events, models.WebhookEventPageDeleted, deletedContentEvent{ID: page.ID, Slug: page.Slug})
+	revalidateTargets(s.revalidator, pageChangeTargets(page, nil))
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -225,7 +335,7 @@ func (s *ContentService) ListPages(ctx context.Context, req *contentv1.ListPages
 
 	// Filter by search term if provided
 	if req.Search != "" {
-		pages = s.filterPagesBySearch(pages, req.Search)
+		pages = s.filterPagesBySearch(ctx, pages, req.Search)
 	}
 
 	// Convert to proto
@@ -233,6 +343,7 @@ func (s *ContentService) ListPages(ctx context.Context, req *contentv1.ListPages
 	for i, page := range pages {
 		protoPages[i] = s.convertModelToProto(page)
 	}
+	s.gatePages(ctx, protoPages...)
 
 	// Calculate next page token
 	nextPageToken := ""
@@ -259,7 +370,7 @@ func (s *ContentService) validateCreatePageRequest(req *contentv1.CreatePageRequ
 	if req.Slug != "" && len(req.Slug) > 100 {
 		return status.Errorf(codes.InvalidArgument, "slug must be less than 100 characters")
 	}
-	return nil
+	return s.validateVisibility(req.Visibility)
 }
 
 func (s *ContentService) validateUpdatePageRequest(req *contentv1.UpdatePageRequest) error {
@@ -275,7 +386,7 @@ func (s *ContentService) validateUpdatePageRequest(req *contentv1.UpdatePageRequ
 	if req.Slug != "" && len(req.Slug) > 100 {
 		return status.Errorf(codes.InvalidArgument, "slug must be less than 100 characters")
 	}
-	return nil
+	return s.validateVisibility(req.Visibility)
 }
 
 func (s *ContentService) validateSlugUniqueness(ctx context.Context, slug, excludeID string) error {
@@ -370,16 +481,24 @@ func (s *ContentService) sanitizeContentBlock(block *contentv1.ContentBlock) *co
 
 // Search filtering
 
-func (s *ContentService) filterPagesBySearch(pages []*models.Page, search string) []*models.Page {
+func (s *ContentService) filterPagesBySearch(ctx context.Context, pages []*models.Page, search string) []*models.Page {
 	if search == "" {
 		return pages
 	}
 
 	searchLower := strings.ToLower(search)
 	var filtered []*models.Page
+	reader := newContentReader(ctx, s.userRepo)
 
 	for _, page := range pages {
-		if s.pageMatchesSearch(page, searchLower) {
+		// Gated pages only match on what the reader can see
+		searchable := page
+		if !reader.canRead(page.Visibility) {
+			teaser := *page
+			teaser.Content = page.Visibility.Teaser(page.Content)
+			searchable = &teaser
+		}
+		if s.pageMatchesSearch(searchable, searchLower) {
 			filtered = append(filtered, page)
 		}
 	}
@@ -424,14 +543,15 @@ func (s *ContentService) pageMatchesSearch(page *models.Page, search string) boo
 
 func (s *ContentService) convertModelToProto(page *models.Page) *contentv1.Page {
 	return &contentv1.Page{
-		Id:        page.ID,
-		Title:     page.Title,
-		Slug:      page.Slug,
-		Content:   s.convertModelContentToProto(page.Content),
-		Meta:      s.convertModelMetaToProto(page.Meta),
-		Status:    s.convertModelStatusToProto(page.Status),
-		CreatedAt: timestamppb.New(page.CreatedAt),
-		UpdatedAt: timestamppb.New(page.UpdatedAt),
+		Id:         page.ID,
+		Title:      page.Title,
+		Slug:       page.Slug,
+		Content:    s.convertModelContentToProto(page.Content),
+		Meta:       s.convertModelMetaToProto(page.Meta),
+		Status:     s.convertModelStatusToProto(page.Status),
+		Visibility: s.convertModelVisibilityToProto(page.Visibility),
+		CreatedAt:  timestamppb.New(page.CreatedAt),
+		UpdatedAt:  timestamppb.New(page.UpdatedAt),
 	}
 }
 
@@ -464,9 +584,15 @@ func (s *ContentService) convertModelMetaToProto(meta models.Meta) *contentv1.Pa
 	}
 
 	return &contentv1.PageMeta{
-		Title:       meta.Title,
-		Description: meta.Description,
-		Keywords:    keywords,
+		Title:         meta.Title,
+		Description:   meta.Description,
+		Keywords:      keywords,
+		OgTitle:       meta.OGTitle,
+		OgDescription: meta.OGDescription,
+		OgImage:       meta.OGImage,
+		CanonicalUrl:  meta.CanonicalURL,
+		Robots:        meta.Robots,
+		TwitterCard:   s.convertModelTwitterCardToProto(meta.TwitterCard),
 	}
 }
 
@@ -514,9 +640,15 @@ func (s *ContentService) convertProtoMetaToModel(meta *contentv1.PageMeta) model
 	}
 
 	return models.Meta{
-		Title:       meta.Title,
-		Description: meta.Description,
-		Keywords:    keywords,
+		Title:         meta.Title,
+		Description:   meta.Description,
+		Keywords:      keywords,
+		OGTitle:       strings.TrimSpace(meta.OgTitle),
+		OGDescription: strings.TrimSpace(meta.OgDescription),
+		OGImage:       strings.TrimSpace(meta.OgImage),
+		CanonicalURL:  strings.TrimSpace(meta.CanonicalUrl),
+		Robots:        meta.Robots,
+		TwitterCard:   s.convertProtoTwitterCardToModel(meta.TwitterCard),
 	}
 }
 
@@ -534,9 +666,7 @@ func (s *ContentService) convertProtoStatusToModel(status contentv1.PageStatus)
 }
 
 // Blog post service methods
-// Note: These methods are placeholders until protobuf types are generated
 
-/*
 // CreateBlogPost creates a new blog post
 func (s *ContentService) CreateBlogPost(ctx context.Context, req *contentv1.CreateBlogPostRequest) (*contentv1.BlogPost, error) {
 	// Validate input
@@ -562,21 +692,32 @@ func (s *ContentService) CreateBlogPost(ctx context.Context, req *contentv1.Crea
 
 	// Create blog post model
 	post := &models.BlogPost{
-		ID:         "blog:" + slug,
-		Type:       "blog_post",
-		Title:      strings.TrimSpace(req.Title),
-		Slug:       slug,
-		Excerpt:    strings.TrimSpace(req.Excerpt),
-		Content:    s.convertProtoContentToModel(sanitizedContent),
-		Meta:       s.convertProtoMetaToModel(req.Meta),
-		Status:     s.convertProtoStatusToModel(req.Status),
-		Author:     req.Author,
-		Categories: req.Categories,
-		Tags:       req.Tags,
+		ID:            "blog:" + slug,
+		Type:          "blog_post",
+		Title:         strings.TrimSpace(req.Title),
+		Slug:          slug,
+		Excerpt:       strings.TrimSpace(req.Excerpt),
+		Content:       s.convertProtoContentToModel(sanitizedContent),
+		Meta:          s.convertProtoMetaToModel(req.Meta),
+		Status:        s.convertProtoStatusToModel(req.Status),
+		Author:        req.Author,
+		Categories:    req.Categories,
+		Tags:          req.Tags,
 		FeaturedImage: req.FeaturedImage,
 	}
+	if req.CommentsEnabled != nil {
+		post.CommentsDisabled = !req.GetCommentsEnabled()
+	}
+	if req.Visibility != nil {
+		post.Visibility = s.convertProtoVisibilityToModel(req.Visibility)
+	}
+	post.SortWeight = int(req.SortWeight)
+	if err := applyPin(post, &req.Pinned, req.PinnedUntil); err != nil {
+		return nil, err
+	}
 
 	// Set published date if status is published
+	var warnings []*contentv1.PublishViolation
 	if req.Status == contentv1.PageStatus_PAGE_STATUS_PUBLISHED {
 		if req.PublishedAt != nil {
 			publishedAt := req.PublishedAt.AsTime()
@@ -584,15 +725,56 @@ func (s *ContentService) CreateBlogPost(ctx context.Context, req *contentv1.Crea
 		} else {
 			post.SetPublished()
 		}
+
+		var err error
+		warnings, post.PublishOverride, err = s.enforcePublishGate(ctx, publishItemFromPost(post), req.PublishOverrideReason)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Save to repository
-	if err := s.blogRepo.Create(ctx, post); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create blog post: %v", err)
+	if err := s.insertBlogPost(ctx, post); err != nil {
+		return nil, err
 	}
 
 	// Convert back to proto and return
-	return s.convertBlogModelToProto(post), nil
+	protoPost := s.convertBlogModelToProto(post)
+	protoPost.PublishWarnings = warnings
+	protoPost.PublishOverride = convertPublishOverrideToProto(ctx, post.PublishOverride)
+	s.attachFeaturedImages(ctx, protoPost)
+	return protoPost, nil
+}
+
+// insertBlogPost validates references and stores a new blog post
+func (s *ContentService) insertBlogPost(ctx context.Context, post *models.BlogPost) error {
+	if err := s.validateReusableBlockRefs(ctx, post.Content); err != nil {
+		return err
+	}
+	if err := s.validateEmbedBlocks(post.Content); err != nil {
+		return err
+	}
+	if err := s.normalizeMeta(ctx, &post.Meta); err != nil {
+		return err
+	}
+	if err := s.normalizeFeaturedImage(ctx, post); err != nil {
+		return err
+	}
+
+	if err := s.blogRepo.Create(ctx, post); err != nil {
+		return status.Errorf(codes.Internal, "failed to create blog post: %v", err)
+	}
+
+	if err := s.syncReusableBlockUsages(ctx, models.ContentTypeBlogPost, post.ID, post.Slug, post.Title, post.Content); err != nil {
+		return err
+	}
+
+	s.publishPostEvents(ctx, models.WebhookEventPostCreated, post, false)
+	revalidateTargets(s.revalidator, postChangeTargets(nil, post))
+	if relatedPostsChanged(nil, post) {
+		s.invalidateRelatedPosts(ctx)
+	}
+	return nil
 }
 
 // GetBlogPost retrieves a blog post by ID
@@ -607,7 +789,19 @@ func (s *ContentService) GetBlogPost(ctx context.Context, req *contentv1.GetBlog
 		return nil, status.Errorf(codes.NotFound, "blog post not found: %v", err)
 	}
 
-	return s.convertBlogModelToProto(post), nil
+	protoPost := s.convertBlogModelToProto(post)
+	protoPost.PublishOverride = convertPublishOverrideToProto(ctx, post.PublishOverride)
+	s.gatePosts(ctx, protoPost)
+	if err := s.resolveReusableBlocks(ctx, protoPost.Content); err != nil {
+		return nil, err
+	}
+	s.resolveEmbeds(ctx, protoPost.Content)
+	s.attachCommentCounts(ctx, protoPost)
+	s.attachFeaturedImages(ctx, protoPost)
+	s.attachSeriesNavigation(ctx, protoPost)
+	s.attachPostStructuredData(ctx, post, protoPost)
+
+	return protoPost, nil
 }
 
 // UpdateBlogPost updates an existing blog post
@@ -641,6 +835,9 @@ func (s *ContentService) UpdateBlogPost(ctx context.Context, req *contentv1.Upda
 	// Sanitize content
 	sanitizedContent := s.sanitizeContent(req.Content)
 
+	wasPublished := existingPost.Status == models.PageStatusPublished
+	before := *existingPost
+
 	// Update blog post model
 	existingPost.Title = strings.TrimSpace(req.Title)
 	existingPost.Slug = slug
@@ -652,6 +849,18 @@ func (s *ContentService) UpdateBlogPost(ctx context.Context, req *contentv1.Upda
 	existingPost.Categories = req.Categories
 	existingPost.Tags = req.Tags
 	existingPost.FeaturedImage = req.FeaturedImage
+	if req.CommentsEnabled != nil {
+		existingPost.CommentsDisabled = !req.GetCommentsEnabled()
+	}
+	if req.Visibility != nil {
+		existingPost.Visibility = s.convertProtoVisibilityToModel(req.Visibility)
+	}
+	if req.SortWeight != nil {
+		existingPost.SortWeight = int(req.GetSortWeight())
+	}
+	if err := applyPin(existingPost, req.Pinned, req.PinnedUntil); err != nil {
+		return nil, err
+	}
 
 	// Handle published date
 	if req.Status == contentv1.PageStatus_PAGE_STATUS_PUBLISHED {
@@ -665,13 +874,48 @@ func (s *ContentService) UpdateBlogPost(ctx context.Context, req *contentv1.Upda
 		existingPost.SetDraft()
 	}
 
+	if err := s.validateReusableBlockRefs(ctx, existingPost.Content); err != nil {
+		return nil, err
+	}
+	if err := s.validateEmbedBlocks(existingPost.Content); err != nil {
+		return nil, err
+	}
+	if err := s.normalizeMeta(ctx, &existingPost.Meta); err != nil {
+		return nil, err
+	}
+	if err := s.normalizeFeaturedImage(ctx, existingPost); err != nil {
+		return nil, err
+	}
+
+	var warnings []*contentv1.PublishViolation
+	if !wasPublished && existingPost.Status == models.PageStatusPublished {
+		warnings, existingPost.PublishOverride, err = s.enforcePublishGate(ctx, publishItemFromPost(existingPost), req.PublishOverrideReason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Save to repository
 	if err := s.blogRepo.Update(ctx, existingPost); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update blog post: %v", err)
 	}
 
+	if err := s.syncReusableBlockUsages(ctx, models.ContentTypeBlogPost, existingPost.ID, existingPost.Slug, existingPost.Title, existingPost.Content); err != nil {
+		return nil, err
+	}
+
+	s.publishPostEvents(ctx, models.WebhookEventPostUpdated, existingPost, wasPublished)
+	revalidateTargets(s.revalidator, postChangeTargets(&before, existingPost))
+	if relatedPostsChanged(&before, existingPost) {
+		s.invalidateRelatedPosts(ctx)
+	}
+
 	// Convert back to proto and return
-	return s.convertBlogModelToProto(existingPost), nil
+	protoPost := s.convertBlogModelToProto(existingPost)
+	protoPost.PublishWarnings = warnings
+	protoPost.PublishOverride = convertPublishOverrideToProto(ctx, existingPost.PublishOverride)
+	s.attachFeaturedImages(ctx, protoPost)
+	return protoPost, nil
 }
 
 // DeleteBlogPost deletes a blog post
@@ -681,7 +925,7 @@ func (s *ContentService) DeleteBlogPost(ctx context.Context, req *contentv1.Dele
 	}
 
 	// Check if blog post exists
-	_, err := s.blogRepo.GetByID(ctx, req.Id)
+	post, err := s.blogRepo.GetByID(ctx, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "blog post not found: %v", err)
 	}
@@ -691,6 +935,19 @@ func (s *ContentService) DeleteBlogPost(ctx context.Context, req *contentv1.Dele
 		return nil, status.Errorf(codes.Internal, "failed to delete blog post: %v", err)
 	}
 
+	if err := s.syncReusableBlockUsages(ctx, models.ContentTypeBlogPost, req.Id, "", "", models.Content{}); err != nil {
+		return nil, err
+	}
+	if err := s.removePostFromCollections(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, s.events, models.WebhookEventPostDeleted, deletedContentEvent{ID: post.ID, Slug: post.Slug})
+	revalidateTargets(s.revalidator, postChangeTargets(post, nil))
+	if relatedPostsChanged(post, nil) {
+		s.invalidateRelatedPosts(ctx)
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -710,29 +967,35 @@ func (s *ContentService) ListBlogPosts(ctx context.Context, req *contentv1.ListB
 		}
 	}
 
-	options := repository.ListOptions{
-		Limit: int(pageSize),
-		Skip:  skip,
-		Order: "desc",
-	}
-
-	var posts []*models.BlogPost
-	var err error
-
 	// Filter by different criteria
+	query := repository.BlogPostQuery{
+		Category: req.Category,
+		Tag:      req.Tag,
+		Author:   req.Author,
+		Sort:     blogPostSortToModel(req.Sort),
+	}
 	if req.Status != contentv1.PageStatus_PAGE_STATUS_UNSPECIFIED {
-		statusStr := s.convertProtoStatusToModel(req.Status)
-		posts, err = s.blogRepo.ListByStatus(ctx, statusStr, options)
-	} else if req.Category != "" {
-		posts, err = s.blogRepo.ListByCategory(ctx, req.Category, options)
-	} else if req.Tag != "" {
-		posts, err = s.blogRepo.ListByTag(ctx, req.Tag, options)
-	} else if req.Author != "" {
-		posts, err = s.blogRepo.ListByAuthor(ctx, req.Author, options)
-	} else {
-		posts, err = s.blogRepo.List(ctx, options)
+		query.Status = s.convertProtoStatusToModel(req.Status)
+	}
+	if req.PublishedAfter != nil || req.PublishedBefore != nil {
+		if req.PublishedAfter != nil {
+			query.PublishedAfter = req.PublishedAfter.AsTime()
+		}
+		if req.PublishedBefore != nil {
+			query.PublishedBefore = req.PublishedBefore.AsTime()
+		}
+		if req.Status != contentv1.PageStatus_PAGE_STATUS_UNSPECIFIED && req.Status != contentv1.PageStatus_PAGE_STATUS_PUBLISHED {
+			return nil, status.Errorf(codes.InvalidArgument, "published_after and published_before only apply to published posts")
+		}
+		if query.Sort != models.BlogSortPublishedAt {
+			return nil, status.Errorf(codes.InvalidArgument, "published_after and published_before list posts by publication date")
+		}
+		if !query.PublishedAfter.IsZero() && !query.PublishedBefore.IsZero() && !query.PublishedAfter.Before(query.PublishedBefore) {
+			return nil, status.Errorf(codes.InvalidArgument, "published_after must be before published_before")
+		}
 	}
 
+	posts, pagination, err := s.blogRepo.ListPosts(ctx, query, repository.ListOptions{Limit: int(pageSize), Skip: skip})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list blog posts: %v", err)
 	}
@@ -742,17 +1005,20 @@ func (s *ContentService) ListBlogPosts(ctx context.Context, req *contentv1.ListB
 	for i, post := range posts {
 		protoPosts[i] = s.convertBlogModelToProto(post)
 	}
+	s.gatePosts(ctx, protoPosts...)
+	s.attachCommentCounts(ctx, protoPosts...)
+	s.attachFeaturedImages(ctx, protoPosts...)
 
 	// Generate next page token
 	nextPageToken := ""
-	if len(posts) == int(pageSize) {
-		nextPageToken = strconv.Itoa(skip + int(pageSize))
+	if pagination.HasMore {
+		nextPageToken = strconv.Itoa(skip + len(posts))
 	}
 
 	return &contentv1.ListBlogPostsResponse{
 		Posts:         protoPosts,
 		NextPageToken: nextPageToken,
-		TotalCount:    int32(len(posts)), // This is approximate
+		TotalCount:    int32(pagination.TotalCount),
 	}, nil
 }
 
@@ -787,6 +1053,7 @@ func (s *ContentService) SearchBlogPosts(ctx context.Context, req *contentv1.Sea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to search blog posts: %v", err)
 	}
+	posts = s.hideGatedMatches(ctx, posts, req.Query)
 
 	// Additional filtering by category or tag if specified
 	if req.Category != "" || req.Tag != "" {
@@ -829,6 +1096,9 @@ func (s *ContentService) SearchBlogPosts(ctx context.Context, req *contentv1.Sea
 	for i, post := range posts {
 		protoPosts[i] = s.convertBlogModelToProto(post)
 	}
+	s.gatePosts(ctx, protoPosts...)
+	s.attachCommentCounts(ctx, protoPosts...)
+	s.attachFeaturedImages(ctx, protoPosts...)
 
 	// Generate next page token
 	nextPageToken := ""
@@ -885,6 +1155,30 @@ func (s *ContentService) GetBlogTags(ctx context.Context, req *contentv1.GetBlog
 	}, nil
 }
 
+// GetBlogArchive counts published posts by year and month
+func (s *ContentService) GetBlogArchive(ctx context.Context, req *contentv1.GetBlogArchiveRequest) (*contentv1.GetBlogArchiveResponse, error) {
+	months, err := s.blogRepo.GetArchive(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get blog archive: %v", err)
+	}
+
+	resp := &contentv1.GetBlogArchiveResponse{}
+	var year *contentv1.BlogArchiveYear
+	for _, month := range months {
+		if year == nil || year.Year != int32(month.Year) {
+			year = &contentv1.BlogArchiveYear{Year: int32(month.Year)}
+			resp.Years = append(resp.Years, year)
+		}
+		year.PostCount += int32(month.PostCount)
+		year.Months = append(year.Months, &contentv1.BlogArchiveMonth{
+			Month:     int32(month.Month),
+			PostCount: int32(month.PostCount),
+		})
+	}
+
+	return resp, nil
+}
+
 // GetRSSFeed generates RSS feed for blog posts
 func (s *ContentService) GetRSSFeed(ctx context.Context, req *contentv1.GetRSSFeedRequest) (*contentv1.GetRSSFeedResponse, error) {
 	// Get published blog posts
@@ -900,7 +1194,7 @@ func (s *ContentService) GetRSSFeed(ctx context.Context, req *contentv1.GetRSSFe
 	}
 
 	// Generate RSS XML
-	rssXML := s.generateRSSFeed(posts)
+	rssXML := s.generateRSSFeed(s.siteInfo(ctx), posts)
 
 	return &contentv1.GetRSSFeedResponse{
 		XmlContent:  rssXML,
@@ -917,7 +1211,7 @@ func (s *ContentService) validateCreateBlogPostRequest(req *contentv1.CreateBlog
 	if req.Author == "" {
 		return status.Errorf(codes.InvalidArgument, "author is required")
 	}
-	return nil
+	return s.validateVisibility(req.Visibility)
 }
 
 func (s *ContentService) validateUpdateBlogPostRequest(req *contentv1.UpdateBlogPostRequest) error {
@@ -930,7 +1224,7 @@ func (s *ContentService) validateUpdateBlogPostRequest(req *contentv1.UpdateBlog
 	if req.Author == "" {
 		return status.Errorf(codes.InvalidArgument, "author is required")
 	}
-	return nil
+	return s.validateVisibility(req.Visibility)
 }
 
 func (s *ContentService) validateBlogSlugUniqueness(ctx context.Context, slug, excludeID string) error {
@@ -943,36 +1237,45 @@ func (s *ContentService) validateBlogSlugUniqueness(ctx context.Context, slug, e
 
 func (s *ContentService) convertBlogModelToProto(post *models.BlogPost) *contentv1.BlogPost {
 	protoBlogPost := &contentv1.BlogPost{
-		Id:            post.ID,
-		Title:         post.Title,
-		Slug:          post.Slug,
-		Excerpt:       post.Excerpt,
-		Content:       s.convertModelContentToProto(post.Content),
-		Meta:          s.convertModelMetaToProto(post.Meta),
-		Status:        s.convertModelStatusToProto(post.Status),
-		Author:        post.Author,
-		Categories:    post.Categories,
-		Tags:          post.Tags,
-		FeaturedImage: post.FeaturedImage,
-		CreatedAt:     timestamppb.New(post.CreatedAt),
-		UpdatedAt:     timestamppb.New(post.UpdatedAt),
+		Id:              post.ID,
+		Title:           post.Title,
+		Slug:            post.Slug,
+		Excerpt:         post.Excerpt,
+		Content:         s.convertModelContentToProto(post.Content),
+		Meta:            s.convertModelMetaToProto(post.Meta),
+		Status:          s.convertModelStatusToProto(post.Status),
+		Author:          post.Author,
+		Categories:      post.Categories,
+		Tags:            post.Tags,
+		FeaturedImage:   post.FeaturedImage,
+		CommentsEnabled: !post.CommentsDisabled,
+		Visibility:      s.convertModelVisibilityToProto(post.Visibility),
+		Pinned:          post.Pinned,
+		SortWeight:      int32(post.SortWeight),
+		CreatedAt:       timestamppb.New(post.CreatedAt),
+		UpdatedAt:       timestamppb.New(post.UpdatedAt),
 	}
 
 	if post.PublishedAt != nil {
 		protoBlogPost.PublishedAt = timestamppb.New(*post.PublishedAt)
 	}
+	if post.PinnedUntil != nil {
+		protoBlogPost.PinnedUntil = timestamppb.New(*post.PinnedUntil)
+	}
 
 	return protoBlogPost
 }
 
-func (s *ContentService) generateRSSFeed(posts []*models.BlogPost) string {
+func (s *ContentService) generateRSSFeed(site SiteInfo, posts []*models.BlogPost) string {
+	blogURL := html.EscapeString(site.URL + "/blog")
+
 	// Basic RSS 2.0 feed generation
 	rss := `<?xml version="1.0" encoding="UTF-8"?>
 <rss version="2.0">
 <channel>
-<title>SaaS Startup Platform Blog</title>
-<description>Latest insights, tutorials, and updates from our team</description>
-<link>https://example.com/blog</link>
+<title>` + html.EscapeString(site.Name) + ` Blog</title>
+<description>` + html.EscapeString(site.Description) + `</description>
+<link>` + blogURL + `</link>
 <language>en-us</language>
 <lastBuildDate>` + time.Now().Format(time.RFC1123Z) + `</lastBuildDate>
 `
@@ -990,11 +1293,11 @@ func (s *ContentService) generateRSSFeed(posts []*models.BlogPost) string {
 		rss += fmt.Sprintf(`<item>
 <title>%s</title>
 <description>%s</description>
-<link>https://example.com/blog/%s</link>
-<guid>https://example.com/blog/%s</guid>
+<link>%s/%s</link>
+<guid>%s/%s</guid>
 <pubDate>%s</pubDate>
 <author>%s</author>
-`, title, description, post.Slug, post.Slug, publishedDate, html.EscapeString(post.Author))
+`, title, description, blogURL, post.Slug, blogURL, post.Slug, publishedDate, html.EscapeString(post.Author))
 
 		// Add categories
 		for _, category := range post.Categories {
@@ -1011,4 +1314,3 @@ func (s *ContentService) generateRSSFeed(posts []*models.BlogPost) string {
 
 	return rss
 }
-*/
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	mediav1 "github.com/7-solutions/saas-platformbackend/gen/media/v1"
+)
+
+const (
+	// DefaultContentFeedReplaySize is how many recent events a reconnecting client can catch up on
+	DefaultContentFeedReplaySize = 1000
+	// contentFeedBuffer bounds the events queued for one slow subscriber before it is dropped
+	contentFeedBuffer = 256
+)
+
+// contentFeedResources maps the event type prefix to the resource type
+var contentFeedResources = map[string]contentv1.ContentResourceType{
+	"page":  contentv1.ContentResourceType_CONTENT_RESOURCE_TYPE_PAGE,
+	"post":  contentv1.ContentResourceType_CONTENT_RESOURCE_TYPE_BLOG_POST,
+	"media": contentv1.ContentResourceType_CONTENT_RESOURCE_TYPE_MEDIA,
+}
+
+// contentFeedActions maps the event type suffix to the action
+var contentFeedActions = map[string]contentv1.ContentEventAction{
+	"created":   contentv1.ContentEventAction_CONTENT_EVENT_ACTION_CREATED,
+	"uploaded":  contentv1.ContentEventAction_CONTENT_EVENT_ACTION_CREATED,
+	"updated":   contentv1.ContentEventAction_CONTENT_EVENT_ACTION_UPDATED,
+	"published": contentv1.ContentEventAction_CONTENT_EVENT_ACTION_PUBLISHED,
+	"deleted":   contentv1.ContentEventAction_CONTENT_EVENT_ACTION_DELETED,
+}
+
+// ContentFeed numbers page, post and media events, keeps the most recent ones in a
+// bounded replay log and fans them out to WatchContent subscribers. It is an
+// EventPublisher; events of other resources are ignored. Sequence numbers restart
+// with the process, so clients resuming with an unknown sequence must resync.
+type ContentFeed struct {
+	replaySize int
+	now        func() time.Time
+
+	mu          sync.Mutex
+	seq         uint64
+	log         []*contentv1.ContentEvent
+	subscribers map[*ContentSubscription]struct{}
+}
+
+var _ EventPublisher = (*ContentFeed)(nil)
+
+// NewContentFeed creates a feed replaying up to replaySize events; <= 0 uses the default
+func NewContentFeed(replaySize int) *ContentFeed {
+	if replaySize <= 0 {
+		replaySize = DefaultContentFeedReplaySize
+	}
+	return &ContentFeed{
+		replaySize:  replaySize,
+		now:         time.Now,
+		subscribers: make(map[*ContentSubscription]struct{}),
+	}
+}
+
+// ContentSubscription receives the events of a ContentFeed after its backlog
+type ContentSubscription struct {
+	feed   *ContentFeed
+	filter map[contentv1.ContentResourceType]bool
+	// Backlog holds the replayed events after the requested sequence, oldest first
+	Backlog []*contentv1.ContentEvent
+	events  chan *contentv1.ContentEvent
+	lagged  chan struct{}
+}
+
+// Events delivers new events in sequence order
+func (s *ContentSubscription) Events() <-chan *contentv1.ContentEvent {
+	return s.events
+}
+
+// Lagged is closed when the subscriber fell too far behind and was dropped.
+// The client should resume from the last sequence it received.
+func (s *ContentSubscription) Lagged() <-chan struct{} {
+	return s.lagged
+}
+
+// Close stops the subscription
+func (s *ContentSubscription) Close() {
+	s.feed.mu.Lock()
+	defer s.feed.mu.Unlock()
+	delete(s.feed.subscribers, s)
+}
+
+func (s *ContentSubscription) wants(event *contentv1.ContentEvent) bool {
+	return len(s.filter) == 0 || s.filter[event.ResourceType]
+}
+
+// Publish records a page, post or media event and delivers it to subscribers
+func (f *ContentFeed) Publish(ctx context.Context, eventType string, data interface{}) {
+	event := newContentEvent(eventType, data)
+	if event == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.seq++
+	event.Sequence = f.seq
+	event.OccurredAt = timestamppb.New(f.now())
+
+	f.log = append(f.log, event)
+	if len(f.log) > f.replaySize {
+		f.log = f.log[len(f.log)-f.replaySize:]
+	}
+
+	for sub := range f.subscribers {
+		if !sub.wants(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// Never block publishers on a slow reader
+			delete(f.subscribers, sub)
+			close(sub.lagged)
+		}
+	}
+}
+
+// Subscribe starts a subscription. With sinceSequence > 0 the events after it are
+// returned as backlog; OutOfRange is returned when they are no longer all in the replay log.
+func (f *ContentFeed) Subscribe(sinceSequence uint64, resourceTypes []contentv1.ContentResourceType) (*ContentSubscription, error) {
+	sub := &ContentSubscription{
+		feed:   f,
+		filter: make(map[contentv1.ContentResourceType]bool),
+		events: make(chan *contentv1.ContentEvent, contentFeedBuffer),
+		lagged: make(chan struct{}),
+	}
+	for _, t := range resourceTypes {
+		if t != contentv1.ContentResourceType_CONTENT_RESOURCE_TYPE_UNSPECIFIED {
+			sub.filter[t] = true
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if sinceSequence > 0 {
+		if sinceSequence > f.seq {
+			return nil, status.Errorf(codes.OutOfRange, "unknown sequence %d: the feed restarted, resync and watch from 0", sinceSequence)
+		}
+		if len(f.log) > 0 && sinceSequence+1 < f.log[0].Sequence {
+			return nil, status.Errorf(codes.OutOfRange, "sequence %d is no longer in the replay log, resync and watch from 0", sinceSequence)
+		}
+		for _, event := range f.log {
+			if event.Sequence > sinceSequence && sub.wants(event) {
+				sub.Backlog = append(sub.Backlog, event)
+			}
+		}
+	}
+	f.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// newContentEvent converts a published event into a feed event; nil for other resources
+func newContentEvent(eventType string, data interface{}) *contentv1.ContentEvent {
+	resource, action, ok := strings.Cut(eventType, ".")
+	if !ok {
+		return nil
+	}
+	resourceType, ok := contentFeedResources[resource]
+	if !ok {
+		return nil
+	}
+	event := &contentv1.ContentEvent{
+		Type:         eventType,
+		ResourceType: resourceType,
+		Action:       contentFeedActions[action],
+	}
+
+	switch d := data.(type) {
+	case *contentv1.Page:
+		event.Id, event.Slug = d.Id, d.Slug
+		event.Resource = &contentv1.ContentEvent_Page{Page: d}
+	case *contentv1.BlogPost:
+		event.Id, event.Slug = d.Id, d.Slug
+		event.Resource = &contentv1.ContentEvent_BlogPost{BlogPost: d}
+	case deletedContentEvent:
+		event.Id, event.Slug = d.ID, d.Slug
+	case *mediav1.File:
+		event.Id, event.Slug = d.Id, d.Filename
+	case map[string]string:
+		event.Id, event.Slug = d["id"], d["filename"]
+	}
+	return event
+}
+
+// SetContentFeed enables WatchContent. When unset, it returns FailedPrecondition.
+func (s *ContentService) SetContentFeed(feed *ContentFeed) {
+	s.feed = feed
+}
+
+// WatchContent streams the replayed backlog after since_sequence, then new events
+// until the client disconnects or falls behind
+func (s *ContentService) WatchContent(req *contentv1.WatchContentRequest, stream grpc.ServerStreamingServer[contentv1.ContentEvent]) error {
+	if s.feed == nil {
+		return status.Errorf(codes.FailedPrecondition, "content feed is not configured")
+	}
+
+	sub, err := s.feed.Subscribe(req.SinceSequence, req.ResourceTypes)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	last := req.SinceSequence
+	for _, event := range sub.Backlog {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		last = event.Sequence
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Lagged():
+			return status.Errorf(codes.ResourceExhausted, "client fell behind; resume from sequence %d", last)
+		case event := <-sub.Events():
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			last = event.Sequence
+		}
+	}
+}
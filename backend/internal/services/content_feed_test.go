@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	mediav1 "github.com/7-solutions/saas-platformbackend/gen/media/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+// watchStream is a WatchContent server stream that forwards sent events to a channel
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *contentv1.ContentEvent
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(event *contentv1.ContentEvent) error {
+	s.events <- event
+	return nil
+}
+
+func sequences(events []*contentv1.ContentEvent) []uint64 {
+	out := make([]uint64, 0, len(events))
+	for _, event := range events {
+		out = append(out, event.Sequence)
+	}
+	return out
+}
+
+func TestContentFeed_PublishConvertsEvents(t *testing.T) {
+	feed := NewContentFeed(10)
+	sub, err := feed.Subscribe(0, nil)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	ctx := context.Background()
+	feed.Publish(ctx, models.WebhookEventPostPublished, &contentv1.BlogPost{Id: "blog:launch", Slug: "launch"})
+	feed.Publish(ctx, models.WebhookEventContactSubmitted, map[string]string{"id": "contact:1"})
+	feed.Publish(ctx, models.WebhookEventPageDeleted, deletedContentEvent{ID: "page:about", Slug: "about"})
+	feed.Publish(ctx, models.WebhookEventMediaUploaded, &mediav1.File{Id: "media:hero.png", Filename: "hero.png"})
+
+	published := <-sub.Events()
+	assert.Equal(t, uint64(1), published.Sequence)
+	assert.Equal(t, contentv1.ContentResourceType_CONTENT_RESOURCE_TYPE_BLOG_POST, published.ResourceType)
+	assert.Equal(t, contentv1.ContentEventAction_CONTENT_EVENT_ACTION_PUBLISHED, published.Action)
+	assert.Equal(t, "launch", published.GetBlogPost().GetSlug())
+	assert.NotNil(t, published.OccurredAt)
+
+	deleted := <-sub.Events()
+	assert.Equal(t, uint64(2), deleted.Sequence, "contact submissions are not part of the feed")
+	assert.Equal(t, "page:about", deleted.Id)
+	assert.Equal(t, contentv1.ContentEventAction_CONTENT_EVENT_ACTION_DELETED, deleted.Action)
+	assert.Nil(t, deleted.Resource)
+
+	uploaded := <-sub.Events()
+	assert.Equal(t, contentv1.ContentResourceType_CONTENT_RESOURCE_TYPE_MEDIA, uploaded.ResourceType)
+	assert.Equal(t, contentv1.ContentEventAction_CONTENT_EVENT_ACTION_CREATED, uploaded.Action)
+	assert.Equal(t, "hero.png", uploaded.Slug)
+}
+
+func TestContentFeed_SubscribeReplaysBoundedLog(t *testing.T) {
+	feed := NewContentFeed(3)
+	ctx := context.Background()
+	for _, slug := range []string{"a", "b", "c", "d"} {
+		feed.Publish(ctx, models.WebhookEventPageUpdated, &contentv1.Page{Id: "page:" + slug, Slug: slug})
+	}
+	feed.Publish(ctx, models.WebhookEventMediaDeleted, map[string]string{"id": "media:x.png", "filename": "x.png"})
+
+	// Events 3-5 are retained
+	sub, err := feed.Subscribe(2, nil)
+	require.NoError(t, err)
+	sub.Close()
+	assert.Equal(t, []uint64{3, 4, 5}, sequences(sub.Backlog))
+
+	sub, err = feed.Subscribe(2, []contentv1.ContentResourceType{contentv1.ContentResourceType_CONTENT_RESOURCE_TYPE_MEDIA})
+	require.NoError(t, err)
+	sub.Close()
+	assert.Equal(t, []uint64{5}, sequences(sub.Backlog))
+
+	sub, err = feed.Subscribe(5, nil)
+	require.NoError(t, err)
+	sub.Close()
+	assert.Empty(t, sub.Backlog)
+
+	_, err = feed.Subscribe(1, nil)
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "event 2 was evicted")
+
+	_, err = feed.Subscribe(6, nil)
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "sequence from before a restart")
+}
+
+func TestContentFeed_DropsLaggingSubscriber(t *testing.T) {
+	feed := NewContentFeed(0)
+	sub, err := feed.Subscribe(0, nil)
+	require.NoError(t, err)
+
+	for i := 0; i <= contentFeedBuffer; i++ {
+		feed.Publish(context.Background(), models.WebhookEventPageUpdated, &contentv1.Page{Id: "page:a", Slug: "a"})
+	}
+
+	select {
+	case <-sub.Lagged():
+	default:
+		t.Fatal("subscriber should be dropped once its buffer is full")
+	}
+	assert.Len(t, sub.Events(), contentFeedBuffer)
+}
+
+func TestContentService_WatchContent(t *testing.T) {
+	service := NewContentService(nil, nil)
+	err := service.WatchContent(&contentv1.WatchContentRequest{}, &watchStream{ctx: context.Background()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	feed := NewContentFeed(10)
+	service.SetContentFeed(feed)
+	service.SetEventPublisher(EventPublishers{feed})
+	publishEvent(context.Background(), service.events, models.WebhookEventPageCreated, &contentv1.Page{Id: "page:about", Slug: "about"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx, events: make(chan *contentv1.ContentEvent, 4)}
+	done := make(chan error, 1)
+	go func() {
+		done <- service.WatchContent(&contentv1.WatchContentRequest{SinceSequence: 0}, stream)
+	}()
+
+	// Wait for the subscription before publishing the live event
+	require.Eventually(t, func() bool {
+		feed.mu.Lock()
+		defer feed.mu.Unlock()
+		return len(feed.subscribers) == 1
+	}, time.Second, 5*time.Millisecond)
+	publishEvent(context.Background(), service.events, models.WebhookEventPageUpdated, &contentv1.Page{Id: "page:about", Slug: "about"})
+
+	live := <-stream.events
+	assert.Equal(t, uint64(2), live.Sequence, "since_sequence 0 skips the backlog")
+	assert.Equal(t, models.WebhookEventPageUpdated, live.Type)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	// Resuming replays what was missed
+	ctx, cancel = context.WithCancel(context.Background())
+	resumed := &watchStream{ctx: ctx, events: make(chan *contentv1.ContentEvent, 4)}
+	go func() {
+		done <- service.WatchContent(&contentv1.WatchContentRequest{SinceSequence: 1}, resumed)
+	}()
+	assert.Equal(t, uint64(2), (<-resumed.events).Sequence)
+	cancel()
+	require.NoError(t, <-done)
+}
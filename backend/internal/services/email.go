@@ -3,6 +3,7 @@ package services
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"html/template"
 	"log"
@@ -22,6 +23,21 @@ type EmailService struct {
 	fromEmail    string
 	fromName     string
 	adminEmail   string
+
+	// Site settings are optional; see SetSiteInfo and SetContactRecipients
+	site       SiteInfoSource
+	recipients ContactRecipientsSource
+}
+
+// ContactRecipientsSource provides the addresses notified of contact form submissions
+type ContactRecipientsSource interface {
+	ContactRecipients(ctx context.Context) []string
+}
+
+// contactEmailData is the template data of contact form emails
+type contactEmailData struct {
+	*models.ContactSubmission
+	SiteName string
 }
 
 // NewEmailService creates a new email service
@@ -37,41 +53,84 @@ func NewEmailService() *EmailService {
 	}
 }
 
-// SendContactNotification sends an email notification for new contact submissions
-func (s *EmailService) SendContactNotification(submission *models.ContactSubmission) error {
+// SetSiteInfo sets the source of the site name used in emails. Until it is called the
+// FROM_NAME sender name is used.
+func (s *EmailService) SetSiteInfo(site SiteInfoSource) {
+	s.site = site
+}
+
+// SetContactRecipients sets the source of the addresses notified of contact form
+// submissions. ADMIN_EMAIL is notified when it is unset or returns no addresses.
+func (s *EmailService) SetContactRecipients(recipients ContactRecipientsSource) {
+	s.recipients = recipients
+}
+
+// siteName returns the site name shown in emails
+func (s *EmailService) siteName(ctx context.Context) string {
+	if s.site == nil {
+		return s.fromName
+	}
+	return s.site.SiteInfo(ctx).Name
+}
+
+// contactRecipients returns the addresses notified of contact form submissions
+func (s *EmailService) contactRecipients(ctx context.Context) []string {
+	if s.recipients != nil {
+		if recipients := s.recipients.ContactRecipients(ctx); len(recipients) > 0 {
+			return recipients
+		}
+	}
 	if s.adminEmail == "" {
+		return nil
+	}
+	return []string{s.adminEmail}
+}
+
+// SendContactNotification sends an email notification for new contact submissions to
+// every contact recipient
+func (s *EmailService) SendContactNotification(ctx context.Context, submission *models.ContactSubmission) error {
+	recipients := s.contactRecipients(ctx)
+	if len(recipients) == 0 {
 		log.Println("Admin email not configured, skipping email notification")
 		return nil
 	}
 
 	// Prepare email content
+	data := contactEmailData{ContactSubmission: submission, SiteName: s.siteName(ctx)}
 	subject := fmt.Sprintf("New Contact Form Submission from %s", submission.Name)
 
 	// Create HTML email body
-	htmlBody, err := s.generateContactNotificationHTML(submission)
+	htmlBody, err := s.generateContactNotificationHTML(data)
 	if err != nil {
 		return fmt.Errorf("failed to generate email HTML: %w", err)
 	}
 
 	// Create plain text email body
-	textBody := s.generateContactNotificationText(submission)
+	textBody := s.generateContactNotificationText(data)
 
 	// Send email
-	return s.sendEmail(s.adminEmail, subject, textBody, htmlBody)
+	var errs []error
+	for _, recipient := range recipients {
+		if err := s.sendEmail(recipient, subject, textBody, htmlBody); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // SendContactConfirmation sends a confirmation email to the person who submitted the form
-func (s *EmailService) SendContactConfirmation(submission *models.ContactSubmission) error {
+func (s *EmailService) SendContactConfirmation(ctx context.Context, submission *models.ContactSubmission) error {
 	subject := "Thank you for contacting us"
+	data := contactEmailData{ContactSubmission: submission, SiteName: s.siteName(ctx)}
 
 	// Create HTML email body
-	htmlBody, err := s.generateContactConfirmationHTML(submission)
+	htmlBody, err := s.generateContactConfirmationHTML(data)
 	if err != nil {
 		return fmt.Errorf("failed to generate confirmation email HTML: %w", err)
 	}
 
 	// Create plain text email body
-	textBody := s.generateContactConfirmationText(submission)
+	textBody := s.generateContactConfirmationText(data)
 
 	// Send email
 	return s.sendEmail(submission.Email, subject, textBody, htmlBody)
@@ -137,7 +196,7 @@ func (s *EmailService) createMIMEMessage(to, subject, textBody, htmlBody string)
 }
 
 // generateContactNotificationHTML generates HTML email for admin notification
-func (s *EmailService) generateContactNotificationHTML(submission *models.ContactSubmission) (string, error) {
+func (s *EmailService) generateContactNotificationHTML(data contactEmailData) (string, error) {
 	tmpl := `
 <!DOCTYPE html>
 <html>
@@ -197,7 +256,7 @@ func (s *EmailService) generateContactNotificationHTML(submission *models.Contac
         {{end}}
         
         <div class="footer">
-            <p>This email was automatically generated by your SaaS Platform contact form.</p>
+            <p>This email was automatically generated by your {{.SiteName}} contact form.</p>
         </div>
     </div>
 </body>
@@ -209,7 +268,7 @@ func (s *EmailService) generateContactNotificationHTML(submission *models.Contac
 	}
 
 	var buf bytes.Buffer
-	err = t.Execute(&buf, submission)
+	err = t.Execute(&buf, data)
 	if err != nil {
 		return "", err
 	}
@@ -218,7 +277,8 @@ func (s *EmailService) generateContactNotificationHTML(submission *models.Contac
 }
 
 // generateContactNotificationText generates plain text email for admin notification
-func (s *EmailService) generateContactNotificationText(submission *models.ContactSubmission) string {
+func (s *EmailService) generateContactNotificationText(data contactEmailData) string {
+	submission := data.ContactSubmission
 	var text strings.Builder
 
 	text.WriteString("New Contact Form Submission\n")
@@ -240,13 +300,13 @@ func (s *EmailService) generateContactNotificationText(submission *models.Contac
 	text.WriteString("--------\n")
 	text.WriteString(submission.Message)
 	text.WriteString("\n\n")
-	text.WriteString("This email was automatically generated by your SaaS Platform contact form.")
+	text.WriteString(fmt.Sprintf("This email was automatically generated by your %s contact form.", data.SiteName))
 
 	return text.String()
 }
 
 // generateContactConfirmationHTML generates HTML confirmation email for the submitter
-func (s *EmailService) generateContactConfirmationHTML(submission *models.ContactSubmission) (string, error) {
+func (s *EmailService) generateContactConfirmationHTML(data contactEmailData) (string, error) {
 	tmpl := `
 <!DOCTYPE html>
 <html>
@@ -279,7 +339,7 @@ func (s *EmailService) generateContactConfirmationHTML(submission *models.Contac
             <p>We typically respond within 24 hours during business days. If your inquiry is urgent, please feel free to call us directly.</p>
             
             <p>Best regards,<br>
-            The SaaS Platform Team</p>
+            The {{.SiteName}} Team</p>
         </div>
         
         <div class="footer">
@@ -295,7 +355,7 @@ func (s *EmailService) generateContactConfirmationHTML(submission *models.Contac
 	}
 
 	var buf bytes.Buffer
-	err = t.Execute(&buf, submission)
+	err = t.Execute(&buf, data)
 	if err != nil {
 		return "", err
 	}
@@ -304,7 +364,8 @@ func (s *EmailService) generateContactConfirmationHTML(submission *models.Contac
 }
 
 // generateContactConfirmationText generates plain text confirmation email for the submitter
-func (s *EmailService) generateContactConfirmationText(submission *models.ContactSubmission) string {
+func (s *EmailService) generateContactConfirmationText(data contactEmailData) string {
+	submission := data.ContactSubmission
 	var text strings.Builder
 
 	text.WriteString(fmt.Sprintf("Hi %s,\n\n", submission.Name))
@@ -315,17 +376,44 @@ func (s *EmailService) generateContactConfirmationText(submission *models.Contac
 	text.WriteString("\n--------\n\n")
 	text.WriteString("We typically respond within 24 hours during business days. If your inquiry is urgent, please feel free to call us directly.\n\n")
 	text.WriteString("Best regards,\n")
-	text.WriteString("The SaaS Platform Team\n\n")
+	text.WriteString(fmt.Sprintf("The %s Team\n\n", data.SiteName))
 	text.WriteString("This is an automated confirmation email. Please do not reply to this message.")
 
 	return text.String()
 }
 
+// SendCommentNotification notifies a post author about a new comment on their post
+func (s *EmailService) SendCommentNotification(ctx context.Context, to string, post *models.BlogPost, comment *models.Comment) error {
+	subject := fmt.Sprintf("New comment on \"%s\"", post.Title)
+	return s.sendEmail(to, subject, s.generateCommentNotificationText(s.siteName(ctx), post, comment), "")
+}
+
+// generateCommentNotificationText generates plain text email for a new comment
+func (s *EmailService) generateCommentNotificationText(siteName string, post *models.BlogPost, comment *models.Comment) string {
+	var text strings.Builder
+
+	text.WriteString(fmt.Sprintf("%s left a comment on \"%s\":\n\n", comment.AuthorName, post.Title))
+	text.WriteString("--------\n")
+	text.WriteString(comment.Body)
+	text.WriteString("\n--------\n\n")
+	if comment.Status == models.CommentStatusPending {
+		text.WriteString("This comment is waiting for moderation and is not yet visible on the site.\n\n")
+	}
+	text.WriteString(fmt.Sprintf("This is an automated notification from your %s website.", siteName))
+
+	return text.String()
+}
+
 // SendEmail sends a simple email (public method for alerting service)
 func (s *EmailService) SendEmail(ctx context.Context, to, subject, body string) error {
 	return s.sendEmail(to, subject, body, "")
 }
 
+// SendHTMLEmail sends an email with plain text and HTML alternatives (used by the newsletter)
+func (s *EmailService) SendHTMLEmail(ctx context.Context, to, subject, textBody, htmlBody string) error {
+	return s.sendEmail(to, subject, textBody, htmlBody)
+}
+
 // getEnvOrDefault returns environment variable value or default
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -0,0 +1,885 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entryv1 "github.com/7-solutions/saas-platformbackend/gen/entry/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+const (
+	maxContentTypeFields = 100
+	// contentTypeScanSize is the page size used when walking every content type
+	contentTypeScanSize = 100
+)
+
+var (
+	// Field keys double as JSON keys and index expressions, so they are kept simple
+	entryFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+	entrySlugPattern     = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+// entrySortColumns are the entry columns ListEntries can sort by besides filterable fields
+var entrySortColumns = map[string]bool{
+	"created_at":   true,
+	"updated_at":   true,
+	"published_at": true,
+	"slug":         true,
+}
+
+// EntryService manages administrator-defined content types and validates their entries
+type EntryService struct {
+	entryv1.UnimplementedEntryServiceServer
+	repo      repository.ContentTypeRepository
+	mediaRepo repository.MediaRepository
+	now       func() time.Time
+}
+
+// NewEntryService creates a new entry service
+func NewEntryService(repo repository.ContentTypeRepository) *EntryService {
+	return &EntryService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// SetMediaRepository enables existence checks for media fields; without it any media ID is accepted
+func (s *EntryService) SetMediaRepository(repo repository.MediaRepository) {
+	s.mediaRepo = repo
+}
+
+// Content types
+
+// CreateContentType validates and creates a content type and indexes its filterable fields
+func (s *EntryService) CreateContentType(ctx context.Context, req *entryv1.CreateContentTypeRequest) (*entryv1.ContentType, error) {
+	fields := convertProtoFieldsToModel(req.Fields)
+	if err := s.validateContentType(ctx, req.Name, req.Slug, fields); err != nil {
+		return nil, err
+	}
+	if existing, err := s.repo.GetTypeBySlug(ctx, req.Slug); err == nil && existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "content type with slug '%s' already exists", req.Slug)
+	}
+
+	userID, _ := ctx.Value("user_id").(string)
+	contentType := &models.ContentType{
+		Slug:        req.Slug,
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Fields:      fields,
+		CreatedBy:   userID,
+	}
+	if err := s.repo.CreateType(ctx, contentType); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create content type: %v", err)
+	}
+	s.syncFieldIndexes(ctx, contentType)
+
+	return convertContentTypeToProto(contentType), nil
+}
+
+// GetContentType returns a content type by ID or slug
+func (s *EntryService) GetContentType(ctx context.Context, req *entryv1.GetContentTypeRequest) (*entryv1.ContentType, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content type ID is required")
+	}
+	contentType, err := s.getContentTypeByIDOrSlug(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return convertContentTypeToProto(contentType), nil
+}
+
+// ListContentTypes lists content types by name
+func (s *EntryService) ListContentTypes(ctx context.Context, req *entryv1.ListContentTypesRequest) (*entryv1.ListContentTypesResponse, error) {
+	pageSize, skip := webhookPagination(req.PageSize, req.PageToken)
+	// Fetch one extra row to learn whether another page exists
+	types, err := s.repo.ListTypes(ctx, repository.ListOptions{Limit: pageSize + 1, Skip: skip})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list content types: %v", err)
+	}
+
+	resp := &entryv1.ListContentTypesResponse{}
+	if len(types) > pageSize {
+		types = types[:pageSize]
+		resp.NextPageToken = strconv.Itoa(skip + pageSize)
+	}
+	for _, contentType := range types {
+		resp.ContentTypes = append(resp.ContentTypes, convertContentTypeToProto(contentType))
+	}
+	return resp, nil
+}
+
+// UpdateContentType replaces the definition of a content type. Existing entries are
+// not revalidated; they are checked against the new fields the next time they are saved.
+func (s *EntryService) UpdateContentType(ctx context.Context, req *entryv1.UpdateContentTypeRequest) (*entryv1.ContentType, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content type ID is required")
+	}
+	contentType, err := s.getContentTypeByIDOrSlug(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = contentType.Slug
+	}
+	fields := convertProtoFieldsToModel(req.Fields)
+	if err := s.validateContentType(ctx, req.Name, slug, fields); err != nil {
+		return nil, err
+	}
+	if slug != contentType.Slug {
+		if existing, err := s.repo.GetTypeBySlug(ctx, slug); err == nil && existing != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "content type with slug '%s' already exists", slug)
+		}
+		if err := s.ensureNotReferenced(ctx, contentType, "renamed"); err != nil {
+			return nil, err
+		}
+		// Self-references follow the rename
+		for i := range fields {
+			if fields[i].ReferenceType == contentType.Slug {
+				fields[i].ReferenceType = slug
+			}
+		}
+	}
+
+	contentType.Slug = slug
+	contentType.Name = strings.TrimSpace(req.Name)
+	contentType.Description = strings.TrimSpace(req.Description)
+	contentType.Fields = fields
+	if err := s.repo.UpdateType(ctx, contentType); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update content type: %v", err)
+	}
+	s.syncFieldIndexes(ctx, contentType)
+
+	return convertContentTypeToProto(contentType), nil
+}
+
+// DeleteContentType deletes a content type. Types that still have entries are only
+// deleted, together with their entries, when force is set.
+func (s *EntryService) DeleteContentType(ctx context.Context, req *entryv1.DeleteContentTypeRequest) (*emptypb.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content type ID is required")
+	}
+	contentType, err := s.getContentTypeByIDOrSlug(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureNotReferenced(ctx, contentType, "deleted"); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountEntries(ctx, contentType.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count entries: %v", err)
+	}
+	if count > 0 && !req.Force {
+		return nil, status.Errorf(codes.FailedPrecondition, "content type '%s' has %d entries; set force to delete them too", contentType.Slug, count)
+	}
+
+	if err := s.repo.DeleteType(ctx, contentType.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete content type: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Entries
+
+// CreateEntry validates the data of a new entry against its content type and stores it as a draft,
+// or published when requested
+func (s *EntryService) CreateEntry(ctx context.Context, req *entryv1.CreateEntryRequest) (*entryv1.Entry, error) {
+	if req.ContentType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content type is required")
+	}
+	contentType, err := s.getContentTypeByIDOrSlug(ctx, req.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEntrySlug(req.Slug); err != nil {
+		return nil, err
+	}
+	if err := s.validateEntrySlugUniqueness(ctx, contentType.ID, req.Slug, ""); err != nil {
+		return nil, err
+	}
+	data, err := s.validateEntryData(ctx, contentType, req.Data.AsMap())
+	if err != nil {
+		return nil, err
+	}
+
+	entry := models.NewEntry(contentType.ID, req.Slug)
+	entry.Data = data
+	entry.CreatedBy, _ = ctx.Value("user_id").(string)
+	if req.Publish {
+		now := s.now()
+		entry.Status = models.PageStatusPublished
+		entry.PublishedAt = &now
+	}
+	if err := s.repo.CreateEntry(ctx, entry); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create entry: %v", err)
+	}
+	return convertEntryToProto(entry), nil
+}
+
+// GetEntry returns an entry; only editors and admins see unpublished entries
+func (s *EntryService) GetEntry(ctx context.Context, req *entryv1.GetEntryRequest) (*entryv1.Entry, error) {
+	entry, err := s.getEntry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.IsPublished() && !canReadDrafts(ctx) {
+		return nil, status.Errorf(codes.NotFound, "entry not found")
+	}
+	return convertEntryToProto(entry), nil
+}
+
+// ListEntries lists the entries of a content type. Filters match filterable fields exactly
+// (list fields match when any item equals the value) and only editors and admins see unpublished entries.
+func (s *EntryService) ListEntries(ctx context.Context, req *entryv1.ListEntriesRequest) (*entryv1.ListEntriesResponse, error) {
+	if req.ContentType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content type is required")
+	}
+	contentType, err := s.getContentTypeByIDOrSlug(ctx, req.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := repository.EntryQuery{
+		ContentTypeID: contentType.ID,
+		Status:        req.Status,
+		Filter:        make(map[string]interface{}, len(req.Filters)),
+	}
+	switch req.Status {
+	case "", models.PageStatusDraft, models.PageStatusPublished, models.PageStatusArchived:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status '%s'", req.Status)
+	}
+	if !canReadDrafts(ctx) {
+		query.Status = models.PageStatusPublished
+	}
+
+	for key, raw := range req.Filters {
+		field, ok := contentType.Field(key)
+		if !ok || !field.Filterable {
+			return nil, status.Errorf(codes.InvalidArgument, "'%s' is not a filterable field", key)
+		}
+		value, err := parseEntryFilter(field, raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter '%s': %v", key, err)
+		}
+		query.Filter[key] = value
+	}
+
+	switch {
+	case req.SortBy == "" || entrySortColumns[req.SortBy]:
+		query.Options.SortBy = req.SortBy
+	default:
+		field, ok := contentType.Field(req.SortBy)
+		if !ok || !field.Filterable {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot sort by '%s'", req.SortBy)
+		}
+		query.SortField = field.Key
+	}
+	switch req.Order {
+	case "", "asc", "desc":
+		query.Options.Order = req.Order
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "order must be 'asc' or 'desc'")
+	}
+	query.Options.Limit, query.Options.Skip = webhookPagination(req.PageSize, req.PageToken)
+
+	entries, info, err := s.repo.ListEntries(ctx, query)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %v", err)
+	}
+
+	resp := &entryv1.ListEntriesResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, convertEntryToProto(entry))
+	}
+	if info != nil {
+		resp.NextPageToken = info.NextPageToken
+		resp.TotalCount = int32(info.TotalCount)
+	}
+	return resp, nil
+}
+
+// UpdateEntry replaces the slug and data of an entry; an empty slug keeps the current one
+func (s *EntryService) UpdateEntry(ctx context.Context, req *entryv1.UpdateEntryRequest) (*entryv1.Entry, error) {
+	entry, err := s.getEntry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	contentType, err := s.repo.GetType(ctx, entry.ContentTypeID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load content type: %v", err)
+	}
+
+	if req.Slug != "" && req.Slug != entry.Slug {
+		if err := validateEntrySlug(req.Slug); err != nil {
+			return nil, err
+		}
+		if err := s.validateEntrySlugUniqueness(ctx, contentType.ID, req.Slug, entry.ID); err != nil {
+			return nil, err
+		}
+		entry.Slug = req.Slug
+	}
+	data, err := s.validateEntryData(ctx, contentType, req.Data.AsMap())
+	if err != nil {
+		return nil, err
+	}
+	entry.Data = data
+
+	if err := s.repo.UpdateEntry(ctx, entry); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update entry: %v", err)
+	}
+	return convertEntryToProto(entry), nil
+}
+
+// DeleteEntry deletes an entry
+func (s *EntryService) DeleteEntry(ctx context.Context, req *entryv1.DeleteEntryRequest) (*emptypb.Empty, error) {
+	entry, err := s.getEntry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteEntry(ctx, entry.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete entry: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PublishEntry publishes an entry, keeping the publish date of a previously published entry
+func (s *EntryService) PublishEntry(ctx context.Context, req *entryv1.PublishEntryRequest) (*entryv1.Entry, error) {
+	entry, err := s.getEntry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.IsPublished() {
+		return convertEntryToProto(entry), nil
+	}
+
+	entry.Status = models.PageStatusPublished
+	if entry.PublishedAt == nil {
+		now := s.now()
+		entry.PublishedAt = &now
+	}
+	if err := s.repo.UpdateEntry(ctx, entry); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish entry: %v", err)
+	}
+	return convertEntryToProto(entry), nil
+}
+
+// UnpublishEntry moves an entry back to draft
+func (s *EntryService) UnpublishEntry(ctx context.Context, req *entryv1.UnpublishEntryRequest) (*entryv1.Entry, error) {
+	entry, err := s.getEntry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status == models.PageStatusDraft {
+		return convertEntryToProto(entry), nil
+	}
+
+	entry.Status = models.PageStatusDraft
+	if err := s.repo.UpdateEntry(ctx, entry); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unpublish entry: %v", err)
+	}
+	return convertEntryToProto(entry), nil
+}
+
+// Helpers
+
+// canReadDrafts reports whether the caller may see unpublished content
+func canReadDrafts(ctx context.Context) bool {
+	role, _ := ctx.Value("user_role").(string)
+	return role == models.UserRoleAdmin || role == models.UserRoleEditor
+}
+
+func (s *EntryService) getEntry(ctx context.Context, id string) (*models.Entry, error) {
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "entry ID is required")
+	}
+	entry, err := s.repo.GetEntry(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "entry not found: %v", err)
+	}
+	return entry, nil
+}
+
+// getContentTypeByIDOrSlug looks a content type up by slug first, so clients can use
+// stable names such as "case-study" instead of IDs
+func (s *EntryService) getContentTypeByIDOrSlug(ctx context.Context, idOrSlug string) (*models.ContentType, error) {
+	if contentType, err := s.repo.GetTypeBySlug(ctx, idOrSlug); err == nil {
+		return contentType, nil
+	}
+	contentType, err := s.repo.GetType(ctx, idOrSlug)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "content type not found: %v", err)
+	}
+	return contentType, nil
+}
+
+// ensureNotReferenced fails when another content type has reference fields pointing at this one
+func (s *EntryService) ensureNotReferenced(ctx context.Context, contentType *models.ContentType, action string) error {
+	for skip := 0; ; skip += contentTypeScanSize {
+		types, err := s.repo.ListTypes(ctx, repository.ListOptions{Limit: contentTypeScanSize, Skip: skip})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list content types: %v", err)
+		}
+		for _, other := range types {
+			if other.ID == contentType.ID {
+				continue
+			}
+			for _, field := range other.Fields {
+				if field.ReferenceType == contentType.Slug {
+					return status.Errorf(codes.FailedPrecondition, "content type '%s' cannot be %s: field '%s' of '%s' references it",
+						contentType.Slug, action, field.Key, other.Slug)
+				}
+			}
+		}
+		if len(types) < contentTypeScanSize {
+			return nil
+		}
+	}
+}
+
+// syncFieldIndexes indexes the filterable fields of a content type. Failures are logged
+// rather than returned: filters still work, scanning the entries of the type instead.
+func (s *EntryService) syncFieldIndexes(ctx context.Context, contentType *models.ContentType) {
+	if err := s.repo.SyncFieldIndexes(ctx, contentType.ID, contentType.FilterableKeys()); err != nil {
+		logger.Error("Failed to sync entry field indexes", err, "content_type", contentType.Slug)
+	}
+}
+
+func (s *EntryService) validateEntrySlugUniqueness(ctx context.Context, contentTypeID, slug, excludeID string) error {
+	existing, err := s.repo.GetEntryBySlug(ctx, contentTypeID, slug)
+	if err == nil && existing != nil && existing.ID != excludeID {
+		return status.Errorf(codes.AlreadyExists, "entry with slug '%s' already exists", slug)
+	}
+	return nil
+}
+
+func validateEntrySlug(slug string) error {
+	if slug == "" {
+		return status.Errorf(codes.InvalidArgument, "slug is required")
+	}
+	if len(slug) > 100 {
+		return status.Errorf(codes.InvalidArgument, "slug must be less than 100 characters")
+	}
+	if !entrySlugPattern.MatchString(slug) {
+		return status.Errorf(codes.InvalidArgument, "slug may only contain lower-case letters, digits and single hyphens")
+	}
+	return nil
+}
+
+// validateContentType checks the name, slug and field definitions of a content type
+func (s *EntryService) validateContentType(ctx context.Context, name, slug string, fields []models.ContentTypeField) error {
+	if strings.TrimSpace(name) == "" {
+		return status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > 200 {
+		return status.Errorf(codes.InvalidArgument, "name must be less than 200 characters")
+	}
+	if err := validateEntrySlug(slug); err != nil {
+		return err
+	}
+	if len(fields) > maxContentTypeFields {
+		return status.Errorf(codes.InvalidArgument, "a content type can have at most %d fields", maxContentTypeFields)
+	}
+
+	seen := make(map[string]bool, len(fields))
+	for i := range fields {
+		field := &fields[i]
+		if !entryFieldKeyPattern.MatchString(field.Key) {
+			return status.Errorf(codes.InvalidArgument, "field key '%s' must start with a letter and contain only lower-case letters, digits and underscores", field.Key)
+		}
+		if seen[field.Key] {
+			return status.Errorf(codes.InvalidArgument, "field '%s' is defined more than once", field.Key)
+		}
+		seen[field.Key] = true
+		if strings.TrimSpace(field.Label) == "" {
+			field.Label = field.Key
+		}
+
+		valueType := field.Type
+		switch field.Type {
+		case "":
+			return status.Errorf(codes.InvalidArgument, "field '%s' needs a type", field.Key)
+		case models.ContentFieldTypeList:
+			if field.ItemType == "" || field.ItemType == models.ContentFieldTypeList {
+				return status.Errorf(codes.InvalidArgument, "list field '%s' needs a non-list item type", field.Key)
+			}
+			valueType = field.ItemType
+		default:
+			if field.ItemType != "" {
+				return status.Errorf(codes.InvalidArgument, "field '%s' is not a list and cannot have an item type", field.Key)
+			}
+		}
+		if valueType == models.ContentFieldTypeRichText && field.Filterable {
+			return status.Errorf(codes.InvalidArgument, "rich text field '%s' cannot be filterable", field.Key)
+		}
+
+		if valueType == models.ContentFieldTypeReference {
+			if field.ReferenceType == "" {
+				return status.Errorf(codes.InvalidArgument, "reference field '%s' needs a reference type", field.Key)
+			}
+			if field.ReferenceType != slug {
+				if _, err := s.repo.GetTypeBySlug(ctx, field.ReferenceType); err != nil {
+					return status.Errorf(codes.InvalidArgument, "field '%s' references unknown content type '%s'", field.Key, field.ReferenceType)
+				}
+			}
+		} else if field.ReferenceType != "" {
+			return status.Errorf(codes.InvalidArgument, "field '%s' is not a reference and cannot have a reference type", field.Key)
+		}
+
+		if field.Pattern != "" {
+			if _, err := regexp.Compile(field.Pattern); err != nil {
+				return status.Errorf(codes.InvalidArgument, "field '%s' has an invalid pattern: %v", field.Key, err)
+			}
+		}
+		if field.MinLength != nil && field.MaxLength != nil && *field.MinLength > *field.MaxLength {
+			return status.Errorf(codes.InvalidArgument, "field '%s' has min_length greater than max_length", field.Key)
+		}
+		if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+			return status.Errorf(codes.InvalidArgument, "field '%s' has min greater than max", field.Key)
+		}
+		if field.MinItems != nil && field.MaxItems != nil && *field.MinItems > *field.MaxItems {
+			return status.Errorf(codes.InvalidArgument, "field '%s' has min_items greater than max_items", field.Key)
+		}
+	}
+	return nil
+}
+
+// validateEntryData checks entry data against the fields of its content type and returns it
+// normalized: unknown keys are rejected, nulls dropped, dates stored as RFC 3339 UTC and rich text escaped
+func (s *EntryService) validateEntryData(ctx context.Context, contentType *models.ContentType, data map[string]interface{}) (map[string]interface{}, error) {
+	for key := range data {
+		if _, ok := contentType.Field(key); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field '%s'", key)
+		}
+	}
+
+	out := make(map[string]interface{}, len(data))
+	for i := range contentType.Fields {
+		field := &contentType.Fields[i]
+		value, present := data[field.Key]
+		if !present || value == nil || value == "" {
+			if field.Required {
+				return nil, status.Errorf(codes.InvalidArgument, "field '%s' is required", field.Key)
+			}
+			continue
+		}
+
+		if field.Type != models.ContentFieldTypeList {
+			normalized, err := s.validateFieldValue(ctx, field, field.Type, value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "field '%s': %v", field.Key, err)
+			}
+			out[field.Key] = normalized
+			continue
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field '%s' must be a list", field.Key)
+		}
+		if len(items) == 0 && field.Required {
+			return nil, status.Errorf(codes.InvalidArgument, "field '%s' is required", field.Key)
+		}
+		if field.MinItems != nil && len(items) < *field.MinItems {
+			return nil, status.Errorf(codes.InvalidArgument, "field '%s' needs at least %d items", field.Key, *field.MinItems)
+		}
+		if field.MaxItems != nil && len(items) > *field.MaxItems {
+			return nil, status.Errorf(codes.InvalidArgument, "field '%s' can have at most %d items", field.Key, *field.MaxItems)
+		}
+		normalized := make([]interface{}, 0, len(items))
+		for n, item := range items {
+			if item == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "field '%s' item %d is empty", field.Key, n)
+			}
+			value, err := s.validateFieldValue(ctx, field, field.ItemType, item)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "field '%s' item %d: %v", field.Key, n, err)
+			}
+			normalized = append(normalized, value)
+		}
+		out[field.Key] = normalized
+	}
+	return out, nil
+}
+
+// validateFieldValue checks one value, or one list item, of the given type against the field's constraints
+func (s *EntryService) validateFieldValue(ctx context.Context, field *models.ContentTypeField, valueType string, value interface{}) (interface{}, error) {
+	switch valueType {
+	case models.ContentFieldTypeText, models.ContentFieldTypeRichText:
+		text, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a string")
+		}
+		length := utf8.RuneCountInString(text)
+		if field.MinLength != nil && length < *field.MinLength {
+			return nil, fmt.Errorf("must be at least %d characters", *field.MinLength)
+		}
+		if field.MaxLength != nil && length > *field.MaxLength {
+			return nil, fmt.Errorf("must be at most %d characters", *field.MaxLength)
+		}
+		if field.Pattern != "" {
+			if matched, _ := regexp.MatchString(field.Pattern, text); !matched {
+				return nil, fmt.Errorf("must match %s", field.Pattern)
+			}
+		}
+		if len(field.Options) > 0 && !containsString(field.Options, text) {
+			return nil, fmt.Errorf("must be one of %s", strings.Join(field.Options, ", "))
+		}
+		if valueType == models.ContentFieldTypeRichText {
+			// HTML escape rich text to prevent XSS, as content blocks are
+			return html.EscapeString(text), nil
+		}
+		return text, nil
+
+	case models.ContentFieldTypeNumber:
+		number, ok := value.(float64)
+		if !ok || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("must be a number")
+		}
+		if field.Min != nil && number < *field.Min {
+			return nil, fmt.Errorf("must be at least %g", *field.Min)
+		}
+		if field.Max != nil && number > *field.Max {
+			return nil, fmt.Errorf("must be at most %g", *field.Max)
+		}
+		return number, nil
+
+	case models.ContentFieldTypeBoolean:
+		flag, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("must be a boolean")
+		}
+		return flag, nil
+
+	case models.ContentFieldTypeDate:
+		text, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a date string")
+		}
+		return normalizeEntryDate(text)
+
+	case models.ContentFieldTypeMedia:
+		id, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a media ID")
+		}
+		if s.mediaRepo != nil {
+			if _, err := s.mediaRepo.GetByID(ctx, id); err != nil {
+				return nil, fmt.Errorf("media '%s' does not exist", id)
+			}
+		}
+		return id, nil
+
+	case models.ContentFieldTypeReference:
+		id, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be an entry ID")
+		}
+		target, err := s.repo.GetTypeBySlug(ctx, field.ReferenceType)
+		if err != nil {
+			return nil, fmt.Errorf("content type '%s' does not exist", field.ReferenceType)
+		}
+		entry, err := s.repo.GetEntry(ctx, id)
+		if err != nil || entry.ContentTypeID != target.ID {
+			return nil, fmt.Errorf("'%s' is not a %s entry", id, field.ReferenceType)
+		}
+		return id, nil
+	}
+	return nil, fmt.Errorf("unknown field type '%s'", valueType)
+}
+
+// parseEntryFilter converts a query string filter value to the stored JSON form of the field,
+// wrapping list items in a list so containment matches any item
+func parseEntryFilter(field *models.ContentTypeField, raw string) (interface{}, error) {
+	valueType := field.Type
+	if valueType == models.ContentFieldTypeList {
+		valueType = field.ItemType
+	}
+
+	var value interface{}
+	switch valueType {
+	case models.ContentFieldTypeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("'%s' is not a number", raw)
+		}
+		value = number
+	case models.ContentFieldTypeBoolean:
+		flag, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a boolean", raw)
+		}
+		value = flag
+	case models.ContentFieldTypeDate:
+		date, err := normalizeEntryDate(raw)
+		if err != nil {
+			return nil, err
+		}
+		value = date
+	default:
+		value = raw
+	}
+
+	if field.Type == models.ContentFieldTypeList {
+		return []interface{}{value}, nil
+	}
+	return value, nil
+}
+
+// normalizeEntryDate accepts RFC 3339 timestamps and YYYY-MM-DD dates and returns RFC 3339 in UTC
+func normalizeEntryDate(text string) (string, error) {
+	if t, err := time.Parse(time.RFC3339, text); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	if t, err := time.Parse("2006-01-02", text); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+	return "", fmt.Errorf("'%s' is not an RFC 3339 timestamp or YYYY-MM-DD date", text)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Conversion helpers
+
+var fieldTypeToProto = map[string]entryv1.FieldType{
+	models.ContentFieldTypeText:      entryv1.FieldType_FIELD_TYPE_TEXT,
+	models.ContentFieldTypeRichText:  entryv1.FieldType_FIELD_TYPE_RICH_TEXT,
+	models.ContentFieldTypeNumber:    entryv1.FieldType_FIELD_TYPE_NUMBER,
+	models.ContentFieldTypeDate:      entryv1.FieldType_FIELD_TYPE_DATE,
+	models.ContentFieldTypeBoolean:   entryv1.FieldType_FIELD_TYPE_BOOLEAN,
+	models.ContentFieldTypeMedia:     entryv1.FieldType_FIELD_TYPE_MEDIA,
+	models.ContentFieldTypeReference: entryv1.FieldType_FIELD_TYPE_REFERENCE,
+	models.ContentFieldTypeList:      entryv1.FieldType_FIELD_TYPE_LIST,
+}
+
+// convertProtoFieldTypeToModel returns "" for unspecified or unknown types
+func convertProtoFieldTypeToModel(fieldType entryv1.FieldType) string {
+	for name, value := range fieldTypeToProto {
+		if value == fieldType {
+			return name
+		}
+	}
+	return ""
+}
+
+func convertProtoFieldsToModel(fields []*entryv1.ContentTypeField) []models.ContentTypeField {
+	out := make([]models.ContentTypeField, 0, len(fields))
+	for _, f := range fields {
+		field := models.ContentTypeField{
+			Key:           strings.TrimSpace(f.Key),
+			Label:         strings.TrimSpace(f.Label),
+			Type:          convertProtoFieldTypeToModel(f.Type),
+			Required:      f.Required,
+			Filterable:    f.Filterable,
+			ItemType:      convertProtoFieldTypeToModel(f.ItemType),
+			ReferenceType: strings.TrimSpace(f.ReferenceType),
+			Min:           f.Min,
+			Max:           f.Max,
+			Pattern:       f.Pattern,
+			Options:       f.Options,
+		}
+		field.MinLength = int32PtrToInt(f.MinLength)
+		field.MaxLength = int32PtrToInt(f.MaxLength)
+		field.MinItems = int32PtrToInt(f.MinItems)
+		field.MaxItems = int32PtrToInt(f.MaxItems)
+		out = append(out, field)
+	}
+	return out
+}
+
+func convertContentTypeToProto(contentType *models.ContentType) *entryv1.ContentType {
+	fields := make([]*entryv1.ContentTypeField, 0, len(contentType.Fields))
+	for _, f := range contentType.Fields {
+		fields = append(fields, &entryv1.ContentTypeField{
+			Key:           f.Key,
+			Label:         f.Label,
+			Type:          fieldTypeToProto[f.Type],
+			Required:      f.Required,
+			Filterable:    f.Filterable,
+			ItemType:      fieldTypeToProto[f.ItemType],
+			ReferenceType: f.ReferenceType,
+			MinLength:     intPtrToInt32(f.MinLength),
+			MaxLength:     intPtrToInt32(f.MaxLength),
+			Min:           f.Min,
+			Max:           f.Max,
+			Pattern:       f.Pattern,
+			Options:       f.Options,
+			MinItems:      intPtrToInt32(f.MinItems),
+			MaxItems:      intPtrToInt32(f.MaxItems),
+		})
+	}
+	return &entryv1.ContentType{
+		Id:          contentType.ID,
+		Slug:        contentType.Slug,
+		Name:        contentType.Name,
+		Description: contentType.Description,
+		Fields:      fields,
+		CreatedBy:   contentType.CreatedBy,
+		CreatedAt:   timestamppb.New(contentType.CreatedAt),
+		UpdatedAt:   timestamppb.New(contentType.UpdatedAt),
+	}
+}
+
+func convertEntryToProto(entry *models.Entry) *entryv1.Entry {
+	data, err := structpb.NewStruct(entry.Data)
+	if err != nil {
+		logger.Error("Failed to convert entry data", err, "entry_id", entry.ID)
+		data = &structpb.Struct{}
+	}
+	resp := &entryv1.Entry{
+		Id:            entry.ID,
+		ContentTypeId: entry.ContentTypeID,
+		Slug:          entry.Slug,
+		Status:        entry.Status,
+		Data:          data,
+		CreatedBy:     entry.CreatedBy,
+		CreatedAt:     timestamppb.New(entry.CreatedAt),
+		UpdatedAt:     timestamppb.New(entry.UpdatedAt),
+	}
+	if entry.PublishedAt != nil {
+		resp.PublishedAt = timestamppb.New(*entry.PublishedAt)
+	}
+	return resp
+}
+
+func int32PtrToInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func intPtrToInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
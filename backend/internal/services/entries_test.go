@@ -0,0 +1,410 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	entryv1 "github.com/7-solutions/saas-platformbackend/gen/entry/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memoryContentTypeRepo is an in-memory ContentTypeRepository
+type memoryContentTypeRepo struct {
+	types   []*models.ContentType
+	entries []*models.Entry
+	indexes map[string][]string
+}
+
+func newMemoryContentTypeRepo() *memoryContentTypeRepo {
+	return &memoryContentTypeRepo{indexes: map[string][]string{}}
+}
+
+func (r *memoryContentTypeRepo) CreateType(ctx context.Context, contentType *models.ContentType) error {
+	contentType.ID = fmt.Sprintf("type-%d", len(r.types)+1)
+	r.types = append(r.types, contentType)
+	return nil
+}
+
+func (r *memoryContentTypeRepo) GetType(ctx context.Context, id string) (*models.ContentType, error) {
+	for _, t := range r.types {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryContentTypeRepo) GetTypeBySlug(ctx context.Context, slug string) (*models.ContentType, error) {
+	for _, t := range r.types {
+		if t.Slug == slug {
+			return t, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryContentTypeRepo) ListTypes(ctx context.Context, options repository.ListOptions) ([]*models.ContentType, error) {
+	if options.Skip >= len(r.types) {
+		return nil, nil
+	}
+	end := options.Skip + options.Limit
+	if end > len(r.types) {
+		end = len(r.types)
+	}
+	return r.types[options.Skip:end], nil
+}
+
+func (r *memoryContentTypeRepo) UpdateType(ctx context.Context, contentType *models.ContentType) error {
+	return nil
+}
+
+func (r *memoryContentTypeRepo) DeleteType(ctx context.Context, id string) error {
+	for i, t := range r.types {
+		if t.ID == id {
+			r.types = append(r.types[:i], r.types[i+1:]...)
+			delete(r.indexes, id)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (r *memoryContentTypeRepo) SyncFieldIndexes(ctx context.Context, contentTypeID string, keys []string) error {
+	r.indexes[contentTypeID] = keys
+	return nil
+}
+
+func (r *memoryContentTypeRepo) CountEntries(ctx context.Context, contentTypeID string) (int, error) {
+	count := 0
+	for _, e := range r.entries {
+		if e.ContentTypeID == contentTypeID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryContentTypeRepo) CreateEntry(ctx context.Context, entry *models.Entry) error {
+	entry.ID = fmt.Sprintf("entry-%d", len(r.entries)+1)
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *memoryContentTypeRepo) GetEntry(ctx context.Context, id string) (*models.Entry, error) {
+	for _, e := range r.entries {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryContentTypeRepo) GetEntryBySlug(ctx context.Context, contentTypeID, slug string) (*models.Entry, error) {
+	for _, e := range r.entries {
+		if e.ContentTypeID == contentTypeID && e.Slug == slug {
+			return e, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryContentTypeRepo) UpdateEntry(ctx context.Context, entry *models.Entry) error {
+	return nil
+}
+
+func (r *memoryContentTypeRepo) DeleteEntry(ctx context.Context, id string) error {
+	for i, e := range r.entries {
+		if e.ID == id {
+			r.entries = append(r.entries[:i], r.entries[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+// ListEntries mimics JSONB containment for scalar values and lists, and sorts numbers or strings
+func (r *memoryContentTypeRepo) ListEntries(ctx context.Context, query repository.EntryQuery) ([]*models.Entry, *repository.PaginationInfo, error) {
+	var matched []*models.Entry
+	for _, e := range r.entries {
+		if e.ContentTypeID != query.ContentTypeID || (query.Status != "" && e.Status != query.Status) {
+			continue
+		}
+		if entryContains(e.Data, query.Filter) {
+			matched = append(matched, e)
+		}
+	}
+	if query.SortField != "" {
+		sort.SliceStable(matched, func(i, j int) bool {
+			a, b := fmt.Sprint(matched[i].Data[query.SortField]), fmt.Sprint(matched[j].Data[query.SortField])
+			if x, err := strconv.ParseFloat(a, 64); err == nil {
+				y, _ := strconv.ParseFloat(b, 64)
+				return (x < y) != (query.Options.Order != "asc")
+			}
+			return (a < b) != (query.Options.Order != "asc")
+		})
+	}
+	return matched, &repository.PaginationInfo{TotalCount: len(matched)}, nil
+}
+
+func entryContains(data, filter map[string]interface{}) bool {
+	for key, want := range filter {
+		got := data[key]
+		if items, ok := want.([]interface{}); ok {
+			list, _ := got.([]interface{})
+			found := false
+			for _, item := range list {
+				if reflect.DeepEqual(item, items[0]) {
+					found = true
+				}
+			}
+			if !found {
+				return false
+			}
+		} else if !reflect.DeepEqual(got, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func int32Ptr(n int) *int32 {
+	v := int32(n)
+	return &v
+}
+
+func newTestEntryService(t *testing.T) (*EntryService, *memoryContentTypeRepo, context.Context) {
+	t.Helper()
+	repo := newMemoryContentTypeRepo()
+	service := NewEntryService(repo)
+	service.SetMediaRepository(newMemoryMediaRepo(&models.Media{ID: "media:logo.png", Filename: "logo.png"}))
+	ctx := context.WithValue(context.WithValue(context.Background(), "user_id", "admin-1"), "user_role", models.UserRoleAdmin)
+
+	_, err := service.CreateContentType(ctx, &entryv1.CreateContentTypeRequest{
+		Slug: "office",
+		Name: "Office",
+		Fields: []*entryv1.ContentTypeField{
+			{Key: "city", Type: entryv1.FieldType_FIELD_TYPE_TEXT, Required: true, Filterable: true},
+		},
+	})
+	require.NoError(t, err)
+	_, err = service.CreateContentType(ctx, &entryv1.CreateContentTypeRequest{
+		Slug: "job",
+		Name: "Job",
+		Fields: []*entryv1.ContentTypeField{
+			{Key: "title", Type: entryv1.FieldType_FIELD_TYPE_TEXT, Required: true, MaxLength: int32Ptr(20)},
+			{Key: "level", Type: entryv1.FieldType_FIELD_TYPE_TEXT, Options: []string{"junior", "senior"}, Filterable: true},
+			{Key: "salary", Type: entryv1.FieldType_FIELD_TYPE_NUMBER, Min: float64Ptr(0), Filterable: true},
+			{Key: "remote", Type: entryv1.FieldType_FIELD_TYPE_BOOLEAN, Filterable: true},
+			{Key: "starts_on", Type: entryv1.FieldType_FIELD_TYPE_DATE},
+			{Key: "body", Type: entryv1.FieldType_FIELD_TYPE_RICH_TEXT},
+			{Key: "logo", Type: entryv1.FieldType_FIELD_TYPE_MEDIA},
+			{Key: "office", Type: entryv1.FieldType_FIELD_TYPE_REFERENCE, ReferenceType: "office"},
+			{Key: "skills", Type: entryv1.FieldType_FIELD_TYPE_LIST, ItemType: entryv1.FieldType_FIELD_TYPE_TEXT, MaxItems: int32Ptr(3), Filterable: true},
+		},
+	})
+	require.NoError(t, err)
+	return service, repo, ctx
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+func mustStruct(t *testing.T, data map[string]interface{}) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(data)
+	require.NoError(t, err)
+	return s
+}
+
+func TestEntryService_CreateContentTypeValidatesFields(t *testing.T) {
+	service, repo, ctx := newTestEntryService(t)
+
+	job, err := repo.GetTypeBySlug(ctx, "job")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"level", "salary", "remote", "skills"}, repo.indexes[job.ID])
+	assert.Equal(t, "title", job.Fields[0].Label, "label defaults to the key")
+
+	cases := map[string][]*entryv1.ContentTypeField{
+		"bad key":         {{Key: "Title", Type: entryv1.FieldType_FIELD_TYPE_TEXT}},
+		"duplicate key":   {{Key: "a", Type: entryv1.FieldType_FIELD_TYPE_TEXT}, {Key: "a", Type: entryv1.FieldType_FIELD_TYPE_NUMBER}},
+		"missing type":    {{Key: "a"}},
+		"nested list":     {{Key: "a", Type: entryv1.FieldType_FIELD_TYPE_LIST, ItemType: entryv1.FieldType_FIELD_TYPE_LIST}},
+		"unknown target":  {{Key: "a", Type: entryv1.FieldType_FIELD_TYPE_REFERENCE, ReferenceType: "nope"}},
+		"bad pattern":     {{Key: "a", Type: entryv1.FieldType_FIELD_TYPE_TEXT, Pattern: "("}},
+		"rich filterable": {{Key: "a", Type: entryv1.FieldType_FIELD_TYPE_RICH_TEXT, Filterable: true}},
+	}
+	for name, fields := range cases {
+		_, err := service.CreateContentType(ctx, &entryv1.CreateContentTypeRequest{Slug: "bad", Name: "Bad", Fields: fields})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	_, err = service.CreateContentType(ctx, &entryv1.CreateContentTypeRequest{Slug: "job", Name: "Job again"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestEntryService_UpdateContentTypeSyncsFieldIndexes(t *testing.T) {
+	service, repo, ctx := newTestEntryService(t)
+
+	office, err := repo.GetTypeBySlug(ctx, "office")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"city"}, repo.indexes[office.ID])
+
+	_, err = service.UpdateContentType(ctx, &entryv1.UpdateContentTypeRequest{
+		Id:   office.ID,
+		Name: "Office",
+		Fields: []*entryv1.ContentTypeField{
+			{Key: "city", Type: entryv1.FieldType_FIELD_TYPE_TEXT, Required: true},
+			{Key: "country", Type: entryv1.FieldType_FIELD_TYPE_TEXT, Filterable: true},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"country"}, repo.indexes[office.ID], "only filterable fields stay indexed")
+}
+
+func TestEntryService_CreateEntryValidatesAndNormalizesData(t *testing.T) {
+	service, _, ctx := newTestEntryService(t)
+
+	office, err := service.CreateEntry(ctx, &entryv1.CreateEntryRequest{
+		ContentType: "office", Slug: "berlin", Data: mustStruct(t, map[string]interface{}{"city": "Berlin"}),
+	})
+	require.NoError(t, err)
+
+	entry, err := service.CreateEntry(ctx, &entryv1.CreateEntryRequest{
+		ContentType: "job",
+		Slug:        "backend-engineer",
+		Publish:     true,
+		Data: mustStruct(t, map[string]interface{}{
+			"title":     "Backend Engineer",
+			"level":     "senior",
+			"salary":    90000,
+			"remote":    true,
+			"starts_on": "2026-01-15",
+			"body":      "<b>Go</b>",
+			"logo":      "media:logo.png",
+			"office":    office.Id,
+			"skills":    []interface{}{"go", "sql"},
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.PageStatusPublished, entry.Status)
+	assert.NotNil(t, entry.PublishedAt)
+	data := entry.Data.AsMap()
+	assert.Equal(t, "2026-01-15T00:00:00Z", data["starts_on"])
+	assert.Equal(t, "&lt;b&gt;Go&lt;/b&gt;", data["body"])
+
+	invalid := map[string]map[string]interface{}{
+		"missing required": {"level": "junior"},
+		"unknown field":    {"title": "x", "colour": "red"},
+		"too long":         {"title": "a title that is far too long"},
+		"not an option":    {"title": "x", "level": "lead"},
+		"below min":        {"title": "x", "salary": -1},
+		"wrong type":       {"title": "x", "remote": "yes"},
+		"bad date":         {"title": "x", "starts_on": "next week"},
+		"missing media":    {"title": "x", "logo": "media:gone.png"},
+		"wrong reference":  {"title": "x", "office": entry.Id},
+		"too many items":   {"title": "x", "skills": []interface{}{"a", "b", "c", "d"}},
+		"bad item":         {"title": "x", "skills": []interface{}{"a", 1}},
+	}
+	for name, data := range invalid {
+		_, err := service.CreateEntry(ctx, &entryv1.CreateEntryRequest{ContentType: "job", Slug: "invalid", Data: mustStruct(t, data)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	_, err = service.CreateEntry(ctx, &entryv1.CreateEntryRequest{ContentType: "job", Slug: "backend-engineer", Data: mustStruct(t, map[string]interface{}{"title": "x"})})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestEntryService_ListEntriesFiltersAndHidesDrafts(t *testing.T) {
+	service, _, ctx := newTestEntryService(t)
+
+	create := func(slug string, publish bool, data map[string]interface{}) {
+		_, err := service.CreateEntry(ctx, &entryv1.CreateEntryRequest{ContentType: "job", Slug: slug, Publish: publish, Data: mustStruct(t, data)})
+		require.NoError(t, err)
+	}
+	create("a", true, map[string]interface{}{"title": "A", "salary": 50000, "remote": true, "skills": []interface{}{"go"}})
+	create("b", true, map[string]interface{}{"title": "B", "salary": 70000, "remote": false, "skills": []interface{}{"go", "sql"}})
+	create("c", false, map[string]interface{}{"title": "C", "salary": 60000, "remote": true})
+
+	slugs := func(resp *entryv1.ListEntriesResponse) []string {
+		out := []string{}
+		for _, e := range resp.Entries {
+			out = append(out, e.Slug)
+		}
+		return out
+	}
+
+	resp, err := service.ListEntries(ctx, &entryv1.ListEntriesRequest{ContentType: "job", Filters: map[string]string{"remote": "true"}, SortBy: "salary"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "a"}, slugs(resp))
+
+	resp, err = service.ListEntries(ctx, &entryv1.ListEntriesRequest{ContentType: "job", Filters: map[string]string{"skills": "sql"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, slugs(resp), "list filters match any item")
+
+	viewer := context.WithValue(context.Background(), "user_role", models.UserRoleViewer)
+	for _, reader := range []context.Context{context.Background(), viewer} {
+		resp, err = service.ListEntries(reader, &entryv1.ListEntriesRequest{ContentType: "job", Status: models.PageStatusDraft, SortBy: "salary", Order: "asc"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, slugs(resp), "readers only see published entries")
+	}
+
+	_, err = service.ListEntries(ctx, &entryv1.ListEntriesRequest{ContentType: "job", Filters: map[string]string{"title": "A"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "title is not filterable")
+	_, err = service.ListEntries(ctx, &entryv1.ListEntriesRequest{ContentType: "job", Filters: map[string]string{"salary": "lots"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.ListEntries(ctx, &entryv1.ListEntriesRequest{ContentType: "job", SortBy: "body"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEntryService_PublishAndVisibility(t *testing.T) {
+	service, _, ctx := newTestEntryService(t)
+	entry, err := service.CreateEntry(ctx, &entryv1.CreateEntryRequest{ContentType: "office", Slug: "paris", Data: mustStruct(t, map[string]interface{}{"city": "Paris"})})
+	require.NoError(t, err)
+
+	_, err = service.GetEntry(context.Background(), &entryv1.GetEntryRequest{Id: entry.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err), "drafts are hidden from anonymous callers")
+	viewer := context.WithValue(context.Background(), "user_role", models.UserRoleViewer)
+	_, err = service.GetEntry(viewer, &entryv1.GetEntryRequest{Id: entry.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err), "drafts are hidden from viewers")
+
+	published, err := service.PublishEntry(ctx, &entryv1.PublishEntryRequest{Id: entry.Id})
+	require.NoError(t, err)
+	assert.Equal(t, models.PageStatusPublished, published.Status)
+	_, err = service.GetEntry(context.Background(), &entryv1.GetEntryRequest{Id: entry.Id})
+	assert.NoError(t, err)
+
+	unpublished, err := service.UnpublishEntry(ctx, &entryv1.UnpublishEntryRequest{Id: entry.Id})
+	require.NoError(t, err)
+	assert.Equal(t, models.PageStatusDraft, unpublished.Status)
+	assert.Equal(t, published.PublishedAt.AsTime(), unpublished.PublishedAt.AsTime(), "the first publish date is kept")
+}
+
+func TestEntryService_DeleteContentType(t *testing.T) {
+	service, repo, ctx := newTestEntryService(t)
+	_, err := service.CreateEntry(ctx, &entryv1.CreateEntryRequest{ContentType: "job", Slug: "a", Data: mustStruct(t, map[string]interface{}{"title": "A"})})
+	require.NoError(t, err)
+
+	_, err = service.DeleteContentType(ctx, &entryv1.DeleteContentTypeRequest{Id: "office"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "job references office")
+
+	_, err = service.DeleteContentType(ctx, &entryv1.DeleteContentTypeRequest{Id: "job"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "job has entries")
+
+	_, err = service.DeleteContentType(ctx, &entryv1.DeleteContentTypeRequest{Id: "job", Force: true})
+	require.NoError(t, err)
+	_, err = service.DeleteContentType(ctx, &entryv1.DeleteContentTypeRequest{Id: "office"})
+	require.NoError(t, err)
+	assert.Empty(t, repo.types)
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+var _ FeaturedImageUsageFinder = (*ContentService)(nil)
+
+// normalizeFeaturedImage checks that a post's featured image is an existing image and stores
+// it as a media ID. Legacy /uploads/ URLs of known files are converted to their media ID.
+func (s *ContentService) normalizeFeaturedImage(ctx context.Context, post *models.BlogPost) error {
+	ref := strings.TrimSpace(post.FeaturedImage)
+	if ref == "" {
+		post.FeaturedImage = ""
+		return nil
+	}
+	if s.mediaRepo == nil {
+		return status.Errorf(codes.FailedPrecondition, "media references are not configured")
+	}
+
+	media, err := s.mediaRepo.GetByID(ctx, ref)
+	if err != nil {
+		filename := uploadFilename(ref)
+		if filename == "" {
+			return status.Errorf(codes.InvalidArgument, "featured image media '%s' does not exist", ref)
+		}
+		if media, err = s.mediaRepo.GetByFilename(ctx, filename); err != nil {
+			return status.Errorf(codes.InvalidArgument, "featured image media '%s' does not exist", ref)
+		}
+	}
+	if !strings.HasPrefix(media.MimeType, "image/") {
+		return status.Errorf(codes.InvalidArgument, "featured image media '%s' is not an image", ref)
+	}
+	post.FeaturedImage = media.ID
+	return nil
+}
+
+// uploadFilename returns the file name of an /uploads/ URL, absolute or site-relative
+func uploadFilename(ref string) string {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	dir, file := path.Split(path.Clean(u.Path))
+	if dir != "/uploads/" || file == "" {
+		return ""
+	}
+	return file
+}
+
+// attachFeaturedImages embeds the resolved featured image of each post, fetching them in a
+// single call when the media repository supports it. Missing media leaves the field unset.
+func (s *ContentService) attachFeaturedImages(ctx context.Context, posts ...*contentv1.BlogPost) {
+	if s.mediaRepo == nil || len(posts) == 0 {
+		return
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	for _, post := range posts {
+		if post.FeaturedImage != "" && !seen[post.FeaturedImage] {
+			seen[post.FeaturedImage] = true
+			ids = append(ids, post.FeaturedImage)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	files, err := s.mediaRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to resolve featured images", err)
+		return
+	}
+	found := make(map[string]*models.Media, len(files))
+	for _, media := range files {
+		found[media.ID] = media
+	}
+
+	for _, post := range posts {
+		if media, ok := found[post.FeaturedImage]; ok {
+			post.FeaturedImageMedia = convertMediaToImageAsset(media)
+		}
+	}
+}
+
+// FindFeaturedImageUsages returns the blog posts, in any status, whose featured image is the media file
+func (s *ContentService) FindFeaturedImageUsages(ctx context.Context, mediaID string) ([]*models.BlogPost, error) {
+	var posts []*models.BlogPost
+	for skip := 0; ; skip += linkScanBatchSize {
+		batch, err := s.blogRepo.List(ctx, repository.ListOptions{Limit: linkScanBatchSize, Skip: skip})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list blog posts: %w", err)
+		}
+		for _, post := range batch {
+			if post.FeaturedImage == mediaID {
+				posts = append(posts, post)
+			}
+		}
+		if len(batch) < linkScanBatchSize {
+			return posts, nil
+		}
+	}
+}
+
+func convertMediaToImageAsset(media *models.Media) *contentv1.ImageAsset {
+	asset := &contentv1.ImageAsset{
+		Id:       media.ID,
+		Url:      media.URL,
+		Width:    int32(media.Width),
+		Height:   int32(media.Height),
+		AltText:  media.AltText,
+		MimeType: media.MimeType,
+	}
+	for _, variant := range media.Variants {
+		asset.Variants = append(asset.Variants, &contentv1.ImageVariant{
+			Name:   variant.Name,
+			Url:    variant.URL,
+			Width:  int32(variant.Width),
+			Height: int32(variant.Height),
+		})
+	}
+	return asset
+}
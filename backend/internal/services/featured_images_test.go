@@ -0,0 +1,175 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	mediav1 "github.com/7-solutions/saas-platformbackend/gen/media/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+func newFeaturedImageTestService() (*ContentService, *countingMediaRepo) {
+	cover := models.NewMedia("cover.png", "cover.png", "image/png", "user-1", 1)
+	cover.Width, cover.Height = 1600, 900
+	cover.AltText = "A mountain at dawn"
+	cover.Variants = []models.MediaVariant{
+		{Name: "thumbnail", Filename: "cover-thumbnail.png", URL: "/uploads/cover-thumbnail.png", Width: 320, Height: 180},
+	}
+
+	media := &countingMediaRepo{memoryMediaRepo: newMemoryMediaRepo(
+		cover,
+		models.NewMedia("terms.pdf", "terms.pdf", "application/pdf", "user-1", 1),
+	)}
+	service := NewContentService(&memoryPageRepo{}, &memoryBlogRepo{posts: map[string]*models.BlogPost{}})
+	service.SetMediaRepository(media)
+	return service, media
+}
+
+func TestContentService_FeaturedImageValidation(t *testing.T) {
+	service, _ := newFeaturedImageTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		image    string
+		code     codes.Code
+		storedAs string
+	}{
+		{name: "media ID", image: "media:cover.png", storedAs: "media:cover.png"},
+		{name: "legacy upload URL", image: "https://cdn.example.com/uploads/cover.png", storedAs: "media:cover.png"},
+		{name: "missing media", image: "media:deleted.png", code: codes.InvalidArgument},
+		{name: "broken URL", image: "https://example.com/broken.png", code: codes.InvalidArgument},
+		{name: "not an image", image: "media:terms.pdf", code: codes.InvalidArgument},
+		{name: "none", image: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, err := service.CreateBlogPost(ctx, &contentv1.CreateBlogPostRequest{
+				Title:         "Featured " + tt.name,
+				Author:        "user-1",
+				FeaturedImage: tt.image,
+			})
+			if tt.code != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.storedAs, post.FeaturedImage)
+			if tt.storedAs == "" {
+				assert.Nil(t, post.FeaturedImageMedia)
+				return
+			}
+			require.NotNil(t, post.FeaturedImageMedia)
+			assert.Equal(t, "/uploads/cover.png", post.FeaturedImageMedia.Url)
+			assert.Equal(t, int32(1600), post.FeaturedImageMedia.Width)
+			assert.Equal(t, int32(900), post.FeaturedImageMedia.Height)
+			assert.Equal(t, "A mountain at dawn", post.FeaturedImageMedia.AltText)
+			require.Len(t, post.FeaturedImageMedia.Variants, 1)
+			assert.Equal(t, "thumbnail", post.FeaturedImageMedia.Variants[0].Name)
+		})
+	}
+}
+
+func TestContentService_ListBlogPostsResolvesFeaturedImagesInOneBatch(t *testing.T) {
+	service, media := newFeaturedImageTestService()
+	ctx := context.Background()
+
+	for _, title := range []string{"One", "Two", "Three"} {
+		_, err := service.CreateBlogPost(ctx, &contentv1.CreateBlogPostRequest{
+			Title:         title,
+			Author:        "user-1",
+			FeaturedImage: "media:cover.png",
+			Status:        contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+		})
+		require.NoError(t, err)
+	}
+	media.batches = 0
+
+	resp, err := service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED})
+	require.NoError(t, err)
+	require.Len(t, resp.Posts, 3)
+	for _, post := range resp.Posts {
+		require.NotNil(t, post.FeaturedImageMedia)
+		assert.Equal(t, "media:cover.png", post.FeaturedImageMedia.Id)
+	}
+	assert.Equal(t, 1, media.batches)
+}
+
+// staticFeaturedImageUsages reports the same posts for every media file
+type staticFeaturedImageUsages []*models.BlogPost
+
+func (u staticFeaturedImageUsages) FindFeaturedImageUsages(ctx context.Context, mediaID string) ([]*models.BlogPost, error) {
+	return u, nil
+}
+
+func TestMediaService_DeleteFeaturedImageRequiresForce(t *testing.T) {
+	mockRepo := new(MockMediaRepository)
+	mockStorage := new(MockFileStorage)
+	service := NewMediaServiceWithDependencies(mockRepo, mockStorage, nil, nil)
+	service.SetFeaturedImageUsages(staticFeaturedImageUsages{models.NewBlogPost("Launch week", "launch-week", "user-1")})
+	ctx := context.Background()
+
+	cover := models.NewMedia("cover.png", "cover.png", "image/png", "user-1", 1)
+	cover.Variants = []models.MediaVariant{{Name: "thumbnail", Filename: "cover-thumbnail.png"}}
+	mockRepo.On("GetByID", ctx, cover.ID).Return(cover, nil)
+
+	_, err := service.DeleteFile(ctx, &mediav1.DeleteFileRequest{Id: cover.ID})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), `"Launch week"`)
+	mockRepo.AssertNotCalled(t, "Delete", ctx, cover.ID)
+
+	mockRepo.On("Delete", ctx, cover.ID).Return(nil)
+	mockStorage.On("DeleteFile", "cover.png").Return(nil)
+	mockStorage.On("DeleteFile", "cover-thumbnail.png").Return(nil)
+	_, err = service.DeleteFile(ctx, &mediav1.DeleteFileRequest{Id: cover.ID, Force: true})
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestMediaService_UploadImageStoresDimensionsAndVariants(t *testing.T) {
+	mockRepo := new(MockMediaRepository)
+	mockStorage := new(MockFileStorage)
+	service := NewMediaServiceWithDependencies(mockRepo, mockStorage, nil, nil)
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1000, 500))))
+
+	mockStorage.On("SaveFile", mock.Anything, "cover.png", "image/png").Return("cover_1.png", "/uploads/cover_1.png", nil)
+	mockStorage.On("SaveFile", mock.Anything, "cover-thumbnail.png", "image/png").Return("cover-thumbnail_1.png", "/uploads/cover-thumbnail_1.png", nil)
+	mockStorage.On("SaveFile", mock.Anything, "cover-medium.png", "image/png").Return("cover-medium_1.png", "/uploads/cover-medium_1.png", nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*models.Media")).Return(nil)
+
+	file, err := service.UploadFile(ctx, &mediav1.UploadFileRequest{
+		Content:  buf.Bytes(),
+		Filename: "cover.png",
+		MimeType: "image/png",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1000), file.Width)
+	assert.Equal(t, int32(500), file.Height)
+
+	// Only variants smaller than the original are generated
+	require.Len(t, file.Variants, 2)
+	assert.Equal(t, "thumbnail", file.Variants[0].Name)
+	assert.Equal(t, "/uploads/cover-thumbnail_1.png", file.Variants[0].Url)
+	assert.Equal(t, int32(320), file.Variants[0].Width)
+	assert.Equal(t, int32(160), file.Variants[0].Height)
+	assert.Equal(t, "medium", file.Variants[1].Name)
+	assert.Equal(t, int32(768), file.Variants[1].Width)
+	assert.Equal(t, int32(384), file.Variants[1].Height)
+	mockStorage.AssertExpectations(t)
+}
@@ -0,0 +1,611 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+const (
+	graphQLDefaultPageSize = 20
+	graphQLMaxPageSize     = 100
+)
+
+// GraphQLLimits bound the shape of an operation before it runs
+type GraphQLLimits struct {
+	// MaxDepth is the deepest allowed field path, e.g. posts.author.posts.title is 4
+	MaxDepth int
+	// MaxComplexity bounds the estimated number of resolved fields; list fields
+	// multiply the cost of their selections by their first argument
+	MaxComplexity int
+}
+
+// DefaultGraphQLLimits allow a standard introspection query and typical page queries
+func DefaultGraphQLLimits() GraphQLLimits {
+	return GraphQLLimits{MaxDepth: 12, MaxComplexity: 5000}
+}
+
+// GraphQLRequest is one GraphQL operation as sent over HTTP
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	OperationName string                 `json:"operationName,omitempty"`
+}
+
+// GraphQLService serves a read-only GraphQL API over pages, posts, categories, tags,
+// authors and media. Anonymous callers only see published pages and posts.
+type GraphQLService struct {
+	pageRepo  repository.PageRepository
+	blogRepo  repository.BlogRepository
+	userRepo  repository.UserRepository
+	mediaRepo repository.MediaRepository
+	limits    GraphQLLimits
+	schema    graphql.Schema
+}
+
+// graphQLLoadersKey is the context key of the per-request loaders
+type graphQLLoadersKey struct{}
+
+// graphQLAuthor is the public view of a post author; email addresses are never exposed
+type graphQLAuthor struct {
+	ID     string
+	Name   string
+	Avatar string
+	// key is the value posts store as their author
+	key string
+}
+
+// NewGraphQLService creates the GraphQL service. userRepo and mediaRepo may be nil, in
+// which case authors resolve to their stored names and media fields to null.
+func NewGraphQLService(pageRepo repository.PageRepository, blogRepo repository.BlogRepository, userRepo repository.UserRepository, mediaRepo repository.MediaRepository) (*GraphQLService, error) {
+	s := &GraphQLService{
+		pageRepo:  pageRepo,
+		blogRepo:  blogRepo,
+		userRepo:  userRepo,
+		mediaRepo: mediaRepo,
+		limits:    DefaultGraphQLLimits(),
+	}
+	schema, err := s.buildSchema()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GraphQL schema: %w", err)
+	}
+	s.schema = schema
+	return s, nil
+}
+
+// SetLimits replaces the depth and complexity limits
+func (s *GraphQLService) SetLimits(limits GraphQLLimits) {
+	s.limits = limits
+}
+
+// Execute validates an operation, checks it against the limits and runs it
+func (s *GraphQLService) Execute(ctx context.Context, req GraphQLRequest) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if result := graphql.ValidateDocument(&s.schema, doc, nil); !result.IsValid {
+		return &graphql.Result{Errors: result.Errors}
+	}
+
+	cost, err := measureOperation(&s.schema, doc, req.OperationName, req.Variables, s.limits.MaxComplexity)
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if cost.Depth > s.limits.MaxDepth {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(fmt.Errorf("query depth %d exceeds the limit of %d", cost.Depth, s.limits.MaxDepth))}
+	}
+	if cost.Complexity > s.limits.MaxComplexity {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(fmt.Errorf("query complexity exceeds the limit of %d", s.limits.MaxComplexity))}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       context.WithValue(ctx, graphQLLoadersKey{}, s.newLoaders()),
+	})
+}
+
+// loaders returns the per-request loaders, creating unshared ones outside Execute
+func (s *GraphQLService) loaders(ctx context.Context) *graphQLLoaders {
+	if loaders, ok := ctx.Value(graphQLLoadersKey{}).(*graphQLLoaders); ok {
+		return loaders
+	}
+	return s.newLoaders()
+}
+
+// graphQLVisible reports whether content with the given status may be shown to the caller;
+// only editors and admins see drafts
+func graphQLVisible(ctx context.Context, status string) bool {
+	return status == models.PageStatusPublished || canReadDrafts(ctx)
+}
+
+// canRead reports whether the caller may read the full content behind a visibility rule
+func (s *GraphQLService) canRead(ctx context.Context, visibility models.Visibility) bool {
+	return s.loaders(ctx).contentReader(ctx, s.userRepo).canRead(visibility)
+}
+
+// readableBlocks returns the content blocks the caller may see: all of them, or the teaser
+func (s *GraphQLService) readableBlocks(ctx context.Context, visibility models.Visibility, content models.Content) []models.ContentBlock {
+	if s.canRead(ctx, visibility) {
+		return content.Blocks
+	}
+	return visibility.Teaser(content).Blocks
+}
+
+func visibilityLevel(visibility models.Visibility) string {
+	if visibility.Level == "" {
+		return models.VisibilityPublic
+	}
+	return visibility.Level
+}
+
+// Schema
+
+var graphQLJSON = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "An arbitrary JSON value",
+	Serialize:   func(value interface{}) interface{} { return value },
+	ParseValue:  func(value interface{}) interface{} { return value },
+	ParseLiteral: func(value ast.Value) interface{} {
+		return nil
+	},
+})
+
+// graphQLListArgs are the pagination arguments of list fields
+var graphQLListArgs = graphql.FieldConfigArgument{
+	"first":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: graphQLDefaultPageSize, Description: "Page size, at most 100"},
+	"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+}
+
+func (s *GraphQLService) buildSchema() (graphql.Schema, error) {
+	mediaVariant := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "MediaVariant",
+		Description: "A downscaled rendition of an image",
+		Fields: graphql.Fields{
+			"name":   variantField(graphql.NewNonNull(graphql.String), func(v models.MediaVariant) interface{} { return v.Name }),
+			"url":    variantField(graphql.NewNonNull(graphql.String), func(v models.MediaVariant) interface{} { return v.URL }),
+			"width":  variantField(graphql.NewNonNull(graphql.Int), func(v models.MediaVariant) interface{} { return v.Width }),
+			"height": variantField(graphql.NewNonNull(graphql.Int), func(v models.MediaVariant) interface{} { return v.Height }),
+		},
+	})
+
+	media := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Media",
+		Fields: graphql.Fields{
+			"id":           mediaField(graphql.NewNonNull(graphql.ID), func(m *models.Media) interface{} { return m.ID }),
+			"url":          mediaField(graphql.NewNonNull(graphql.String), func(m *models.Media) interface{} { return m.URL }),
+			"filename":     mediaField(graphql.NewNonNull(graphql.String), func(m *models.Media) interface{} { return m.Filename }),
+			"originalName": mediaField(graphql.String, func(m *models.Media) interface{} { return m.OriginalName }),
+			"mimeType":     mediaField(graphql.String, func(m *models.Media) interface{} { return m.MimeType }),
+			"size":         mediaField(graphql.Int, func(m *models.Media) interface{} { return int(m.Size) }),
+			"altText":      mediaField(graphql.String, func(m *models.Media) interface{} { return m.AltText }),
+			"createdAt":    mediaField(graphql.DateTime, func(m *models.Media) interface{} { return m.CreatedAt }),
+			"width":        mediaField(graphql.Int, func(m *models.Media) interface{} { return optionalInt(m.Width) }),
+			"height":       mediaField(graphql.Int, func(m *models.Media) interface{} { return optionalInt(m.Height) }),
+			"variants":     mediaField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(mediaVariant))), func(m *models.Media) interface{} { return m.Variants }),
+		},
+	})
+
+	block := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.Fields{
+			"type": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ContentBlock).Type, nil
+			}},
+			"data": &graphql.Field{Type: graphQLJSON, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ContentBlock).Data, nil
+			}},
+		},
+	})
+
+	meta := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Meta",
+		Fields: graphql.Fields{
+			"title":         metaField(func(m models.Meta) string { return m.Title }),
+			"description":   metaField(func(m models.Meta) string { return m.Description }),
+			"keywords":      metaField(func(m models.Meta) string { return m.Keywords }),
+			"ogTitle":       metaField(func(m models.Meta) string { return m.OGTitle }),
+			"ogDescription": metaField(func(m models.Meta) string { return m.OGDescription }),
+			"canonicalUrl":  metaField(func(m models.Meta) string { return m.CanonicalURL }),
+			"robots":        metaField(func(m models.Meta) string { return m.Robots }),
+			"twitterCard":   metaField(func(m models.Meta) string { return m.TwitterCard }),
+			"ogImage": &graphql.Field{Type: media, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return s.resolveMedia(p.Context, p.Source.(models.Meta).OGImage), nil
+			}},
+		},
+	})
+
+	page := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Page",
+		Fields: graphql.Fields{
+			"id":     pageField(graphql.NewNonNull(graphql.ID), func(p *models.Page) interface{} { return p.ID }),
+			"title":  pageField(graphql.NewNonNull(graphql.String), func(p *models.Page) interface{} { return p.Title }),
+			"slug":   pageField(graphql.NewNonNull(graphql.String), func(p *models.Page) interface{} { return p.Slug }),
+			"status": pageField(graphql.NewNonNull(graphql.String), func(p *models.Page) interface{} { return p.Status }),
+			"blocks": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(block))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				page := p.Source.(*models.Page)
+				return s.readableBlocks(p.Context, page.Visibility, page.Content), nil
+			}},
+			"visibility": pageField(graphql.NewNonNull(graphql.String), func(p *models.Page) interface{} { return visibilityLevel(p.Visibility) }),
+			"teaser": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Description: "True when blocks holds only the teaser of gated content", Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return !s.canRead(p.Context, p.Source.(*models.Page).Visibility), nil
+			}},
+			"meta":      pageField(graphql.NewNonNull(meta), func(p *models.Page) interface{} { return p.Meta }),
+			"createdAt": pageField(graphql.DateTime, func(p *models.Page) interface{} { return p.CreatedAt }),
+			"updatedAt": pageField(graphql.DateTime, func(p *models.Page) interface{} { return p.UpdatedAt }),
+		},
+	})
+
+	category := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Category",
+		Fields: graphql.Fields{
+			"name":      categoryField(graphql.NewNonNull(graphql.String), func(c *models.BlogCategory) interface{} { return c.Name }),
+			"slug":      categoryField(graphql.NewNonNull(graphql.String), func(c *models.BlogCategory) interface{} { return c.Slug }),
+			"postCount": categoryField(graphql.Int, func(c *models.BlogCategory) interface{} { return c.PostCount }),
+		},
+	})
+	tag := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Tag",
+		Fields: graphql.Fields{
+			"name":      tagField(graphql.NewNonNull(graphql.String), func(t *models.BlogTag) interface{} { return t.Name }),
+			"slug":      tagField(graphql.NewNonNull(graphql.String), func(t *models.BlogTag) interface{} { return t.Slug }),
+			"postCount": tagField(graphql.Int, func(t *models.BlogTag) interface{} { return t.PostCount }),
+		},
+	})
+	author := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Author",
+		Description: "A post author; only public profile fields are exposed",
+		Fields: graphql.Fields{
+			"id":     authorField(graphql.ID, func(a *graphQLAuthor) interface{} { return a.ID }),
+			"name":   authorField(graphql.String, func(a *graphQLAuthor) interface{} { return a.Name }),
+			"avatar": authorField(graphql.String, func(a *graphQLAuthor) interface{} { return a.Avatar }),
+		},
+	})
+
+	post := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Post",
+		Fields: graphql.Fields{
+			"id":      postField(graphql.NewNonNull(graphql.ID), func(p *models.BlogPost) interface{} { return p.ID }),
+			"title":   postField(graphql.NewNonNull(graphql.String), func(p *models.BlogPost) interface{} { return p.Title }),
+			"slug":    postField(graphql.NewNonNull(graphql.String), func(p *models.BlogPost) interface{} { return p.Slug }),
+			"excerpt": postField(graphql.String, func(p *models.BlogPost) interface{} { return p.Excerpt }),
+			"status":  postField(graphql.NewNonNull(graphql.String), func(p *models.BlogPost) interface{} { return p.Status }),
+			"blocks": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(block))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				post := p.Source.(*models.BlogPost)
+				return s.readableBlocks(p.Context, post.Visibility, post.Content), nil
+			}},
+			"visibility": postField(graphql.NewNonNull(graphql.String), func(p *models.BlogPost) interface{} { return visibilityLevel(p.Visibility) }),
+			"teaser": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Description: "True when blocks holds only the teaser of gated content", Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return !s.canRead(p.Context, p.Source.(*models.BlogPost).Visibility), nil
+			}},
+			"meta":        postField(graphql.NewNonNull(meta), func(p *models.BlogPost) interface{} { return p.Meta }),
+			"publishedAt": postField(graphql.DateTime, func(p *models.BlogPost) interface{} { return p.PublishedAt }),
+			"createdAt":   postField(graphql.DateTime, func(p *models.BlogPost) interface{} { return p.CreatedAt }),
+			"updatedAt":   postField(graphql.DateTime, func(p *models.BlogPost) interface{} { return p.UpdatedAt }),
+			"author": &graphql.Field{Type: author, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return s.resolveAuthor(p.Context, p.Source.(*models.BlogPost).Author), nil
+			}},
+			"featuredImage": &graphql.Field{Type: media, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return s.resolveMedia(p.Context, p.Source.(*models.BlogPost).FeaturedImage), nil
+			}},
+			"categories": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(category))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				categories, _, err := s.loaders(p.Context).taxonomy(p.Context, s.blogRepo)
+				if err != nil {
+					return nil, err
+				}
+				out := make([]*models.BlogCategory, 0, len(p.Source.(*models.BlogPost).Categories))
+				for _, name := range p.Source.(*models.BlogPost).Categories {
+					if c, ok := categories[name]; ok {
+						out = append(out, c)
+					} else {
+						out = append(out, &models.BlogCategory{Name: name, Slug: taxonomySlug(name)})
+					}
+				}
+				return out, nil
+			}},
+			"tags": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tag))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				_, tags, err := s.loaders(p.Context).taxonomy(p.Context, s.blogRepo)
+				if err != nil {
+					return nil, err
+				}
+				out := make([]*models.BlogTag, 0, len(p.Source.(*models.BlogPost).Tags))
+				for _, name := range p.Source.(*models.BlogPost).Tags {
+					if t, ok := tags[name]; ok {
+						out = append(out, t)
+					} else {
+						out = append(out, &models.BlogTag{Name: name, Slug: taxonomySlug(name)})
+					}
+				}
+				return out, nil
+			}},
+		},
+	})
+
+	postList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(post)))
+	category.AddFieldConfig("posts", &graphql.Field{Type: postList, Args: graphQLListArgs, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return s.listPosts(p.Context, p.Args, func(options repository.ListOptions) ([]*models.BlogPost, error) {
+			return s.blogRepo.ListByCategory(p.Context, p.Source.(*models.BlogCategory).Name, options)
+		})
+	}})
+	tag.AddFieldConfig("posts", &graphql.Field{Type: postList, Args: graphQLListArgs, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return s.listPosts(p.Context, p.Args, func(options repository.ListOptions) ([]*models.BlogPost, error) {
+			return s.blogRepo.ListByTag(p.Context, p.Source.(*models.BlogTag).Name, options)
+		})
+	}})
+	author.AddFieldConfig("posts", &graphql.Field{Type: postList, Args: graphQLListArgs, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return s.listPosts(p.Context, p.Args, func(options repository.ListOptions) ([]*models.BlogPost, error) {
+			return s.blogRepo.ListByAuthor(p.Context, p.Source.(*graphQLAuthor).key, options)
+		})
+	}})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"page": &graphql.Field{
+				Type: page,
+				Args: graphql.FieldConfigArgument{
+					"id":   &graphql.ArgumentConfig{Type: graphql.ID},
+					"slug": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: s.resolvePage,
+			},
+			"pages": &graphql.Field{
+				Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(page))),
+				Args:    graphQLListArgs,
+				Resolve: s.resolvePages,
+			},
+			"post": &graphql.Field{
+				Type: post,
+				Args: graphql.FieldConfigArgument{
+					"id":   &graphql.ArgumentConfig{Type: graphql.ID},
+					"slug": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: s.resolvePost,
+			},
+			"posts": &graphql.Field{
+				Type: postList,
+				Args: graphql.FieldConfigArgument{
+					"first":    graphQLListArgs["first"],
+					"offset":   graphQLListArgs["offset"],
+					"category": &graphql.ArgumentConfig{Type: graphql.String, Description: "Category name"},
+					"tag":      &graphql.ArgumentConfig{Type: graphql.String, Description: "Tag name"},
+					"author":   &graphql.ArgumentConfig{Type: graphql.String, Description: "Author as stored on posts"},
+				},
+				Resolve: s.resolvePosts,
+			},
+			"categories": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(category))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return s.blogRepo.GetCategories(p.Context)
+				},
+			},
+			"tags": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tag))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return s.blogRepo.GetTags(p.Context)
+				},
+			},
+			"author": &graphql.Field{
+				Type: author,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return s.resolveAuthor(p.Context, p.Args["id"].(string)), nil
+				},
+			},
+			"media": &graphql.Field{
+				Type: media,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return s.resolveMedia(p.Context, p.Args["id"].(string)), nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// Resolvers
+
+func (s *GraphQLService) resolvePage(p graphql.ResolveParams) (interface{}, error) {
+	var page *models.Page
+	var err error
+	if id, ok := p.Args["id"].(string); ok && id != "" {
+		page, err = s.pageRepo.GetByID(p.Context, id)
+	} else if slug, ok := p.Args["slug"].(string); ok && slug != "" {
+		page, err = s.pageRepo.GetBySlug(p.Context, slug)
+	} else {
+		return nil, fmt.Errorf("id or slug is required")
+	}
+	if err != nil || !graphQLVisible(p.Context, page.Status) {
+		return nil, nil
+	}
+	return page, nil
+}
+
+func (s *GraphQLService) resolvePages(p graphql.ResolveParams) (interface{}, error) {
+	options := graphQLListOptions(p.Args)
+	if canReadDrafts(p.Context) {
+		return s.pageRepo.List(p.Context, options)
+	}
+	return s.pageRepo.ListByStatus(p.Context, models.PageStatusPublished, options)
+}
+
+func (s *GraphQLService) resolvePost(p graphql.ResolveParams) (interface{}, error) {
+	var post *models.BlogPost
+	var err error
+	if id, ok := p.Args["id"].(string); ok && id != "" {
+		post, err = s.blogRepo.GetByID(p.Context, id)
+	} else if slug, ok := p.Args["slug"].(string); ok && slug != "" {
+		post, err = s.blogRepo.GetBySlug(p.Context, slug)
+	} else {
+		return nil, fmt.Errorf("id or slug is required")
+	}
+	if err != nil || !graphQLVisible(p.Context, post.Status) {
+		return nil, nil
+	}
+	return post, nil
+}
+
+// resolvePosts filters by one of category, tag or author, in that order of precedence
+func (s *GraphQLService) resolvePosts(p graphql.ResolveParams) (interface{}, error) {
+	category, _ := p.Args["category"].(string)
+	tag, _ := p.Args["tag"].(string)
+	author, _ := p.Args["author"].(string)
+
+	return s.listPosts(p.Context, p.Args, func(options repository.ListOptions) ([]*models.BlogPost, error) {
+		switch {
+		case category != "":
+			return s.blogRepo.ListByCategory(p.Context, category, options)
+		case tag != "":
+			return s.blogRepo.ListByTag(p.Context, tag, options)
+		case author != "":
+			return s.blogRepo.ListByAuthor(p.Context, author, options)
+		case canReadDrafts(p.Context):
+			return s.blogRepo.List(p.Context, options)
+		default:
+			return s.blogRepo.GetPublishedPosts(p.Context, options)
+		}
+	})
+}
+
+// listPosts runs a paginated post query and drops posts the caller may not see
+func (s *GraphQLService) listPosts(ctx context.Context, args map[string]interface{}, list func(repository.ListOptions) ([]*models.BlogPost, error)) ([]*models.BlogPost, error) {
+	posts, err := list(graphQLListOptions(args))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*models.BlogPost, 0, len(posts))
+	for _, post := range posts {
+		if graphQLVisible(ctx, post.Status) {
+			out = append(out, post)
+		}
+	}
+	return out, nil
+}
+
+// resolveAuthor returns a thunk so the authors of sibling posts are loaded in one batch.
+// Unknown authors keep their stored name unless it is an email address.
+func (s *GraphQLService) resolveAuthor(ctx context.Context, key string) interface{} {
+	if key == "" {
+		return nil
+	}
+	load := s.loaders(ctx).authors.load(ctx, key)
+	return func() (interface{}, error) {
+		value, err := load()
+		if err != nil {
+			return nil, err
+		}
+		if user, ok := value.(*models.User); ok {
+			return &graphQLAuthor{ID: user.ID, Name: user.Profile.Name, Avatar: user.Profile.Avatar, key: key}, nil
+		}
+		if strings.Contains(key, "@") {
+			return nil, nil
+		}
+		return &graphQLAuthor{Name: key, key: key}, nil
+	}
+}
+
+// resolveMedia returns a thunk so media of sibling fields are loaded in one batch
+func (s *GraphQLService) resolveMedia(ctx context.Context, id string) interface{} {
+	if id == "" {
+		return nil
+	}
+	return s.loaders(ctx).media.load(ctx, id)
+}
+
+// graphQLListOptions converts first/offset arguments to list options, newest first
+func graphQLListOptions(args map[string]interface{}) repository.ListOptions {
+	first, _ := args["first"].(int)
+	if first <= 0 {
+		first = graphQLDefaultPageSize
+	}
+	if first > graphQLMaxPageSize {
+		first = graphQLMaxPageSize
+	}
+	offset, _ := args["offset"].(int)
+	if offset < 0 {
+		offset = 0
+	}
+	return repository.ListOptions{Limit: first, Skip: offset, Order: "desc"}
+}
+
+// Field helpers
+
+func mediaField(t graphql.Output, get func(*models.Media) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(*models.Media)), nil
+	}}
+}
+
+func variantField(t graphql.Output, get func(models.MediaVariant) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(models.MediaVariant)), nil
+	}}
+}
+
+// optionalInt returns null for zero, such as the dimensions of files that are not images
+func optionalInt(n int) interface{} {
+	if n == 0 {
+		return nil
+	}
+	return n
+}
+
+func pageField(t graphql.Output, get func(*models.Page) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(*models.Page)), nil
+	}}
+}
+
+func postField(t graphql.Output, get func(*models.BlogPost) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(*models.BlogPost)), nil
+	}}
+}
+
+func categoryField(t graphql.Output, get func(*models.BlogCategory) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(*models.BlogCategory)), nil
+	}}
+}
+
+func tagField(t graphql.Output, get func(*models.BlogTag) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(*models.BlogTag)), nil
+	}}
+}
+
+func authorField(t graphql.Output, get func(*graphQLAuthor) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(*graphQLAuthor)), nil
+	}}
+}
+
+// metaField resolves an optional meta string, returning null when it is empty
+func metaField(get func(models.Meta) string) *graphql.Field {
+	return &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		if value := get(p.Source.(models.Meta)); value != "" {
+			return value, nil
+		}
+		return nil, nil
+	}}
+}
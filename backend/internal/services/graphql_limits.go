@@ -0,0 +1,198 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// graphQLDefaultListSize is the assumed length of list fields without a first argument
+const graphQLDefaultListSize = 10
+
+// queryCost is the shape of an operation: its deepest field path and its estimated
+// number of resolved fields, where list fields multiply the cost of their selections
+type queryCost struct {
+	Depth      int
+	Complexity int
+}
+
+// costMeasurer walks an operation's selections, following fragments, against the schema
+type costMeasurer struct {
+	schema    *graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	// limit caps the complexity so large multipliers cannot overflow
+	limit int
+}
+
+// measureOperation returns the cost of the named operation, or of the only operation
+func measureOperation(schema *graphql.Schema, doc *ast.Document, operationName string, variables map[string]interface{}, limit int) (queryCost, error) {
+	m := &costMeasurer{
+		schema:    schema,
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+		limit:     limit,
+	}
+
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			m.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				if operation != nil && operationName == "" {
+					return queryCost{}, fmt.Errorf("operation name is required when the document has several operations")
+				}
+				operation = def
+			}
+		}
+	}
+	if operation == nil {
+		return queryCost{}, fmt.Errorf("unknown operation %q", operationName)
+	}
+
+	depth, complexity := m.selections(operation.SelectionSet, schema.QueryType(), map[string]bool{})
+	return queryCost{Depth: depth, Complexity: complexity}, nil
+}
+
+// selections returns the depth and complexity of a selection set on the parent type
+func (m *costMeasurer) selections(set *ast.SelectionSet, parent graphql.Type, visiting map[string]bool) (int, int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	maxDepth, total := 0, 0
+	for _, selection := range set.Selections {
+		var depth, cost int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			depth, cost = m.field(selection, parent, visiting)
+		case *ast.InlineFragment:
+			depth, cost = m.selections(selection.SelectionSet, m.typeCondition(selection.TypeCondition, parent), visiting)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := m.fragments[name]
+			if !ok || visiting[name] {
+				continue
+			}
+			visiting[name] = true
+			depth, cost = m.selections(fragment.SelectionSet, m.typeCondition(fragment.TypeCondition, parent), visiting)
+			delete(visiting, name)
+		}
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+		total = m.add(total, cost)
+	}
+	return maxDepth, total
+}
+
+// field returns the depth and complexity of one field including its selections
+func (m *costMeasurer) field(field *ast.Field, parent graphql.Type, visiting map[string]bool) (int, int) {
+	if field.Name.Value == "__typename" {
+		return 1, 0
+	}
+
+	var def *graphql.FieldDefinition
+	var fieldType graphql.Type
+	if fields, ok := parent.(interface {
+		Fields() graphql.FieldDefinitionMap
+	}); ok {
+		if def = fields.Fields()[field.Name.Value]; def != nil {
+			fieldType = def.Type
+		}
+	}
+	switch field.Name.Value {
+	case "__schema":
+		fieldType = graphql.NewNonNull(graphql.SchemaType)
+	case "__type":
+		fieldType = graphql.TypeType
+	}
+
+	multiplier := 1
+	named := fieldType
+	for {
+		switch t := named.(type) {
+		case *graphql.NonNull:
+			named = t.OfType
+			continue
+		case *graphql.List:
+			multiplier = m.listSize(field, def)
+			named = t.OfType
+			continue
+		}
+		break
+	}
+
+	depth, childCost := m.selections(field.SelectionSet, named, visiting)
+	return depth + 1, m.add(1, m.mul(multiplier, childCost))
+}
+
+// listSize is the page size a list field resolves with: its first argument, capped like
+// the resolvers cap it, or the default page size; lists without paging count as graphQLDefaultListSize
+func (m *costMeasurer) listSize(field *ast.Field, def *graphql.FieldDefinition) int {
+	paged := false
+	if def != nil {
+		for _, arg := range def.Args {
+			paged = paged || arg.Name() == "first"
+		}
+	}
+	if !paged {
+		return graphQLDefaultListSize
+	}
+
+	first := graphQLDefaultPageSize
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+		switch value := arg.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil && n > 0 {
+				first = n
+			}
+		case *ast.Variable:
+			switch n := m.variables[value.Name.Value].(type) {
+			case int:
+				first = n
+			case float64:
+				first = int(n)
+			}
+		}
+	}
+	if first <= 0 {
+		first = graphQLDefaultPageSize
+	}
+	if first > graphQLMaxPageSize {
+		first = graphQLMaxPageSize
+	}
+	return first
+}
+
+func (m *costMeasurer) typeCondition(condition *ast.Named, parent graphql.Type) graphql.Type {
+	if condition == nil {
+		return parent
+	}
+	if t := m.schema.Type(condition.Name.Value); t != nil {
+		return t
+	}
+	return parent
+}
+
+// add and mul saturate just above the limit
+func (m *costMeasurer) add(a, b int) int {
+	if a+b > m.limit {
+		return m.limit + 1
+	}
+	return a + b
+}
+
+func (m *costMeasurer) mul(a, b int) int {
+	if a > 0 && b > (m.limit+1)/a {
+		return m.limit + 1
+	}
+	return a * b
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// batchLoader collects the keys requested by sibling GraphQL fields and fetches them in a
+// single call when the first deferred result is read. The executor resolves every field at
+// one depth before reading any deferred result, so a list of 20 posts costs one author lookup.
+type batchLoader[T any] struct {
+	fetch func(ctx context.Context, keys []string) (map[string]T, error)
+
+	mu      sync.Mutex
+	pending []string
+	queued  map[string]bool
+	results map[string]T
+	errs    map[string]error
+}
+
+func newBatchLoader[T any](fetch func(ctx context.Context, keys []string) (map[string]T, error)) *batchLoader[T] {
+	return &batchLoader[T]{
+		fetch:   fetch,
+		queued:  make(map[string]bool),
+		results: make(map[string]T),
+		errs:    make(map[string]error),
+	}
+}
+
+// load queues key and returns a thunk yielding its value, or nil when it does not exist
+func (l *batchLoader[T]) load(ctx context.Context, key string) func() (interface{}, error) {
+	l.mu.Lock()
+	if !l.queued[key] {
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if len(l.pending) > 0 {
+			keys := l.pending
+			l.pending = nil
+			found, err := l.fetch(ctx, keys)
+			for _, k := range keys {
+				if err != nil {
+					l.errs[k] = err
+				} else if value, ok := found[k]; ok {
+					l.results[k] = value
+				}
+			}
+		}
+		if err := l.errs[key]; err != nil {
+			return nil, err
+		}
+		if value, ok := l.results[key]; ok {
+			return value, nil
+		}
+		return nil, nil
+	}
+}
+
+// graphQLLoaders are the per-request loaders of one GraphQL execution
+type graphQLLoaders struct {
+	authors *batchLoader[*models.User]
+	media   *batchLoader[*models.Media]
+
+	taxonomyOnce sync.Once
+	categories   map[string]*models.BlogCategory
+	tags         map[string]*models.BlogTag
+	taxonomyErr  error
+
+	readerOnce sync.Once
+	reader     *contentReader
+}
+
+func (s *GraphQLService) newLoaders() *graphQLLoaders {
+	return &graphQLLoaders{
+		authors: newBatchLoader(s.fetchAuthors),
+		media:   newBatchLoader(s.fetchMedia),
+	}
+}
+
+// contentReader returns the caller, resolved once per request
+func (l *graphQLLoaders) contentReader(ctx context.Context, userRepo repository.UserRepository) *contentReader {
+	l.readerOnce.Do(func() {
+		l.reader = newContentReader(ctx, userRepo)
+	})
+	return l.reader
+}
+
+// fetchAuthors resolves post authors, which are stored as user IDs or emails
+func (s *GraphQLService) fetchAuthors(ctx context.Context, keys []string) (map[string]*models.User, error) {
+	found := make(map[string]*models.User, len(keys))
+	if s.userRepo == nil {
+		return found, nil
+	}
+
+	var ids []string
+	for _, key := range keys {
+		if !strings.Contains(key, "@") {
+			ids = append(ids, key)
+			continue
+		}
+		if user, err := s.userRepo.GetByEmail(ctx, key); err == nil {
+			found[key] = user
+		}
+	}
+	if len(ids) == 0 {
+		return found, nil
+	}
+
+	users, err := s.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		found[user.ID] = user
+	}
+	return found, nil
+}
+
+// fetchMedia resolves media files by ID
+func (s *GraphQLService) fetchMedia(ctx context.Context, ids []string) (map[string]*models.Media, error) {
+	found := make(map[string]*models.Media, len(ids))
+	if s.mediaRepo == nil {
+		return found, nil
+	}
+
+	files, err := s.mediaRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, media := range files {
+		found[media.ID] = media
+	}
+	return found, nil
+}
+
+// taxonomy loads categories and tags once per request, keyed by name
+func (l *graphQLLoaders) taxonomy(ctx context.Context, repo repository.BlogRepository) (map[string]*models.BlogCategory, map[string]*models.BlogTag, error) {
+	l.taxonomyOnce.Do(func() {
+		categories, err := repo.GetCategories(ctx)
+		if err != nil {
+			l.taxonomyErr = err
+			return
+		}
+		tags, err := repo.GetTags(ctx)
+		if err != nil {
+			l.taxonomyErr = err
+			return
+		}
+		l.categories = make(map[string]*models.BlogCategory, len(categories))
+		for _, category := range categories {
+			l.categories[category.Name] = category
+		}
+		l.tags = make(map[string]*models.BlogTag, len(tags))
+		for _, tag := range tags {
+			l.tags[tag.Name] = tag
+		}
+	})
+	return l.categories, l.tags, l.taxonomyErr
+}
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// graphQLBlogRepo adds listing and taxonomy to memoryBlogRepo
+type graphQLBlogRepo struct {
+	*memoryBlogRepo
+	categories []*models.BlogCategory
+}
+
+func (r *graphQLBlogRepo) List(ctx context.Context, options repository.ListOptions) ([]*models.BlogPost, error) {
+	var out []*models.BlogPost
+	for _, status := range []string{models.PageStatusPublished, models.PageStatusDraft} {
+		posts, _ := r.ListByStatus(ctx, status, repository.ListOptions{})
+		out = append(out, posts...)
+	}
+	return out, nil
+}
+
+func (r *graphQLBlogRepo) GetPublishedPosts(ctx context.Context, options repository.ListOptions) ([]*models.BlogPost, error) {
+	return r.ListByStatus(ctx, models.PageStatusPublished, options)
+}
+
+func (r *graphQLBlogRepo) ListByAuthor(ctx context.Context, author string, options repository.ListOptions) ([]*models.BlogPost, error) {
+	posts, _ := r.List(ctx, options)
+	var out []*models.BlogPost
+	for _, post := range posts {
+		if post.Author == author {
+			out = append(out, post)
+		}
+	}
+	return out, nil
+}
+
+func (r *graphQLBlogRepo) GetCategories(ctx context.Context) ([]*models.BlogCategory, error) {
+	return r.categories, nil
+}
+
+func (r *graphQLBlogRepo) GetTags(ctx context.Context) ([]*models.BlogTag, error) {
+	return nil, nil
+}
+
+// countingUserRepo counts batch lookups
+type countingUserRepo struct {
+	*memoryUserRepo
+	batches int
+}
+
+func (r *countingUserRepo) GetByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	r.batches++
+	return r.memoryUserRepo.GetByIDs(ctx, ids)
+}
+
+// countingMediaRepo counts batch lookups
+type countingMediaRepo struct {
+	*memoryMediaRepo
+	batches int
+}
+
+func (r *countingMediaRepo) GetByIDs(ctx context.Context, ids []string) ([]*models.Media, error) {
+	r.batches++
+	return r.memoryMediaRepo.GetByIDs(ctx, ids)
+}
+
+func newGraphQLTestService(t *testing.T) (*GraphQLService, *countingUserRepo, *countingMediaRepo) {
+	posts := map[string]*models.BlogPost{}
+	for i := 0; i < 6; i++ {
+		post := models.NewBlogPost(fmt.Sprintf("Post %d", i), fmt.Sprintf("post-%d", i), "user-1")
+		if i%2 == 1 {
+			post.Author = "user-2"
+		}
+		post.FeaturedImage = fmt.Sprintf("media:cover-%d.png", i%3)
+		post.Categories = []string{"News"}
+		post.SetPublished()
+		posts[post.ID] = post
+	}
+	draft := models.NewBlogPost("Secret draft", "secret-draft", "user-1")
+	posts[draft.ID] = draft
+
+	users := &countingUserRepo{memoryUserRepo: &memoryUserRepo{users: []*models.User{
+		{ID: "user-1", Email: "jane@example.com", Profile: models.Profile{Name: "Jane Doe"}},
+		{ID: "user-2", Email: "john@example.com", Profile: models.Profile{Name: "John Roe"}},
+	}}}
+	media := &countingMediaRepo{memoryMediaRepo: newMemoryMediaRepo(
+		models.NewMedia("cover-0.png", "cover-0.png", "image/png", "user-1", 1),
+		models.NewMedia("cover-1.png", "cover-1.png", "image/png", "user-1", 1),
+		models.NewMedia("cover-2.png", "cover-2.png", "image/png", "user-1", 1),
+	)}
+	blog := &graphQLBlogRepo{
+		memoryBlogRepo: &memoryBlogRepo{posts: posts},
+		categories:     []*models.BlogCategory{{Name: "News", Slug: "news", PostCount: 6}},
+	}
+
+	service, err := NewGraphQLService(&memoryPageRepo{}, blog, users, media)
+	require.NoError(t, err)
+	return service, users, media
+}
+
+func graphQLData(t *testing.T, result *graphql.Result) map[string]interface{} {
+	t.Helper()
+	require.Empty(t, result.Errors)
+	raw, err := json.Marshal(result.Data)
+	require.NoError(t, err)
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal(raw, &data))
+	return data
+}
+
+func TestGraphQLService_BatchesAuthorsAndMedia(t *testing.T) {
+	service, users, media := newGraphQLTestService(t)
+
+	result := service.Execute(context.Background(), GraphQLRequest{Query: `{
+		posts(first: 10) { title author { name } featuredImage { url } categories { slug } }
+	}`})
+	data := graphQLData(t, result)
+
+	posts := data["posts"].([]interface{})
+	require.Len(t, posts, 6)
+	for _, p := range posts {
+		post := p.(map[string]interface{})
+		assert.NotNil(t, post["author"])
+		assert.NotNil(t, post["featuredImage"])
+		assert.Equal(t, "news", post["categories"].([]interface{})[0].(map[string]interface{})["slug"])
+	}
+	assert.Equal(t, 1, users.batches)
+	assert.Equal(t, 1, media.batches)
+}
+
+func TestGraphQLService_HidesDraftsFromReaders(t *testing.T) {
+	service, _, _ := newGraphQLTestService(t)
+	query := GraphQLRequest{Query: `query($slug: String) { post(slug: $slug) { title } posts { slug } }`, Variables: map[string]interface{}{"slug": "secret-draft"}}
+
+	for _, reader := range []context.Context{context.Background(), context.WithValue(context.Background(), "user_role", models.UserRoleViewer)} {
+		data := graphQLData(t, service.Execute(reader, query))
+		assert.Nil(t, data["post"])
+		assert.Len(t, data["posts"], 6)
+	}
+
+	editor := context.WithValue(context.Background(), "user_role", models.UserRoleEditor)
+	data := graphQLData(t, service.Execute(editor, query))
+	assert.Equal(t, "Secret draft", data["post"].(map[string]interface{})["title"])
+	assert.Len(t, data["posts"], 7)
+}
+
+func TestGraphQLService_AuthorHasNoEmail(t *testing.T) {
+	service, _, _ := newGraphQLTestService(t)
+
+	result := service.Execute(context.Background(), GraphQLRequest{Query: `{ author(id: "user-1") { email } }`})
+	require.NotEmpty(t, result.Errors)
+
+	data := graphQLData(t, service.Execute(context.Background(), GraphQLRequest{Query: `{ author(id: "user-1") { id name posts { title } } }`}))
+	author := data["author"].(map[string]interface{})
+	assert.Equal(t, "Jane Doe", author["name"])
+	assert.Len(t, author["posts"], 3)
+}
+
+func TestGraphQLService_EnforcesLimits(t *testing.T) {
+	service, _, _ := newGraphQLTestService(t)
+	service.SetLimits(GraphQLLimits{MaxDepth: 4, MaxComplexity: 500})
+
+	deep := service.Execute(context.Background(), GraphQLRequest{Query: `{
+		posts { author { posts { author { name } } } }
+	}`})
+	require.Len(t, deep.Errors, 1)
+	assert.Contains(t, deep.Errors[0].Message, "depth 5 exceeds")
+
+	wide := service.Execute(context.Background(), GraphQLRequest{Query: `{
+		categories { posts(first: 100) { title slug excerpt } }
+	}`})
+	require.Len(t, wide.Errors, 1)
+	assert.Contains(t, wide.Errors[0].Message, "complexity")
+
+	// Fragments count towards depth like inline selections
+	fragment := service.Execute(context.Background(), GraphQLRequest{Query: `
+		query { posts { ...P } }
+		fragment P on Post { author { posts { author { name } } } }
+	`})
+	require.Len(t, fragment.Errors, 1)
+
+	ok := service.Execute(context.Background(), GraphQLRequest{Query: `{ posts(first: 5) { title author { name } } }`})
+	assert.Empty(t, ok.Errors)
+}
+
+func TestGraphQLService_GatedPostsReturnTeasers(t *testing.T) {
+	service, _, _ := newGraphQLTestService(t)
+	post, err := service.blogRepo.GetBySlug(context.Background(), "post-0")
+	require.NoError(t, err)
+	post.Content = models.Content{Blocks: []models.ContentBlock{{Type: "text"}, {Type: "text"}}}
+	post.Visibility = models.Visibility{Level: models.VisibilityAuthenticated, TeaserBlocks: 1}
+	query := GraphQLRequest{Query: `{ post(slug: "post-0") { visibility teaser blocks { type } } }`}
+
+	data := graphQLData(t, service.Execute(context.Background(), query))
+	gated := data["post"].(map[string]interface{})
+	assert.Equal(t, "authenticated", gated["visibility"])
+	assert.Equal(t, true, gated["teaser"])
+	assert.Len(t, gated["blocks"], 1)
+
+	signedIn := context.WithValue(context.Background(), "user_id", "user-1")
+	data = graphQLData(t, service.Execute(signedIn, query))
+	gated = data["post"].(map[string]interface{})
+	assert.Equal(t, false, gated["teaser"])
+	assert.Len(t, gated["blocks"], 2)
+}
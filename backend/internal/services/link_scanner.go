@@ -0,0 +1,522 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+const (
+	// linkScanBatchSize is the page size used to walk pages and posts
+	linkScanBatchSize = 100
+	// linkReportsRetained bounds how many past reports are kept
+	linkReportsRetained = 30
+	linkCheckUserAgent  = "saas-platform-link-checker/1.0"
+	// linkCheckTimeout bounds each external URL check
+	linkCheckTimeout = 10 * time.Second
+)
+
+// ErrLinkScanRunning is returned when a scan is requested while another one is in progress
+var ErrLinkScanRunning = errors.New("a link scan is already running")
+
+// linkFieldSuffixes mark block data keys whose whole value is a link, e.g. "src" or "ctaLink"
+var linkFieldSuffixes = []string{"link", "url", "href", "src", "image"}
+
+// Links embedded in rich text: HTML href/src attributes and markdown link targets
+var (
+	htmlLinkPattern     = regexp.MustCompile(`(?i)\b(?:href|src)\s*=\s*["']([^"']+)["']`)
+	markdownLinkPattern = regexp.MustCompile(`\]\(([^)\s]+)`)
+)
+
+// reservedSitePaths are website routes that are not backed by pages
+var reservedSitePaths = map[string]bool{
+	"about":    true,
+	"admin":    true,
+	"api":      true,
+	"blog":     true,
+	"contact":  true,
+	"services": true,
+}
+
+// reservedBlogPaths are routes under /blog that are not posts
+var reservedBlogPaths = map[string]bool{
+	"rss":    true,
+	"search": true,
+}
+
+// LinkScanner walks all pages and posts and reports references that no longer resolve:
+// internal links to missing page or post slugs, uploaded files whose media record has
+// been deleted and, when an HTTP client is configured, external URLs that fail.
+type LinkScanner struct {
+	reportRepo repository.LinkReportRepository
+	pageRepo   repository.PageRepository
+	blogRepo   repository.BlogRepository
+	mediaRepo  repository.MediaRepository
+	httpClient *http.Client
+	now        func() time.Time
+
+	running sync.Mutex
+}
+
+// NewLinkScanner creates a link scanner. External URLs are not checked until
+// EnableExternalChecks or SetHTTPClient is called.
+func NewLinkScanner(
+	reportRepo repository.LinkReportRepository,
+	pageRepo repository.PageRepository,
+	blogRepo repository.BlogRepository,
+	mediaRepo repository.MediaRepository,
+) *LinkScanner {
+	return &LinkScanner{
+		reportRepo: reportRepo,
+		pageRepo:   pageRepo,
+		blogRepo:   blogRepo,
+		mediaRepo:  mediaRepo,
+		now:        time.Now,
+	}
+}
+
+// EnableExternalChecks checks external URLs with a client that only connects to public
+// addresses, since the URLs come from content rather than from the operator
+func (s *LinkScanner) EnableExternalChecks() {
+	s.httpClient = newPublicHTTPClient(linkCheckTimeout)
+}
+
+// SetHTTPClient enables external URL checks using the given client; nil disables them.
+// The client's timeout bounds each request.
+func (s *LinkScanner) SetHTTPClient(client *http.Client) {
+	s.httpClient = client
+}
+
+// Start runs a scheduled scan every interval until ctx is cancelled
+func (s *LinkScanner) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.Scan(ctx, models.LinkReportTriggerScheduled); err != nil && !errors.Is(err, ErrLinkScanRunning) {
+					logger.Error("Scheduled link scan failed", err)
+				}
+			}
+		}
+	}()
+}
+
+// Scan checks every draft and published page and post and stores the report.
+// Only one scan runs at a time; concurrent calls return ErrLinkScanRunning.
+func (s *LinkScanner) Scan(ctx context.Context, trigger string) (*models.LinkReport, error) {
+	if !s.running.TryLock() {
+		return nil, ErrLinkScanRunning
+	}
+	defer s.running.Unlock()
+
+	run := &linkScan{
+		scanner: s,
+		checked: make(map[string]*models.LinkIssue),
+		report: &models.LinkReport{
+			Trigger:         trigger,
+			ExternalChecked: s.httpClient != nil,
+			Issues:          []models.LinkIssue{},
+			StartedAt:       s.now(),
+		},
+	}
+
+	// Archived content is not reachable on the site and is skipped
+	for _, contentStatus := range []string{models.PageStatusPublished, models.PageStatusDraft} {
+		if err := run.scanPages(ctx, contentStatus); err != nil {
+			return nil, err
+		}
+		if err := run.scanPosts(ctx, contentStatus); err != nil {
+			return nil, err
+		}
+	}
+	// A cancelled scan may have recorded failed lookups as broken links
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	run.report.FinishedAt = s.now()
+
+	if err := s.reportRepo.Create(ctx, run.report); err != nil {
+		return nil, fmt.Errorf("failed to store link report: %w", err)
+	}
+	if err := s.reportRepo.Prune(ctx, linkReportsRetained); err != nil {
+		logger.Error("Failed to prune old link reports", err)
+	}
+
+	logger.Info("Link scan finished",
+		"report_id", run.report.ID,
+		"trigger", trigger,
+		"links_checked", run.report.LinksChecked,
+		"issues", len(run.report.Issues),
+	)
+	return run.report, nil
+}
+
+// linkScan holds the state of a single scan
+type linkScan struct {
+	scanner *LinkScanner
+	report  *models.LinkReport
+	// checked caches lookups by target so each distinct link is resolved once; nil means OK
+	checked map[string]*models.LinkIssue
+}
+
+// linkRef is a link found in a page or post
+type linkRef struct {
+	blockIndex int
+	field      string
+	url        string
+}
+
+func (r *linkScan) scanPages(ctx context.Context, pageStatus string) error {
+	for skip := 0; ; skip += linkScanBatchSize {
+		pages, err := r.scanner.pageRepo.ListByStatus(ctx, pageStatus, repository.ListOptions{Limit: linkScanBatchSize, Skip: skip})
+		if err != nil {
+			return fmt.Errorf("failed to list pages: %w", err)
+		}
+		for _, page := range pages {
+			r.report.PagesScanned++
+			if err := r.checkLinks(ctx, models.ContentTypePage, page.ID, page.Slug, extractContentLinks(page.Content)); err != nil {
+				return err
+			}
+		}
+		if len(pages) < linkScanBatchSize {
+			return nil
+		}
+	}
+}
+
+func (r *linkScan) scanPosts(ctx context.Context, postStatus string) error {
+	for skip := 0; ; skip += linkScanBatchSize {
+		posts, err := r.scanner.blogRepo.ListByStatus(ctx, postStatus, repository.ListOptions{Limit: linkScanBatchSize, Skip: skip})
+		if err != nil {
+			return fmt.Errorf("failed to list blog posts: %w", err)
+		}
+		for _, post := range posts {
+			r.report.PostsScanned++
+			refs := extractContentLinks(post.Content)
+			if post.FeaturedImage != "" {
+				refs = append(refs, linkRef{blockIndex: -1, field: "featured_image", url: post.FeaturedImage})
+			}
+			if err := r.checkLinks(ctx, models.ContentTypeBlogPost, post.ID, post.Slug, refs); err != nil {
+				return err
+			}
+		}
+		if len(posts) < linkScanBatchSize {
+			return nil
+		}
+	}
+}
+
+// checkLinks resolves the links of one page or post and records broken ones
+func (r *linkScan) checkLinks(ctx context.Context, contentType, contentID, slug string, refs []linkRef) error {
+	for _, ref := range refs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		issue, checked := r.resolve(ctx, ref.url)
+		if !checked {
+			continue
+		}
+		r.report.LinksChecked++
+		if issue == nil {
+			continue
+		}
+
+		found := *issue
+		found.ContentType = contentType
+		found.ContentID = contentID
+		found.ContentSlug = slug
+		found.BlockIndex = ref.blockIndex
+		found.Field = ref.field
+		found.URL = ref.url
+		r.report.Issues = append(r.report.Issues, found)
+	}
+	return nil
+}
+
+// resolve classifies a link and looks up its target. checked is false for links
+// the scanner does not verify, such as mailto: links, anchors and site routes.
+func (r *linkScan) resolve(ctx context.Context, raw string) (issue *models.LinkIssue, checked bool) {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "//") {
+		raw = "https:" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, false
+	}
+
+	var key string
+	var lookup func() *models.LinkIssue
+	switch {
+	case u.Scheme == "http" || u.Scheme == "https":
+		if r.scanner.httpClient == nil || u.Host == "" {
+			return nil, false
+		}
+		u.Fragment = ""
+		key = "external:" + u.String()
+		lookup = func() *models.LinkIssue { return r.checkExternal(ctx, u.String()) }
+	case u.Scheme == "media" && u.Opaque != "":
+		// Media IDs, as stored for featured images
+		key = raw
+		lookup = func() *models.LinkIssue {
+			if _, err := r.scanner.mediaRepo.GetByID(ctx, raw); err != nil {
+				return &models.LinkIssue{Kind: models.LinkIssueMissingMedia}
+			}
+			return nil
+		}
+	case u.Scheme != "" || u.Host != "" || !strings.HasPrefix(u.Path, "/"):
+		return nil, false
+	default:
+		segments := strings.Split(strings.Trim(path.Clean(u.Path), "/"), "/")
+		switch {
+		case segments[0] == "uploads" && len(segments) == 2:
+			filename := segments[1]
+			key = "media:" + filename
+			lookup = func() *models.LinkIssue {
+				if _, err := r.scanner.mediaRepo.GetByFilename(ctx, filename); err != nil {
+					return &models.LinkIssue{Kind: models.LinkIssueMissingMedia}
+				}
+				return nil
+			}
+		case segments[0] == "blog" && len(segments) == 2 && !reservedBlogPaths[segments[1]]:
+			slug := segments[1]
+			key = "post:" + slug
+			lookup = func() *models.LinkIssue {
+				if _, err := r.scanner.blogRepo.GetBySlug(ctx, slug); err != nil {
+					return &models.LinkIssue{Kind: models.LinkIssueMissingPost}
+				}
+				return nil
+			}
+		case segments[0] == "" || reservedSitePaths[segments[0]]:
+			return nil, false
+		default:
+			slug := strings.Join(segments, "/")
+			key = "page:" + slug
+			lookup = func() *models.LinkIssue {
+				if _, err := r.scanner.pageRepo.GetBySlug(ctx, slug); err != nil {
+					return &models.LinkIssue{Kind: models.LinkIssueMissingPage}
+				}
+				return nil
+			}
+		}
+	}
+
+	if cached, ok := r.checked[key]; ok {
+		return cached, true
+	}
+	issue = lookup()
+	r.checked[key] = issue
+	return issue, true
+}
+
+// checkExternal requests an external URL with HEAD, falling back to GET for servers
+// that do not support HEAD. Any failure or 4xx/5xx status is reported.
+func (r *linkScan) checkExternal(ctx context.Context, target string) *models.LinkIssue {
+	resp, err := r.requestExternal(ctx, http.MethodHead, target)
+	if err == nil && (resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented) {
+		resp, err = r.requestExternal(ctx, http.MethodGet, target)
+	}
+	if err != nil {
+		return &models.LinkIssue{Kind: models.LinkIssueBrokenExternal, Detail: err.Error()}
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return &models.LinkIssue{
+			Kind:       models.LinkIssueBrokenExternal,
+			StatusCode: resp.StatusCode,
+			Detail:     http.StatusText(resp.StatusCode),
+		}
+	}
+	return nil
+}
+
+func (r *linkScan) requestExternal(ctx context.Context, method, target string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", linkCheckUserAgent)
+
+	resp, err := r.scanner.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// extractContentLinks returns the links referenced by the content blocks in block order
+func extractContentLinks(content models.Content) []linkRef {
+	var refs []linkRef
+	for i, block := range content.Blocks {
+		keys := make([]string, 0, len(block.Data))
+		for key := range block.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			refs = appendValueLinks(refs, i, key, key, block.Data[key])
+		}
+	}
+	return refs
+}
+
+// appendValueLinks collects links from a block data value, descending into nested
+// objects and lists. field is the dotted path of the value, e.g. "features.0.link".
+func appendValueLinks(refs []linkRef, blockIndex int, field, key string, value interface{}) []linkRef {
+	switch v := value.(type) {
+	case string:
+		// Block data is HTML-escaped when saved through the API
+		v = html.UnescapeString(v)
+		if isLinkField(key) && looksLikeLink(v) {
+			return append(refs, linkRef{blockIndex: blockIndex, field: field, url: strings.TrimSpace(v)})
+		}
+		for _, pattern := range []*regexp.Regexp{htmlLinkPattern, markdownLinkPattern} {
+			for _, match := range pattern.FindAllStringSubmatch(v, -1) {
+				refs = append(refs, linkRef{blockIndex: blockIndex, field: field, url: match[1]})
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			refs = appendValueLinks(refs, blockIndex, field+"."+k, k, v[k])
+		}
+	case []interface{}:
+		for i, item := range v {
+			refs = appendValueLinks(refs, blockIndex, field+"."+strconv.Itoa(i), key, item)
+		}
+	}
+	return refs
+}
+
+// isLinkField reports whether a block data key holds a link, e.g. "src" or "primaryButtonLink"
+func isLinkField(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range linkFieldSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func looksLikeLink(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, "/") || strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://")
+}
+
+// SetLinkScanner enables the link-integrity report RPCs. When unset, they return FailedPrecondition.
+func (s *ContentService) SetLinkScanner(scanner *LinkScanner) {
+	s.linkScanner = scanner
+}
+
+// GetLinkReport returns the latest link-integrity report, or a specific report by ID
+func (s *ContentService) GetLinkReport(ctx context.Context, req *contentv1.GetLinkReportRequest) (*contentv1.LinkReport, error) {
+	if s.linkScanner == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "link reports are not configured")
+	}
+
+	var report *models.LinkReport
+	var err error
+	if req.Id == "" {
+		report, err = s.linkScanner.reportRepo.GetLatest(ctx)
+	} else {
+		report, err = s.linkScanner.reportRepo.GetByID(ctx, req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "link report not found: %v", err)
+	}
+
+	return s.convertLinkReportToProto(report), nil
+}
+
+// RunLinkScan scans all pages and posts now and returns the stored report
+func (s *ContentService) RunLinkScan(ctx context.Context, req *contentv1.RunLinkScanRequest) (*contentv1.LinkReport, error) {
+	if s.linkScanner == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "link reports are not configured")
+	}
+
+	report, err := s.linkScanner.Scan(ctx, models.LinkReportTriggerManual)
+	if err != nil {
+		if errors.Is(err, ErrLinkScanRunning) {
+			return nil, status.Errorf(codes.Aborted, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "link scan failed: %v", err)
+	}
+
+	return s.convertLinkReportToProto(report), nil
+}
+
+// convertLinkReportToProto converts a link report model to proto
+func (s *ContentService) convertLinkReportToProto(report *models.LinkReport) *contentv1.LinkReport {
+	issues := make([]*contentv1.LinkIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, &contentv1.LinkIssue{
+			ContentType: issue.ContentType,
+			ContentId:   issue.ContentID,
+			ContentSlug: issue.ContentSlug,
+			BlockIndex:  int32(issue.BlockIndex),
+			Field:       issue.Field,
+			Url:         issue.URL,
+			Kind:        s.convertLinkIssueKindToProto(issue.Kind),
+			StatusCode:  int32(issue.StatusCode),
+			Detail:      issue.Detail,
+		})
+	}
+
+	return &contentv1.LinkReport{
+		Id:              report.ID,
+		Trigger:         report.Trigger,
+		PagesScanned:    int32(report.PagesScanned),
+		PostsScanned:    int32(report.PostsScanned),
+		LinksChecked:    int32(report.LinksChecked),
+		ExternalChecked: report.ExternalChecked,
+		Issues:          issues,
+		StartedAt:       timestamppb.New(report.StartedAt),
+		FinishedAt:      timestamppb.New(report.FinishedAt),
+	}
+}
+
+// convertLinkIssueKindToProto converts a model issue kind to proto
+func (s *ContentService) convertLinkIssueKindToProto(kind string) contentv1.LinkIssueKind {
+	switch kind {
+	case models.LinkIssueMissingPage:
+		return contentv1.LinkIssueKind_LINK_ISSUE_KIND_MISSING_PAGE
+	case models.LinkIssueMissingPost:
+		return contentv1.LinkIssueKind_LINK_ISSUE_KIND_MISSING_POST
+	case models.LinkIssueMissingMedia:
+		return contentv1.LinkIssueKind_LINK_ISSUE_KIND_MISSING_MEDIA
+	case models.LinkIssueBrokenExternal:
+		return contentv1.LinkIssueKind_LINK_ISSUE_KIND_BROKEN_EXTERNAL
+	default:
+		return contentv1.LinkIssueKind_LINK_ISSUE_KIND_UNSPECIFIED
+	}
+}
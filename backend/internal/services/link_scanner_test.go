@@ -0,0 +1,340 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memoryPageRepo creates pages and serves them by ID, slug and status; other methods are left to the embedded interface
+type memoryPageRepo struct {
+	repository.PageRepository
+	pages []*models.Page
+}
+
+func (r *memoryPageRepo) GetByID(ctx context.Context, id string) (*models.Page, error) {
+	for _, page := range r.pages {
+		if page.ID == id {
+			return page, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryPageRepo) Create(ctx context.Context, page *models.Page) error {
+	r.pages = append(r.pages, page)
+	return nil
+}
+
+func (r *memoryPageRepo) GetBySlug(ctx context.Context, slug string) (*models.Page, error) {
+	for _, page := range r.pages {
+		if page.Slug == slug {
+			return page, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryPageRepo) ListByStatus(ctx context.Context, status string, options repository.ListOptions) ([]*models.Page, error) {
+	var out []*models.Page
+	for _, page := range r.pages {
+		if page.Status == status {
+			out = append(out, page)
+		}
+	}
+	if options.Skip >= len(out) {
+		return nil, nil
+	}
+	return out[options.Skip:], nil
+}
+
+// memoryMediaRepo serves media records by ID and filename
+type memoryMediaRepo struct {
+	repository.MediaRepository
+	media []*models.Media
+}
+
+func newMemoryMediaRepo(media ...*models.Media) *memoryMediaRepo {
+	return &memoryMediaRepo{media: media}
+}
+
+func (r *memoryMediaRepo) GetByID(ctx context.Context, id string) (*models.Media, error) {
+	for _, m := range r.media {
+		if m.ID == id {
+			return m, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryMediaRepo) GetByIDs(ctx context.Context, ids []string) ([]*models.Media, error) {
+	var out []*models.Media
+	for _, id := range ids {
+		if m, err := r.GetByID(ctx, id); err == nil {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryMediaRepo) GetByFilename(ctx context.Context, filename string) (*models.Media, error) {
+	for _, m := range r.media {
+		if m.Filename == filename {
+			return m, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// memoryLinkReportRepo keeps stored reports in order
+type memoryLinkReportRepo struct {
+	mu      sync.Mutex
+	reports []*models.LinkReport
+}
+
+func (r *memoryLinkReportRepo) Create(ctx context.Context, report *models.LinkReport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report.ID = fmt.Sprintf("report-%d", len(r.reports)+1)
+	r.reports = append(r.reports, report)
+	return nil
+}
+
+func (r *memoryLinkReportRepo) GetByID(ctx context.Context, id string) (*models.LinkReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, report := range r.reports {
+		if report.ID == id {
+			return report, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryLinkReportRepo) GetLatest(ctx context.Context) (*models.LinkReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.reports) == 0 {
+		return nil, repository.ErrNotFound
+	}
+	return r.reports[len(r.reports)-1], nil
+}
+
+func (r *memoryLinkReportRepo) Prune(ctx context.Context, keep int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.reports) > keep {
+		r.reports = r.reports[len(r.reports)-keep:]
+	}
+	return nil
+}
+
+func newLinkScanTestService(externalURL string) (*ContentService, *LinkScanner) {
+	about := models.NewPage("About us", "about-us")
+	about.Status = models.PageStatusPublished
+	about.Content.Blocks = []models.ContentBlock{
+		{Type: "hero", Data: map[string]interface{}{"title": "Hi", "image": "/uploads/team.png", "ctaLink": "/pricing"}},
+		{Type: "text", Data: map[string]interface{}{
+			"content": `<p>Read <a href="/blog/launch">the launch</a>, <a href="/blog/gone">an old post</a>, ` +
+				`<a href="mailto:hi@example.com">mail us</a> or see [our docs](` + externalURL + `/docs).</p>`,
+		}},
+		{Type: "feature-grid", Data: map[string]interface{}{"features": []interface{}{
+			map[string]interface{}{"title": "Contact", "link": "/contact"},
+			map[string]interface{}{"title": "Missing", "link": "/pricing#plans"},
+		}}},
+	}
+	draft := models.NewPage("Draft", "draft-page")
+	draft.Content.Blocks = []models.ContentBlock{
+		{Type: "cta", Data: map[string]interface{}{"primaryButtonLink": "/about-us", "secondaryButtonLink": externalURL + "/missing"}},
+	}
+
+	launch := models.NewBlogPost("Launch", "launch", "author@example.com")
+	launch.SetPublished()
+	launch.FeaturedImage = "/uploads/deleted.png"
+
+	service := NewContentService(
+		&memoryPageRepo{pages: []*models.Page{about, draft}},
+		&memoryBlogRepo{posts: map[string]*models.BlogPost{launch.ID: launch}},
+	)
+	scanner := NewLinkScanner(
+		&memoryLinkReportRepo{},
+		service.pageRepo,
+		service.blogRepo,
+		newMemoryMediaRepo(models.NewMedia("team.png", "team.png", "image/png", "user-1", 1)),
+	)
+	service.SetLinkScanner(scanner)
+	return service, scanner
+}
+
+func TestLinkScanner_InternalLinks(t *testing.T) {
+	service, _ := newLinkScanTestService("https://example.org")
+	ctx := context.Background()
+
+	report, err := service.RunLinkScan(ctx, &contentv1.RunLinkScanRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, models.LinkReportTriggerManual, report.Trigger)
+	assert.Equal(t, int32(2), report.PagesScanned)
+	assert.Equal(t, int32(1), report.PostsScanned)
+	assert.False(t, report.ExternalChecked)
+	// team.png, /pricing twice, /blog/launch, /blog/gone, /about-us and the featured image
+	assert.Equal(t, int32(7), report.LinksChecked)
+
+	type found struct {
+		slug  string
+		block int32
+		field string
+		url   string
+		kind  contentv1.LinkIssueKind
+	}
+	var issues []found
+	for _, issue := range report.Issues {
+		issues = append(issues, found{issue.ContentSlug, issue.BlockIndex, issue.Field, issue.Url, issue.Kind})
+	}
+	assert.ElementsMatch(t, []found{
+		{"about-us", 0, "ctaLink", "/pricing", contentv1.LinkIssueKind_LINK_ISSUE_KIND_MISSING_PAGE},
+		{"about-us", 1, "content", "/blog/gone", contentv1.LinkIssueKind_LINK_ISSUE_KIND_MISSING_POST},
+		{"about-us", 2, "features.1.link", "/pricing#plans", contentv1.LinkIssueKind_LINK_ISSUE_KIND_MISSING_PAGE},
+		{"launch", -1, "featured_image", "/uploads/deleted.png", contentv1.LinkIssueKind_LINK_ISSUE_KIND_MISSING_MEDIA},
+	}, issues)
+
+	latest, err := service.GetLinkReport(ctx, &contentv1.GetLinkReportRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, report.Id, latest.Id)
+	assert.Len(t, latest.Issues, 4)
+
+	_, err = service.GetLinkReport(ctx, &contentv1.GetLinkReportRequest{Id: "nope"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLinkScanner_ExternalLinks(t *testing.T) {
+	var heads int
+	var mu sync.Mutex
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/docs":
+			heads++
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case r.URL.Path == "/docs":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer target.Close()
+
+	service, scanner := newLinkScanTestService(target.URL)
+	scanner.SetHTTPClient(target.Client())
+	scanner.now = func() time.Time { return time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC) }
+
+	report, err := scanner.Scan(context.Background(), models.LinkReportTriggerScheduled)
+	require.NoError(t, err)
+	assert.True(t, report.ExternalChecked)
+	assert.Equal(t, 9, report.LinksChecked)
+	assert.Equal(t, 1, heads, "HEAD falls back to GET when not allowed")
+
+	var external []models.LinkIssue
+	for _, issue := range report.Issues {
+		if issue.Kind == models.LinkIssueBrokenExternal {
+			external = append(external, issue)
+		}
+	}
+	require.Len(t, external, 1)
+	assert.Equal(t, "draft-page", external[0].ContentSlug)
+	assert.Equal(t, target.URL+"/missing", external[0].URL)
+	assert.Equal(t, http.StatusNotFound, external[0].StatusCode)
+
+	latest, err := service.GetLinkReport(context.Background(), &contentv1.GetLinkReportRequest{Id: report.ID})
+	require.NoError(t, err)
+	assert.Equal(t, models.LinkReportTriggerScheduled, latest.Trigger)
+}
+
+func TestLinkScanner_FeaturedImageMediaIDs(t *testing.T) {
+	kept := models.NewBlogPost("Kept", "kept", "author@example.com")
+	kept.SetPublished()
+	kept.FeaturedImage = "media:team.png"
+	orphaned := models.NewBlogPost("Orphaned", "orphaned", "author@example.com")
+	orphaned.SetPublished()
+	orphaned.FeaturedImage = "media:deleted.png"
+
+	scanner := NewLinkScanner(
+		&memoryLinkReportRepo{},
+		&memoryPageRepo{},
+		&memoryBlogRepo{posts: map[string]*models.BlogPost{kept.ID: kept, orphaned.ID: orphaned}},
+		newMemoryMediaRepo(models.NewMedia("team.png", "team.png", "image/png", "user-1", 1)),
+	)
+
+	report, err := scanner.Scan(context.Background(), models.LinkReportTriggerManual)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.LinksChecked)
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, "orphaned", report.Issues[0].ContentSlug)
+	assert.Equal(t, "featured_image", report.Issues[0].Field)
+	assert.Equal(t, models.LinkIssueMissingMedia, report.Issues[0].Kind)
+}
+
+func TestLinkScanner_NotConfigured(t *testing.T) {
+	service := NewContentService(nil, nil)
+	_, err := service.GetLinkReport(context.Background(), &contentv1.GetLinkReportRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = service.RunLinkScan(context.Background(), &contentv1.RunLinkScanRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestExtractContentLinks(t *testing.T) {
+	refs := extractContentLinks(models.Content{Blocks: []models.ContentBlock{
+		{Type: "image", Data: map[string]interface{}{"src": "/uploads/a.png", "alt": "/not-a-link-field"}},
+		{Type: "text", Data: map[string]interface{}{"content": "See [docs](/docs) and <img SRC='/uploads/b.png'>"}},
+		{Type: "text", Data: map[string]interface{}{"content": html.EscapeString(`<a href="/blog/escaped?a=1&b=2">saved via API</a>`)}},
+	}})
+	assert.Equal(t, []linkRef{
+		{blockIndex: 0, field: "src", url: "/uploads/a.png"},
+		{blockIndex: 1, field: "content", url: "/uploads/b.png"},
+		{blockIndex: 1, field: "content", url: "/docs"},
+		{blockIndex: 2, field: "content", url: "/blog/escaped?a=1&b=2"},
+	}, refs)
+}
+
+func TestLinkScanner_ExternalChecksStayOnPublicAddresses(t *testing.T) {
+	var requests int
+	var mu sync.Mutex
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+	}))
+	defer internal.Close()
+
+	_, scanner := newLinkScanTestService(internal.URL)
+	scanner.EnableExternalChecks()
+
+	report, err := scanner.Scan(context.Background(), models.LinkReportTriggerManual)
+	require.NoError(t, err)
+	assert.True(t, report.ExternalChecked)
+	mu.Lock()
+	assert.Zero(t, requests, "links to internal addresses are never requested")
+	mu.Unlock()
+	var external int
+	for _, issue := range report.Issues {
+		if issue.Kind == models.LinkIssueBrokenExternal {
+			external++
+			assert.Contains(t, issue.Detail, errNonPublicAddress.Error())
+		}
+	}
+	assert.Equal(t, 2, external)
+}
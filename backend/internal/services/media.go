@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 
@@ -15,7 +16,9 @@ import (
 	"github.com/7-solutions/saas-platformbackend/internal/models"
 	ports "github.com/7-solutions/saas-platformbackend/internal/ports"
 	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
 	"github.com/7-solutions/saas-platformbackend/internal/utils/media"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
 )
 
 // MediaService implements the media service
@@ -26,6 +29,11 @@ type MediaService struct {
 	fileStorage       media.FileStorageInterface
 	imageProcessor    *media.ImageProcessor
 	metadataExtractor *media.MetadataExtractor
+	events            EventPublisher
+	revalidator       revalidate.Revalidator
+	mediaUsages       MediaUsageFinder
+	// featuredImageUsages blocks deleting featured images; nil disables the check
+	featuredImageUsages FeaturedImageUsageFinder
 
 	// Optional future-use dependencies via ports (can be nil; not used yet)
 	uow ports.UnitOfWork
@@ -127,26 +135,25 @@ func (s *MediaService) UploadFile(ctx context.Context, req *mediav1.UploadFileRe
 	mediaDoc := models.NewMedia(filename, req.Filename, mimeType, "user-123", int64(len(processedContent))) // TODO: Get user from auth context
 	mediaDoc.AltText = req.AltText
 	mediaDoc.URL = url
+	if metadata.IsImage {
+		if width, height, err := s.imageProcessor.GetImageDimensions(processedContent); err == nil {
+			mediaDoc.Width, mediaDoc.Height = width, height
+			s.saveVariants(mediaDoc, processedContent)
+		}
+	}
 
 	// Save to database
 	if err := s.mediaRepo.Create(ctx, mediaDoc); err != nil {
-		// Clean up file if database save fails
+		// Clean up files if database save fails
 		s.fileStorage.DeleteFile(filename)
+		s.deleteVariants(mediaDoc)
 		return nil, status.Errorf(codes.Internal, "failed to save media record: %v", err)
 	}
 
 	// Convert to protobuf response
-	file := &mediav1.File{
-		Id:           mediaDoc.ID,
-		Filename:     mediaDoc.Filename,
-		OriginalName: mediaDoc.OriginalName,
-		MimeType:     mediaDoc.MimeType,
-		Size:         mediaDoc.Size,
-		Url:          mediaDoc.URL,
-		AltText:      mediaDoc.AltText,
-		UploadedBy:   mediaDoc.UploadedBy,
-		CreatedAt:    timestamppb.New(mediaDoc.CreatedAt),
-	}
+	file := convertMediaToProto(mediaDoc)
+
+	publishEvent(ctx, s.events, models.WebhookEventMediaUploaded, file)
 
 	return file, nil
 }
@@ -169,17 +176,7 @@ func (s *MediaService) GetFile(ctx context.Context, req *mediav1.GetFileRequest)
 	}
 
 	// Convert to protobuf response
-	file := &mediav1.File{
-		Id:           mediaDoc.ID,
-		Filename:     mediaDoc.Filename,
-		OriginalName: mediaDoc.OriginalName,
-		MimeType:     mediaDoc.MimeType,
-		Size:         mediaDoc.Size,
-		Url:          mediaDoc.URL,
-		AltText:      mediaDoc.AltText,
-		UploadedBy:   mediaDoc.UploadedBy,
-		CreatedAt:    timestamppb.New(mediaDoc.CreatedAt),
-	}
+	file := convertMediaToProto(mediaDoc)
 
 	return file, nil
 }
@@ -196,6 +193,24 @@ func (s *MediaService) DeleteFile(ctx context.Context, req *mediav1.DeleteFileRe
 		return nil, status.Errorf(codes.NotFound, "file not found: %v", err)
 	}
 
+	// Refuse to break featured images unless forced
+	if s.featuredImageUsages != nil {
+		posts, err := s.featuredImageUsages.FindFeaturedImageUsages(ctx, mediaDoc.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check media usages: %v", err)
+		}
+		if len(posts) > 0 && !req.Force {
+			titles := make([]string, 0, len(posts))
+			for _, post := range posts {
+				titles = append(titles, fmt.Sprintf("%q", post.Title))
+			}
+			return nil, status.Errorf(codes.FailedPrecondition, "file is the featured image of %d blog post(s): %s; delete with force to remove it anyway", len(posts), strings.Join(titles, ", "))
+		}
+		if len(posts) > 0 {
+			logger.Warn("Deleting media used as featured image", "media_id", mediaDoc.ID, "posts", len(posts))
+		}
+	}
+
 	// Delete from database first
 	if err := s.mediaRepo.Delete(ctx, req.Id); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete media record: %v", err)
@@ -207,6 +222,13 @@ func (s *MediaService) DeleteFile(ctx context.Context, req *mediav1.DeleteFileRe
 		// In production, you might want to implement a cleanup job for orphaned files
 		fmt.Printf("Warning: failed to delete file from storage: %v\n", err)
 	}
+	s.deleteVariants(mediaDoc)
+
+	publishEvent(ctx, s.events, models.WebhookEventMediaDeleted, map[string]string{
+		"id":       mediaDoc.ID,
+		"filename": mediaDoc.Filename,
+	})
+	s.revalidateMediaUsages(mediaDoc)
 
 	return &emptypb.Empty{}, nil
 }
@@ -270,17 +292,7 @@ func (s *MediaService) ListFiles(ctx context.Context, req *mediav1.ListFilesRequ
 	// Convert to protobuf response
 	files := make([]*mediav1.File, len(filteredMedia))
 	for i, media := range filteredMedia {
-		files[i] = &mediav1.File{
-			Id:           media.ID,
-			Filename:     media.Filename,
-			OriginalName: media.OriginalName,
-			MimeType:     media.MimeType,
-			Size:         media.Size,
-			Url:          media.URL,
-			AltText:      media.AltText,
-			UploadedBy:   media.UploadedBy,
-			CreatedAt:    timestamppb.New(media.CreatedAt),
-		}
+		files[i] = convertMediaToProto(media)
 	}
 
 	// Calculate next page token
@@ -320,8 +332,108 @@ func (s *MediaService) UpdateFile(ctx context.Context, req *mediav1.UpdateFileRe
 	if err := s.mediaRepo.Update(ctx, mediaDoc); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update media record: %v", err)
 	}
+	s.revalidateMediaUsages(mediaDoc)
 
 	// Convert to protobuf response
+	file := convertMediaToProto(mediaDoc)
+
+	publishEvent(ctx, s.events, models.WebhookEventMediaUpdated, file)
+
+	return file, nil
+}
+
+// SetRevalidator configures the ISR revalidator used to refresh the published pages and posts
+// embedding a media file after it changes. usages resolves those pages and posts.
+func (s *MediaService) SetRevalidator(r revalidate.Revalidator, usages MediaUsageFinder) {
+	s.revalidator = r
+	s.mediaUsages = usages
+}
+
+// revalidateMediaUsages asynchronously refreshes every published page and post embedding the media file
+func (s *MediaService) revalidateMediaUsages(mediaDoc *models.Media) {
+	if s.revalidator == nil || s.mediaUsages == nil {
+		return
+	}
+
+	go func(r revalidate.Revalidator, usages MediaUsageFinder, mediaDoc models.Media) {
+		ctx, cancel := context.WithTimeout(context.Background(), revalidateTimeout)
+		defer cancel()
+		pages, posts, err := usages.FindMediaUsages(ctx, &mediaDoc)
+		if err != nil {
+			logger.Error("Failed to find media usages for revalidation", err, "media_id", mediaDoc.ID)
+			return
+		}
+		t := &revalidationTargets{}
+		for _, page := range pages {
+			t.addPage(page)
+		}
+		for _, post := range posts {
+			t.addPost(post)
+		}
+		revalidateTargets(r, t)
+	}(s.revalidator, s.mediaUsages, *mediaDoc)
+}
+
+// FeaturedImageUsageFinder resolves the blog posts, in any status, that use a media file as featured image
+type FeaturedImageUsageFinder interface {
+	FindFeaturedImageUsages(ctx context.Context, mediaID string) ([]*models.BlogPost, error)
+}
+
+// SetFeaturedImageUsages enables the check that refuses to delete featured images without force
+func (s *MediaService) SetFeaturedImageUsages(usages FeaturedImageUsageFinder) {
+	s.featuredImageUsages = usages
+}
+
+// mediaVariantSizes are the renditions generated for uploaded images larger than their bounds
+var mediaVariantSizes = []struct {
+	name          string
+	width, height int
+}{
+	{"thumbnail", 320, 320},
+	{"medium", 768, 768},
+	{"large", 1280, 1280},
+}
+
+// saveVariants stores a downscaled copy of an image for every variant size it exceeds.
+// Variants are best effort: failures are logged and the upload proceeds without them.
+func (s *MediaService) saveVariants(mediaDoc *models.Media, content []byte) {
+	ext := path.Ext(mediaDoc.OriginalName)
+	base := strings.TrimSuffix(mediaDoc.OriginalName, ext)
+	for _, size := range mediaVariantSizes {
+		if mediaDoc.Width <= size.width && mediaDoc.Height <= size.height {
+			continue
+		}
+		resized, width, height, err := s.imageProcessor.Resize(content, size.width, size.height)
+		if err != nil {
+			logger.Error("Failed to resize image variant", err, "media_id", mediaDoc.ID, "variant", size.name)
+			continue
+		}
+		filename, url, err := s.fileStorage.SaveFile(resized, base+"-"+size.name+ext, mediaDoc.MimeType)
+		if err != nil {
+			logger.Error("Failed to save image variant", err, "media_id", mediaDoc.ID, "variant", size.name)
+			continue
+		}
+		mediaDoc.Variants = append(mediaDoc.Variants, models.MediaVariant{
+			Name:     size.name,
+			Filename: filename,
+			URL:      url,
+			Width:    width,
+			Height:   height,
+		})
+	}
+}
+
+// deleteVariants removes the variant files of a media file
+func (s *MediaService) deleteVariants(mediaDoc *models.Media) {
+	for _, variant := range mediaDoc.Variants {
+		if err := s.fileStorage.DeleteFile(variant.Filename); err != nil {
+			logger.Error("Failed to delete image variant", err, "media_id", mediaDoc.ID, "variant", variant.Name)
+		}
+	}
+}
+
+// convertMediaToProto converts a media document to its API representation
+func convertMediaToProto(mediaDoc *models.Media) *mediav1.File {
 	file := &mediav1.File{
 		Id:           mediaDoc.ID,
 		Filename:     mediaDoc.Filename,
@@ -332,7 +444,16 @@ func (s *MediaService) UpdateFile(ctx context.Context, req *mediav1.UpdateFileRe
 		AltText:      mediaDoc.AltText,
 		UploadedBy:   mediaDoc.UploadedBy,
 		CreatedAt:    timestamppb.New(mediaDoc.CreatedAt),
-	}
-
-	return file, nil
+		Width:        int32(mediaDoc.Width),
+		Height:       int32(mediaDoc.Height),
+	}
+	for _, variant := range mediaDoc.Variants {
+		file.Variants = append(file.Variants, &mediav1.Variant{
+			Name:   variant.Name,
+			Url:    variant.URL,
+			Width:  int32(variant.Width),
+			Height: int32(variant.Height),
+		})
+	}
+	return file
 }
@@ -59,6 +59,14 @@ func (m *MockMediaRepository) GetByID(ctx context.Context, id string) (*models.M
 	return args.Get(0).(*models.Media), args.Error(1)
 }
 
+func (m *MockMediaRepository) GetByIDs(ctx context.Context, ids []string) ([]*models.Media, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Media), args.Error(1)
+}
+
 func (m *MockMediaRepository) GetByFilename(ctx context.Context, filename string) (*models.Media, error) {
 	args := m.Called(ctx, filename)
 	if args.Get(0) == nil {
@@ -0,0 +1,592 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	navigationv1 "github.com/7-solutions/saas-platformbackend/gen/navigation/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
+)
+
+const (
+	// maxNavigationItems bounds the items of one menu, counting nested items
+	maxNavigationItems = 200
+	// maxNavigationDepth is the number of levels a menu can have, top level included
+	maxNavigationDepth = 3
+	maxNavigationLabel = 100
+
+	// revalidateTagNavigation tags every menu fetch of the website; each menu is also
+	// tagged "navigation:<slug>"
+	revalidateTagNavigation = "navigation"
+)
+
+// navigationLocalePattern accepts lowercased BCP 47 style locales such as "de" or "pt-br"
+var navigationLocalePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// NavigationService manages the website's menus. Items link to content by ID, so menus
+// follow slug changes and hide links to content that is unpublished or deleted.
+type NavigationService struct {
+	navigationv1.UnimplementedNavigationServiceServer
+	repo        repository.NavigationRepository
+	content     *ContentService
+	revalidator revalidate.Revalidator
+}
+
+// NewNavigationService creates a navigation service; links are resolved through the
+// page and blog repositories of the content service
+func NewNavigationService(repo repository.NavigationRepository, content *ContentService) *NavigationService {
+	return &NavigationService{
+		repo:    repo,
+		content: content,
+	}
+}
+
+// SetRevalidator enables frontend cache revalidation after menus change
+func (s *NavigationService) SetRevalidator(r revalidate.Revalidator) {
+	s.revalidator = r
+}
+
+// CreateMenu validates and creates a menu
+func (s *NavigationService) CreateMenu(ctx context.Context, req *navigationv1.CreateMenuRequest) (*navigationv1.Menu, error) {
+	slug, err := s.validateMenuFields(ctx, req.Name, req.Slug, "")
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.validateItems(ctx, req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _ := ctx.Value("user_id").(string)
+	menu := &models.NavigationMenu{
+		Slug:        slug,
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Items:       items,
+		CreatedBy:   userID,
+	}
+	if err := s.repo.Create(ctx, menu); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create menu: %v", err)
+	}
+	s.revalidate(menu)
+
+	return s.convertMenuToProto(ctx, menu, "", true), nil
+}
+
+// GetMenu returns a menu by ID or slug. Hidden items are left out unless an editor asks for them.
+func (s *NavigationService) GetMenu(ctx context.Context, req *navigationv1.GetMenuRequest) (*navigationv1.Menu, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "menu ID is required")
+	}
+	locale, err := normalizeNavigationLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	menu, err := s.getMenuByIDOrSlug(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertMenuToProto(ctx, menu, locale, req.IncludeHidden && canEditNavigation(ctx)), nil
+}
+
+// ListMenus lists menus by name with their hidden items included
+func (s *NavigationService) ListMenus(ctx context.Context, req *navigationv1.ListMenusRequest) (*navigationv1.ListMenusResponse, error) {
+	locale, err := normalizeNavigationLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize, skip := webhookPagination(req.PageSize, req.PageToken)
+	// Fetch one extra row to learn whether another page exists
+	menus, err := s.repo.List(ctx, repository.ListOptions{Limit: pageSize + 1, Skip: skip})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list menus: %v", err)
+	}
+
+	resp := &navigationv1.ListMenusResponse{}
+	if len(menus) > pageSize {
+		menus = menus[:pageSize]
+		resp.NextPageToken = strconv.Itoa(skip + pageSize)
+	}
+	for _, menu := range menus {
+		resp.Menus = append(resp.Menus, s.convertMenuToProto(ctx, menu, locale, true))
+	}
+	return resp, nil
+}
+
+// UpdateMenu replaces the name, slug, description and item tree of a menu
+func (s *NavigationService) UpdateMenu(ctx context.Context, req *navigationv1.UpdateMenuRequest) (*navigationv1.Menu, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "menu ID is required")
+	}
+	existing, err := s.repo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+	}
+
+	slug, err := s.validateMenuFields(ctx, req.Name, req.Slug, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.validateItems(ctx, req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *existing
+	existing.Slug = slug
+	existing.Name = strings.TrimSpace(req.Name)
+	existing.Description = strings.TrimSpace(req.Description)
+	existing.Items = items
+	if err := s.repo.Update(ctx, existing); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update menu: %v", err)
+	}
+	s.revalidate(&before, existing)
+
+	return s.convertMenuToProto(ctx, existing, "", true), nil
+}
+
+// DeleteMenu deletes a menu
+func (s *NavigationService) DeleteMenu(ctx context.Context, req *navigationv1.DeleteMenuRequest) (*emptypb.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "menu ID is required")
+	}
+	menu, err := s.repo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+	}
+
+	if err := s.repo.Delete(ctx, menu.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete menu: %v", err)
+	}
+	s.revalidate(menu)
+
+	return &emptypb.Empty{}, nil
+}
+
+// Helpers
+
+// getMenuByIDOrSlug looks a menu up by slug first, so the website can fetch "header"
+// and "footer" without knowing their IDs
+func (s *NavigationService) getMenuByIDOrSlug(ctx context.Context, idOrSlug string) (*models.NavigationMenu, error) {
+	if menu, err := s.repo.GetBySlug(ctx, idOrSlug); err == nil {
+		return menu, nil
+	}
+	menu, err := s.repo.GetByID(ctx, idOrSlug)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "menu not found: %v", err)
+	}
+	return menu, nil
+}
+
+// validateMenuFields checks the name and returns the sanitized slug, which must be unique
+func (s *NavigationService) validateMenuFields(ctx context.Context, name, slug, excludeID string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > 100 {
+		return "", status.Errorf(codes.InvalidArgument, "name must be less than 100 characters")
+	}
+	if slug == "" {
+		slug = s.content.generateSlug(name)
+	} else {
+		slug = s.content.sanitizeSlug(slug)
+	}
+	if slug == "" {
+		return "", status.Errorf(codes.InvalidArgument, "slug is required")
+	}
+	if existing, err := s.repo.GetBySlug(ctx, slug); err == nil && existing != nil && existing.ID != excludeID {
+		return "", status.Errorf(codes.AlreadyExists, "menu with slug '%s' already exists", slug)
+	}
+	return slug, nil
+}
+
+// navigationValidation carries the state of validating one item tree
+type navigationValidation struct {
+	ctx        context.Context
+	service    *NavigationService
+	ids        map[string]bool
+	count      int
+	categories map[string]bool
+}
+
+// validateItems validates an item tree and converts it to the stored form. Content links
+// must point at existing pages, posts and categories; drafts are allowed and stay hidden
+// until they are published.
+func (s *NavigationService) validateItems(ctx context.Context, inputs []*navigationv1.MenuItemInput) ([]models.NavigationItem, error) {
+	v := &navigationValidation{ctx: ctx, service: s, ids: map[string]bool{}}
+	return v.items(inputs, 1)
+}
+
+func (v *navigationValidation) items(inputs []*navigationv1.MenuItemInput, depth int) ([]models.NavigationItem, error) {
+	if len(inputs) > 0 && depth > maxNavigationDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "menus can be nested at most %d levels deep", maxNavigationDepth)
+	}
+	out := make([]models.NavigationItem, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			continue
+		}
+		v.count++
+		if v.count > maxNavigationItems {
+			return nil, status.Errorf(codes.InvalidArgument, "a menu can hold at most %d items", maxNavigationItems)
+		}
+		item, err := v.item(input)
+		if err != nil {
+			return nil, err
+		}
+		if item.Children, err = v.items(input.Children, depth+1); err != nil {
+			return nil, err
+		}
+		if len(item.Children) == 0 {
+			item.Children = nil
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func (v *navigationValidation) item(input *navigationv1.MenuItemInput) (models.NavigationItem, error) {
+	item := models.NavigationItem{
+		ID:           strings.TrimSpace(input.Id),
+		Label:        strings.TrimSpace(input.Label),
+		LinkType:     convertProtoLinkTypeToModel(input.LinkType),
+		OpenInNewTab: input.OpenInNewTab,
+	}
+	if item.ID == "" {
+		item.ID = newEventID()
+	}
+	if len(item.ID) > 64 {
+		return item, status.Errorf(codes.InvalidArgument, "menu item ID must be less than 64 characters")
+	}
+	if v.ids[item.ID] {
+		return item, status.Errorf(codes.InvalidArgument, "menu item ID '%s' is used more than once", item.ID)
+	}
+	v.ids[item.ID] = true
+
+	if err := validateNavigationLabel(item.Label); err != nil {
+		return item, err
+	}
+	for locale, label := range input.Labels {
+		normalized, err := normalizeNavigationLocale(locale)
+		if err != nil {
+			return item, err
+		}
+		label = strings.TrimSpace(label)
+		if normalized == "" || label == "" {
+			continue
+		}
+		if err := validateNavigationLabel(label); err != nil {
+			return item, err
+		}
+		if item.Labels == nil {
+			item.Labels = map[string]string{}
+		}
+		item.Labels[normalized] = label
+	}
+
+	target := strings.TrimSpace(input.TargetId)
+	switch item.LinkType {
+	case models.NavigationLinkPage:
+		if target == "" {
+			return item, status.Errorf(codes.InvalidArgument, "page links require a target ID")
+		}
+		if _, err := v.service.content.pageRepo.GetByID(v.ctx, target); err != nil {
+			return item, status.Errorf(codes.InvalidArgument, "page '%s' does not exist", target)
+		}
+		item.TargetID = target
+	case models.NavigationLinkPost:
+		if target == "" {
+			return item, status.Errorf(codes.InvalidArgument, "post links require a target ID")
+		}
+		if _, err := v.service.content.blogRepo.GetByID(v.ctx, target); err != nil {
+			return item, status.Errorf(codes.InvalidArgument, "blog post '%s' does not exist", target)
+		}
+		item.TargetID = target
+	case models.NavigationLinkCategory:
+		target = strings.ToLower(target)
+		if target == "" {
+			return item, status.Errorf(codes.InvalidArgument, "category links require a category slug")
+		}
+		known, err := v.categorySlugs()
+		if err != nil {
+			return item, err
+		}
+		if !known[target] {
+			return item, status.Errorf(codes.InvalidArgument, "category '%s' does not exist", target)
+		}
+		item.TargetID = target
+	case models.NavigationLinkURL:
+		link, err := validateNavigationURL(input.Url)
+		if err != nil {
+			return item, err
+		}
+		if item.Label == "" {
+			return item, status.Errorf(codes.InvalidArgument, "URL links require a label")
+		}
+		item.URL = link
+	case models.NavigationLinkNone:
+		if item.Label == "" {
+			return item, status.Errorf(codes.InvalidArgument, "items without a link require a label")
+		}
+	default:
+		return item, status.Errorf(codes.InvalidArgument, "menu item link type is required")
+	}
+	return item, nil
+}
+
+// categorySlugs loads the known category slugs once per validation
+func (v *navigationValidation) categorySlugs() (map[string]bool, error) {
+	if v.categories != nil {
+		return v.categories, nil
+	}
+	categories, err := v.service.content.blogRepo.GetCategories(v.ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+	}
+	v.categories = make(map[string]bool, len(categories))
+	for _, category := range categories {
+		v.categories[category.Slug] = true
+	}
+	return v.categories, nil
+}
+
+func validateNavigationLabel(label string) error {
+	if utf8.RuneCountInString(label) > maxNavigationLabel {
+		return status.Errorf(codes.InvalidArgument, "labels must be less than %d characters", maxNavigationLabel)
+	}
+	return nil
+}
+
+// validateNavigationURL accepts website paths and absolute http(s), mailto: and tel: URLs
+func validateNavigationURL(raw string) (string, error) {
+	link := strings.TrimSpace(raw)
+	if link == "" {
+		return "", status.Errorf(codes.InvalidArgument, "URL links require a URL")
+	}
+	if len(link) > 2048 {
+		return "", status.Errorf(codes.InvalidArgument, "URL must be less than 2048 characters")
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid URL '%s'", link)
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "":
+		// Paths on the website; "//host" would leave the site with the current scheme
+		if strings.HasPrefix(link, "/") && !strings.HasPrefix(link, "//") {
+			return link, nil
+		}
+	case "http", "https":
+		if parsed.Host != "" {
+			return link, nil
+		}
+	case "mailto", "tel":
+		if parsed.Opaque != "" {
+			return link, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "URL must be a path starting with '/' or an http(s), mailto: or tel: URL")
+}
+
+// normalizeNavigationLocale lowercases a locale and turns "pt_BR" into "pt-br"
+func normalizeNavigationLocale(locale string) (string, error) {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if locale != "" && !navigationLocalePattern.MatchString(locale) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid locale '%s'", locale)
+	}
+	return locale, nil
+}
+
+// canEditNavigation reports whether the caller may see hidden menu items
+func canEditNavigation(ctx context.Context) bool {
+	role, _ := ctx.Value("user_role").(string)
+	return role == models.UserRoleAdmin || role == models.UserRoleEditor
+}
+
+// revalidate refreshes the website's cached menus
+func (s *NavigationService) revalidate(menus ...*models.NavigationMenu) {
+	t := &revalidationTargets{}
+	t.addTag(revalidateTagNavigation)
+	for _, menu := range menus {
+		t.addTag("navigation:" + menu.Slug)
+	}
+	revalidateTargets(s.revalidator, t)
+}
+
+// navigationResolver resolves the links of menu items for one read, loading each
+// page, post and the category list at most once
+type navigationResolver struct {
+	ctx           context.Context
+	content       *ContentService
+	locale        string
+	includeHidden bool
+
+	pages      map[string]*models.Page
+	posts      map[string]*models.BlogPost
+	categories map[string]*models.BlogCategory
+}
+
+func (r *navigationResolver) items(items []models.NavigationItem) []*navigationv1.MenuItem {
+	out := make([]*navigationv1.MenuItem, 0, len(items))
+	for _, item := range items {
+		resolved := r.item(item)
+		if resolved.Hidden && !r.includeHidden {
+			continue
+		}
+		out = append(out, resolved)
+	}
+	return out
+}
+
+// item resolves one item and its children. Items linking to missing or unpublished
+// content are hidden with their children, as are groups without visible children.
+func (r *navigationResolver) item(item models.NavigationItem) *navigationv1.MenuItem {
+	out := &navigationv1.MenuItem{
+		Id:           item.ID,
+		LinkType:     convertModelLinkTypeToProto(item.LinkType),
+		TargetId:     item.TargetID,
+		OpenInNewTab: item.OpenInNewTab,
+		DefaultLabel: item.Label,
+		Labels:       item.Labels,
+		Children:     r.items(item.Children),
+	}
+
+	title, link, ok := r.link(item)
+	out.Url = link
+	out.Label = firstNonEmpty(item.LabelFor(r.locale), title)
+	out.Hidden = !ok
+	if item.LinkType == models.NavigationLinkNone {
+		out.Hidden = true
+		for _, child := range out.Children {
+			if !child.Hidden {
+				out.Hidden = false
+				break
+			}
+		}
+	}
+	return out
+}
+
+// link returns the title and website URL of an item's target and whether it can be shown
+func (r *navigationResolver) link(item models.NavigationItem) (string, string, bool) {
+	switch item.LinkType {
+	case models.NavigationLinkPage:
+		page := r.page(item.TargetID)
+		if page == nil {
+			return "", "", false
+		}
+		return page.Title, "/" + page.Slug, page.Status == models.PageStatusPublished
+	case models.NavigationLinkPost:
+		post := r.post(item.TargetID)
+		if post == nil {
+			return "", "", false
+		}
+		return post.Title, "/blog/" + post.Slug, post.IsPublished()
+	case models.NavigationLinkCategory:
+		category := r.category(item.TargetID)
+		if category == nil {
+			return "", "", false
+		}
+		return category.Name, "/blog?category=" + url.QueryEscape(category.Slug), true
+	case models.NavigationLinkURL:
+		return "", item.URL, true
+	default:
+		return "", "", true
+	}
+}
+
+func (r *navigationResolver) page(id string) *models.Page {
+	if page, ok := r.pages[id]; ok {
+		return page
+	}
+	page, err := r.content.pageRepo.GetByID(r.ctx, id)
+	if err != nil {
+		page = nil
+	}
+	r.pages[id] = page
+	return page
+}
+
+func (r *navigationResolver) post(id string) *models.BlogPost {
+	if post, ok := r.posts[id]; ok {
+		return post
+	}
+	post, err := r.content.blogRepo.GetByID(r.ctx, id)
+	if err != nil {
+		post = nil
+	}
+	r.posts[id] = post
+	return post
+}
+
+func (r *navigationResolver) category(slug string) *models.BlogCategory {
+	if r.categories == nil {
+		r.categories = map[string]*models.BlogCategory{}
+		categories, err := r.content.blogRepo.GetCategories(r.ctx)
+		if err == nil {
+			for _, category := range categories {
+				r.categories[category.Slug] = category
+			}
+		}
+	}
+	return r.categories[slug]
+}
+
+// Conversion helpers
+
+// convertMenuToProto converts a menu to protobuf with its links resolved and labels in the locale
+func (s *NavigationService) convertMenuToProto(ctx context.Context, menu *models.NavigationMenu, locale string, includeHidden bool) *navigationv1.Menu {
+	resolver := &navigationResolver{
+		ctx:           ctx,
+		content:       s.content,
+		locale:        locale,
+		includeHidden: includeHidden,
+		pages:         map[string]*models.Page{},
+		posts:         map[string]*models.BlogPost{},
+	}
+	return &navigationv1.Menu{
+		Id:          menu.ID,
+		Slug:        menu.Slug,
+		Name:        menu.Name,
+		Description: menu.Description,
+		Items:       resolver.items(menu.Items),
+		Locale:      locale,
+		CreatedAt:   timestamppb.New(menu.CreatedAt),
+		UpdatedAt:   timestamppb.New(menu.UpdatedAt),
+	}
+}
+
+var linkTypeToProto = map[string]navigationv1.LinkType{
+	models.NavigationLinkPage:     navigationv1.LinkType_LINK_TYPE_PAGE,
+	models.NavigationLinkPost:     navigationv1.LinkType_LINK_TYPE_POST,
+	models.NavigationLinkCategory: navigationv1.LinkType_LINK_TYPE_CATEGORY,
+	models.NavigationLinkURL:      navigationv1.LinkType_LINK_TYPE_URL,
+	models.NavigationLinkNone:     navigationv1.LinkType_LINK_TYPE_NONE,
+}
+
+func convertModelLinkTypeToProto(t string) navigationv1.LinkType {
+	return linkTypeToProto[t]
+}
+
+func convertProtoLinkTypeToModel(t navigationv1.LinkType) string {
+	for model, proto := range linkTypeToProto {
+		if proto == t {
+			return model
+		}
+	}
+	return ""
+}
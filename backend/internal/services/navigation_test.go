@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	navigationv1 "github.com/7-solutions/saas-platformbackend/gen/navigation/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// memoryNavigationRepo keeps menus in memory
+type memoryNavigationRepo struct {
+	mu    sync.Mutex
+	menus map[string]*models.NavigationMenu
+}
+
+func (r *memoryNavigationRepo) Create(ctx context.Context, menu *models.NavigationMenu) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	menu.ID = newEventID()
+	stored := *menu
+	r.menus[menu.ID] = &stored
+	return nil
+}
+
+func (r *memoryNavigationRepo) GetByID(ctx context.Context, id string) (*models.NavigationMenu, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	menu, ok := r.menus[id]
+	if !ok {
+		return nil, appErr.ErrNotFound
+	}
+	copied := *menu
+	return &copied, nil
+}
+
+func (r *memoryNavigationRepo) GetBySlug(ctx context.Context, slug string) (*models.NavigationMenu, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, menu := range r.menus {
+		if menu.Slug == slug {
+			copied := *menu
+			return &copied, nil
+		}
+	}
+	return nil, appErr.ErrNotFound
+}
+
+func (r *memoryNavigationRepo) List(ctx context.Context, options repository.ListOptions) ([]*models.NavigationMenu, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.NavigationMenu
+	for _, menu := range r.menus {
+		out = append(out, menu)
+	}
+	return out, nil
+}
+
+func (r *memoryNavigationRepo) Update(ctx context.Context, menu *models.NavigationMenu) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *menu
+	r.menus[menu.ID] = &stored
+	return nil
+}
+
+func (r *memoryNavigationRepo) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.menus, id)
+	return nil
+}
+
+// navigationFixture holds a published "About" page, a draft "Careers" page and a
+// published post in the "Go" category
+type navigationFixture struct {
+	service *NavigationService
+	about   *models.Page
+	careers *models.Page
+	post    *models.BlogPost
+}
+
+func newNavigationFixture() *navigationFixture {
+	about := models.NewPage("About", "about")
+	about.Status = models.PageStatusPublished
+	careers := models.NewPage("Careers", "careers")
+
+	post := models.NewBlogPost("Launch", "launch", "user-1")
+	post.SetPublished()
+	post.Categories = []string{"Go"}
+
+	blogRepo := newsletterBlogRepo{&archiveBlogRepo{memoryBlogRepo: &memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}}}}
+	content := NewContentService(&memoryPageRepo{pages: []*models.Page{about, careers}}, blogRepo)
+	service := NewNavigationService(&memoryNavigationRepo{menus: map[string]*models.NavigationMenu{}}, content)
+	return &navigationFixture{service: service, about: about, careers: careers, post: post}
+}
+
+func menuLabels(items []*navigationv1.MenuItem) []string {
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		out = append(out, item.Label)
+	}
+	return out
+}
+
+func TestNavigationService_ResolvesContentLinks(t *testing.T) {
+	f := newNavigationFixture()
+	editor := readerContext("editor-1", models.UserRoleEditor)
+
+	_, err := f.service.CreateMenu(editor, &navigationv1.CreateMenuRequest{
+		Name: "Header",
+		Items: []*navigationv1.MenuItemInput{
+			{LinkType: navigationv1.LinkType_LINK_TYPE_PAGE, TargetId: f.about.ID, Labels: map[string]string{"de": "Über uns", "pt_BR": "Sobre"}},
+			{LinkType: navigationv1.LinkType_LINK_TYPE_POST, TargetId: f.post.ID, Label: "What's new"},
+			{LinkType: navigationv1.LinkType_LINK_TYPE_CATEGORY, TargetId: "GO"},
+			{LinkType: navigationv1.LinkType_LINK_TYPE_NONE, Label: "Company", Children: []*navigationv1.MenuItemInput{
+				{LinkType: navigationv1.LinkType_LINK_TYPE_PAGE, TargetId: f.careers.ID},
+				{LinkType: navigationv1.LinkType_LINK_TYPE_URL, Label: "Contact", Url: "/contact"},
+				{LinkType: navigationv1.LinkType_LINK_TYPE_URL, Label: "GitHub", Url: "https://github.com/example", OpenInNewTab: true},
+			}},
+			{LinkType: navigationv1.LinkType_LINK_TYPE_NONE, Label: "Jobs", Children: []*navigationv1.MenuItemInput{
+				{LinkType: navigationv1.LinkType_LINK_TYPE_PAGE, TargetId: f.careers.ID},
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	menu, err := f.service.GetMenu(context.Background(), &navigationv1.GetMenuRequest{Id: "header", Locale: "de-AT"})
+	require.NoError(t, err)
+	assert.Equal(t, "de-at", menu.Locale)
+	assert.Equal(t, []string{"Über uns", "What's new", "Go", "Company"}, menuLabels(menu.Items), "groups without visible children are hidden")
+	assert.Equal(t, "/about", menu.Items[0].Url)
+	assert.Equal(t, map[string]string{"de": "Über uns", "pt-br": "Sobre"}, menu.Items[0].Labels)
+	assert.Equal(t, "/blog/launch", menu.Items[1].Url)
+	assert.Equal(t, "/blog?category=go", menu.Items[2].Url)
+	assert.Equal(t, "go", menu.Items[2].TargetId)
+	company := menu.Items[3]
+	assert.Empty(t, company.Url)
+	assert.Equal(t, []string{"Contact", "GitHub"}, menuLabels(company.Children), "draft pages are hidden")
+	assert.True(t, company.Children[1].OpenInNewTab)
+
+	// Without a matching locale the default label, then the page title is used
+	menu, err = f.service.GetMenu(context.Background(), &navigationv1.GetMenuRequest{Id: "header", Locale: "fr"})
+	require.NoError(t, err)
+	assert.Equal(t, "About", menu.Items[0].Label)
+
+	// Slug changes are followed, unpublished and deleted content disappears
+	f.about.Slug = "about-us"
+	f.careers.Status = models.PageStatusPublished
+	f.post.Status = models.PageStatusDraft
+	menu, err = f.service.GetMenu(context.Background(), &navigationv1.GetMenuRequest{Id: "header"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"About", "Go", "Company", "Jobs"}, menuLabels(menu.Items))
+	assert.Equal(t, "/about-us", menu.Items[0].Url)
+	assert.Equal(t, "/careers", menu.Items[3].Children[0].Url)
+
+	// Editors can ask for hidden items to repair them; anyone else gets the public menu
+	menu, err = f.service.GetMenu(editor, &navigationv1.GetMenuRequest{Id: "header", IncludeHidden: true})
+	require.NoError(t, err)
+	require.Len(t, menu.Items, 5)
+	assert.True(t, menu.Items[1].Hidden)
+	assert.Equal(t, "/blog/launch", menu.Items[1].Url)
+	menu, err = f.service.GetMenu(readerContext("user-1", models.UserRoleViewer), &navigationv1.GetMenuRequest{Id: "header", IncludeHidden: true})
+	require.NoError(t, err)
+	assert.Len(t, menu.Items, 4)
+
+	_, err = f.service.GetMenu(context.Background(), &navigationv1.GetMenuRequest{Id: "footer"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = f.service.GetMenu(context.Background(), &navigationv1.GetMenuRequest{Id: "header", Locale: "not a locale"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestNavigationService_ValidatesItems(t *testing.T) {
+	nested := func(depth int) []*navigationv1.MenuItemInput {
+		var items []*navigationv1.MenuItemInput
+		for i := 0; i < depth; i++ {
+			items = []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_NONE, Label: "Level", Children: items}}
+		}
+		return items
+	}
+	link := func(url string) []*navigationv1.MenuItemInput {
+		return []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_URL, Label: "Link", Url: url}}
+	}
+
+	tests := []struct {
+		name  string
+		items []*navigationv1.MenuItemInput
+		valid bool
+	}{
+		{"three levels", nested(3), true},
+		{"four levels", nested(4), false},
+		{"missing link type", []*navigationv1.MenuItemInput{{Label: "Home", Url: "/"}}, false},
+		{"unknown page", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_PAGE, TargetId: "page:missing"}}, false},
+		{"unknown post", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_POST, TargetId: "blog:missing"}}, false},
+		{"unknown category", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_CATEGORY, TargetId: "cooking"}}, false},
+		{"draft page", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_PAGE, TargetId: "page:careers"}}, true},
+		{"website path", link("/blog?tag=go#top"), true},
+		{"mailto", link("mailto:hello@example.com"), true},
+		{"javascript", link("javascript:alert(1)"), false},
+		{"protocol relative", link("//evil.example"), false},
+		{"relative path", link("contact"), false},
+		{"URL without label", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_URL, Url: "/contact"}}, false},
+		{"group without label", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_NONE}}, false},
+		{"invalid locale", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_NONE, Label: "Menu", Labels: map[string]string{"english": "Menu"}}}, false},
+		{"long label", []*navigationv1.MenuItemInput{{LinkType: navigationv1.LinkType_LINK_TYPE_NONE, Label: strings.Repeat("a", 101)}}, false},
+		{"duplicate ID", []*navigationv1.MenuItemInput{
+			{Id: "home", LinkType: navigationv1.LinkType_LINK_TYPE_URL, Label: "Home", Url: "/"},
+			{Id: "home", LinkType: navigationv1.LinkType_LINK_TYPE_URL, Label: "Start", Url: "/"},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newNavigationFixture()
+			_, err := f.service.CreateMenu(context.Background(), &navigationv1.CreateMenuRequest{Name: "Header", Items: tt.items})
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), "got %v", err)
+			}
+		})
+	}
+}
+
+func TestNavigationService_UpdateMenu(t *testing.T) {
+	f := newNavigationFixture()
+	revalidator := &collectingRevalidator{}
+	f.service.SetRevalidator(revalidator)
+	ctx := readerContext("editor-1", models.UserRoleEditor)
+
+	created, err := f.service.CreateMenu(ctx, &navigationv1.CreateMenuRequest{
+		Name: "Header",
+		Items: []*navigationv1.MenuItemInput{
+			{LinkType: navigationv1.LinkType_LINK_TYPE_URL, Label: "Home", Url: "/"},
+			{LinkType: navigationv1.LinkType_LINK_TYPE_PAGE, TargetId: f.about.ID},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "header", created.Slug)
+	home, about := created.Items[0], created.Items[1]
+	assert.NotEmpty(t, home.Id)
+	assert.NotEqual(t, home.Id, about.Id)
+	_, tags := revalidator.wait(t, 2)
+	assert.ElementsMatch(t, []string{"navigation", "navigation:header"}, tags)
+
+	_, err = f.service.CreateMenu(ctx, &navigationv1.CreateMenuRequest{Name: "Header"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Reorder and nest: the tree is replaced, IDs sent back are kept
+	updated, err := f.service.UpdateMenu(ctx, &navigationv1.UpdateMenuRequest{
+		Id:   created.Id,
+		Name: "Main",
+		Slug: "Main Menu",
+		Items: []*navigationv1.MenuItemInput{
+			{Id: about.Id, LinkType: navigationv1.LinkType_LINK_TYPE_PAGE, TargetId: f.about.ID, Children: []*navigationv1.MenuItemInput{
+				{Id: home.Id, LinkType: navigationv1.LinkType_LINK_TYPE_URL, Label: "Home", Url: "/"},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "main-menu", updated.Slug)
+	require.Len(t, updated.Items, 1)
+	assert.Equal(t, about.Id, updated.Items[0].Id)
+	assert.Equal(t, home.Id, updated.Items[0].Children[0].Id)
+	_, tags = revalidator.wait(t, 5)
+	assert.ElementsMatch(t, []string{"navigation", "navigation:header", "navigation", "navigation:header", "navigation:main-menu"}, tags)
+
+	_, err = f.service.GetMenu(context.Background(), &navigationv1.GetMenuRequest{Id: created.Id})
+	require.NoError(t, err)
+	_, err = f.service.UpdateMenu(ctx, &navigationv1.UpdateMenuRequest{Id: newEventID(), Name: "Footer"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestNavigationItem_LabelFor(t *testing.T) {
+	item := models.NavigationItem{Label: "About", Labels: map[string]string{"de": "Über uns", "pt-br": "Sobre nós"}}
+	assert.Equal(t, "Über uns", item.LabelFor("de"))
+	assert.Equal(t, "Über uns", item.LabelFor("DE-at"))
+	assert.Equal(t, "Sobre nós", item.LabelFor("pt-BR"))
+	assert.Equal(t, "About", item.LabelFor("pt"))
+	assert.Equal(t, "About", item.LabelFor(""))
+}
@@ -0,0 +1,962 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	newsletterv1 "github.com/7-solutions/saas-platformbackend/gen/newsletter/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/ratelimit"
+)
+
+const (
+	// DefaultNewsletterDigestInterval sends one digest a week
+	DefaultNewsletterDigestInterval = 7 * 24 * time.Hour
+
+	// newsletterConfirmTokenTTL bounds confirmation links; preference and unsubscribe
+	// links in sent digests never expire
+	newsletterConfirmTokenTTL = 7 * 24 * time.Hour
+	// newsletterConfirmResendDelay stops repeated sign-ups from flooding an inbox with confirmations
+	newsletterConfirmResendDelay = 10 * time.Minute
+	// newsletterMaxSoftBounces turns repeated transient delivery failures into a bounce
+	newsletterMaxSoftBounces = 3
+	// newsletterMaxDigestPosts bounds the posts in one digest
+	newsletterMaxDigestPosts = 20
+	// newsletterPreviewBlocks is how many content blocks of each post the digest shows
+	newsletterPreviewBlocks = 3
+	newsletterSendBatchSize = 100
+	newsletterEmailTimeout  = 30 * time.Second
+
+	// Default public sign-up rate: 5 per client per 10 minutes
+	defaultNewsletterRateLimit  = 5
+	defaultNewsletterRateWindow = 10 * time.Minute
+
+	newsletterSubscribeMessage = "Thanks! Please check your inbox and confirm your subscription."
+)
+
+// Token purposes; a token signed for one purpose is rejected for the other
+const (
+	newsletterTokenConfirm = "confirm"
+	newsletterTokenManage  = "manage"
+)
+
+// Delivery event types accepted by HandleDeliveryEvent
+const (
+	NewsletterEventBounce    = "bounce"
+	NewsletterEventComplaint = "complaint"
+)
+
+// ErrNewsletterDigestRunning is returned when a digest is requested while another one is being sent
+var ErrNewsletterDigestRunning = errors.New("a newsletter digest is already being sent")
+
+// NewsletterMailer sends newsletter email with plain text and HTML alternatives; EmailService implements it
+type NewsletterMailer interface {
+	SendHTMLEmail(ctx context.Context, to, subject, textBody, htmlBody string) error
+}
+
+// NewsletterConfig configures the newsletter
+type NewsletterConfig struct {
+	// Site names the newsletter; its URL is the base of post links and of the website's
+	// /newsletter/confirm, /newsletter/preferences and /newsletter/unsubscribe pages.
+	// DefaultSiteInfo is used when nil.
+	Site SiteInfoSource
+	// Secret signs the tokens in confirmation, preference and unsubscribe links
+	Secret string
+	// DigestInterval is the time between scheduled digests
+	DigestInterval time.Duration
+}
+
+// NewsletterDeliveryEvent is a bounce or complaint reported by the email provider
+type NewsletterDeliveryEvent struct {
+	// Type is NewsletterEventBounce or NewsletterEventComplaint
+	Type  string `json:"type"`
+	Email string `json:"email"`
+	// BounceType is "hard" for permanent failures and "soft" for transient ones;
+	// bounces without a type are treated as hard
+	BounceType string `json:"bounce_type,omitempty"`
+}
+
+// NewsletterService handles double opt-in subscriptions and sends digests of newly
+// published posts to confirmed subscribers
+type NewsletterService struct {
+	newsletterv1.UnimplementedNewsletterServiceServer
+	repo    repository.NewsletterRepository
+	content *ContentService
+	mailer  NewsletterMailer
+	config  NewsletterConfig
+	limiter *ratelimit.Limiter
+	now     func() time.Time
+
+	running sync.Mutex
+	// pending tracks asynchronous confirmation emails so tests can wait for them
+	pending sync.WaitGroup
+}
+
+// NewNewsletterService creates a newsletter service. Posts are read through the content
+// service so digests show what an anonymous reader sees.
+func NewNewsletterService(repo repository.NewsletterRepository, content *ContentService, mailer NewsletterMailer, config NewsletterConfig) *NewsletterService {
+	if config.Site == nil {
+		config.Site = DefaultSiteInfo()
+	}
+	if config.DigestInterval <= 0 {
+		config.DigestInterval = DefaultNewsletterDigestInterval
+	}
+	return &NewsletterService{
+		repo:    repo,
+		content: content,
+		mailer:  mailer,
+		config:  config,
+		limiter: ratelimit.New(defaultNewsletterRateLimit, defaultNewsletterRateWindow),
+		now:     time.Now,
+	}
+}
+
+// SetRateLimiter replaces the limiter applied to public sign-ups
+func (s *NewsletterService) SetRateLimiter(limiter *ratelimit.Limiter) {
+	s.limiter = limiter
+}
+
+// Start checks every interval whether the next scheduled digest is due and sends it,
+// until ctx is cancelled
+func (s *NewsletterService) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.runDigest(ctx, true); err != nil && !errors.Is(err, ErrNewsletterDigestRunning) {
+					logger.Error("Scheduled newsletter digest failed", err)
+				}
+			}
+		}
+	}()
+}
+
+// Subscribe starts a subscription and emails a confirmation link. The response does not
+// reveal whether the address was already subscribed; active subscribers change their
+// topics through the preferences link instead.
+func (s *NewsletterService) Subscribe(ctx context.Context, req *newsletterv1.SubscribeRequest) (*newsletterv1.SubscribeResponse, error) {
+	ipAddress, _ := extractClientInfo(ctx)
+	if s.limiter != nil && !s.limiter.Allow(ipAddress) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many sign-ups, please try again later")
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email format")
+	}
+	name := strings.TrimSpace(req.Name)
+	if len(name) > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "name too long (max 100 characters)")
+	}
+	topics, err := s.validateTopics(ctx, req.Topics)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &newsletterv1.SubscribeResponse{Message: newsletterSubscribeMessage}
+	// Stored with microsecond precision, since confirmation tokens are bound to it
+	now := s.now().Truncate(time.Microsecond)
+	subscriber, err := s.repo.GetSubscriberByEmail(ctx, email)
+	switch {
+	case errors.Is(err, appErr.ErrNotFound):
+		subscriber = models.NewNewsletterSubscriber(email, name, topics)
+		subscriber.IPAddress = ipAddress
+		subscriber.ConfirmationSentAt = &now
+		if err := s.repo.CreateSubscriber(ctx, subscriber); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create subscription: %v", err)
+		}
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to look up subscription: %v", err)
+	default:
+		switch subscriber.Status {
+		case models.NewsletterStatusActive, models.NewsletterStatusComplained:
+			// Addresses that reported the newsletter as spam are never mailed again
+			return resp, nil
+		case models.NewsletterStatusPending:
+			if subscriber.ConfirmationSentAt != nil && now.Sub(*subscriber.ConfirmationSentAt) < newsletterConfirmResendDelay {
+				return resp, nil
+			}
+		}
+		// Pending, unsubscribed and bounced addresses start over and must confirm again
+		subscriber.Status = models.NewsletterStatusPending
+		subscriber.Name = name
+		subscriber.Topics = topics
+		subscriber.SoftBounces = 0
+		subscriber.IPAddress = ipAddress
+		subscriber.ConfirmationSentAt = &now
+		if err := s.repo.UpdateSubscriber(ctx, subscriber); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update subscription: %v", err)
+		}
+	}
+
+	s.sendConfirmationAsync(ctx, subscriber)
+	return resp, nil
+}
+
+// ConfirmSubscription activates a pending subscription
+func (s *NewsletterService) ConfirmSubscription(ctx context.Context, req *newsletterv1.ConfirmSubscriptionRequest) (*newsletterv1.Subscriber, error) {
+	subscriber, err := s.subscriberFromToken(ctx, req.Token, newsletterTokenConfirm)
+	if err != nil {
+		return nil, err
+	}
+	switch subscriber.Status {
+	case models.NewsletterStatusActive:
+		return convertNewsletterSubscriberToProto(subscriber), nil
+	case models.NewsletterStatusPending:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "this subscription is no longer waiting for confirmation; please subscribe again")
+	}
+
+	now := s.now()
+	subscriber.Status = models.NewsletterStatusActive
+	subscriber.ConfirmedAt = &now
+	subscriber.UnsubscribedAt = nil
+	subscriber.SoftBounces = 0
+	if err := s.repo.UpdateSubscriber(ctx, subscriber); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to confirm subscription: %v", err)
+	}
+	return convertNewsletterSubscriberToProto(subscriber), nil
+}
+
+// GetPreferences returns the subscription behind a preferences or unsubscribe token
+func (s *NewsletterService) GetPreferences(ctx context.Context, req *newsletterv1.GetPreferencesRequest) (*newsletterv1.Subscriber, error) {
+	subscriber, err := s.subscriberFromToken(ctx, req.Token, newsletterTokenManage)
+	if err != nil {
+		return nil, err
+	}
+	return convertNewsletterSubscriberToProto(subscriber), nil
+}
+
+// UpdatePreferences replaces the topics of a subscription
+func (s *NewsletterService) UpdatePreferences(ctx context.Context, req *newsletterv1.UpdatePreferencesRequest) (*newsletterv1.Subscriber, error) {
+	subscriber, err := s.subscriberFromToken(ctx, req.Token, newsletterTokenManage)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := s.validateTopics(ctx, req.Topics)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber.Topics = topics
+	if err := s.repo.UpdateSubscriber(ctx, subscriber); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update preferences: %v", err)
+	}
+	return convertNewsletterSubscriberToProto(subscriber), nil
+}
+
+// Unsubscribe stops all newsletter email to the subscriber; unsubscribing twice is not an error
+func (s *NewsletterService) Unsubscribe(ctx context.Context, req *newsletterv1.UnsubscribeRequest) (*emptypb.Empty, error) {
+	subscriber, err := s.subscriberFromToken(ctx, req.Token, newsletterTokenManage)
+	if err != nil {
+		return nil, err
+	}
+	if subscriber.Status != models.NewsletterStatusPending && subscriber.Status != models.NewsletterStatusActive {
+		return &emptypb.Empty{}, nil
+	}
+
+	now := s.now()
+	subscriber.Status = models.NewsletterStatusUnsubscribed
+	subscriber.UnsubscribedAt = &now
+	if err := s.repo.UpdateSubscriber(ctx, subscriber); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unsubscribe: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListTopics lists the blog categories subscribers can choose from
+func (s *NewsletterService) ListTopics(ctx context.Context, req *newsletterv1.ListTopicsRequest) (*newsletterv1.ListTopicsResponse, error) {
+	categories, err := s.content.blogRepo.GetCategories(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list topics: %v", err)
+	}
+	resp := &newsletterv1.ListTopicsResponse{}
+	for _, category := range categories {
+		resp.Topics = append(resp.Topics, &newsletterv1.Topic{Slug: category.Slug, Name: category.Name})
+	}
+	return resp, nil
+}
+
+// ListSubscribers lists subscribers, newest first
+func (s *NewsletterService) ListSubscribers(ctx context.Context, req *newsletterv1.ListSubscribersRequest) (*newsletterv1.ListSubscribersResponse, error) {
+	pageSize, skip := webhookPagination(req.PageSize, req.PageToken)
+	subscribers, info, err := s.repo.ListSubscribers(ctx, convertProtoSubscriberStatusToModel(req.Status), repository.ListOptions{Limit: pageSize, Skip: skip})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list subscribers: %v", err)
+	}
+
+	resp := &newsletterv1.ListSubscribersResponse{
+		TotalCount:    int32(info.TotalCount),
+		NextPageToken: info.NextPageToken,
+	}
+	for _, subscriber := range subscribers {
+		resp.Subscribers = append(resp.Subscribers, convertNewsletterSubscriberToProto(subscriber))
+	}
+	return resp, nil
+}
+
+// SendDigest sends the posts published since the last digest now instead of on schedule
+func (s *NewsletterService) SendDigest(ctx context.Context, req *newsletterv1.SendDigestRequest) (*newsletterv1.Digest, error) {
+	// The digest is sent to every subscriber even if the caller goes away
+	digest, err := s.runDigest(context.WithoutCancel(ctx), false)
+	if err != nil {
+		if errors.Is(err, ErrNewsletterDigestRunning) {
+			return nil, status.Errorf(codes.Aborted, "%v", err)
+		}
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to send digest: %v", err)
+	}
+	return convertNewsletterDigestToProto(digest), nil
+}
+
+// HandleDeliveryEvent applies a bounce or complaint reported by the email provider.
+// Complaints and hard bounces stop all email to the address at once; soft bounces do
+// after newsletterMaxSoftBounces. Unknown addresses are ignored.
+func (s *NewsletterService) HandleDeliveryEvent(ctx context.Context, event NewsletterDeliveryEvent) error {
+	email := strings.ToLower(strings.TrimSpace(event.Email))
+	if email == "" {
+		return status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	if event.Type != NewsletterEventBounce && event.Type != NewsletterEventComplaint {
+		return status.Errorf(codes.InvalidArgument, "unknown event type '%s'", event.Type)
+	}
+
+	subscriber, err := s.repo.GetSubscriberByEmail(ctx, email)
+	if errors.Is(err, appErr.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to look up subscriber: %v", err)
+	}
+
+	now := s.now()
+	switch {
+	case event.Type == NewsletterEventComplaint:
+		if subscriber.Status == models.NewsletterStatusComplained {
+			return nil
+		}
+		subscriber.Status = models.NewsletterStatusComplained
+		subscriber.UnsubscribedAt = &now
+	case subscriber.Status == models.NewsletterStatusComplained || subscriber.Status == models.NewsletterStatusBounced:
+		return nil
+	case strings.EqualFold(event.BounceType, "soft"):
+		subscriber.SoftBounces++
+		if subscriber.SoftBounces >= newsletterMaxSoftBounces {
+			subscriber.Status = models.NewsletterStatusBounced
+		}
+	default:
+		subscriber.Status = models.NewsletterStatusBounced
+	}
+
+	if err := s.repo.UpdateSubscriber(ctx, subscriber); err != nil {
+		return status.Errorf(codes.Internal, "failed to update subscriber: %v", err)
+	}
+	logger.Info("Newsletter delivery event applied", "subscriber_id", subscriber.ID, "type", event.Type, "status", subscriber.Status)
+	return nil
+}
+
+// runDigest sends the posts published since the previous digest to every active subscriber
+// and records the run. Scheduled runs return nil until DigestInterval has passed since the
+// previous digest, and record a digest without recipients when nothing was published so the
+// schedule keeps its rhythm; manual runs fail instead. Only one digest is sent at a time.
+func (s *NewsletterService) runDigest(ctx context.Context, scheduled bool) (*models.NewsletterDigest, error) {
+	if !s.running.TryLock() {
+		return nil, ErrNewsletterDigestRunning
+	}
+	defer s.running.Unlock()
+
+	now := s.now()
+	start := now.Add(-s.config.DigestInterval)
+	last, err := s.repo.GetLatestDigest(ctx)
+	switch {
+	case err == nil:
+		if scheduled && now.Before(last.PeriodEnd.Add(s.config.DigestInterval)) {
+			return nil, nil
+		}
+		start = last.PeriodEnd
+	case !errors.Is(err, appErr.ErrNotFound):
+		return nil, err
+	}
+
+	posts, err := s.content.newsletterPosts(ctx, start, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(posts) == 0 && !scheduled {
+		return nil, status.Errorf(codes.FailedPrecondition, "no posts were published since the last digest")
+	}
+
+	digest := &models.NewsletterDigest{PeriodStart: start, PeriodEnd: now, PostIDs: []string{}}
+	for _, post := range posts {
+		digest.PostIDs = append(digest.PostIDs, post.Id)
+	}
+	if len(posts) > 0 {
+		digest.Recipients, digest.Failures = s.deliverDigest(ctx, posts)
+	}
+	if err := s.repo.CreateDigest(ctx, digest); err != nil {
+		return nil, err
+	}
+	logger.Info("Newsletter digest sent", "digest_id", digest.ID, "posts", len(posts), "recipients", digest.Recipients, "failures", digest.Failures)
+	return digest, nil
+}
+
+// deliverDigest emails each active subscriber the posts matching their topics and returns
+// how many digests were sent and how many failed. Subscribers without matching posts are skipped.
+func (s *NewsletterService) deliverDigest(ctx context.Context, posts []*contentv1.BlogPost) (int, int) {
+	postTopics := s.postTopics(ctx, posts)
+
+	var sent, failed int
+	afterID := ""
+	for {
+		subscribers, err := s.repo.ListActiveSubscribers(ctx, afterID, newsletterSendBatchSize)
+		if err != nil {
+			logger.Error("Failed to list newsletter subscribers", err, "after_id", afterID)
+			return sent, failed
+		}
+		for _, subscriber := range subscribers {
+			afterID = subscriber.ID
+			var selected []*contentv1.BlogPost
+			for i, post := range posts {
+				if subscriber.WantsCategory(postTopics[i]) {
+					selected = append(selected, post)
+				}
+			}
+			if len(selected) == 0 {
+				continue
+			}
+			if err := s.sendDigestEmail(ctx, subscriber, selected); err != nil {
+				logger.Error("Failed to send newsletter digest", err, "subscriber_id", subscriber.ID)
+				failed++
+				continue
+			}
+			sent++
+		}
+		if len(subscribers) < newsletterSendBatchSize || ctx.Err() != nil {
+			return sent, failed
+		}
+	}
+}
+
+// postTopics returns the category slugs of each post
+func (s *NewsletterService) postTopics(ctx context.Context, posts []*contentv1.BlogPost) [][]string {
+	slugs := make(map[string]string)
+	if categories, err := s.content.blogRepo.GetCategories(ctx); err == nil {
+		for _, category := range categories {
+			slugs[strings.ToLower(category.Name)] = category.Slug
+			slugs[category.Slug] = category.Slug
+		}
+	} else {
+		logger.Error("Failed to load categories for newsletter topics", err)
+	}
+
+	out := make([][]string, len(posts))
+	for i, post := range posts {
+		for _, category := range post.Categories {
+			slug, ok := slugs[strings.ToLower(category)]
+			if !ok {
+				slug = strings.ToLower(strings.ReplaceAll(category, " ", "-"))
+			}
+			out[i] = append(out[i], slug)
+		}
+	}
+	return out
+}
+
+// validateTopics normalises topics to lowercase, removes duplicates and rejects unknown category slugs
+func (s *NewsletterService) validateTopics(ctx context.Context, topics []string) ([]string, error) {
+	out := []string{}
+	if len(topics) == 0 {
+		return out, nil
+	}
+	categories, err := s.content.blogRepo.GetCategories(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list topics: %v", err)
+	}
+	known := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		known[category.Slug] = true
+	}
+	for _, topic := range topics {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if !known[topic] {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown topic '%s'", topic)
+		}
+		if !containsString(out, topic) {
+			out = append(out, topic)
+		}
+	}
+	return out, nil
+}
+
+// subscriberFromToken verifies a signed link token and loads its subscriber
+func (s *NewsletterService) subscriberFromToken(ctx context.Context, token, purpose string) (*models.NewsletterSubscriber, error) {
+	id, version, err := s.verifyToken(token, purpose)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired link")
+	}
+	subscriber, err := s.repo.GetSubscriber(ctx, id)
+	if errors.Is(err, appErr.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "subscription not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load subscription: %v", err)
+	}
+	if version != newsletterTokenVersion(purpose, subscriber) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired link")
+	}
+	return subscriber, nil
+}
+
+// newsletterTokenVersion binds confirmation tokens to the sign-up they were sent for. Every
+// sign-up stamps ConfirmationSentAt, so a link sent before an unsubscribe stops working once
+// the address subscribes again. Manage tokens stay valid for the life of the subscriber.
+func newsletterTokenVersion(purpose string, subscriber *models.NewsletterSubscriber) string {
+	if purpose != newsletterTokenConfirm || subscriber.ConfirmationSentAt == nil {
+		return "0"
+	}
+	return strconv.FormatInt(subscriber.ConfirmationSentAt.UnixMicro(), 10)
+}
+
+// signToken returns "<payload>.<signature>", both base64url encoded, where the payload is
+// "<purpose>:<subscriber ID>:<expiry in Unix seconds, 0 for none>:<version>"
+func (s *NewsletterService) signToken(purpose string, subscriber *models.NewsletterSubscriber, expires time.Time) string {
+	var expiry int64
+	if !expires.IsZero() {
+		expiry = expires.Unix()
+	}
+	payload := purpose + ":" + subscriber.ID + ":" + strconv.FormatInt(expiry, 10) + ":" + newsletterTokenVersion(purpose, subscriber)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(s.tokenSignature(payload))
+}
+
+// verifyToken checks the signature, purpose and expiry of a token and returns its subscriber
+// ID and version
+func (s *NewsletterService) verifyToken(token, purpose string) (string, string, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok {
+		return "", "", errors.New("malformed token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", "", errors.New("malformed token")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.tokenSignature(string(payload))) {
+		return "", "", errors.New("invalid token signature")
+	}
+
+	parts := strings.Split(string(payload), ":")
+	if len(parts) != 4 || parts[0] != purpose || parts[1] == "" {
+		return "", "", errors.New("token is not valid for this action")
+	}
+	expiry, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", "", errors.New("malformed token")
+	}
+	if expiry != 0 && s.now().Unix() > expiry {
+		return "", "", errors.New("token has expired")
+	}
+	return parts[1], parts[3], nil
+}
+
+func (s *NewsletterService) tokenSignature(payload string) []byte {
+	mac := hmac.New(sha256.New, []byte(s.config.Secret))
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// newsletterLink builds a link to one of the website's newsletter pages
+func (s *NewsletterService) newsletterLink(site SiteInfo, page, token string) string {
+	return site.URL + "/newsletter/" + page + "?token=" + url.QueryEscape(token)
+}
+
+// sendConfirmationAsync emails the confirmation link in the background
+func (s *NewsletterService) sendConfirmationAsync(ctx context.Context, subscriber *models.NewsletterSubscriber) {
+	site := s.config.Site.SiteInfo(ctx)
+	token := s.signToken(newsletterTokenConfirm, subscriber, s.now().Add(newsletterConfirmTokenTTL))
+	email := newsletterConfirmationEmail{
+		SiteName:   site.Name,
+		Name:       subscriber.Name,
+		ConfirmURL: s.newsletterLink(site, "confirm", token),
+		ValidDays:  int(newsletterConfirmTokenTTL / (24 * time.Hour)),
+	}
+	id, to := subscriber.ID, subscriber.Email
+
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), newsletterEmailTimeout)
+		defer cancel()
+
+		var body bytes.Buffer
+		if err := newsletterConfirmationHTML.Execute(&body, email); err != nil {
+			logger.Error("Failed to render newsletter confirmation", err, "subscriber_id", id)
+			return
+		}
+		subject := fmt.Sprintf("Confirm your subscription to %s", email.SiteName)
+		if err := s.mailer.SendHTMLEmail(ctx, to, subject, email.text(), body.String()); err != nil {
+			logger.Error("Failed to send newsletter confirmation", err, "subscriber_id", id)
+		}
+	}()
+}
+
+// sendDigestEmail renders the digest for one subscriber and sends it
+func (s *NewsletterService) sendDigestEmail(ctx context.Context, subscriber *models.NewsletterSubscriber, posts []*contentv1.BlogPost) error {
+	site := s.config.Site.SiteInfo(ctx)
+	token := s.signToken(newsletterTokenManage, subscriber, time.Time{})
+	digest := newsletterDigestEmail{
+		SiteName:       site.Name,
+		SiteURL:        site.URL,
+		PreferencesURL: s.newsletterLink(site, "preferences", token),
+		UnsubscribeURL: s.newsletterLink(site, "unsubscribe", token),
+	}
+	for _, post := range posts {
+		digest.Posts = append(digest.Posts, s.renderDigestPost(site, post))
+	}
+
+	var body bytes.Buffer
+	if err := newsletterDigestHTML.Execute(&body, digest); err != nil {
+		return fmt.Errorf("failed to render digest: %w", err)
+	}
+	subject := fmt.Sprintf("%s: %d new posts", digest.SiteName, len(posts))
+	if len(posts) == 1 {
+		subject = fmt.Sprintf("%s: %s", digest.SiteName, posts[0].Title)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, newsletterEmailTimeout)
+	defer cancel()
+	return s.mailer.SendHTMLEmail(ctx, subscriber.Email, subject, digest.text(), body.String())
+}
+
+// renderDigestPost renders the first newsletterPreviewBlocks blocks of a post's PageContent
+// that have an email form; embeds, videos and unknown block types are left out
+func (s *NewsletterService) renderDigestPost(site SiteInfo, post *contentv1.BlogPost) newsletterPost {
+	out := newsletterPost{
+		Title:   post.Title,
+		URL:     site.URL + "/blog/" + post.Slug,
+		Excerpt: post.Excerpt,
+	}
+	if post.FeaturedImageMedia != nil && post.FeaturedImageMedia.Url != "" {
+		out.ImageURL = absoluteURL(site.URL, post.FeaturedImageMedia.Url)
+		out.ImageAlt = post.FeaturedImageMedia.AltText
+	}
+	if post.Content != nil {
+		s.appendDigestBlocks(site, &out, post.Content.Blocks)
+	}
+	return out
+}
+
+func (s *NewsletterService) appendDigestBlocks(site SiteInfo, out *newsletterPost, blocks []*contentv1.ContentBlock) {
+	for _, block := range blocks {
+		if len(out.Blocks) >= newsletterPreviewBlocks {
+			return
+		}
+		if block.Type == "reusable" {
+			s.appendDigestBlocks(site, out, block.ResolvedBlocks)
+			continue
+		}
+		value := func(key string) string {
+			return strings.TrimSpace(html.UnescapeString(block.Data[key]))
+		}
+
+		var rendered newsletterBlock
+		switch block.Type {
+		case "hero", "feature-grid":
+			rendered.Heading = value("title")
+			rendered.Paragraphs = newsletterParagraphs(value("subtitle"))
+		case "text":
+			rendered.Paragraphs = newsletterParagraphs(value("content"))
+		case "image":
+			if src := value("src"); src != "" {
+				rendered.ImageURL = absoluteURL(site.URL, src)
+				rendered.ImageAlt = value("alt")
+				rendered.Caption = value("caption")
+			}
+		case "quote":
+			rendered.Quote = value("quote")
+			rendered.Cite = strings.Join(nonEmptyStrings(value("author"), value("role"), value("company")), ", ")
+		case "cta":
+			rendered.Heading = value("title")
+			rendered.Paragraphs = newsletterParagraphs(value("subtitle"))
+			if link := value("primaryButtonLink"); link != "" {
+				rendered.LinkText = firstNonEmpty(value("primaryButtonText"), link)
+				rendered.LinkURL = absoluteURL(site.URL, link)
+			}
+		}
+		if !rendered.empty() {
+			out.Blocks = append(out.Blocks, rendered)
+		}
+	}
+}
+
+// newsletterParagraphs turns the HTML or plain text of a block into plain text paragraphs
+func newsletterParagraphs(content string) []string {
+	var out []string
+	for _, paragraph := range strings.Split(htmlToPlainText(content), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			out = append(out, paragraph)
+		}
+	}
+	return out
+}
+
+func nonEmptyStrings(values ...string) []string {
+	var out []string
+	for _, value := range values {
+		if value != "" {
+			out = append(out, value)
+		}
+	}
+	return out
+}
+
+// newsletterPosts returns the posts published in [after, before), newest first, as an
+// anonymous reader sees them: gated posts only carry their teaser blocks
+func (s *ContentService) newsletterPosts(ctx context.Context, after, before time.Time) ([]*contentv1.BlogPost, error) {
+	posts, err := s.blogRepo.ListPublishedBetween(ctx, after, before, repository.ListOptions{Limit: newsletterMaxDigestPosts})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published posts: %w", err)
+	}
+
+	out := make([]*contentv1.BlogPost, 0, len(posts))
+	for _, post := range posts {
+		out = append(out, s.convertBlogModelToProto(post))
+	}
+	// Gate with an empty context: the digest goes to readers who are not signed in,
+	// whoever triggered it
+	s.gatePosts(context.Background(), out...)
+	for _, post := range out {
+		if err := s.resolveReusableBlocks(ctx, post.Content); err != nil {
+			return nil, err
+		}
+	}
+	s.attachFeaturedImages(ctx, out...)
+	return out, nil
+}
+
+// Conversion helpers
+
+func convertNewsletterSubscriberToProto(subscriber *models.NewsletterSubscriber) *newsletterv1.Subscriber {
+	out := &newsletterv1.Subscriber{
+		Id:        subscriber.ID,
+		Email:     subscriber.Email,
+		Name:      subscriber.Name,
+		Status:    convertModelSubscriberStatusToProto(subscriber.Status),
+		Topics:    subscriber.Topics,
+		CreatedAt: timestamppb.New(subscriber.CreatedAt),
+		UpdatedAt: timestamppb.New(subscriber.UpdatedAt),
+	}
+	if subscriber.ConfirmedAt != nil {
+		out.ConfirmedAt = timestamppb.New(*subscriber.ConfirmedAt)
+	}
+	return out
+}
+
+func convertNewsletterDigestToProto(digest *models.NewsletterDigest) *newsletterv1.Digest {
+	return &newsletterv1.Digest{
+		Id:          digest.ID,
+		PeriodStart: timestamppb.New(digest.PeriodStart),
+		PeriodEnd:   timestamppb.New(digest.PeriodEnd),
+		PostIds:     digest.PostIDs,
+		Recipients:  int32(digest.Recipients),
+		Failures:    int32(digest.Failures),
+		CreatedAt:   timestamppb.New(digest.CreatedAt),
+	}
+}
+
+var subscriberStatusToProto = map[string]newsletterv1.SubscriberStatus{
+	models.NewsletterStatusPending:      newsletterv1.SubscriberStatus_SUBSCRIBER_STATUS_PENDING,
+	models.NewsletterStatusActive:       newsletterv1.SubscriberStatus_SUBSCRIBER_STATUS_ACTIVE,
+	models.NewsletterStatusUnsubscribed: newsletterv1.SubscriberStatus_SUBSCRIBER_STATUS_UNSUBSCRIBED,
+	models.NewsletterStatusBounced:      newsletterv1.SubscriberStatus_SUBSCRIBER_STATUS_BOUNCED,
+	models.NewsletterStatusComplained:   newsletterv1.SubscriberStatus_SUBSCRIBER_STATUS_COMPLAINED,
+}
+
+func convertModelSubscriberStatusToProto(s string) newsletterv1.SubscriberStatus {
+	return subscriberStatusToProto[s]
+}
+
+// convertProtoSubscriberStatusToModel returns "" for UNSPECIFIED, which lists every status
+func convertProtoSubscriberStatusToModel(s newsletterv1.SubscriberStatus) string {
+	for modelStatus, protoStatus := range subscriberStatusToProto {
+		if protoStatus == s {
+			return modelStatus
+		}
+	}
+	return ""
+}
+
+// Email templates
+
+// newsletterConfirmationEmail is the data of the double opt-in email
+type newsletterConfirmationEmail struct {
+	SiteName   string
+	Name       string
+	ConfirmURL string
+	ValidDays  int
+}
+
+func (e newsletterConfirmationEmail) text() string {
+	var text strings.Builder
+	if e.Name != "" {
+		text.WriteString(fmt.Sprintf("Hi %s,\n\n", e.Name))
+	}
+	text.WriteString(fmt.Sprintf("Please confirm that you want to receive the %s newsletter by opening this link:\n\n", e.SiteName))
+	text.WriteString(e.ConfirmURL + "\n\n")
+	text.WriteString(fmt.Sprintf("The link is valid for %d days. If you did not sign up, ignore this email and you will not hear from us again.\n", e.ValidDays))
+	return text.String()
+}
+
+var newsletterConfirmationHTML = template.Must(template.New("newsletter-confirmation").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Confirm your subscription</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        {{if .Name}}<p>Hi {{.Name}},</p>{{end}}
+        <p>Please confirm that you want to receive the {{.SiteName}} newsletter.</p>
+        <p><a href="{{.ConfirmURL}}" style="display: inline-block; padding: 10px 20px; background-color: #2563eb; color: #fff; text-decoration: none; border-radius: 5px;">Confirm subscription</a></p>
+        <p style="font-size: 12px; color: #666;">The link is valid for {{.ValidDays}} days. If you did not sign up, ignore this email and you will not hear from us again.</p>
+    </div>
+</body>
+</html>`))
+
+// newsletterDigestEmail is the data of one subscriber's digest
+type newsletterDigestEmail struct {
+	SiteName       string
+	SiteURL        string
+	Posts          []newsletterPost
+	PreferencesURL string
+	UnsubscribeURL string
+}
+
+// newsletterPost is a post rendered for the digest
+type newsletterPost struct {
+	Title    string
+	URL      string
+	Excerpt  string
+	ImageURL string
+	ImageAlt string
+	Blocks   []newsletterBlock
+}
+
+// newsletterBlock is a content block rendered for email; only the fields of its block type are set
+type newsletterBlock struct {
+	Heading    string
+	Paragraphs []string
+	ImageURL   string
+	ImageAlt   string
+	Caption    string
+	Quote      string
+	Cite       string
+	LinkText   string
+	LinkURL    string
+}
+
+func (b newsletterBlock) empty() bool {
+	return b.Heading == "" && len(b.Paragraphs) == 0 && b.ImageURL == "" && b.Quote == "" && b.LinkURL == ""
+}
+
+func (e newsletterDigestEmail) text() string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("New on %s\n\n", e.SiteName))
+	for _, post := range e.Posts {
+		text.WriteString(post.Title + "\n")
+		text.WriteString(strings.Repeat("=", len([]rune(post.Title))) + "\n\n")
+		if post.Excerpt != "" {
+			text.WriteString(post.Excerpt + "\n\n")
+		}
+		for _, block := range post.Blocks {
+			if block.Heading != "" {
+				text.WriteString(block.Heading + "\n\n")
+			}
+			for _, paragraph := range block.Paragraphs {
+				text.WriteString(paragraph + "\n\n")
+			}
+			if block.ImageURL != "" && block.Caption != "" {
+				text.WriteString("[" + block.Caption + "]\n\n")
+			}
+			if block.Quote != "" {
+				text.WriteString("\"" + block.Quote + "\"")
+				if block.Cite != "" {
+					text.WriteString(" - " + block.Cite)
+				}
+				text.WriteString("\n\n")
+			}
+			if block.LinkURL != "" {
+				text.WriteString(block.LinkText + ": " + block.LinkURL + "\n\n")
+			}
+		}
+		text.WriteString("Read more: " + post.URL + "\n\n")
+	}
+	text.WriteString("--------\n")
+	text.WriteString("Change your topics: " + e.PreferencesURL + "\n")
+	text.WriteString("Unsubscribe: " + e.UnsubscribeURL + "\n")
+	return text.String()
+}
+
+var newsletterDigestHTML = template.Must(template.New("newsletter-digest").Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>New on {{.SiteName}}</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="font-size: 22px;"><a href="{{.SiteURL}}" style="color: #333; text-decoration: none;">New on {{.SiteName}}</a></h1>
+        {{range .Posts}}
+        <div style="margin-bottom: 32px; padding-bottom: 24px; border-bottom: 1px solid #eee;">
+            {{if .ImageURL}}<a href="{{.URL}}"><img src="{{.ImageURL}}" alt="{{.ImageAlt}}" style="max-width: 100%; height: auto; border-radius: 5px;"></a>{{end}}
+            <h2 style="font-size: 20px;"><a href="{{.URL}}" style="color: #2563eb; text-decoration: none;">{{.Title}}</a></h2>
+            {{if .Excerpt}}<p style="color: #555;">{{.Excerpt}}</p>{{end}}
+            {{range .Blocks}}
+            {{if .Heading}}<h3 style="font-size: 16px;">{{.Heading}}</h3>{{end}}
+            {{range .Paragraphs}}<p>{{.}}</p>{{end}}
+            {{if .ImageURL}}<p><img src="{{.ImageURL}}" alt="{{.ImageAlt}}" style="max-width: 100%; height: auto;">{{if .Caption}}<br><span style="font-size: 12px; color: #666;">{{.Caption}}</span>{{end}}</p>{{end}}
+            {{if .Quote}}<blockquote style="margin: 0 0 16px; padding-left: 12px; border-left: 3px solid #ddd; color: #555;">{{.Quote}}{{if .Cite}}<br><span style="font-size: 12px;">&mdash; {{.Cite}}</span>{{end}}</blockquote>{{end}}
+            {{if .LinkURL}}<p><a href="{{.LinkURL}}">{{.LinkText}}</a></p>{{end}}
+            {{end}}
+            <p><a href="{{.URL}}" style="display: inline-block; padding: 8px 16px; background-color: #2563eb; color: #fff; text-decoration: none; border-radius: 5px;">Read more</a></p>
+        </div>
+        {{end}}
+        <p style="font-size: 12px; color: #666;">
+            You receive this email because you subscribed to the {{.SiteName}} newsletter.
+            <a href="{{.PreferencesURL}}">Change your topics</a> or <a href="{{.UnsubscribeURL}}">unsubscribe</a>.
+        </p>
+    </div>
+</body>
+</html>`))
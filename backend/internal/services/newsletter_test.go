@@ -0,0 +1,551 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	newsletterv1 "github.com/7-solutions/saas-platformbackend/gen/newsletter/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/ratelimit"
+)
+
+// memoryNewsletterRepo keeps subscribers and digests in memory
+type memoryNewsletterRepo struct {
+	mu          sync.Mutex
+	subscribers map[string]*models.NewsletterSubscriber
+	digests     []*models.NewsletterDigest
+}
+
+func newMemoryNewsletterRepo() *memoryNewsletterRepo {
+	return &memoryNewsletterRepo{subscribers: map[string]*models.NewsletterSubscriber{}}
+}
+
+func (r *memoryNewsletterRepo) CreateSubscriber(ctx context.Context, subscriber *models.NewsletterSubscriber) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.subscribers {
+		if existing.Email == subscriber.Email {
+			return appErr.ErrConflict
+		}
+	}
+	subscriber.ID = newEventID()
+	stored := *subscriber
+	r.subscribers[subscriber.ID] = &stored
+	return nil
+}
+
+func (r *memoryNewsletterRepo) GetSubscriber(ctx context.Context, id string) (*models.NewsletterSubscriber, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	subscriber, ok := r.subscribers[id]
+	if !ok {
+		return nil, appErr.ErrNotFound
+	}
+	copied := *subscriber
+	return &copied, nil
+}
+
+func (r *memoryNewsletterRepo) GetSubscriberByEmail(ctx context.Context, email string) (*models.NewsletterSubscriber, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, subscriber := range r.subscribers {
+		if subscriber.Email == email {
+			copied := *subscriber
+			return &copied, nil
+		}
+	}
+	return nil, appErr.ErrNotFound
+}
+
+func (r *memoryNewsletterRepo) UpdateSubscriber(ctx context.Context, subscriber *models.NewsletterSubscriber) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.subscribers[subscriber.ID]; !ok {
+		return appErr.ErrNotFound
+	}
+	stored := *subscriber
+	r.subscribers[subscriber.ID] = &stored
+	return nil
+}
+
+func (r *memoryNewsletterRepo) ListSubscribers(ctx context.Context, status string, options repository.ListOptions) ([]*models.NewsletterSubscriber, *repository.PaginationInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.NewsletterSubscriber
+	for _, subscriber := range r.subscribers {
+		if status == "" || subscriber.Status == status {
+			out = append(out, subscriber)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Email < out[j].Email })
+	return out, &repository.PaginationInfo{TotalCount: len(out)}, nil
+}
+
+func (r *memoryNewsletterRepo) ListActiveSubscribers(ctx context.Context, afterID string, limit int) ([]*models.NewsletterSubscriber, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.NewsletterSubscriber
+	for _, subscriber := range r.subscribers {
+		if subscriber.Status == models.NewsletterStatusActive && subscriber.ID > afterID {
+			copied := *subscriber
+			out = append(out, &copied)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *memoryNewsletterRepo) CreateDigest(ctx context.Context, digest *models.NewsletterDigest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	digest.ID = newEventID()
+	r.digests = append(r.digests, digest)
+	return nil
+}
+
+func (r *memoryNewsletterRepo) GetLatestDigest(ctx context.Context) (*models.NewsletterDigest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.digests) == 0 {
+		return nil, appErr.ErrNotFound
+	}
+	return r.digests[len(r.digests)-1], nil
+}
+
+func (r *memoryNewsletterRepo) byEmail(email string) *models.NewsletterSubscriber {
+	subscriber, _ := r.GetSubscriberByEmail(context.Background(), email)
+	return subscriber
+}
+
+// newsletterBlogRepo adds categories derived from the posts to archiveBlogRepo
+type newsletterBlogRepo struct {
+	*archiveBlogRepo
+}
+
+func (r newsletterBlogRepo) GetCategories(ctx context.Context) ([]*models.BlogCategory, error) {
+	seen := map[string]bool{}
+	var out []*models.BlogCategory
+	for _, post := range r.posts {
+		for _, name := range post.Categories {
+			slug := strings.ToLower(strings.ReplaceAll(name, " ", "-"))
+			if !seen[slug] {
+				seen[slug] = true
+				out = append(out, &models.BlogCategory{Name: name, Slug: slug})
+			}
+		}
+	}
+	return out, nil
+}
+
+// sentEmail is one message handed to recordingMailer
+type sentEmail struct {
+	to, subject, text, html string
+}
+
+// recordingMailer records sent messages and fails for addresses in failFor
+type recordingMailer struct {
+	mu      sync.Mutex
+	sent    []sentEmail
+	failFor map[string]bool
+}
+
+func (m *recordingMailer) SendHTMLEmail(ctx context.Context, to, subject, textBody, htmlBody string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failFor[to] {
+		return fmt.Errorf("mailbox unavailable")
+	}
+	m.sent = append(m.sent, sentEmail{to: to, subject: subject, text: textBody, html: htmlBody})
+	return nil
+}
+
+func (m *recordingMailer) to(address string) []sentEmail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []sentEmail
+	for _, email := range m.sent {
+		if email.to == address {
+			out = append(out, email)
+		}
+	}
+	return out
+}
+
+var newsletterTokenPattern = regexp.MustCompile(`/newsletter/([a-z]+)\?token=([^"&\s]+)`)
+
+// newsletterToken extracts the token of the given website page from an email body
+func newsletterToken(t *testing.T, body, page string) string {
+	for _, match := range newsletterTokenPattern.FindAllStringSubmatch(body, -1) {
+		if match[1] == page {
+			token, err := url.QueryUnescape(html.UnescapeString(match[2]))
+			require.NoError(t, err)
+			return token
+		}
+	}
+	t.Fatalf("no %s link in %q", page, body)
+	return ""
+}
+
+var newsletterTestNow = time.Date(2026, 5, 10, 9, 0, 0, 0, time.UTC)
+
+func newNewsletterTestService(posts ...*models.BlogPost) (*NewsletterService, *memoryNewsletterRepo, *recordingMailer) {
+	byID := map[string]*models.BlogPost{}
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+	blogRepo := newsletterBlogRepo{&archiveBlogRepo{memoryBlogRepo: &memoryBlogRepo{posts: byID}}}
+	content := NewContentService(&memoryPageRepo{}, blogRepo)
+
+	repo := newMemoryNewsletterRepo()
+	mailer := &recordingMailer{}
+	service := NewNewsletterService(repo, content, mailer, NewsletterConfig{
+		Site:   SiteInfo{Name: "Example Blog", URL: "https://blog.example/"},
+		Secret: "test-secret",
+	})
+	service.now = func() time.Time { return newsletterTestNow }
+	return service, repo, mailer
+}
+
+// newsletterTestPost creates a post published the given time before newsletterTestNow
+func newsletterTestPost(slug, category string, age time.Duration, blocks ...models.ContentBlock) *models.BlogPost {
+	post := models.NewBlogPost(strings.ToUpper(slug[:1])+slug[1:], slug, "user-1")
+	post.SetPublished()
+	post.PublishedAt = timePtr(newsletterTestNow.Add(-age))
+	post.Categories = []string{category}
+	post.Excerpt = "About " + slug
+	post.Content = models.Content{Blocks: blocks}
+	return post
+}
+
+func textBlock(content string) models.ContentBlock {
+	return models.ContentBlock{Type: "text", Data: map[string]interface{}{"content": html.EscapeString(content)}}
+}
+
+// activeSubscriber stores a confirmed subscriber
+func activeSubscriber(t *testing.T, repo *memoryNewsletterRepo, email string, topics ...string) *models.NewsletterSubscriber {
+	subscriber := models.NewNewsletterSubscriber(email, "", topics)
+	subscriber.Status = models.NewsletterStatusActive
+	require.NoError(t, repo.CreateSubscriber(context.Background(), subscriber))
+	return subscriber
+}
+
+func TestNewsletterService_DoubleOptIn(t *testing.T) {
+	service, repo, mailer := newNewsletterTestService(newsletterTestPost("gophers", "Go Lang", time.Hour))
+	ctx := guestContext("203.0.113.5")
+
+	resp, err := service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: " Reader@Example.com ", Name: "Reader", Topics: []string{"GO-LANG"}})
+	require.NoError(t, err)
+	assert.Equal(t, newsletterSubscribeMessage, resp.Message)
+	service.pending.Wait()
+
+	subscriber := repo.byEmail("reader@example.com")
+	require.NotNil(t, subscriber)
+	assert.Equal(t, models.NewsletterStatusPending, subscriber.Status)
+	assert.Equal(t, []string{"go-lang"}, subscriber.Topics)
+	assert.Equal(t, "203.0.113.5", subscriber.IPAddress)
+
+	sent := mailer.to("reader@example.com")
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Confirm your subscription to Example Blog", sent[0].subject)
+	assert.Contains(t, sent[0].text, "https://blog.example/newsletter/confirm?token=")
+	token := newsletterToken(t, sent[0].html, "confirm")
+
+	// Signing up again right away neither resends nor reveals anything
+	resp, err = service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "reader@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, newsletterSubscribeMessage, resp.Message)
+	service.pending.Wait()
+	assert.Len(t, mailer.to("reader@example.com"), 1)
+
+	confirmed, err := service.ConfirmSubscription(ctx, &newsletterv1.ConfirmSubscriptionRequest{Token: token})
+	require.NoError(t, err)
+	assert.Equal(t, newsletterv1.SubscriberStatus_SUBSCRIBER_STATUS_ACTIVE, confirmed.Status)
+	assert.NotNil(t, confirmed.ConfirmedAt)
+
+	// Confirming twice is harmless, and active subscribers are not mailed on sign-up
+	_, err = service.ConfirmSubscription(ctx, &newsletterv1.ConfirmSubscriptionRequest{Token: token})
+	require.NoError(t, err)
+	service.now = func() time.Time { return newsletterTestNow.Add(time.Hour) }
+	_, err = service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "reader@example.com", Topics: []string{}})
+	require.NoError(t, err)
+	service.pending.Wait()
+	assert.Len(t, mailer.to("reader@example.com"), 1)
+	assert.Equal(t, []string{"go-lang"}, repo.byEmail("reader@example.com").Topics)
+}
+
+func TestNewsletterService_ResubscribeInvalidatesOldConfirmLinks(t *testing.T) {
+	service, repo, mailer := newNewsletterTestService(newsletterTestPost("gophers", "Go", time.Hour))
+	ctx := guestContext("203.0.113.5")
+
+	_, err := service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "reader@example.com"})
+	require.NoError(t, err)
+	service.pending.Wait()
+	old := newsletterToken(t, mailer.to("reader@example.com")[0].html, "confirm")
+	_, err = service.Unsubscribe(ctx, &newsletterv1.UnsubscribeRequest{
+		Token: service.signToken(newsletterTokenManage, repo.byEmail("reader@example.com"), time.Time{}),
+	})
+	require.NoError(t, err)
+
+	// Signing up again, even within the same second, must not make the earlier link usable
+	service.now = func() time.Time { return newsletterTestNow.Add(time.Millisecond) }
+	_, err = service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "reader@example.com"})
+	require.NoError(t, err)
+	service.pending.Wait()
+	sent := mailer.to("reader@example.com")
+	require.Len(t, sent, 2)
+
+	_, err = service.ConfirmSubscription(ctx, &newsletterv1.ConfirmSubscriptionRequest{Token: old})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, models.NewsletterStatusPending, repo.byEmail("reader@example.com").Status)
+
+	confirmed, err := service.ConfirmSubscription(ctx, &newsletterv1.ConfirmSubscriptionRequest{Token: newsletterToken(t, sent[1].html, "confirm")})
+	require.NoError(t, err)
+	assert.Equal(t, newsletterv1.SubscriberStatus_SUBSCRIBER_STATUS_ACTIVE, confirmed.Status)
+}
+
+func TestNewsletterService_SubscribeValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *newsletterv1.SubscribeRequest
+		code codes.Code
+	}{
+		{"missing email", &newsletterv1.SubscribeRequest{}, codes.InvalidArgument},
+		{"invalid email", &newsletterv1.SubscribeRequest{Email: "not-an-email"}, codes.InvalidArgument},
+		{"display name", &newsletterv1.SubscribeRequest{Email: "Reader <reader@example.com>"}, codes.InvalidArgument},
+		{"unknown topic", &newsletterv1.SubscribeRequest{Email: "reader@example.com", Topics: []string{"cooking"}}, codes.InvalidArgument},
+		{"long name", &newsletterv1.SubscribeRequest{Email: "reader@example.com", Name: strings.Repeat("a", 101)}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, _ := newNewsletterTestService(newsletterTestPost("gophers", "Go", time.Hour))
+			_, err := service.Subscribe(context.Background(), &newsletterv1.SubscribeRequest{Email: tt.req.Email, Name: tt.req.Name, Topics: tt.req.Topics})
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Empty(t, repo.subscribers)
+		})
+	}
+
+	t.Run("rate limited", func(t *testing.T) {
+		service, _, _ := newNewsletterTestService()
+		service.SetRateLimiter(ratelimit.New(1, time.Minute))
+		ctx := guestContext("198.51.100.7")
+		_, err := service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "one@example.com"})
+		require.NoError(t, err)
+		_, err = service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "two@example.com"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		service.pending.Wait()
+	})
+}
+
+func TestNewsletterService_SignedLinks(t *testing.T) {
+	service, repo, _ := newNewsletterTestService(newsletterTestPost("gophers", "Go", time.Hour), newsletterTestPost("palettes", "Design", time.Hour))
+	ctx := context.Background()
+	subscriber := activeSubscriber(t, repo, "reader@example.com")
+	manage := service.signToken(newsletterTokenManage, subscriber, time.Time{})
+
+	prefs, err := service.UpdatePreferences(ctx, &newsletterv1.UpdatePreferencesRequest{Token: manage, Topics: []string{"design", "design"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"design"}, prefs.Topics)
+
+	got, err := service.GetPreferences(ctx, &newsletterv1.GetPreferencesRequest{Token: manage})
+	require.NoError(t, err)
+	assert.Equal(t, "reader@example.com", got.Email)
+
+	// A manage token cannot confirm and a confirm token cannot manage
+	_, err = service.ConfirmSubscription(ctx, &newsletterv1.ConfirmSubscriptionRequest{Token: manage})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	confirm := service.signToken(newsletterTokenConfirm, subscriber, newsletterTestNow.Add(time.Hour))
+	_, err = service.Unsubscribe(ctx, &newsletterv1.UnsubscribeRequest{Token: confirm})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Tampered and foreign tokens are rejected
+	payload, _, _ := strings.Cut(manage, ".")
+	_, err = service.GetPreferences(ctx, &newsletterv1.GetPreferencesRequest{Token: payload + ".AAAA"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	other, _, _ := newNewsletterTestService()
+	other.config.Secret = "another-secret"
+	_, err = service.GetPreferences(ctx, &newsletterv1.GetPreferencesRequest{Token: other.signToken(newsletterTokenManage, subscriber, time.Time{})})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Confirmation links expire
+	expired := service.signToken(newsletterTokenConfirm, subscriber, newsletterTestNow.Add(-time.Second))
+	_, err = service.ConfirmSubscription(ctx, &newsletterv1.ConfirmSubscriptionRequest{Token: expired})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.Unsubscribe(ctx, &newsletterv1.UnsubscribeRequest{Token: manage})
+	require.NoError(t, err)
+	stored := repo.byEmail("reader@example.com")
+	assert.Equal(t, models.NewsletterStatusUnsubscribed, stored.Status)
+	assert.NotNil(t, stored.UnsubscribedAt)
+	_, err = service.Unsubscribe(ctx, &newsletterv1.UnsubscribeRequest{Token: manage})
+	require.NoError(t, err)
+
+	// An old confirmation link cannot undo the unsubscribe
+	_, err = service.ConfirmSubscription(ctx, &newsletterv1.ConfirmSubscriptionRequest{Token: confirm})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestNewsletterService_DigestFollowsTopics(t *testing.T) {
+	gophers := newsletterTestPost("gophers", "Go", 2*time.Hour,
+		models.ContentBlock{Type: "hero", Data: map[string]interface{}{"title": "Meet the gophers", "subtitle": html.EscapeString("Tips & tricks")}},
+		textBlock("<p>First <strong>paragraph</strong></p><p>Second paragraph</p>"),
+		models.ContentBlock{Type: "video", Data: map[string]interface{}{"src": "https://video.example/1"}},
+		models.ContentBlock{Type: "image", Data: map[string]interface{}{"src": "/uploads/gopher.png", "alt": "A gopher"}},
+		textBlock("<p>Not in the preview</p>"),
+	)
+	palettes := newsletterTestPost("palettes", "Design", time.Hour, textBlock("<p>Colour theory</p>"))
+	old := newsletterTestPost("archive", "Go", 30*24*time.Hour, textBlock("<p>Old news</p>"))
+	service, repo, mailer := newNewsletterTestService(gophers, palettes, old)
+	ctx := readerContext("admin-1", models.UserRoleAdmin)
+
+	activeSubscriber(t, repo, "all@example.com")
+	gopher := activeSubscriber(t, repo, "go@example.com", "go")
+	activeSubscriber(t, repo, "cooking@example.com", "cooking")
+	pending := models.NewNewsletterSubscriber("pending@example.com", "", nil)
+	require.NoError(t, repo.CreateSubscriber(ctx, pending))
+	activeSubscriber(t, repo, "broken@example.com")
+	mailer.failFor = map[string]bool{"broken@example.com": true}
+
+	digest, err := service.SendDigest(ctx, &newsletterv1.SendDigestRequest{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{gophers.ID, palettes.ID}, digest.PostIds)
+	assert.Equal(t, int32(2), digest.Recipients)
+	assert.Equal(t, int32(1), digest.Failures)
+	assert.True(t, digest.PeriodStart.AsTime().Equal(newsletterTestNow.Add(-DefaultNewsletterDigestInterval)))
+	assert.True(t, digest.PeriodEnd.AsTime().Equal(newsletterTestNow))
+	assert.Empty(t, mailer.to("cooking@example.com"))
+	assert.Empty(t, mailer.to("pending@example.com"))
+
+	all := mailer.to("all@example.com")
+	require.Len(t, all, 1)
+	assert.Equal(t, "Example Blog: 2 new posts", all[0].subject)
+	assert.Contains(t, all[0].html, "Palettes")
+
+	sent := mailer.to("go@example.com")
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Example Blog: Gophers", sent[0].subject)
+	body := sent[0].html
+	assert.NotContains(t, body, "Palettes")
+	assert.Contains(t, body, `href="https://blog.example/blog/gophers"`)
+	assert.Contains(t, body, "<h3 style=\"font-size: 16px;\">Meet the gophers</h3>")
+	assert.Contains(t, body, "Tips &amp; tricks")
+	assert.Contains(t, body, "<p>First paragraph</p>")
+	assert.Contains(t, body, "<p>Second paragraph</p>")
+	assert.Contains(t, body, `src="https://blog.example/uploads/gopher.png"`)
+	assert.NotContains(t, body, "video.example")
+	assert.NotContains(t, body, "Not in the preview")
+	assert.Contains(t, sent[0].text, "Read more: https://blog.example/blog/gophers")
+
+	// The footer links manage the subscription that received them
+	manage := newsletterToken(t, body, "unsubscribe")
+	assert.Equal(t, manage, newsletterToken(t, body, "preferences"))
+	_, err = service.Unsubscribe(ctx, &newsletterv1.UnsubscribeRequest{Token: manage})
+	require.NoError(t, err)
+	assert.Equal(t, models.NewsletterStatusUnsubscribed, repo.byEmail(gopher.Email).Status)
+
+	// Nothing new has been published since
+	_, err = service.SendDigest(ctx, &newsletterv1.SendDigestRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestNewsletterService_DigestShowsTeasersOfGatedPosts(t *testing.T) {
+	members := newsletterTestPost("members", "Go", time.Hour, textBlock("<p>Free intro</p>"), textBlock("<p>Members only</p>"))
+	members.Visibility = models.Visibility{Level: models.VisibilityAuthenticated, TeaserBlocks: 1}
+	service, repo, mailer := newNewsletterTestService(members)
+	activeSubscriber(t, repo, "reader@example.com")
+
+	// Triggered by an admin, who could read the whole post
+	_, err := service.SendDigest(readerContext("admin-1", models.UserRoleAdmin), &newsletterv1.SendDigestRequest{})
+	require.NoError(t, err)
+
+	sent := mailer.to("reader@example.com")
+	require.Len(t, sent, 1)
+	assert.Contains(t, sent[0].html, "Free intro")
+	assert.NotContains(t, sent[0].html, "Members only")
+}
+
+func TestNewsletterService_ScheduledDigests(t *testing.T) {
+	service, repo, mailer := newNewsletterTestService(newsletterTestPost("gophers", "Go", time.Hour))
+	ctx := context.Background()
+	activeSubscriber(t, repo, "reader@example.com")
+
+	digest, err := service.runDigest(ctx, true)
+	require.NoError(t, err)
+	require.NotNil(t, digest)
+	assert.Equal(t, 1, digest.Recipients)
+
+	// Not due until a full interval after the previous digest
+	service.now = func() time.Time { return newsletterTestNow.Add(DefaultNewsletterDigestInterval - time.Minute) }
+	digest, err = service.runDigest(ctx, true)
+	require.NoError(t, err)
+	assert.Nil(t, digest)
+
+	// A due digest without new posts is recorded without mail so the schedule holds
+	service.now = func() time.Time { return newsletterTestNow.Add(DefaultNewsletterDigestInterval) }
+	digest, err = service.runDigest(ctx, true)
+	require.NoError(t, err)
+	require.NotNil(t, digest)
+	assert.Empty(t, digest.PostIDs)
+	assert.Equal(t, 0, digest.Recipients)
+	assert.True(t, digest.PeriodStart.Equal(newsletterTestNow))
+	assert.Len(t, repo.digests, 2)
+	assert.Len(t, mailer.to("reader@example.com"), 1)
+}
+
+func TestNewsletterService_DeliveryEvents(t *testing.T) {
+	service, repo, mailer := newNewsletterTestService()
+	ctx := context.Background()
+	activeSubscriber(t, repo, "hard@example.com")
+	activeSubscriber(t, repo, "soft@example.com")
+	activeSubscriber(t, repo, "complaint@example.com")
+
+	require.NoError(t, service.HandleDeliveryEvent(ctx, NewsletterDeliveryEvent{Type: NewsletterEventBounce, Email: "HARD@example.com", BounceType: "hard"}))
+	assert.Equal(t, models.NewsletterStatusBounced, repo.byEmail("hard@example.com").Status)
+
+	for i := 1; i <= newsletterMaxSoftBounces; i++ {
+		require.NoError(t, service.HandleDeliveryEvent(ctx, NewsletterDeliveryEvent{Type: NewsletterEventBounce, Email: "soft@example.com", BounceType: "soft"}))
+		want := models.NewsletterStatusActive
+		if i == newsletterMaxSoftBounces {
+			want = models.NewsletterStatusBounced
+		}
+		assert.Equal(t, want, repo.byEmail("soft@example.com").Status, "after %d soft bounces", i)
+	}
+
+	require.NoError(t, service.HandleDeliveryEvent(ctx, NewsletterDeliveryEvent{Type: NewsletterEventComplaint, Email: "complaint@example.com"}))
+	assert.Equal(t, models.NewsletterStatusComplained, repo.byEmail("complaint@example.com").Status)
+
+	// Unknown addresses are ignored, malformed events rejected
+	require.NoError(t, service.HandleDeliveryEvent(ctx, NewsletterDeliveryEvent{Type: NewsletterEventBounce, Email: "nobody@example.com"}))
+	err := service.HandleDeliveryEvent(ctx, NewsletterDeliveryEvent{Type: "delivered", Email: "hard@example.com"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = service.HandleDeliveryEvent(ctx, NewsletterDeliveryEvent{Type: NewsletterEventBounce})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Bounced addresses may subscribe again; complaints are never mailed again
+	_, err = service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "hard@example.com"})
+	require.NoError(t, err)
+	_, err = service.Subscribe(ctx, &newsletterv1.SubscribeRequest{Email: "complaint@example.com"})
+	require.NoError(t, err)
+	service.pending.Wait()
+	assert.Equal(t, models.NewsletterStatusPending, repo.byEmail("hard@example.com").Status)
+	assert.Len(t, mailer.to("hard@example.com"), 1)
+	assert.Equal(t, models.NewsletterStatusComplained, repo.byEmail("complaint@example.com").Status)
+	assert.Empty(t, mailer.to("complaint@example.com"))
+}
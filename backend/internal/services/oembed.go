@@ -0,0 +1,548 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/cache"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+const (
+	// DefaultOEmbedCacheTTL is how long resolved embeds are cached
+	DefaultOEmbedCacheTTL = 24 * time.Hour
+	// oembedFailureTTL is how long a URL that failed to resolve is not fetched again
+	oembedFailureTTL     = 5 * time.Minute
+	oembedRequestTimeout = 5 * time.Second
+	// oembedMaxResponseSize bounds provider responses and pages fetched for discovery
+	oembedMaxResponseSize = 1 << 20
+	// oembedFailedMarker is cached in place of an embed for URLs that could not be resolved
+	oembedFailedMarker = "-"
+
+	// oembedFrameSandbox applies to iframes on a provider's own embed host
+	oembedFrameSandbox = "allow-scripts allow-same-origin allow-popups allow-presentation"
+	// oembedSrcdocSandbox isolates provider markup in an opaque origin, away from our cookies and DOM
+	oembedSrcdocSandbox = "allow-scripts allow-popups allow-popups-to-escape-sandbox"
+
+	// Size of the card returned by our own oEmbed endpoint
+	oembedCardWidth  = 600
+	oembedCardHeight = 240
+)
+
+var (
+	errEmbedNotAllowed = errors.New("embed URL is not from an allowed provider")
+	errEmbedFailed     = errors.New("embed URL recently failed to resolve")
+
+	oembedLinkTag      = regexp.MustCompile(`(?i)<link\s[^>]*>`)
+	oembedAttr         = regexp.MustCompile(`(?i)\s([a-z-]+)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	oembedSingleIframe = regexp.MustCompile(`(?is)^<iframe\s[^>]*>\s*</iframe>$`)
+	gistPath           = regexp.MustCompile(`^/(?:[A-Za-z0-9-]+/)?[0-9a-f]+/?$`)
+)
+
+// OEmbedProvider is an allowlisted source of embeds
+type OEmbedProvider struct {
+	Name string
+	// Hosts are the hosts of embeddable URLs; subdomains match as well
+	Hosts []string
+	// Endpoint is the provider's oEmbed endpoint. When empty, the endpoint is discovered from the
+	// <link type="application/json+oembed"> of the embedded page and must be on one of Hosts.
+	Endpoint string
+	// FrameHosts are the hosts whose iframes are embedded directly. Any other provider
+	// markup is isolated in a sandboxed srcdoc iframe.
+	FrameHosts []string
+	// Render builds the embed markup locally for providers without an oEmbed endpoint.
+	// It returns an empty string for URLs that cannot be embedded.
+	Render func(u *url.URL) string
+}
+
+// DefaultOEmbedProviders returns the YouTube, X and GitHub Gist providers
+func DefaultOEmbedProviders() []OEmbedProvider {
+	return []OEmbedProvider{
+		{
+			Name:       "YouTube",
+			Hosts:      []string{"youtube.com", "youtu.be"},
+			Endpoint:   "https://www.youtube.com/oembed",
+			FrameHosts: []string{"youtube.com", "youtube-nocookie.com"},
+		},
+		{
+			Name:     "X",
+			Hosts:    []string{"twitter.com", "x.com"},
+			Endpoint: "https://publish.twitter.com/oembed",
+		},
+		{
+			Name:   "GitHub Gist",
+			Hosts:  []string{"gist.github.com"},
+			Render: renderGist,
+		},
+	}
+}
+
+// renderGist returns the script tag GitHub documents for embedding a gist
+func renderGist(u *url.URL) string {
+	if !gistPath.MatchString(u.Path) {
+		return ""
+	}
+	src := "https://gist.github.com" + strings.TrimSuffix(u.Path, "/") + ".js"
+	return `<script src="` + html.EscapeString(src) + `"></script>`
+}
+
+// OEmbedResolver resolves URLs of allowlisted providers to sanitized embeds
+type OEmbedResolver struct {
+	providers  []OEmbedProvider
+	cache      cache.Cache
+	cacheTTL   time.Duration
+	httpClient *http.Client
+}
+
+// NewOEmbedResolver creates a resolver for the given providers. Resolved embeds are cached
+// in c for DefaultOEmbedCacheTTL; a nil cache disables caching.
+func NewOEmbedResolver(providers []OEmbedProvider, c cache.Cache) *OEmbedResolver {
+	return &OEmbedResolver{
+		providers:  providers,
+		cache:      c,
+		cacheTTL:   DefaultOEmbedCacheTTL,
+		httpClient: &http.Client{Timeout: oembedRequestTimeout},
+	}
+}
+
+// SetHTTPClient replaces the client used to fetch oEmbed endpoints and discovery pages
+func (r *OEmbedResolver) SetHTTPClient(client *http.Client) {
+	r.httpClient = client
+}
+
+// SetCacheTTL sets how long resolved embeds are cached
+func (r *OEmbedResolver) SetCacheTTL(ttl time.Duration) {
+	r.cacheTTL = ttl
+}
+
+// provider returns the allowlisted provider of an http(s) URL
+func (r *OEmbedResolver) provider(rawURL string) (*OEmbedProvider, *url.URL, error) {
+	u, ok := parseHTTPURL(rawURL)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid embed URL '%s'", rawURL)
+	}
+	for i := range r.providers {
+		if hostMatches(u.Hostname(), r.providers[i].Hosts) {
+			return &r.providers[i], u, nil
+		}
+	}
+	return nil, nil, errEmbedNotAllowed
+}
+
+// Resolve returns the embed of a URL, fetching it from the provider unless cached.
+// Failures are cached for a short time so a broken URL does not slow down every read.
+func (r *OEmbedResolver) Resolve(ctx context.Context, rawURL string) (*models.Embed, error) {
+	provider, u, err := r.provider(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	key := "oembed:" + u.String()
+	if r.cache != nil {
+		if value, err := r.cache.Get(ctx, key); err == nil && value != "" {
+			if value == oembedFailedMarker {
+				return nil, errEmbedFailed
+			}
+			var embed models.Embed
+			if err := json.Unmarshal([]byte(value), &embed); err == nil {
+				return &embed, nil
+			}
+		}
+	}
+
+	embed, err := r.fetch(ctx, provider, u)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.store(ctx, key, oembedFailedMarker, oembedFailureTTL)
+		}
+		return nil, err
+	}
+	if data, err := json.Marshal(embed); err == nil {
+		r.store(ctx, key, string(data), r.cacheTTL)
+	}
+	return embed, nil
+}
+
+func (r *OEmbedResolver) store(ctx context.Context, key, value string, ttl time.Duration) {
+	if r.cache == nil {
+		return
+	}
+	if err := r.cache.Set(ctx, key, value, ttl); err != nil {
+		logger.Error("Failed to cache embed", err, "key", key)
+	}
+}
+
+// oembedResponse is the subset of an oEmbed 1.0 response we use
+type oembedResponse struct {
+	Type         string          `json:"type"`
+	Title        string          `json:"title"`
+	AuthorName   string          `json:"author_name"`
+	ProviderName string          `json:"provider_name"`
+	ThumbnailURL string          `json:"thumbnail_url"`
+	URL          string          `json:"url"`
+	Width        oembedDimension `json:"width"`
+	Height       oembedDimension `json:"height"`
+	HTML         string          `json:"html"`
+}
+
+// oembedDimension accepts the numbers and numeric strings providers send as sizes.
+// Anything else, such as "100%", reads as zero.
+type oembedDimension int
+
+func (d *oembedDimension) UnmarshalJSON(data []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		var s string
+		if json.Unmarshal(data, &s) != nil {
+			return nil
+		}
+		n = json.Number(s)
+	}
+	if v, err := n.Int64(); err == nil && v > 0 {
+		*d = oembedDimension(v)
+	}
+	return nil
+}
+
+func (r *OEmbedResolver) fetch(ctx context.Context, provider *OEmbedProvider, u *url.URL) (*models.Embed, error) {
+	if provider.Render != nil {
+		markup := provider.Render(u)
+		if markup == "" {
+			return nil, fmt.Errorf("%s cannot embed '%s'", provider.Name, u)
+		}
+		return &models.Embed{Type: "rich", ProviderName: provider.Name, HTML: srcdocFrame(markup, provider.Name, 0, 0)}, nil
+	}
+
+	endpoint := provider.Endpoint
+	if endpoint == "" {
+		discovered, err := r.discover(ctx, provider, u)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = discovered
+	} else {
+		query := url.Values{"url": {u.String()}, "format": {"json"}}
+		endpoint += "?" + query.Encode()
+	}
+
+	body, err := r.get(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var resp oembedResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("invalid oEmbed response from %s: %w", provider.Name, err)
+	}
+	return sanitizeOEmbed(provider, &resp)
+}
+
+// discover finds the JSON oEmbed endpoint advertised by the embedded page
+func (r *OEmbedResolver) discover(ctx context.Context, provider *OEmbedProvider, u *url.URL) (string, error) {
+	body, err := r.get(ctx, u.String())
+	if err != nil {
+		return "", err
+	}
+	for _, tag := range oembedLinkTag.FindAllString(string(body), -1) {
+		attrs := tagAttributes(tag)
+		if !strings.EqualFold(attrs["type"], "application/json+oembed") || attrs["href"] == "" {
+			continue
+		}
+		ref, err := url.Parse(attrs["href"])
+		if err != nil {
+			continue
+		}
+		endpoint := u.ResolveReference(ref)
+		// The page must not point us at an arbitrary host
+		if (endpoint.Scheme != "http" && endpoint.Scheme != "https") || !hostMatches(endpoint.Hostname(), provider.Hosts) {
+			return "", fmt.Errorf("%s advertised an oEmbed endpoint on another host", provider.Name)
+		}
+		return endpoint.String(), nil
+	}
+	return "", fmt.Errorf("no oEmbed endpoint found for '%s'", u)
+}
+
+func (r *OEmbedResolver) get(ctx context.Context, target string) ([]byte, error) {
+	code, _, body, err := fetchLimited(ctx, r.httpClient, target, oembedMaxResponseSize)
+	if err != nil {
+		return nil, err
+	}
+	if code != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned %d", target, code)
+	}
+	return body, nil
+}
+
+// fetchLimited GETs a URL and returns its status code, headers and body.
+// Bodies larger than limit bytes are an error.
+func fetchLimited(ctx context.Context, client *http.Client, target string, limit int) (int, http.Header, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(limit)+1))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if len(body) > limit {
+		return 0, nil, nil, fmt.Errorf("GET %s returned more than %d bytes", target, limit)
+	}
+	return resp.StatusCode, resp.Header, body, nil
+}
+
+// sanitizeOEmbed converts a provider response into an embed whose HTML is safe to render.
+// Only a lone iframe on one of the provider's frame hosts is embedded directly; photos are
+// rebuilt as img tags and all other markup runs inside a sandboxed srcdoc iframe.
+func sanitizeOEmbed(provider *OEmbedProvider, resp *oembedResponse) (*models.Embed, error) {
+	embed := &models.Embed{
+		Type:         resp.Type,
+		ProviderName: firstNonEmpty(resp.ProviderName, provider.Name),
+		Title:        resp.Title,
+		AuthorName:   resp.AuthorName,
+		Width:        int(resp.Width),
+		Height:       int(resp.Height),
+	}
+	if thumbnail, ok := parseHTTPURL(resp.ThumbnailURL); ok {
+		embed.ThumbnailURL = thumbnail.String()
+	}
+
+	switch resp.Type {
+	case "photo":
+		src, ok := parseHTTPURL(resp.URL)
+		if !ok {
+			return nil, fmt.Errorf("%s returned a photo without a valid URL", provider.Name)
+		}
+		embed.HTML = `<img src="` + html.EscapeString(src.String()) + `" alt="` + html.EscapeString(resp.Title) + `"` +
+			dimensionAttributes(embed.Width, embed.Height) + ` loading="lazy">`
+	case "video", "rich":
+		markup := strings.TrimSpace(resp.HTML)
+		if markup == "" {
+			return nil, fmt.Errorf("%s returned no embed HTML", provider.Name)
+		}
+		if src, ok := providerFrameSrc(provider, markup); ok {
+			embed.HTML = `<iframe src="` + html.EscapeString(src) + `"` + dimensionAttributes(embed.Width, embed.Height) +
+				` title="` + html.EscapeString(firstNonEmpty(resp.Title, embed.ProviderName)) + `"` +
+				` sandbox="` + oembedFrameSandbox + `" allow="encrypted-media; picture-in-picture; fullscreen"` +
+				` referrerpolicy="strict-origin-when-cross-origin" loading="lazy" allowfullscreen></iframe>`
+		} else {
+			embed.HTML = srcdocFrame(markup, firstNonEmpty(resp.Title, embed.ProviderName), embed.Width, embed.Height)
+		}
+	case "link":
+		// Links carry no markup; clients render the title and thumbnail
+	default:
+		return nil, fmt.Errorf("%s returned unknown oEmbed type '%s'", provider.Name, resp.Type)
+	}
+	return embed, nil
+}
+
+// providerFrameSrc returns the src of markup that is a single https iframe on a frame host
+func providerFrameSrc(provider *OEmbedProvider, markup string) (string, bool) {
+	if len(provider.FrameHosts) == 0 || !oembedSingleIframe.MatchString(markup) {
+		return "", false
+	}
+	open := markup[:strings.Index(markup, ">")+1]
+	src, ok := parseHTTPURL(tagAttributes(open)["src"])
+	if !ok || src.Scheme != "https" || !hostMatches(src.Hostname(), provider.FrameHosts) {
+		return "", false
+	}
+	return src.String(), true
+}
+
+// srcdocFrame isolates untrusted markup in a sandboxed iframe without same-origin access
+func srcdocFrame(markup, title string, width, height int) string {
+	return `<iframe srcdoc="` + html.EscapeString(markup) + `"` + dimensionAttributes(width, height) +
+		` title="` + html.EscapeString(title) + `" sandbox="` + oembedSrcdocSandbox + `" loading="lazy"></iframe>`
+}
+
+func dimensionAttributes(width, height int) string {
+	var b strings.Builder
+	if width > 0 {
+		b.WriteString(` width="` + strconv.Itoa(width) + `"`)
+	}
+	if height > 0 {
+		b.WriteString(` height="` + strconv.Itoa(height) + `"`)
+	}
+	return b.String()
+}
+
+// tagAttributes returns the quoted attributes of an HTML start tag, unescaped and keyed by lowercase name
+func tagAttributes(tag string) map[string]string {
+	attrs := make(map[string]string)
+	for _, m := range oembedAttr.FindAllStringSubmatch(tag, -1) {
+		attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2] + m[3])
+	}
+	return attrs
+}
+
+// parseHTTPURL parses an absolute http or https URL
+func parseHTTPURL(raw string) (*url.URL, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, false
+	}
+	return u, true
+}
+
+// hostMatches reports whether host is one of hosts or a subdomain of one
+func hostMatches(host string, hosts []string) bool {
+	host = strings.ToLower(host)
+	for _, h := range hosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetOEmbedResolver enables "embed" content blocks. When unset, saving content with
+// embed blocks returns FailedPrecondition.
+func (s *ContentService) SetOEmbedResolver(r *OEmbedResolver) {
+	s.oembed = r
+}
+
+// validateEmbedBlocks ensures every "embed" block has a URL of an allowlisted provider
+func (s *ContentService) validateEmbedBlocks(content models.Content) error {
+	for _, block := range content.Blocks {
+		if block.Type != models.ContentBlockTypeEmbed {
+			continue
+		}
+		// Block data is stored HTML-escaped, see sanitizeContentBlock
+		raw, _ := block.Data[models.EmbedURLKey].(string)
+		rawURL := html.UnescapeString(raw)
+		if rawURL == "" {
+			return status.Errorf(codes.InvalidArgument, "embed block is missing %s", models.EmbedURLKey)
+		}
+		if s.oembed == nil {
+			return status.Errorf(codes.FailedPrecondition, "embeds are not configured")
+		}
+		if _, _, err := s.oembed.provider(rawURL); err != nil {
+			return status.Errorf(codes.InvalidArgument, "embed block '%s': %v", rawURL, err)
+		}
+	}
+	return nil
+}
+
+// resolveEmbeds sets Embed on every "embed" block of a read response, including blocks of
+// resolved reusable blocks. URLs that cannot be resolved are logged and left unresolved,
+// so clients fall back to rendering a link.
+func (s *ContentService) resolveEmbeds(ctx context.Context, content *contentv1.PageContent) {
+	if s.oembed == nil || content == nil {
+		return
+	}
+	var resolve func(blocks []*contentv1.ContentBlock)
+	resolve = func(blocks []*contentv1.ContentBlock) {
+		for _, block := range blocks {
+			resolve(block.ResolvedBlocks)
+			if block.Type != models.ContentBlockTypeEmbed {
+				continue
+			}
+			rawURL := html.UnescapeString(block.Data[models.EmbedURLKey])
+			embed, err := s.oembed.Resolve(ctx, rawURL)
+			if err != nil {
+				logger.Warn("Failed to resolve embed", "url", rawURL, "error", err.Error())
+				continue
+			}
+			block.Embed = convertEmbedToProto(embed)
+		}
+	}
+	resolve(content.Blocks)
+}
+
+func convertEmbedToProto(e *models.Embed) *contentv1.Embed {
+	return &contentv1.Embed{
+		Type:         e.Type,
+		ProviderName: e.ProviderName,
+		Title:        e.Title,
+		AuthorName:   e.AuthorName,
+		ThumbnailUrl: e.ThumbnailURL,
+		Width:        int32(e.Width),
+		Height:       int32(e.Height),
+		Html:         e.HTML,
+	}
+}
+
+// OEmbedResponse is an oEmbed 1.0 "rich" response describing one of our published posts
+type OEmbedResponse struct {
+	Version      string `json:"version"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name,omitempty"`
+	ProviderName string `json:"provider_name"`
+	ProviderURL  string `json:"provider_url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	HTML         string `json:"html"`
+}
+
+// PostOEmbed answers oEmbed requests for published posts at <site URL>/blog/<slug>.
+// maxWidth, when positive, narrows the embed card. Other URLs return NotFound.
+func (s *ContentService) PostOEmbed(ctx context.Context, rawURL string, maxWidth int) (*OEmbedResponse, error) {
+	site := s.siteInfo(ctx)
+	u, ok := parseHTTPURL(rawURL)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "a valid url is required")
+	}
+	siteURL, _ := url.Parse(site.URL)
+	slug := strings.TrimSuffix(strings.TrimPrefix(u.Path, "/blog/"), "/")
+	if siteURL == nil || !strings.EqualFold(u.Host, siteURL.Host) || !strings.HasPrefix(u.Path, "/blog/") || slug == "" || strings.Contains(slug, "/") {
+		return nil, status.Errorf(codes.NotFound, "no embeddable content at '%s'", rawURL)
+	}
+
+	post, err := s.blogRepo.GetBySlug(ctx, slug)
+	if err != nil || post.Status != models.PageStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "no embeddable content at '%s'", rawURL)
+	}
+
+	width := oembedCardWidth
+	if maxWidth > 0 && maxWidth < width {
+		width = maxWidth
+	}
+	postURL := site.URL + "/blog/" + post.Slug
+	resp := &OEmbedResponse{
+		Version:      "1.0",
+		Type:         "rich",
+		Title:        post.Title,
+		ProviderName: site.Name,
+		ProviderURL:  site.URL,
+		Width:        width,
+		Height:       oembedCardHeight,
+	}
+	if author, ok := s.structuredDataAuthor(ctx, post, site)["name"].(string); ok {
+		resp.AuthorName = author
+	}
+	protoPost := s.convertBlogModelToProto(post)
+	s.attachFeaturedImages(ctx, protoPost)
+	if protoPost.FeaturedImageMedia != nil {
+		resp.ThumbnailURL = absoluteURL(site.URL, protoPost.FeaturedImageMedia.Url)
+	}
+
+	var card strings.Builder
+	card.WriteString(`<blockquote class="post-embed" style="max-width:` + strconv.Itoa(width) + `px">`)
+	card.WriteString(`<p><a href="` + html.EscapeString(postURL) + `">` + html.EscapeString(post.Title) + `</a></p>`)
+	if post.Excerpt != "" {
+		card.WriteString(`<p>` + html.EscapeString(post.Excerpt) + `</p>`)
+	}
+	card.WriteString(`<p>` + html.EscapeString(site.Name) + `</p></blockquote>`)
+	resp.HTML = card.String()
+	return resp, nil
+}
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/cache"
+)
+
+// oembedStub serves a video provider at /video, a page with oEmbed discovery at /post
+// and a broken endpoint at /broken, counting the requests it receives
+type oembedStub struct {
+	*httptest.Server
+	hits atomic.Int32
+}
+
+func newOEmbedStub(t *testing.T) *oembedStub {
+	stub := &oembedStub{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/video", func(w http.ResponseWriter, r *http.Request) {
+		stub.hits.Add(1)
+		assert.Equal(t, "json", r.URL.Query().Get("format"))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"type":          "video",
+			"title":         "Launch <video>",
+			"provider_name": "StubTube",
+			"thumbnail_url": "javascript:alert(1)",
+			"width":         "640",
+			"height":        360,
+			"html":          `<iframe width="640" height="360" src="https://www.youtube.com/embed/abc?feature=oembed" onload="steal()" allowfullscreen></iframe>`,
+		})
+	})
+	mux.HandleFunc("/post", func(w http.ResponseWriter, r *http.Request) {
+		stub.hits.Add(1)
+		w.Write([]byte(`<html><head><link rel="alternate" type="application/json+oembed" href="/rich?url=post&amp;format=json"></head></html>`))
+	})
+	mux.HandleFunc("/rich", func(w http.ResponseWriter, r *http.Request) {
+		stub.hits.Add(1)
+		assert.Equal(t, "post", r.URL.Query().Get("url"))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"type":        "rich",
+			"author_name": "Jane",
+			"html":        `<blockquote>Hello</blockquote><script src="https://platform.example/widgets.js"></script>`,
+		})
+	})
+	mux.HandleFunc("/elsewhere", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<link type="application/json+oembed" href="https://attacker.example/oembed">`))
+	})
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, r *http.Request) {
+		stub.hits.Add(1)
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+	stub.Server = httptest.NewServer(mux)
+	t.Cleanup(stub.Close)
+	return stub
+}
+
+func newStubResolver(stub *oembedStub) *OEmbedResolver {
+	resolver := NewOEmbedResolver([]OEmbedProvider{
+		{Name: "StubTube", Hosts: []string{"127.0.0.1"}, Endpoint: stub.URL + "/video", FrameHosts: []string{"youtube.com"}},
+		{Name: "Discovered", Hosts: []string{"localhost"}},
+		DefaultOEmbedProviders()[2],
+	}, cache.NewRedisCache())
+	resolver.SetHTTPClient(stub.Client())
+	return resolver
+}
+
+func TestOEmbedResolver_EndpointAndCache(t *testing.T) {
+	stub := newOEmbedStub(t)
+	resolver := newStubResolver(stub)
+	ctx := context.Background()
+
+	embed, err := resolver.Resolve(ctx, stub.URL+"/watch?v=abc")
+	require.NoError(t, err)
+	assert.Equal(t, "video", embed.Type)
+	assert.Equal(t, "StubTube", embed.ProviderName)
+	assert.Equal(t, 640, embed.Width)
+	assert.Equal(t, 360, embed.Height)
+	assert.Empty(t, embed.ThumbnailURL, "non-http thumbnails are dropped")
+	assert.Contains(t, embed.HTML, `<iframe src="https://www.youtube.com/embed/abc?feature=oembed" width="640" height="360" title="Launch &lt;video&gt;"`)
+	assert.Contains(t, embed.HTML, `sandbox="`+oembedFrameSandbox+`"`)
+	assert.NotContains(t, embed.HTML, "onload")
+
+	_, err = resolver.Resolve(ctx, stub.URL+"/watch?v=abc")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), stub.hits.Load(), "the second read is served from cache")
+}
+
+func TestOEmbedResolver_Discovery(t *testing.T) {
+	stub := newOEmbedStub(t)
+	resolver := newStubResolver(stub)
+	local := "http://localhost:" + strconv.Itoa(stub.Listener.Addr().(*net.TCPAddr).Port)
+	ctx := context.Background()
+
+	embed, err := resolver.Resolve(ctx, local+"/post")
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", embed.AuthorName)
+	assert.Equal(t, "Discovered", embed.ProviderName)
+	assert.Contains(t, embed.HTML, `<iframe srcdoc="&lt;blockquote&gt;Hello&lt;/blockquote&gt;&lt;script`)
+	assert.Contains(t, embed.HTML, `sandbox="`+oembedSrcdocSandbox+`"`)
+	assert.NotContains(t, embed.HTML, "<script")
+
+	_, err = resolver.Resolve(ctx, local+"/elsewhere")
+	assert.ErrorContains(t, err, "another host")
+}
+
+func TestOEmbedResolver_AllowlistAndFailures(t *testing.T) {
+	stub := newOEmbedStub(t)
+	resolver := newStubResolver(stub)
+	ctx := context.Background()
+
+	_, err := resolver.Resolve(ctx, "https://example.org/video")
+	assert.ErrorIs(t, err, errEmbedNotAllowed)
+	_, err = resolver.Resolve(ctx, "ftp://127.0.0.1/video")
+	assert.Error(t, err)
+
+	gist, err := resolver.Resolve(ctx, "https://gist.github.com/octocat/6cad326836d38bd3a7ae")
+	require.NoError(t, err)
+	assert.Contains(t, gist.HTML, `srcdoc="&lt;script src=&#34;https://gist.github.com/octocat/6cad326836d38bd3a7ae.js&#34;&gt;&lt;/script&gt;"`)
+	_, err = resolver.Resolve(ctx, "https://gist.github.com/octocat")
+	assert.Error(t, err)
+
+	resolver.providers[0].Endpoint = stub.URL + "/broken"
+	_, err = resolver.Resolve(ctx, stub.URL+"/watch?v=broken")
+	require.Error(t, err)
+	_, err = resolver.Resolve(ctx, stub.URL+"/watch?v=broken")
+	assert.ErrorIs(t, err, errEmbedFailed)
+	assert.Equal(t, int32(1), stub.hits.Load(), "failures are cached briefly")
+}
+
+func TestContentService_EmbedBlocks(t *testing.T) {
+	stub := newOEmbedStub(t)
+	service := NewContentService(&memoryPageRepo{}, &memoryBlogRepo{posts: map[string]*models.BlogPost{}})
+	ctx := context.Background()
+	embedBlock := func(url string) *contentv1.PageContent {
+		return &contentv1.PageContent{Blocks: []*contentv1.ContentBlock{
+			{Type: models.ContentBlockTypeEmbed, Data: map[string]string{models.EmbedURLKey: url}},
+		}}
+	}
+	videoURL := stub.URL + "/watch?v=abc&t=10"
+
+	_, err := service.CreateBlogPost(ctx, &contentv1.CreateBlogPostRequest{Title: "Video", Author: "user-1", Content: embedBlock(videoURL)})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "embeds need a resolver")
+
+	service.SetOEmbedResolver(newStubResolver(stub))
+	for name, url := range map[string]string{"missing url": "", "not allowed": "https://example.org/video"} {
+		_, err := service.CreateBlogPost(ctx, &contentv1.CreateBlogPostRequest{Title: "Video", Author: "user-1", Content: embedBlock(url)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	post, err := service.CreateBlogPost(ctx, &contentv1.CreateBlogPostRequest{Title: "Video", Author: "user-1", Content: embedBlock(videoURL)})
+	require.NoError(t, err)
+	read, err := service.GetBlogPost(ctx, &contentv1.GetBlogPostRequest{Id: post.Id})
+	require.NoError(t, err)
+	require.NotNil(t, read.Content.Blocks[0].Embed)
+	assert.Equal(t, "StubTube", read.Content.Blocks[0].Embed.ProviderName)
+}
+
+func TestContentService_PostOEmbed(t *testing.T) {
+	published := models.NewBlogPost("Hello <world>", "hello-world", "Jane Doe")
+	published.SetPublished()
+	published.Excerpt = "A first post"
+	draft := models.NewBlogPost("Draft", "draft", "Jane Doe")
+	service := NewContentService(nil, &memoryBlogRepo{posts: map[string]*models.BlogPost{published.ID: published, draft.ID: draft}})
+	service.SetSiteInfo(SiteInfo{Name: "Acme", URL: "https://acme.test"})
+	ctx := context.Background()
+
+	resp, err := service.PostOEmbed(ctx, "https://acme.test/blog/hello-world/", 400)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", resp.Version)
+	assert.Equal(t, "rich", resp.Type)
+	assert.Equal(t, "Acme", resp.ProviderName)
+	assert.Equal(t, "Jane Doe", resp.AuthorName)
+	assert.Equal(t, 400, resp.Width)
+	assert.Contains(t, resp.HTML, `<a href="https://acme.test/blog/hello-world">Hello &lt;world&gt;</a>`)
+	assert.Contains(t, resp.HTML, "<p>A first post</p>")
+
+	for name, url := range map[string]string{
+		"draft":      "https://acme.test/blog/draft",
+		"other host": "https://other.test/blog/hello-world",
+		"not a post": "https://acme.test/about",
+		"missing":    "https://acme.test/blog/nope",
+	} {
+		_, err := service.PostOEmbed(ctx, url, 0)
+		assert.Equal(t, codes.NotFound, status.Code(err), name)
+	}
+	_, err = service.PostOEmbed(ctx, "", 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// maxSlugAttempts bounds the search for a free slug when duplicating content
+const maxSlugAttempts = 100
+
+// SetPageTemplateRepository enables page templates. When unset, the template RPCs return FailedPrecondition.
+func (s *ContentService) SetPageTemplateRepository(repo repository.PageTemplateRepository) {
+	s.templateRepo = repo
+}
+
+// CreatePageTemplate creates a new page template at version 1
+func (s *ContentService) CreatePageTemplate(ctx context.Context, req *contentv1.CreatePageTemplateRequest) (*contentv1.PageTemplate, error) {
+	if err := s.requireTemplateRepo(); err != nil {
+		return nil, err
+	}
+	if err := s.validatePageTemplateFields(req.Name, req.Slug); err != nil {
+		return nil, err
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = s.generateSlug(req.Name)
+	} else {
+		slug = s.sanitizeSlug(slug)
+	}
+	if err := s.validatePageTemplateSlugUniqueness(ctx, slug, ""); err != nil {
+		return nil, err
+	}
+
+	template := models.NewPageTemplate(strings.TrimSpace(req.Name), slug)
+	template.Description = strings.TrimSpace(req.Description)
+	template.Content = s.convertProtoContentToModel(s.sanitizeContent(req.Content))
+	template.Meta = s.convertProtoMetaToModel(req.Meta)
+
+	if err := s.validateReusableBlockRefs(ctx, template.Content); err != nil {
+		return nil, err
+	}
+	if err := s.validateEmbedBlocks(template.Content); err != nil {
+		return nil, err
+	}
+	if err := s.normalizeMeta(ctx, &template.Meta); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create page template: %v", err)
+	}
+
+	return s.convertPageTemplateToProto(template), nil
+}
+
+// GetPageTemplate retrieves a page template, optionally at a specific version
+func (s *ContentService) GetPageTemplate(ctx context.Context, req *contentv1.GetPageTemplateRequest) (*contentv1.PageTemplate, error) {
+	if err := s.requireTemplateRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template ID is required")
+	}
+
+	template, err := s.getPageTemplate(ctx, req.Id, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertPageTemplateToProto(template), nil
+}
+
+// UpdatePageTemplate updates a page template; each update is stored as a new version
+func (s *ContentService) UpdatePageTemplate(ctx context.Context, req *contentv1.UpdatePageTemplateRequest) (*contentv1.PageTemplate, error) {
+	if err := s.requireTemplateRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template ID is required")
+	}
+	if err := s.validatePageTemplateFields(req.Name, req.Slug); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.templateRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "page template not found: %v", err)
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = s.generateSlug(req.Name)
+	} else {
+		slug = s.sanitizeSlug(slug)
+	}
+	if slug != existing.Slug {
+		if err := s.validatePageTemplateSlugUniqueness(ctx, slug, existing.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.Name = strings.TrimSpace(req.Name)
+	existing.Slug = slug
+	existing.Description = strings.TrimSpace(req.Description)
+	existing.Content = s.convertProtoContentToModel(s.sanitizeContent(req.Content))
+	existing.Meta = s.convertProtoMetaToModel(req.Meta)
+
+	if err := s.validateReusableBlockRefs(ctx, existing.Content); err != nil {
+		return nil, err
+	}
+	if err := s.validateEmbedBlocks(existing.Content); err != nil {
+		return nil, err
+	}
+	if err := s.normalizeMeta(ctx, &existing.Meta); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.Update(ctx, existing); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update page template: %v", err)
+	}
+
+	return s.convertPageTemplateToProto(existing), nil
+}
+
+// DeletePageTemplate deletes a page template. Pages created from it are not affected.
+func (s *ContentService) DeletePageTemplate(ctx context.Context, req *contentv1.DeletePageTemplateRequest) (*emptypb.Empty, error) {
+	if err := s.requireTemplateRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template ID is required")
+	}
+
+	if _, err := s.templateRepo.GetByID(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "page template not found: %v", err)
+	}
+
+	if err := s.templateRepo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete page template: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListPageTemplates lists page templates with optional search and pagination
+func (s *ContentService) ListPageTemplates(ctx context.Context, req *contentv1.ListPageTemplatesRequest) (*contentv1.ListPageTemplatesResponse, error) {
+	if err := s.requireTemplateRepo(); err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	skip := 0
+	if req.PageToken != "" {
+		if parsedSkip, err := strconv.Atoi(req.PageToken); err == nil {
+			skip = parsedSkip
+		}
+	}
+
+	templates, err := s.templateRepo.List(ctx, repository.ListOptions{
+		Limit:  int(pageSize),
+		Skip:   skip,
+		Search: strings.TrimSpace(req.Search),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list page templates: %v", err)
+	}
+
+	protoTemplates := make([]*contentv1.PageTemplate, len(templates))
+	for i, template := range templates {
+		protoTemplates[i] = s.convertPageTemplateToProto(template)
+	}
+
+	nextPageToken := ""
+	if len(templates) == int(pageSize) {
+		nextPageToken = strconv.Itoa(skip + int(pageSize))
+	}
+
+	return &contentv1.ListPageTemplatesResponse{
+		Templates:     protoTemplates,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(len(protoTemplates)), // This is approximate for this page
+	}, nil
+}
+
+// CreatePageFromTemplate instantiates a template as a new draft page
+func (s *ContentService) CreatePageFromTemplate(ctx context.Context, req *contentv1.CreatePageFromTemplateRequest) (*contentv1.Page, error) {
+	if err := s.requireTemplateRepo(); err != nil {
+		return nil, err
+	}
+	if req.TemplateId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "template ID is required")
+	}
+	if err := s.validateCreatePageRequest(&contentv1.CreatePageRequest{Title: req.Title, Slug: req.Slug}); err != nil {
+		return nil, err
+	}
+
+	template, err := s.getPageTemplate(ctx, req.TemplateId, req.TemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = s.generateSlug(req.Title)
+	} else {
+		slug = s.sanitizeSlug(slug)
+	}
+	if err := s.validateSlugUniqueness(ctx, slug, ""); err != nil {
+		return nil, err
+	}
+
+	page := models.NewPage(strings.TrimSpace(req.Title), slug)
+	page.Content = template.Content.Clone()
+	page.Meta = mergeMeta(template.Meta, s.convertProtoMetaToModel(req.Meta))
+
+	if err := s.insertPage(ctx, page); err != nil {
+		return nil, err
+	}
+
+	return s.convertModelToProto(page), nil
+}
+
+// DuplicatePage clones a page into a new draft with a unique slug
+func (s *ContentService) DuplicatePage(ctx context.Context, req *contentv1.DuplicatePageRequest) (*contentv1.Page, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "page ID is required")
+	}
+
+	source, err := s.pageRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "page not found: %v", err)
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = source.Title + " (Copy)"
+	}
+	if err := s.validateCreatePageRequest(&contentv1.CreatePageRequest{Title: title, Slug: req.Slug}); err != nil {
+		return nil, err
+	}
+
+	var slug string
+	if req.Slug != "" {
+		slug = s.sanitizeSlug(req.Slug)
+		if err := s.validateSlugUniqueness(ctx, slug, ""); err != nil {
+			return nil, err
+		}
+	} else {
+		slug, err = s.uniqueSlug(source.Slug+"-copy", func(candidate string) bool {
+			_, err := s.pageRepo.GetBySlug(ctx, candidate)
+			return err == nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page := models.NewPage(title, slug)
+	page.Content = source.Content.Clone()
+	page.Meta = duplicateMeta(source.Meta)
+	page.Visibility = source.Visibility
+
+	if err := s.insertPage(ctx, page); err != nil {
+		return nil, err
+	}
+
+	return s.convertModelToProto(page), nil
+}
+
+// DuplicateBlogPost clones a blog post into a new draft with a unique slug
+func (s *ContentService) DuplicateBlogPost(ctx context.Context, req *contentv1.DuplicateBlogPostRequest) (*contentv1.BlogPost, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "blog post ID is required")
+	}
+
+	source, err := s.blogRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "blog post not found: %v", err)
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = source.Title + " (Copy)"
+	}
+	if err := s.validateCreateBlogPostRequest(&contentv1.CreateBlogPostRequest{Title: title, Slug: req.Slug, Author: source.Author}); err != nil {
+		return nil, err
+	}
+
+	var slug string
+	if req.Slug != "" {
+		slug = s.sanitizeSlug(req.Slug)
+		if err := s.validateBlogSlugUniqueness(ctx, slug, ""); err != nil {
+			return nil, err
+		}
+	} else {
+		slug, err = s.uniqueSlug(source.Slug+"-copy", func(candidate string) bool {
+			_, err := s.blogRepo.GetBySlug(ctx, candidate)
+			return err == nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	post := models.NewBlogPost(title, slug, source.Author)
+	post.Excerpt = source.Excerpt
+	post.Content = source.Content.Clone()
+	post.Meta = duplicateMeta(source.Meta)
+	post.Categories = append([]string{}, source.Categories...)
+	post.Tags = append([]string{}, source.Tags...)
+	post.FeaturedImage = source.FeaturedImage
+	post.CommentsDisabled = source.CommentsDisabled
+	post.Visibility = source.Visibility
+
+	if err := s.insertBlogPost(ctx, post); err != nil {
+		return nil, err
+	}
+
+	return s.convertBlogModelToProto(post), nil
+}
+
+// Page template helpers
+
+func (s *ContentService) requireTemplateRepo() error {
+	if s.templateRepo == nil {
+		return status.Errorf(codes.FailedPrecondition, "page templates are not configured")
+	}
+	return nil
+}
+
+func (s *ContentService) getPageTemplate(ctx context.Context, id string, version int32) (*models.PageTemplate, error) {
+	if version < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "version must not be negative")
+	}
+
+	var template *models.PageTemplate
+	var err error
+	if version == 0 {
+		template, err = s.templateRepo.GetByID(ctx, id)
+	} else {
+		template, err = s.templateRepo.GetVersion(ctx, id, int(version))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "page template not found: %v", err)
+	}
+	return template, nil
+}
+
+func (s *ContentService) validatePageTemplateFields(name, slug string) error {
+	if strings.TrimSpace(name) == "" {
+		return status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > 200 {
+		return status.Errorf(codes.InvalidArgument, "name must be less than 200 characters")
+	}
+	if slug != "" && len(slug) > 100 {
+		return status.Errorf(codes.InvalidArgument, "slug must be less than 100 characters")
+	}
+	return nil
+}
+
+func (s *ContentService) validatePageTemplateSlugUniqueness(ctx context.Context, slug, excludeID string) error {
+	existing, err := s.templateRepo.GetBySlug(ctx, slug)
+	if err == nil && existing != nil && existing.ID != excludeID {
+		return status.Errorf(codes.AlreadyExists, "page template with slug '%s' already exists", slug)
+	}
+	return nil
+}
+
+// uniqueSlug returns base, or base with a numeric suffix, choosing the first candidate not taken
+func (s *ContentService) uniqueSlug(base string, taken func(string) bool) (string, error) {
+	base = s.sanitizeSlug(base)
+	for i := 1; i <= maxSlugAttempts; i++ {
+		candidate := base
+		if i > 1 {
+			suffix := "-" + strconv.Itoa(i)
+			trimmed := base
+			if len(trimmed)+len(suffix) > 100 {
+				trimmed = strings.TrimRight(trimmed[:100-len(suffix)], "-")
+			}
+			candidate = trimmed + suffix
+		}
+		if !taken(candidate) {
+			return candidate, nil
+		}
+	}
+	return "", status.Errorf(codes.AlreadyExists, "could not find a free slug for '%s'", base)
+}
+
+// duplicateMeta returns the metadata of a copy. The canonical URL and the Open Graph
+// overrides describe the original, so the copy falls back to its own title and description.
+func duplicateMeta(meta models.Meta) models.Meta {
+	meta.CanonicalURL = ""
+	meta.OGTitle, meta.OGDescription, meta.OGImage = "", "", ""
+	return meta
+}
+
+// mergeMeta returns defaults with every non-empty field of override applied on top
+func mergeMeta(defaults, override models.Meta) models.Meta {
+	merged := defaults
+	if override.Title != "" {
+		merged.Title = override.Title
+	}
+	if override.Description != "" {
+		merged.Description = override.Description
+	}
+	if override.Keywords != "" {
+		merged.Keywords = override.Keywords
+	}
+	if override.OGTitle != "" {
+		merged.OGTitle = override.OGTitle
+	}
+	if override.OGDescription != "" {
+		merged.OGDescription = override.OGDescription
+	}
+	if override.OGImage != "" {
+		merged.OGImage = override.OGImage
+	}
+	if override.CanonicalURL != "" {
+		merged.CanonicalURL = override.CanonicalURL
+	}
+	if override.Robots != "" {
+		merged.Robots = override.Robots
+	}
+	if override.TwitterCard != "" {
+		merged.TwitterCard = override.TwitterCard
+	}
+	return merged
+}
+
+func (s *ContentService) convertPageTemplateToProto(template *models.PageTemplate) *contentv1.PageTemplate {
+	return &contentv1.PageTemplate{
+		Id:          template.ID,
+		Name:        template.Name,
+		Slug:        template.Slug,
+		Description: template.Description,
+		Version:     int32(template.Version),
+		Content:     s.convertModelContentToProto(template.Content),
+		Meta:        s.convertModelMetaToProto(template.Meta),
+		CreatedAt:   timestamppb.New(template.CreatedAt),
+		UpdatedAt:   timestamppb.New(template.UpdatedAt),
+	}
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memoryTemplateRepo is an in-memory PageTemplateRepository that keeps every version
+type memoryTemplateRepo struct {
+	mu       sync.Mutex
+	seq      int
+	versions map[string][]models.PageTemplate
+}
+
+func newMemoryTemplateRepo() *memoryTemplateRepo {
+	return &memoryTemplateRepo{versions: map[string][]models.PageTemplate{}}
+}
+
+func (r *memoryTemplateRepo) Create(ctx context.Context, template *models.PageTemplate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	template.ID = fmt.Sprintf("tpl-%d", r.seq)
+	template.Version = 1
+	r.versions[template.ID] = []models.PageTemplate{*template}
+	return nil
+}
+
+func (r *memoryTemplateRepo) GetByID(ctx context.Context, id string) (*models.PageTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions, ok := r.versions[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	latest := versions[len(versions)-1]
+	return &latest, nil
+}
+
+func (r *memoryTemplateRepo) GetBySlug(ctx context.Context, slug string) (*models.PageTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, versions := range r.versions {
+		if latest := versions[len(versions)-1]; latest.Slug == slug {
+			return &latest, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryTemplateRepo) GetVersion(ctx context.Context, id string, version int) (*models.PageTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions, ok := r.versions[id]
+	if !ok || version < 1 || version > len(versions) {
+		return nil, repository.ErrNotFound
+	}
+	v := versions[version-1]
+	return &v, nil
+}
+
+func (r *memoryTemplateRepo) Update(ctx context.Context, template *models.PageTemplate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	template.Version = len(r.versions[template.ID]) + 1
+	r.versions[template.ID] = append(r.versions[template.ID], *template)
+	return nil
+}
+
+func (r *memoryTemplateRepo) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.versions, id)
+	return nil
+}
+
+func (r *memoryTemplateRepo) List(ctx context.Context, options repository.ListOptions) ([]*models.PageTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.PageTemplate
+	for _, versions := range r.versions {
+		latest := versions[len(versions)-1]
+		out = append(out, &latest)
+	}
+	return out, nil
+}
+
+func TestContentService_PageTemplateVersions(t *testing.T) {
+	service := NewContentService(nil, nil)
+	service.SetPageTemplateRepository(newMemoryTemplateRepo())
+	ctx := context.Background()
+
+	created, err := service.CreatePageTemplate(ctx, &contentv1.CreatePageTemplateRequest{
+		Name: "Landing Page",
+		Content: &contentv1.PageContent{Blocks: []*contentv1.ContentBlock{
+			{Type: "hero", Data: map[string]string{"title": "Headline"}},
+		}},
+		Meta: &contentv1.PageMeta{Description: "Default description"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "landing-page", created.Slug)
+	assert.Equal(t, int32(1), created.Version)
+
+	_, err = service.CreatePageTemplate(ctx, &contentv1.CreatePageTemplateRequest{Name: "Landing Page"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	updated, err := service.UpdatePageTemplate(ctx, &contentv1.UpdatePageTemplateRequest{
+		Id:   created.Id,
+		Name: "Landing Page",
+		Content: &contentv1.PageContent{Blocks: []*contentv1.ContentBlock{
+			{Type: "hero", Data: map[string]string{"title": "New headline"}},
+			{Type: "cta", Data: map[string]string{"label": "Sign up"}},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), updated.Version)
+
+	first, err := service.GetPageTemplate(ctx, &contentv1.GetPageTemplateRequest{Id: created.Id, Version: 1})
+	require.NoError(t, err)
+	assert.Len(t, first.Content.Blocks, 1)
+	assert.Equal(t, "Default description", first.Meta.Description)
+
+	latest, err := service.GetPageTemplate(ctx, &contentv1.GetPageTemplateRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.Len(t, latest.Content.Blocks, 2)
+
+	_, err = service.GetPageTemplate(ctx, &contentv1.GetPageTemplateRequest{Id: created.Id, Version: 9})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestContentService_PageTemplatesDisabled(t *testing.T) {
+	service := NewContentService(nil, nil)
+
+	_, err := service.CreatePageFromTemplate(context.Background(), &contentv1.CreatePageFromTemplateRequest{TemplateId: "tpl-1", Title: "Page"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestContentService_UniqueSlug(t *testing.T) {
+	service := NewContentService(nil, nil)
+	taken := map[string]bool{"about-copy": true, "about-copy-2": true}
+
+	slug, err := service.uniqueSlug("about-copy", func(s string) bool { return taken[s] })
+	require.NoError(t, err)
+	assert.Equal(t, "about-copy-3", slug)
+
+	slug, err = service.uniqueSlug("contact-copy", func(s string) bool { return taken[s] })
+	require.NoError(t, err)
+	assert.Equal(t, "contact-copy", slug)
+
+	_, err = service.uniqueSlug("busy", func(s string) bool { return true })
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestMergeMeta(t *testing.T) {
+	defaults := models.Meta{Title: "Default", Description: "Default description", Keywords: "a, b"}
+
+	merged := mergeMeta(defaults, models.Meta{Title: "Custom"})
+	assert.Equal(t, "Custom", merged.Title)
+	assert.Equal(t, "Default description", merged.Description)
+	assert.Equal(t, "a, b", merged.Keywords)
+}
+
+func TestContentService_DuplicateClearsCanonicalAndSocialMeta(t *testing.T) {
+	meta := models.Meta{
+		Title:         "Pricing",
+		Description:   "Plans and prices",
+		OGTitle:       "Our pricing",
+		OGDescription: "Compare plans",
+		OGImage:       "media:pricing.png",
+		CanonicalURL:  "https://example.com/pricing",
+		Robots:        "noindex",
+	}
+	want := models.Meta{Title: "Pricing", Description: "Plans and prices", Robots: "noindex"}
+
+	page := models.NewPage("Pricing", "pricing")
+	page.Meta = meta
+	post := models.NewBlogPost("Pricing update", "pricing-update", "user-1")
+	post.Meta = meta
+	service := NewContentService(
+		&memoryPageRepo{pages: []*models.Page{page}},
+		&memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}},
+	)
+	ctx := context.Background()
+
+	pageCopy, err := service.DuplicatePage(ctx, &contentv1.DuplicatePageRequest{Id: page.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "pricing-copy", pageCopy.Slug)
+	copied, err := service.pageRepo.GetByID(ctx, pageCopy.Id)
+	require.NoError(t, err)
+	assert.Equal(t, want, copied.Meta)
+
+	postCopy, err := service.DuplicateBlogPost(ctx, &contentv1.DuplicateBlogPostRequest{Id: post.ID})
+	require.NoError(t, err)
+	copiedPost, err := service.blogRepo.GetByID(ctx, postCopy.Id)
+	require.NoError(t, err)
+	assert.Equal(t, want, copiedPost.Meta)
+
+	assert.Equal(t, "https://example.com/pricing", page.Meta.CanonicalURL, "the original keeps its metadata")
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// applyPin sets the pin of a post. A nil pinned leaves the pin unchanged; unpinning
+// also drops the expiry, which is only accepted for pinned posts and must lie ahead.
+func applyPin(post *models.BlogPost, pinned *bool, until *timestamppb.Timestamp) error {
+	if pinned != nil {
+		post.Pinned = *pinned
+		post.PinnedUntil = nil
+	}
+	if until == nil {
+		return nil
+	}
+	if !post.Pinned {
+		return status.Errorf(codes.InvalidArgument, "pinned_until requires the post to be pinned")
+	}
+	expiry := until.AsTime()
+	if !expiry.After(time.Now()) {
+		return status.Errorf(codes.InvalidArgument, "pinned_until must be in the future")
+	}
+	post.PinnedUntil = &expiry
+	return nil
+}
+
+// StartPinRelease unpins posts whose pin expired every interval until the context is cancelled
+func (s *ContentService) StartPinRelease(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.releaseExpiredPins(ctx)
+			}
+		}
+	}()
+}
+
+// releaseExpiredPins unpins the posts whose pin expired; posts that could not be unpinned
+// are retried on the next run
+func (s *ContentService) releaseExpiredPins(ctx context.Context) {
+	released, err := s.blogRepo.ReleaseExpiredPins(ctx, time.Now())
+	if err != nil {
+		logger.Error("Failed to release expired pins", err, "released", released)
+	}
+}
+
+// blogPostSortToModel converts a proto sort mode to one of the models.BlogSort* orders
+func blogPostSortToModel(mode contentv1.BlogPostSort) string {
+	switch mode {
+	case contentv1.BlogPostSort_BLOG_POST_SORT_UPDATED_AT:
+		return models.BlogSortUpdatedAt
+	case contentv1.BlogPostSort_BLOG_POST_SORT_TITLE:
+		return models.BlogSortTitle
+	case contentv1.BlogPostSort_BLOG_POST_SORT_MANUAL:
+		return models.BlogSortManual
+	default:
+		return models.BlogSortPublishedAt
+	}
+}
+
+// reorderedPostIDs moves the listed posts to the front in the given order; the posts
+// left out keep their relative order after them
+func reorderedPostIDs(current, order []string) ([]string, error) {
+	members := make(map[string]bool, len(current))
+	for _, id := range current {
+		members[id] = true
+	}
+	listed := make(map[string]bool, len(order))
+	out := make([]string, 0, len(current))
+	for _, id := range order {
+		if !members[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "post '%s' is not in the collection", id)
+		}
+		if listed[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "post '%s' is listed more than once", id)
+		}
+		listed[id] = true
+		out = append(out, id)
+	}
+	for _, id := range current {
+		if !listed[id] {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
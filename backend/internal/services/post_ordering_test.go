@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+func newOrderingTestService() *ContentService {
+	now := time.Now()
+	posts := map[string]*models.BlogPost{}
+	add := func(slug, title string, age time.Duration, weight int) *models.BlogPost {
+		post := models.NewBlogPost(title, slug, "user-1")
+		post.Status = models.PageStatusPublished
+		post.PublishedAt = timePtr(now.Add(-age))
+		post.UpdatedAt = now.Add(-age)
+		post.SortWeight = weight
+		posts[post.ID] = post
+		return post
+	}
+	add("a-oldest", "Zebra crossings", 72*time.Hour, 1)
+	add("b-newest", "Apples", time.Hour, 3)
+	add("c-middle", "Mangoes", 24*time.Hour, 2)
+	announcement := add("d-announcement", "Launch week", 48*time.Hour, 9)
+	announcement.Pinned = true
+	expired := add("e-expired", "Old news", 96*time.Hour, 0)
+	expired.Pinned = true
+	expired.PinnedUntil = timePtr(now.Add(-time.Minute))
+	// The title sort ignores updates, so this post was edited after the others
+	posts["blog:a-oldest"].UpdatedAt = now
+
+	return NewContentService(&memoryPageRepo{}, &memoryBlogRepo{posts: posts})
+}
+
+func TestContentService_ListBlogPostsSortModes(t *testing.T) {
+	service := newOrderingTestService()
+
+	tests := []struct {
+		name string
+		sort contentv1.BlogPostSort
+		want []string
+	}{
+		{name: "default", want: []string{"d-announcement", "b-newest", "c-middle", "a-oldest", "e-expired"}},
+		{name: "updated", sort: contentv1.BlogPostSort_BLOG_POST_SORT_UPDATED_AT, want: []string{"d-announcement", "a-oldest", "b-newest", "c-middle", "e-expired"}},
+		{name: "title", sort: contentv1.BlogPostSort_BLOG_POST_SORT_TITLE, want: []string{"d-announcement", "b-newest", "c-middle", "e-expired", "a-oldest"}},
+		{name: "manual", sort: contentv1.BlogPostSort_BLOG_POST_SORT_MANUAL, want: []string{"d-announcement", "e-expired", "a-oldest", "c-middle", "b-newest"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.ListBlogPosts(context.Background(), &contentv1.ListBlogPostsRequest{
+				Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+				Sort:   tt.sort,
+			})
+			require.NoError(t, err)
+			var slugs []string
+			for _, post := range resp.Posts {
+				slugs = append(slugs, post.Slug)
+			}
+			assert.Equal(t, tt.want, slugs)
+			assert.Equal(t, int32(5), resp.TotalCount)
+		})
+	}
+}
+
+func TestContentService_ListBlogPostsPaginatesSortedPosts(t *testing.T) {
+	service := newOrderingTestService()
+	ctx := context.Background()
+
+	first, err := service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED, PageSize: 3})
+	require.NoError(t, err)
+	require.Len(t, first.Posts, 3)
+	assert.Equal(t, "d-announcement", first.Posts[0].Slug)
+	assert.Equal(t, "3", first.NextPageToken)
+
+	second, err := service.ListBlogPosts(ctx, &contentv1.ListBlogPostsRequest{Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED, PageSize: 3, PageToken: first.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, second.Posts, 2)
+	assert.Equal(t, "a-oldest", second.Posts[0].Slug)
+	assert.Empty(t, second.NextPageToken)
+}
+
+func TestContentService_PinBlogPost(t *testing.T) {
+	service := newOrderingTestService()
+	ctx := context.Background()
+	until := time.Now().Add(24 * time.Hour)
+
+	post, err := service.UpdateBlogPost(ctx, &contentv1.UpdateBlogPostRequest{
+		Id:          "blog:c-middle",
+		Title:       "Mangoes",
+		Author:      "user-1",
+		Status:      contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+		Pinned:      proto.Bool(true),
+		PinnedUntil: timestamppb.New(until),
+		SortWeight:  proto.Int32(-1),
+	})
+	require.NoError(t, err)
+	assert.True(t, post.Pinned)
+	assert.True(t, post.PinnedUntil.AsTime().Equal(until))
+	assert.Equal(t, int32(-1), post.SortWeight)
+
+	// Leaving the pin fields unset keeps the pin
+	post, err = service.UpdateBlogPost(ctx, &contentv1.UpdateBlogPostRequest{Id: "blog:c-middle", Title: "Mangoes", Author: "user-1", Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED})
+	require.NoError(t, err)
+	assert.True(t, post.Pinned)
+	assert.NotNil(t, post.PinnedUntil)
+
+	post, err = service.UpdateBlogPost(ctx, &contentv1.UpdateBlogPostRequest{Id: "blog:c-middle", Title: "Mangoes", Author: "user-1", Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED, Pinned: proto.Bool(false)})
+	require.NoError(t, err)
+	assert.False(t, post.Pinned)
+	assert.Nil(t, post.PinnedUntil)
+
+	_, err = service.UpdateBlogPost(ctx, &contentv1.UpdateBlogPostRequest{Id: "blog:b-newest", Title: "Apples", Author: "user-1", PinnedUntil: timestamppb.New(until)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "an expiry needs a pin")
+
+	_, err = service.CreateBlogPost(ctx, &contentv1.CreateBlogPostRequest{Title: "Past", Author: "user-1", Pinned: true, PinnedUntil: timestamppb.New(time.Now().Add(-time.Hour))})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "the expiry must lie ahead")
+}
+
+func TestContentService_ReleaseExpiredPins(t *testing.T) {
+	service := newOrderingTestService()
+	ctx := context.Background()
+
+	service.releaseExpiredPins(ctx)
+
+	expired, err := service.GetBlogPost(ctx, &contentv1.GetBlogPostRequest{Id: "blog:e-expired"})
+	require.NoError(t, err)
+	assert.False(t, expired.Pinned)
+	assert.Nil(t, expired.PinnedUntil)
+	pinned, err := service.GetBlogPost(ctx, &contentv1.GetBlogPostRequest{Id: "blog:d-announcement"})
+	require.NoError(t, err)
+	assert.True(t, pinned.Pinned, "pins without an expiry stay")
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// publicHTTPMaxRedirects bounds the redirects followed when fetching a third-party URL
+const publicHTTPMaxRedirects = 5
+
+// errNonPublicAddress is returned when a third-party URL resolves to an internal address
+var errNonPublicAddress = errors.New("address is not publicly routable")
+
+// sharedAddressSpace is the carrier-grade NAT range, which net.IP does not treat as private
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// newPublicHTTPClient returns a client for URLs supplied by third parties, such as the
+// sources of received Webmentions. It only connects to publicly routable addresses: the
+// check runs on the resolved address of every connection, so it also covers DNS names
+// pointing inside the network and each hop of a redirect.
+func newPublicHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   publicAddressControl,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// No proxy: the proxy would be dialed instead of the target
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		CheckRedirect: checkPublicRedirect,
+	}
+}
+
+// publicAddressControl rejects connections to loopback, private, link-local, multicast and
+// unspecified addresses. It runs after DNS resolution, right before the socket connects.
+func publicAddressControl(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("invalid address %s: %w", address, err)
+	}
+	if !isPublicAddr(addr) {
+		return fmt.Errorf("dial %s: %w", address, errNonPublicAddress)
+	}
+	return nil
+}
+
+// isPublicAddr reports whether addr is a publicly routable unicast address
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	switch {
+	case !addr.IsValid(),
+		addr.IsUnspecified(),
+		addr.IsLoopback(),
+		addr.IsPrivate(),
+		addr.IsLinkLocalUnicast(),
+		addr.IsLinkLocalMulticast(),
+		addr.IsInterfaceLocalMulticast(),
+		addr.IsMulticast(),
+		sharedAddressSpace.Contains(addr):
+		return false
+	}
+	return true
+}
+
+// checkPublicRedirect only follows a bounded number of redirects to http and https URLs.
+// The address of each hop is checked by publicAddressControl when it is dialed.
+func checkPublicRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= publicHTTPMaxRedirects {
+		return fmt.Errorf("stopped after %d redirects", publicHTTPMaxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+	}
+	return nil
+}
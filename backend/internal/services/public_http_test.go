@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34":    true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"fe80::1":          false,
+		"fc00::1":          false,
+		"0.0.0.0":          false,
+		"::":               false,
+		"100.64.0.1":       false,
+		"224.0.0.1":        false,
+		"::ffff:127.0.0.1": false,
+		"::ffff:10.0.0.1":  false,
+	}
+	for addr, want := range tests {
+		assert.Equal(t, want, isPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestPublicHTTPClient_RejectsInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("internal"))
+	}))
+	t.Cleanup(server.Close)
+	client := newPublicHTTPClient(time.Second)
+
+	_, _, _, err := fetchLimited(context.Background(), client, server.URL, 1024)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, errNonPublicAddress), err.Error())
+
+	_, _, _, err = fetchLimited(context.Background(), client, "http://127.0.0.1:1/", 1024)
+	assert.True(t, errors.Is(err, errNonPublicAddress), err.Error())
+}
+
+func TestCheckPublicRedirect(t *testing.T) {
+	hop := func(target string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		require.NoError(t, err)
+		return req
+	}
+
+	assert.NoError(t, checkPublicRedirect(hop("https://example.com/next"), []*http.Request{hop("https://example.com/")}))
+	assert.Error(t, checkPublicRedirect(hop("ftp://example.com/"), []*http.Request{hop("https://example.com/")}))
+
+	via := make([]*http.Request, publicHTTPMaxRedirects)
+	for i := range via {
+		via[i] = hop("https://example.com/")
+	}
+	assert.Error(t, checkPublicRedirect(hop("https://example.com/next"), via))
+}
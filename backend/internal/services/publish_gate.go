@@ -0,0 +1,356 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// Publish quality gate rules
+const (
+	// PublishRuleMetaDescription requires a meta description
+	PublishRuleMetaDescription = "meta_description"
+	// PublishRuleFeaturedImage requires blog posts to have a featured image
+	PublishRuleFeaturedImage = "featured_image"
+	// PublishRuleImageAlt requires alt text on every image
+	PublishRuleImageAlt = "image_alt"
+	// PublishRuleEmptyBlocks rejects content blocks without any content
+	PublishRuleEmptyBlocks = "empty_blocks"
+	// PublishRuleDraftLinks rejects internal links to pages and posts that are not published
+	PublishRuleDraftLinks = "draft_links"
+	// PublishRuleExcerptLength limits the length of blog post excerpts
+	PublishRuleExcerptLength = "excerpt_length"
+)
+
+// publishRules lists the rules in the order their violations are reported
+var publishRules = []string{
+	PublishRuleMetaDescription,
+	PublishRuleFeaturedImage,
+	PublishRuleImageAlt,
+	PublishRuleEmptyBlocks,
+	PublishRuleDraftLinks,
+	PublishRuleExcerptLength,
+}
+
+// contentlessBlockTypes are block types that render without any data
+var contentlessBlockTypes = map[string]bool{
+	"divider": true,
+	"spacer":  true,
+}
+
+// PublishRuleSeverity is what a failed rule does to a publish
+type PublishRuleSeverity string
+
+const (
+	// PublishRuleOff disables a rule
+	PublishRuleOff PublishRuleSeverity = "off"
+	// PublishRuleWarning reports a violation without stopping the publish
+	PublishRuleWarning PublishRuleSeverity = "warning"
+	// PublishRuleError stops the publish unless an admin overrides it
+	PublishRuleError PublishRuleSeverity = "error"
+)
+
+// PublishGateConfig configures the checks run when a page or post is published.
+// Rules that are not listed are off.
+type PublishGateConfig struct {
+	Rules            map[string]PublishRuleSeverity
+	MaxExcerptLength int
+}
+
+// DefaultPublishGateConfig blocks content that is broken for readers or search engines
+// and warns about editorial omissions
+func DefaultPublishGateConfig() PublishGateConfig {
+	return PublishGateConfig{
+		Rules: map[string]PublishRuleSeverity{
+			PublishRuleMetaDescription: PublishRuleError,
+			PublishRuleFeaturedImage:   PublishRuleWarning,
+			PublishRuleImageAlt:        PublishRuleError,
+			PublishRuleEmptyBlocks:     PublishRuleWarning,
+			PublishRuleDraftLinks:      PublishRuleError,
+			PublishRuleExcerptLength:   PublishRuleWarning,
+		},
+		MaxExcerptLength: 300,
+	}
+}
+
+// ParseRules applies comma-separated rule=severity pairs to the config,
+// e.g. "featured_image=error,empty_blocks=off"
+func (c *PublishGateConfig) ParseRules(spec string) error {
+	if c.Rules == nil {
+		c.Rules = map[string]PublishRuleSeverity{}
+	}
+	for _, pair := range strings.Split(spec, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		rule, severity, ok := strings.Cut(pair, "=")
+		if !ok {
+			return fmt.Errorf("invalid publish rule %q: expected rule=severity", pair)
+		}
+		rule = strings.TrimSpace(rule)
+		if !isPublishRule(rule) {
+			return fmt.Errorf("unknown publish rule %q", rule)
+		}
+		switch s := PublishRuleSeverity(strings.TrimSpace(severity)); s {
+		case PublishRuleOff, PublishRuleWarning, PublishRuleError:
+			c.Rules[rule] = s
+		default:
+			return fmt.Errorf("invalid severity %q for publish rule %q", severity, rule)
+		}
+	}
+	return nil
+}
+
+func isPublishRule(rule string) bool {
+	for _, r := range publishRules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPublishGate enables the quality gate that runs when a page or post becomes published.
+// When unset, content is published without checks.
+func (s *ContentService) SetPublishGate(config PublishGateConfig) {
+	rules := make(map[string]PublishRuleSeverity, len(config.Rules))
+	for rule, severity := range config.Rules {
+		rules[rule] = severity
+	}
+	config.Rules = rules
+	s.publishGate = &config
+}
+
+// publishItem is the view of a page or post the quality gate checks
+type publishItem struct {
+	contentType   string
+	id            string
+	excerpt       string
+	featuredImage string
+	meta          models.Meta
+	content       models.Content
+}
+
+func publishItemFromPage(page *models.Page) *publishItem {
+	return &publishItem{
+		contentType: models.ContentTypePage,
+		id:          page.ID,
+		meta:        page.Meta,
+		content:     page.Content,
+	}
+}
+
+func publishItemFromPost(post *models.BlogPost) *publishItem {
+	return &publishItem{
+		contentType:   models.ContentTypeBlogPost,
+		id:            post.ID,
+		excerpt:       post.Excerpt,
+		featuredImage: post.FeaturedImage,
+		meta:          post.Meta,
+		content:       post.Content,
+	}
+}
+
+// enforcePublishGate checks an item that is about to be published. Error violations block
+// the publish unless an admin gives an override reason, which is returned for recording
+// on the item; warnings are returned for the response.
+func (s *ContentService) enforcePublishGate(ctx context.Context, item *publishItem, overrideReason string) ([]*contentv1.PublishViolation, *models.PublishOverride, error) {
+	if s.publishGate == nil {
+		return nil, nil, nil
+	}
+
+	violations := s.checkPublishRules(ctx, item)
+	var warnings, blocking []*contentv1.PublishViolation
+	for _, v := range violations {
+		if v.Severity == contentv1.PublishRuleSeverity_PUBLISH_RULE_SEVERITY_ERROR {
+			blocking = append(blocking, v)
+		} else {
+			warnings = append(warnings, v)
+		}
+	}
+	if len(blocking) == 0 {
+		return warnings, nil, nil
+	}
+
+	overrideReason = strings.TrimSpace(overrideReason)
+	if overrideReason == "" {
+		messages := make([]string, len(blocking))
+		for i, v := range blocking {
+			messages[i] = v.Rule + ": " + v.Message
+		}
+		st := status.New(codes.FailedPrecondition, "publishing blocked by quality checks: "+strings.Join(messages, "; "))
+		if detailed, err := st.WithDetails(&contentv1.PublishGateReport{Violations: violations}); err == nil {
+			st = detailed
+		}
+		return nil, nil, st.Err()
+	}
+	if role, _ := ctx.Value("user_role").(string); role != models.UserRoleAdmin {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "only admins can override the publish quality gate")
+	}
+	if len(overrideReason) > 500 {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "publish override reason must be less than 500 characters")
+	}
+
+	userID, _ := ctx.Value("user_id").(string)
+	override := &models.PublishOverride{
+		Reason:       overrideReason,
+		UserID:       userID,
+		OverriddenAt: time.Now(),
+	}
+	for _, v := range blocking {
+		if len(override.Rules) == 0 || override.Rules[len(override.Rules)-1] != v.Rule {
+			override.Rules = append(override.Rules, v.Rule)
+		}
+	}
+	logger.Warn("Publish quality gate overridden",
+		"content_type", item.contentType, "content_id", item.id, "user_id", userID,
+		"rules", strings.Join(override.Rules, ","), "reason", overrideReason)
+	return warnings, override, nil
+}
+
+// checkPublishRules runs every enabled rule on an item, in publishRules order
+func (s *ContentService) checkPublishRules(ctx context.Context, item *publishItem) []*contentv1.PublishViolation {
+	var violations []*contentv1.PublishViolation
+	for _, rule := range publishRules {
+		severity := contentv1.PublishRuleSeverity_PUBLISH_RULE_SEVERITY_WARNING
+		switch s.publishGate.Rules[rule] {
+		case PublishRuleError:
+			severity = contentv1.PublishRuleSeverity_PUBLISH_RULE_SEVERITY_ERROR
+		case PublishRuleWarning:
+		default:
+			continue
+		}
+		add := func(blockIndex int, format string, args ...interface{}) {
+			violations = append(violations, &contentv1.PublishViolation{
+				Rule:       rule,
+				Severity:   severity,
+				Message:    fmt.Sprintf(format, args...),
+				BlockIndex: int32(blockIndex),
+			})
+		}
+
+		switch rule {
+		case PublishRuleMetaDescription:
+			if strings.TrimSpace(item.meta.Description) == "" {
+				add(-1, "Add a meta description")
+			}
+		case PublishRuleFeaturedImage:
+			if item.contentType == models.ContentTypeBlogPost && item.featuredImage == "" {
+				add(-1, "Set a featured image")
+			}
+		case PublishRuleImageAlt:
+			checkSEOImages(&seoItem{content: item.content}, func(_ string, _ contentv1.SEOSeverity, blockIndex int, format string, args ...interface{}) {
+				add(blockIndex, format, args...)
+			})
+		case PublishRuleEmptyBlocks:
+			for i, block := range item.content.Blocks {
+				if !contentlessBlockTypes[block.Type] && !hasBlockContent(block.Data) {
+					add(i, "Remove the empty %s block or add content to it", block.Type)
+				}
+			}
+		case PublishRuleDraftLinks:
+			s.checkDraftLinks(ctx, item, add)
+		case PublishRuleExcerptLength:
+			limit := s.publishGate.MaxExcerptLength
+			if length := utf8.RuneCountInString(strings.TrimSpace(item.excerpt)); limit > 0 && length > limit {
+				add(-1, "Excerpt is %d characters; shorten it to %d or less", length, limit)
+			}
+		}
+	}
+	return violations
+}
+
+// checkDraftLinks reports internal links to pages and posts that exist but are not
+// published; links to missing content are left to the link scanner
+func (s *ContentService) checkDraftLinks(ctx context.Context, item *publishItem, add func(blockIndex int, format string, args ...interface{})) {
+	reported := make(map[string]bool)
+	for _, ref := range extractContentLinks(item.content) {
+		contentType, slug := internalContentLink(ref.url)
+		key := contentType + ":" + slug
+		if contentType == "" || reported[key] {
+			continue
+		}
+
+		var draft bool
+		switch contentType {
+		case models.ContentTypePage:
+			page, err := s.pageRepo.GetBySlug(ctx, slug)
+			draft = err == nil && page.ID != item.id && page.Status != models.PageStatusPublished
+		case models.ContentTypeBlogPost:
+			post, err := s.blogRepo.GetBySlug(ctx, slug)
+			draft = err == nil && post.ID != item.id && post.Status != models.PageStatusPublished
+		}
+		if draft {
+			reported[key] = true
+			add(ref.blockIndex, "Links to %s %q, which is not published", strings.ReplaceAll(contentType, "_", " "), slug)
+		}
+	}
+}
+
+// internalContentLink returns the content type and slug a site-relative link points to,
+// or empty strings for other links, following the website routes the link scanner uses
+func internalContentLink(raw string) (contentType, slug string) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme != "" || u.Host != "" || !strings.HasPrefix(u.Path, "/") {
+		return "", ""
+	}
+	segments := strings.Split(strings.Trim(path.Clean(u.Path), "/"), "/")
+	switch {
+	case segments[0] == "blog" && len(segments) == 2 && !reservedBlogPaths[segments[1]]:
+		return models.ContentTypeBlogPost, segments[1]
+	case segments[0] == "" || segments[0] == "uploads" || reservedSitePaths[segments[0]]:
+		return "", ""
+	default:
+		return models.ContentTypePage, strings.Join(segments, "/")
+	}
+}
+
+// hasBlockContent reports whether block data holds any visible text or value
+func hasBlockContent(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		text := seoHTMLTagPattern.ReplaceAllString(html.UnescapeString(v), "")
+		return strings.TrimSpace(text) != "" || seoHTMLImagePattern.MatchString(html.UnescapeString(v))
+	case map[string]interface{}:
+		for _, item := range v {
+			if hasBlockContent(item) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if hasBlockContent(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// convertPublishOverrideToProto returns the override for editors and admins only, as
+// it names the admin and their reasoning
+func convertPublishOverrideToProto(ctx context.Context, override *models.PublishOverride) *contentv1.PublishOverride {
+	role, _ := ctx.Value("user_role").(string)
+	if override == nil || (role != models.UserRoleAdmin && role != models.UserRoleEditor) {
+		return nil
+	}
+	return &contentv1.PublishOverride{
+		Reason:       override.Reason,
+		UserId:       override.UserID,
+		OverriddenAt: timestamppb.New(override.OverriddenAt),
+		Rules:        override.Rules,
+	}
+}
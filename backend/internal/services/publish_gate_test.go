@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+// storedBlogRepo returns copies of stored posts, so rejected updates leave them untouched
+type storedBlogRepo struct {
+	*memoryBlogRepo
+}
+
+func (r *storedBlogRepo) GetByID(ctx context.Context, id string) (*models.BlogPost, error) {
+	post, err := r.memoryBlogRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	stored := *post
+	return &stored, nil
+}
+
+func newPublishGateTestService() *ContentService {
+	pricing := models.NewPage("Pricing", "pricing")
+	pricing.Status = models.PageStatusPublished
+	roadmap := models.NewPage("Roadmap", "roadmap")
+
+	draft := models.NewBlogPost("Launch", "launch", "user-1")
+	draft.FeaturedImage = "media:cover.png"
+	upcoming := models.NewBlogPost("Upcoming", "upcoming", "user-1")
+
+	service := NewContentService(
+		&memoryPageRepo{pages: []*models.Page{pricing, roadmap}},
+		&storedBlogRepo{&memoryBlogRepo{posts: map[string]*models.BlogPost{draft.ID: draft, upcoming.ID: upcoming}}},
+	)
+	service.SetMediaRepository(newMemoryMediaRepo(models.NewMedia("cover.png", "cover.png", "image/png", "user-1", 1)))
+	service.SetPublishGate(DefaultPublishGateConfig())
+	return service
+}
+
+func publishLaunchRequest(description string, blocks ...*contentv1.ContentBlock) *contentv1.UpdateBlogPostRequest {
+	return &contentv1.UpdateBlogPostRequest{
+		Id:            "blog:launch",
+		Title:         "Launch",
+		Slug:          "launch",
+		Author:        "user-1",
+		Status:        contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+		FeaturedImage: "media:cover.png",
+		Meta:          &contentv1.PageMeta{Description: description},
+		Content:       &contentv1.PageContent{Blocks: blocks},
+	}
+}
+
+func TestContentService_PublishGateRules(t *testing.T) {
+	service := newPublishGateTestService()
+	ctx := context.Background()
+
+	page := models.NewPage("About", "about-us")
+	page.Content = models.Content{Blocks: []models.ContentBlock{
+		{Type: "image", Data: map[string]interface{}{"src": "/uploads/team.jpg"}},
+		{Type: "text", Data: map[string]interface{}{"content": "<p> </p>"}},
+		{Type: "divider", Data: map[string]interface{}{}},
+		{Type: "text", Data: map[string]interface{}{"content": `See the <a href="/roadmap">roadmap</a>, <a href="/pricing">pricing</a> and <a href="/blog/upcoming">what is next</a>`}},
+		{Type: "cta", Data: map[string]interface{}{"title": "Talk to us", "primaryButtonLink": "/blog/upcoming"}},
+	}}
+
+	var rules []string
+	for _, v := range service.checkPublishRules(ctx, publishItemFromPage(page)) {
+		rules = append(rules, fmt.Sprintf("%s@%d", v.Rule, v.BlockIndex))
+	}
+	// The repeated link to the draft post in the last block is reported once
+	assert.Equal(t, []string{"meta_description@-1", "image_alt@0", "empty_blocks@1", "draft_links@3", "draft_links@3"}, rules)
+
+	post := models.NewBlogPost("Long", "long", "user-1")
+	post.Meta.Description = "A long read"
+	post.Excerpt = strings.Repeat("x", 301)
+	var postRules []string
+	for _, v := range service.checkPublishRules(ctx, publishItemFromPost(post)) {
+		postRules = append(postRules, v.Rule)
+		assert.Equal(t, contentv1.PublishRuleSeverity_PUBLISH_RULE_SEVERITY_WARNING, v.Severity)
+	}
+	assert.Equal(t, []string{"featured_image", "excerpt_length"}, postRules)
+}
+
+func TestContentService_PublishGateBlocksAndWarns(t *testing.T) {
+	service := newPublishGateTestService()
+	ctx := readerContext("editor-1", models.UserRoleEditor)
+
+	_, err := service.UpdateBlogPost(ctx, publishLaunchRequest("", &contentv1.ContentBlock{Type: "text", Data: map[string]string{"content": "Hello"}}))
+	st := status.Convert(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "meta_description")
+	require.Len(t, st.Details(), 1)
+	report, ok := st.Details()[0].(*contentv1.PublishGateReport)
+	require.True(t, ok)
+	assert.Equal(t, "meta_description", report.Violations[0].Rule)
+
+	stored, err := service.blogRepo.GetByID(ctx, "blog:launch")
+	require.NoError(t, err)
+	assert.Equal(t, models.PageStatusDraft, stored.Status, "a blocked publish is not saved")
+
+	post, err := service.UpdateBlogPost(ctx, publishLaunchRequest("Our launch", &contentv1.ContentBlock{Type: "text", Data: map[string]string{"content": ""}}))
+	require.NoError(t, err)
+	assert.Equal(t, contentv1.PageStatus_PAGE_STATUS_PUBLISHED, post.Status)
+	require.Len(t, post.PublishWarnings, 1)
+	assert.Equal(t, "empty_blocks", post.PublishWarnings[0].Rule)
+	assert.Nil(t, post.PublishOverride)
+
+	// Updates to published content are not gated again
+	_, err = service.UpdateBlogPost(ctx, publishLaunchRequest(""))
+	assert.NoError(t, err)
+}
+
+func TestContentService_PublishGateOverride(t *testing.T) {
+	service := newPublishGateTestService()
+	req := publishLaunchRequest("")
+	req.PublishOverrideReason = "Embargo lifts now, SEO copy follows"
+
+	_, err := service.UpdateBlogPost(readerContext("editor-1", models.UserRoleEditor), req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	post, err := service.UpdateBlogPost(readerContext("admin-1", models.UserRoleAdmin), req)
+	require.NoError(t, err)
+	require.NotNil(t, post.PublishOverride)
+	assert.Equal(t, "Embargo lifts now, SEO copy follows", post.PublishOverride.Reason)
+	assert.Equal(t, "admin-1", post.PublishOverride.UserId)
+	assert.Equal(t, []string{"meta_description"}, post.PublishOverride.Rules)
+
+	read, err := service.GetBlogPost(readerContext("editor-1", models.UserRoleEditor), &contentv1.GetBlogPostRequest{Id: "blog:launch"})
+	require.NoError(t, err)
+	assert.NotNil(t, read.PublishOverride)
+
+	read, err = service.GetBlogPost(context.Background(), &contentv1.GetBlogPostRequest{Id: "blog:launch"})
+	require.NoError(t, err)
+	assert.Nil(t, read.PublishOverride, "overrides are not shown to readers")
+}
+
+func TestPublishGateConfig_ParseRules(t *testing.T) {
+	config := DefaultPublishGateConfig()
+	require.NoError(t, config.ParseRules("featured_image=error, empty_blocks=off"))
+	assert.Equal(t, PublishRuleError, config.Rules[PublishRuleFeaturedImage])
+	assert.Equal(t, PublishRuleOff, config.Rules[PublishRuleEmptyBlocks])
+	assert.Equal(t, PublishRuleError, config.Rules[PublishRuleMetaDescription])
+
+	assert.Error(t, config.ParseRules("word_count=error"))
+	assert.Error(t, config.ParseRules("image_alt=fatal"))
+	assert.Error(t, config.ParseRules("image_alt"))
+}
@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/cache"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+const (
+	defaultRelatedPostsLimit = 4
+	maxRelatedPostsLimit     = 12
+	// relatedPostCandidateLimit bounds the number of candidates scored for one post
+	relatedPostCandidateLimit = 200
+	// relatedTitleThreshold is pg_trgm's default similarity threshold for the % operator
+	relatedTitleThreshold = 0.3
+	// relatedPostsCacheTTL bounds how stale the recency signal of cached rankings can get
+	relatedPostsCacheTTL = time.Hour
+	// relatedPostsGenerationKey holds the cache generation; changing it drops every cached ranking
+	relatedPostsGenerationKey = "related-posts:generation"
+)
+
+// RelatedPostWeights weight the signals that relate two posts. A candidate scores
+// Category per shared category, Tag per shared tag, Title times the trigram similarity
+// of the titles and Recency times a decay that halves every RecencyHalfLife.
+type RelatedPostWeights struct {
+	Category        float64
+	Tag             float64
+	Title           float64
+	Recency         float64
+	RecencyHalfLife time.Duration
+}
+
+// DefaultRelatedPostWeights favour shared tags, which are more specific than categories
+func DefaultRelatedPostWeights() RelatedPostWeights {
+	return RelatedPostWeights{
+		Category:        2,
+		Tag:             3,
+		Title:           4,
+		Recency:         1,
+		RecencyHalfLife: 90 * 24 * time.Hour,
+	}
+}
+
+// SetRelatedPostWeights replaces the weights used to rank related posts
+func (s *ContentService) SetRelatedPostWeights(weights RelatedPostWeights) {
+	s.relatedWeights = &weights
+	s.invalidateRelatedPosts(context.Background())
+}
+
+// SetRelatedPostsCache caches related post rankings. Rankings are dropped whenever a
+// published post is created, deleted, published, unpublished or changes its title,
+// categories or tags, and expire after relatedPostsCacheTTL.
+func (s *ContentService) SetRelatedPostsCache(c cache.Cache) {
+	s.relatedCache = c
+}
+
+func (s *ContentService) relatedPostWeights() RelatedPostWeights {
+	if s.relatedWeights == nil {
+		return DefaultRelatedPostWeights()
+	}
+	return *s.relatedWeights
+}
+
+// GetRelatedPosts returns the published posts most related to a post
+func (s *ContentService) GetRelatedPosts(ctx context.Context, req *contentv1.GetRelatedPostsRequest) (*contentv1.GetRelatedPostsResponse, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "blog post ID is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultRelatedPostsLimit
+	}
+	if limit > maxRelatedPostsLimit {
+		limit = maxRelatedPostsLimit
+	}
+
+	post, err := s.blogRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "blog post not found: %v", err)
+	}
+
+	ids, cached := s.cachedRelatedPosts(ctx, post.ID)
+	if !cached {
+		ids, err = s.rankRelatedPosts(ctx, post)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find related posts: %v", err)
+		}
+		s.storeRelatedPosts(ctx, post.ID, ids)
+	}
+
+	resp := &contentv1.GetRelatedPostsResponse{}
+	if len(ids) == 0 {
+		return resp, nil
+	}
+	// Posts are loaded in one call, then emitted in ranking order
+	loaded, err := s.blogRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load related posts: %v", err)
+	}
+	byID := make(map[string]*models.BlogPost, len(loaded))
+	for _, related := range loaded {
+		byID[related.ID] = related
+	}
+	for _, id := range ids {
+		if len(resp.Posts) == limit {
+			break
+		}
+		related, ok := byID[id]
+		if !ok || related.Status != models.PageStatusPublished {
+			continue
+		}
+		resp.Posts = append(resp.Posts, s.convertBlogModelToProto(related))
+	}
+	s.gatePosts(ctx, resp.Posts...)
+	s.attachCommentCounts(ctx, resp.Posts...)
+	s.attachFeaturedImages(ctx, resp.Posts...)
+	return resp, nil
+}
+
+// rankRelatedPosts returns the IDs of the best maxRelatedPostsLimit candidates, best first
+func (s *ContentService) rankRelatedPosts(ctx context.Context, post *models.BlogPost) ([]string, error) {
+	var candidates []repository.RelatedPostCandidate
+	var err error
+	if finder, ok := s.blogRepo.(repository.RelatedPostFinder); ok {
+		candidates, err = finder.FindRelatedPostCandidates(ctx, post, relatedPostCandidateLimit)
+	} else {
+		candidates, err = s.scanRelatedPostCandidates(ctx, post)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	weights := s.relatedPostWeights()
+	now := time.Now()
+	type scored struct {
+		post  *models.BlogPost
+		score float64
+	}
+	ranked := make([]scored, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Post.ID == post.ID || c.Post.Status != models.PageStatusPublished {
+			continue
+		}
+		score := weights.Category*float64(c.SharedCategories) +
+			weights.Tag*float64(c.SharedTags) +
+			weights.Title*c.TitleSimilarity
+		if weights.RecencyHalfLife > 0 {
+			age := now.Sub(postDate(c.Post))
+			if age < 0 {
+				age = 0
+			}
+			score += weights.Recency * math.Pow(0.5, float64(age)/float64(weights.RecencyHalfLife))
+		}
+		ranked = append(ranked, scored{post: c.Post, score: score})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return postDate(ranked[i].post).After(postDate(ranked[j].post))
+	})
+
+	ids := make([]string, 0, maxRelatedPostsLimit)
+	for _, r := range ranked {
+		if len(ids) == maxRelatedPostsLimit {
+			break
+		}
+		ids = append(ids, r.post.ID)
+	}
+	return ids, nil
+}
+
+// scanRelatedPostCandidates selects candidates in memory for repositories that cannot do it
+// in the database, with the same rules: shared taxonomy or title similarity above the threshold
+func (s *ContentService) scanRelatedPostCandidates(ctx context.Context, post *models.BlogPost) ([]repository.RelatedPostCandidate, error) {
+	titleTrigrams := trigrams(post.Title)
+	var candidates []repository.RelatedPostCandidate
+	for skip := 0; ; skip += linkScanBatchSize {
+		batch, err := s.blogRepo.ListByStatus(ctx, models.PageStatusPublished, repository.ListOptions{Limit: linkScanBatchSize, Skip: skip})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list blog posts: %w", err)
+		}
+		for _, other := range batch {
+			if other.ID == post.ID {
+				continue
+			}
+			c := repository.RelatedPostCandidate{
+				Post:             other,
+				SharedCategories: countShared(post.Categories, other.Categories),
+				SharedTags:       countShared(post.Tags, other.Tags),
+				TitleSimilarity:  trigramSimilarity(titleTrigrams, trigrams(other.Title)),
+			}
+			if c.SharedCategories > 0 || c.SharedTags > 0 || c.TitleSimilarity >= relatedTitleThreshold {
+				candidates = append(candidates, c)
+			}
+		}
+		if len(batch) < linkScanBatchSize {
+			break
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return postDate(candidates[i].Post).After(postDate(candidates[j].Post))
+	})
+	if len(candidates) > relatedPostCandidateLimit {
+		candidates = candidates[:relatedPostCandidateLimit]
+	}
+	return candidates, nil
+}
+
+// cachedRelatedPosts returns the cached ranking of a post, if any
+func (s *ContentService) cachedRelatedPosts(ctx context.Context, postID string) ([]string, bool) {
+	if s.relatedCache == nil {
+		return nil, false
+	}
+	value, err := s.relatedCache.Get(ctx, s.relatedPostsCacheKey(ctx, postID))
+	if err != nil || value == "" {
+		return nil, false
+	}
+	var ids []string
+	if err := json.Unmarshal([]byte(value), &ids); err != nil {
+		return nil, false
+	}
+	return ids, true
+}
+
+func (s *ContentService) storeRelatedPosts(ctx context.Context, postID string, ids []string) {
+	if s.relatedCache == nil {
+		return
+	}
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return
+	}
+	if err := s.relatedCache.Set(ctx, s.relatedPostsCacheKey(ctx, postID), string(data), relatedPostsCacheTTL); err != nil {
+		logger.Error("Failed to cache related posts", err, "post_id", postID)
+	}
+}
+
+func (s *ContentService) relatedPostsCacheKey(ctx context.Context, postID string) string {
+	generation, _ := s.relatedCache.Get(ctx, relatedPostsGenerationKey)
+	return "related-posts:" + generation + ":" + postID
+}
+
+// invalidateRelatedPosts drops every cached ranking. A change to one post can move it into
+// or out of any other post's ranking, so rankings are invalidated together by bumping the
+// generation that is part of every cache key.
+func (s *ContentService) invalidateRelatedPosts(ctx context.Context) {
+	if s.relatedCache == nil {
+		return
+	}
+	generation := strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := s.relatedCache.Set(ctx, relatedPostsGenerationKey, generation, 0); err != nil {
+		logger.Error("Failed to invalidate related posts cache", err)
+	}
+}
+
+// relatedPostsChanged reports whether a write to a post can change related post rankings:
+// a published post appears or disappears, or a published post changes its title or taxonomy
+func relatedPostsChanged(before, after *models.BlogPost) bool {
+	wasPublished := before != nil && before.Status == models.PageStatusPublished
+	isPublished := after != nil && after.Status == models.PageStatusPublished
+	if wasPublished != isPublished {
+		return true
+	}
+	if !isPublished {
+		return false
+	}
+	return before.Title != after.Title ||
+		!sameStrings(before.Categories, after.Categories) ||
+		!sameStrings(before.Tags, after.Tags)
+}
+
+// postDate is the date a post is ranked by: its publication date, or its creation date
+func postDate(post *models.BlogPost) time.Time {
+	if post.PublishedAt != nil {
+		return *post.PublishedAt
+	}
+	return post.CreatedAt
+}
+
+// countShared counts the values of b that also appear in a, ignoring case
+func countShared(a, b []string) int {
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[strings.ToLower(v)] = true
+	}
+	n := 0
+	for _, v := range b {
+		if set[strings.ToLower(v)] {
+			n++
+			delete(set, strings.ToLower(v))
+		}
+	}
+	return n
+}
+
+// sameStrings reports whether a and b hold the same values, ignoring order and case
+func sameStrings(a, b []string) bool {
+	return len(a) == len(b) && countShared(a, b) == len(a)
+}
+
+// trigrams returns the trigram set of a string the way pg_trgm builds it: lower-cased
+// alphanumeric words, each padded with two spaces in front and one behind
+func trigrams(text string) map[string]bool {
+	set := make(map[string]bool)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = true
+		}
+	}
+	return set
+}
+
+// trigramSimilarity is pg_trgm's similarity: shared trigrams over all distinct trigrams
+func trigramSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range a {
+		if b[t] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
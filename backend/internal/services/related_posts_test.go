@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/cache"
+)
+
+func newRelatedPostsTestService() (*ContentService, *memoryBlogRepo) {
+	repo := &memoryBlogRepo{posts: map[string]*models.BlogPost{}}
+	add := func(title, slug string, categories, tags []string, published bool) {
+		post := models.NewBlogPost(title, slug, "user-1")
+		post.ID = slug
+		post.Categories = categories
+		post.Tags = tags
+		if published {
+			post.SetPublished()
+			post.PublishedAt = timePtr(time.Now().Add(-24 * time.Hour))
+		}
+		repo.posts[post.ID] = post
+	}
+	add("Kubernetes networking deep dive", "current", []string{"Infrastructure"}, []string{"kubernetes", "networking"}, true)
+	add("Service meshes in practice", "same-taxonomy", []string{"Infrastructure"}, []string{"Kubernetes", "networking"}, true)
+	add("Debugging DNS", "one-tag", nil, []string{"networking"}, true)
+	add("Kubernetes networking basics", "similar-title", nil, nil, true)
+	add("Baking sourdough bread", "unrelated", []string{"Cooking"}, []string{"bread"}, true)
+	add("Draft about kubernetes networking", "draft", []string{"Infrastructure"}, []string{"kubernetes", "networking"}, false)
+
+	service := NewContentService(&memoryPageRepo{}, repo)
+	service.SetRelatedPostsCache(cache.NewRedisCache())
+	return service, repo
+}
+
+func relatedSlugs(resp *contentv1.GetRelatedPostsResponse) []string {
+	var slugs []string
+	for _, post := range resp.Posts {
+		slugs = append(slugs, post.Slug)
+	}
+	return slugs
+}
+
+func TestContentService_GetRelatedPostsRanksBySharedTaxonomyAndTitle(t *testing.T) {
+	service, _ := newRelatedPostsTestService()
+	ctx := context.Background()
+
+	resp, err := service.GetRelatedPosts(ctx, &contentv1.GetRelatedPostsRequest{Id: "current"})
+	require.NoError(t, err)
+	// Drafts, unrelated posts and the post itself are left out
+	assert.Equal(t, []string{"same-taxonomy", "one-tag", "similar-title"}, relatedSlugs(resp))
+
+	resp, err = service.GetRelatedPosts(ctx, &contentv1.GetRelatedPostsRequest{Id: "current", Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"same-taxonomy"}, relatedSlugs(resp))
+
+	_, err = service.GetRelatedPosts(ctx, &contentv1.GetRelatedPostsRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// shuffledBlogRepo returns batch lookups in reverse order and counts single lookups
+type shuffledBlogRepo struct {
+	*memoryBlogRepo
+	singleLookups int
+}
+
+func (r *shuffledBlogRepo) GetByID(ctx context.Context, id string) (*models.BlogPost, error) {
+	r.singleLookups++
+	return r.memoryBlogRepo.GetByID(ctx, id)
+}
+
+func (r *shuffledBlogRepo) GetByIDs(ctx context.Context, ids []string) ([]*models.BlogPost, error) {
+	posts, err := r.memoryBlogRepo.GetByIDs(ctx, ids)
+	for i, j := 0, len(posts)-1; i < j; i, j = i+1, j-1 {
+		posts[i], posts[j] = posts[j], posts[i]
+	}
+	return posts, err
+}
+
+func TestContentService_GetRelatedPostsLoadsRankedPostsInOneCall(t *testing.T) {
+	_, memory := newRelatedPostsTestService()
+	repo := &shuffledBlogRepo{memoryBlogRepo: memory}
+	service := NewContentService(&memoryPageRepo{}, repo)
+	service.SetRelatedPostsCache(cache.NewRedisCache())
+
+	resp, err := service.GetRelatedPosts(context.Background(), &contentv1.GetRelatedPostsRequest{Id: "current"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"same-taxonomy", "one-tag", "similar-title"}, relatedSlugs(resp), "ranking order is kept")
+	assert.Equal(t, 1, repo.singleLookups, "only the post itself is looked up on its own")
+}
+
+func TestContentService_GetRelatedPostsCachesUntilTaxonomyChanges(t *testing.T) {
+	service, repo := newRelatedPostsTestService()
+	ctx := context.Background()
+
+	resp, err := service.GetRelatedPosts(ctx, &contentv1.GetRelatedPostsRequest{Id: "current"})
+	require.NoError(t, err)
+	require.Len(t, resp.Posts, 3)
+
+	// A post written behind the service's back is not seen while the ranking is cached
+	sneaky := models.NewBlogPost("Kubernetes networking deep dive, part two", "sneaky", "user-1")
+	sneaky.ID = "sneaky"
+	sneaky.Tags = []string{"kubernetes", "networking"}
+	sneaky.SetPublished()
+	repo.posts[sneaky.ID] = sneaky
+
+	resp, err = service.GetRelatedPosts(ctx, &contentv1.GetRelatedPostsRequest{Id: "current"})
+	require.NoError(t, err)
+	assert.NotContains(t, relatedSlugs(resp), "sneaky")
+
+	// Editing a post without touching its title or taxonomy keeps the cache
+	_, err = service.UpdateBlogPost(ctx, &contentv1.UpdateBlogPostRequest{
+		Id: "unrelated", Title: "Baking sourdough bread", Slug: "unrelated", Author: "user-1",
+		Excerpt: "Now with pictures", Categories: []string{"Cooking"}, Tags: []string{"bread"},
+		Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+	})
+	require.NoError(t, err)
+	resp, err = service.GetRelatedPosts(ctx, &contentv1.GetRelatedPostsRequest{Id: "current"})
+	require.NoError(t, err)
+	assert.NotContains(t, relatedSlugs(resp), "sneaky")
+
+	// Changing a published post's tags invalidates every ranking
+	_, err = service.UpdateBlogPost(ctx, &contentv1.UpdateBlogPostRequest{
+		Id: "unrelated", Title: "Baking sourdough bread", Slug: "unrelated", Author: "user-1",
+		Categories: []string{"Cooking"}, Tags: []string{"bread", "networking"},
+		Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+	})
+	require.NoError(t, err)
+	resp, err = service.GetRelatedPosts(ctx, &contentv1.GetRelatedPostsRequest{Id: "current"})
+	require.NoError(t, err)
+	assert.Contains(t, relatedSlugs(resp), "sneaky")
+	assert.Contains(t, relatedSlugs(resp), "unrelated")
+}
+
+func TestTrigramSimilarityMatchesPgTrgm(t *testing.T) {
+	// Reference values from PostgreSQL: SELECT similarity('word', 'two words');
+	assert.InDelta(t, 0.363636, trigramSimilarity(trigrams("word"), trigrams("two words")), 0.0001)
+	assert.InDelta(t, 1.0, trigramSimilarity(trigrams("Hello, World"), trigrams("hello world")), 0.0001)
+	assert.Zero(t, trigramSimilarity(trigrams(""), trigrams("anything")))
+}
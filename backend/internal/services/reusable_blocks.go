@@ -0,0 +1,418 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
+)
+
+// revalidateTimeout bounds the background revalidation triggered by content and media changes
+const revalidateTimeout = 30 * time.Second
+
+// SetReusableBlockRepository enables reusable content blocks. When unset, the
+// reusable block RPCs return FailedPrecondition and "reusable" blocks are left unresolved.
+func (s *ContentService) SetReusableBlockRepository(repo repository.ReusableBlockRepository) {
+	s.blockRepo = repo
+}
+
+// SetRevalidator configures the ISR revalidator used to refresh pages after content changes
+func (s *ContentService) SetRevalidator(r revalidate.Revalidator) {
+	s.revalidator = r
+}
+
+// CreateReusableBlock creates a new reusable content block
+func (s *ContentService) CreateReusableBlock(ctx context.Context, req *contentv1.CreateReusableBlockRequest) (*contentv1.ReusableBlock, error) {
+	if err := s.requireBlockRepo(); err != nil {
+		return nil, err
+	}
+	if err := s.validateReusableBlockFields(req.Name, req.Slug, req.Content); err != nil {
+		return nil, err
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = s.generateSlug(req.Name)
+	} else {
+		slug = s.sanitizeSlug(slug)
+	}
+	if err := s.validateReusableBlockSlugUniqueness(ctx, slug, ""); err != nil {
+		return nil, err
+	}
+
+	block := models.NewReusableBlock(strings.TrimSpace(req.Name), slug)
+	block.Description = strings.TrimSpace(req.Description)
+	block.Content = s.convertProtoContentToModel(s.sanitizeContent(req.Content))
+	if err := s.validateEmbedBlocks(block.Content); err != nil {
+		return nil, err
+	}
+
+	if err := s.blockRepo.Create(ctx, block); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create reusable block: %v", err)
+	}
+
+	return s.convertReusableBlockToProto(block, 0), nil
+}
+
+// GetReusableBlock retrieves a reusable block by ID
+func (s *ContentService) GetReusableBlock(ctx context.Context, req *contentv1.GetReusableBlockRequest) (*contentv1.ReusableBlock, error) {
+	if err := s.requireBlockRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "block ID is required")
+	}
+
+	block, err := s.blockRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "reusable block not found: %v", err)
+	}
+
+	count, err := s.blockRepo.CountUsages(ctx, block.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count block usages: %v", err)
+	}
+
+	return s.convertReusableBlockToProto(block, count), nil
+}
+
+// UpdateReusableBlock updates a reusable block and revalidates every page and post embedding it
+func (s *ContentService) UpdateReusableBlock(ctx context.Context, req *contentv1.UpdateReusableBlockRequest) (*contentv1.ReusableBlock, error) {
+	if err := s.requireBlockRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "block ID is required")
+	}
+	if err := s.validateReusableBlockFields(req.Name, req.Slug, req.Content); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.blockRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "reusable block not found: %v", err)
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		slug = s.generateSlug(req.Name)
+	} else {
+		slug = s.sanitizeSlug(slug)
+	}
+	if slug != existing.Slug {
+		if err := s.validateReusableBlockSlugUniqueness(ctx, slug, existing.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.Name = strings.TrimSpace(req.Name)
+	existing.Slug = slug
+	existing.Description = strings.TrimSpace(req.Description)
+	existing.Content = s.convertProtoContentToModel(s.sanitizeContent(req.Content))
+	if err := s.validateEmbedBlocks(existing.Content); err != nil {
+		return nil, err
+	}
+
+	if err := s.blockRepo.Update(ctx, existing); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update reusable block: %v", err)
+	}
+
+	usages, err := s.blockRepo.ListUsages(ctx, existing.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list block usages: %v", err)
+	}
+	s.revalidateUsages(usages)
+
+	return s.convertReusableBlockToProto(existing, len(usages)), nil
+}
+
+// DeleteReusableBlock deletes a reusable block. Blocks still in use are only deleted when force is set.
+func (s *ContentService) DeleteReusableBlock(ctx context.Context, req *contentv1.DeleteReusableBlockRequest) (*emptypb.Empty, error) {
+	if err := s.requireBlockRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "block ID is required")
+	}
+
+	if _, err := s.blockRepo.GetByID(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "reusable block not found: %v", err)
+	}
+
+	usages, err := s.blockRepo.ListUsages(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list block usages: %v", err)
+	}
+	if len(usages) > 0 && !req.Force {
+		return nil, status.Errorf(codes.FailedPrecondition, "reusable block is used by %d page(s) or post(s)", len(usages))
+	}
+
+	if err := s.blockRepo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete reusable block: %v", err)
+	}
+
+	// Embedding pages now render without the block
+	s.revalidateUsages(usages)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListReusableBlocks lists reusable blocks with optional search and pagination
+func (s *ContentService) ListReusableBlocks(ctx context.Context, req *contentv1.ListReusableBlocksRequest) (*contentv1.ListReusableBlocksResponse, error) {
+	if err := s.requireBlockRepo(); err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	skip := 0
+	if req.PageToken != "" {
+		if parsedSkip, err := strconv.Atoi(req.PageToken); err == nil {
+			skip = parsedSkip
+		}
+	}
+
+	blocks, err := s.blockRepo.List(ctx, repository.ListOptions{
+		Limit:  int(pageSize),
+		Skip:   skip,
+		Search: strings.TrimSpace(req.Search),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reusable blocks: %v", err)
+	}
+
+	ids := make([]string, len(blocks))
+	for i, block := range blocks {
+		ids[i] = block.ID
+	}
+	counts, err := s.blockRepo.CountUsagesByBlock(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count block usages: %v", err)
+	}
+
+	protoBlocks := make([]*contentv1.ReusableBlock, len(blocks))
+	for i, block := range blocks {
+		protoBlocks[i] = s.convertReusableBlockToProto(block, counts[block.ID])
+	}
+
+	nextPageToken := ""
+	if len(blocks) == int(pageSize) {
+		nextPageToken = strconv.Itoa(skip + int(pageSize))
+	}
+
+	return &contentv1.ListReusableBlocksResponse{
+		Blocks:        protoBlocks,
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(len(protoBlocks)), // This is approximate for this page
+	}, nil
+}
+
+// ListReusableBlockUsages lists the pages and posts that embed a reusable block
+func (s *ContentService) ListReusableBlockUsages(ctx context.Context, req *contentv1.ListReusableBlockUsagesRequest) (*contentv1.ListReusableBlockUsagesResponse, error) {
+	if err := s.requireBlockRepo(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "block ID is required")
+	}
+
+	usages, err := s.blockRepo.ListUsages(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list block usages: %v", err)
+	}
+
+	protoUsages := make([]*contentv1.ReusableBlockUsage, len(usages))
+	for i, u := range usages {
+		protoUsages[i] = &contentv1.ReusableBlockUsage{
+			ContentType: u.ContentType,
+			ContentId:   u.ContentID,
+			Slug:        u.Slug,
+			Title:       u.Title,
+		}
+	}
+
+	return &contentv1.ListReusableBlockUsagesResponse{Usages: protoUsages}, nil
+}
+
+// Reusable block helpers used by the page and blog post write/read paths
+
+func (s *ContentService) requireBlockRepo() error {
+	if s.blockRepo == nil {
+		return status.Errorf(codes.FailedPrecondition, "reusable blocks are not configured")
+	}
+	return nil
+}
+
+func (s *ContentService) validateReusableBlockFields(name, slug string, content *contentv1.PageContent) error {
+	if strings.TrimSpace(name) == "" {
+		return status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > 200 {
+		return status.Errorf(codes.InvalidArgument, "name must be less than 200 characters")
+	}
+	if slug != "" && len(slug) > 100 {
+		return status.Errorf(codes.InvalidArgument, "slug must be less than 100 characters")
+	}
+	if content != nil {
+		for _, block := range content.Blocks {
+			if block != nil && block.Type == models.ContentBlockTypeReusable {
+				return status.Errorf(codes.InvalidArgument, "reusable blocks cannot embed other reusable blocks")
+			}
+		}
+	}
+	return nil
+}
+
+func (s *ContentService) validateReusableBlockSlugUniqueness(ctx context.Context, slug, excludeID string) error {
+	existing, err := s.blockRepo.GetBySlug(ctx, slug)
+	if err == nil && existing != nil && existing.ID != excludeID {
+		return status.Errorf(codes.AlreadyExists, "reusable block with slug '%s' already exists", slug)
+	}
+	return nil
+}
+
+// validateReusableBlockRefs ensures every "reusable" block in content points at an existing block
+func (s *ContentService) validateReusableBlockRefs(ctx context.Context, content models.Content) error {
+	for _, block := range content.Blocks {
+		if block.Type != models.ContentBlockTypeReusable {
+			continue
+		}
+		if id, _ := block.Data[models.ReusableBlockRefKey].(string); id == "" {
+			return status.Errorf(codes.InvalidArgument, "reusable block is missing %s", models.ReusableBlockRefKey)
+		}
+	}
+
+	ids := content.ReusableBlockRefs()
+	if len(ids) == 0 {
+		return nil
+	}
+	if s.blockRepo == nil {
+		return status.Errorf(codes.FailedPrecondition, "reusable blocks are not configured")
+	}
+
+	blocks, err := s.blockRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load reusable blocks: %v", err)
+	}
+	found := make(map[string]bool, len(blocks))
+	for _, b := range blocks {
+		found[b.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return status.Errorf(codes.InvalidArgument, "reusable block '%s' does not exist", id)
+		}
+	}
+	return nil
+}
+
+// syncReusableBlockUsages records which reusable blocks a page or post embeds.
+// Passing empty content clears the usages, e.g. when the page or post is deleted.
+func (s *ContentService) syncReusableBlockUsages(ctx context.Context, contentType, contentID, slug, title string, content models.Content) error {
+	if s.blockRepo == nil {
+		return nil
+	}
+
+	ids := content.ReusableBlockRefs()
+	usages := make([]*models.ReusableBlockUsage, len(ids))
+	for i, id := range ids {
+		usages[i] = &models.ReusableBlockUsage{
+			BlockID:     id,
+			ContentType: contentType,
+			ContentID:   contentID,
+			Slug:        slug,
+			Title:       title,
+		}
+	}
+
+	if err := s.blockRepo.ReplaceUsages(ctx, contentType, contentID, usages); err != nil {
+		return status.Errorf(codes.Internal, "failed to record reusable block usages: %v", err)
+	}
+	return nil
+}
+
+// resolveReusableBlocks fills ResolvedBlocks on every "reusable" block of a read response
+func (s *ContentService) resolveReusableBlocks(ctx context.Context, content *contentv1.PageContent) error {
+	if s.blockRepo == nil || content == nil {
+		return nil
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	for _, block := range content.Blocks {
+		if block.Type != models.ContentBlockTypeReusable {
+			continue
+		}
+		id := block.Data[models.ReusableBlockRefKey]
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	blocks, err := s.blockRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to resolve reusable blocks: %v", err)
+	}
+	byID := make(map[string]*models.ReusableBlock, len(blocks))
+	for _, b := range blocks {
+		byID[b.ID] = b
+	}
+
+	for _, block := range content.Blocks {
+		if block.Type != models.ContentBlockTypeReusable {
+			continue
+		}
+		// A deleted block resolves to nothing rather than failing the whole read
+		if resolved, ok := byID[block.Data[models.ReusableBlockRefKey]]; ok {
+			block.ResolvedBlocks = s.convertModelContentToProto(resolved.Content).Blocks
+		}
+	}
+	return nil
+}
+
+// revalidateUsages asynchronously refreshes the frontend paths of every page and post embedding a block
+func (s *ContentService) revalidateUsages(usages []*models.ReusableBlockUsage) {
+	t := &revalidationTargets{}
+	for _, u := range usages {
+		switch u.ContentType {
+		case models.ContentTypePage:
+			t.addPath("/" + u.Slug)
+		case models.ContentTypeBlogPost:
+			t.addPath("/blog/" + u.Slug)
+		}
+	}
+	revalidateTargets(s.revalidator, t)
+}
+
+func (s *ContentService) convertReusableBlockToProto(block *models.ReusableBlock, usageCount int) *contentv1.ReusableBlock {
+	return &contentv1.ReusableBlock{
+		Id:          block.ID,
+		Name:        block.Name,
+		Slug:        block.Slug,
+		Description: block.Description,
+		Content:     s.convertModelContentToProto(block.Content),
+		UsageCount:  int32(usageCount),
+		CreatedAt:   timestamppb.New(block.CreatedAt),
+		UpdatedAt:   timestamppb.New(block.UpdatedAt),
+	}
+}
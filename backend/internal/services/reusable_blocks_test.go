@@ -0,0 +1,326 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memoryBlockRepo is an in-memory ReusableBlockRepository for service tests
+type memoryBlockRepo struct {
+	mu     sync.Mutex
+	seq    int
+	blocks map[string]*models.ReusableBlock
+	usages map[string][]*models.ReusableBlockUsage // keyed by contentType/contentID
+
+	countBatches int
+}
+
+func newMemoryBlockRepo() *memoryBlockRepo {
+	return &memoryBlockRepo{
+		blocks: map[string]*models.ReusableBlock{},
+		usages: map[string][]*models.ReusableBlockUsage{},
+	}
+}
+
+func (r *memoryBlockRepo) Create(ctx context.Context, block *models.ReusableBlock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	block.ID = fmt.Sprintf("00000000-0000-0000-0000-%012d", r.seq)
+	cp := *block
+	r.blocks[block.ID] = &cp
+	return nil
+}
+
+func (r *memoryBlockRepo) GetByID(ctx context.Context, id string) (*models.ReusableBlock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if b, ok := r.blocks[id]; ok {
+		cp := *b
+		return &cp, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryBlockRepo) GetBySlug(ctx context.Context, slug string) (*models.ReusableBlock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, b := range r.blocks {
+		if b.Slug == slug {
+			cp := *b
+			return &cp, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryBlockRepo) GetByIDs(ctx context.Context, ids []string) ([]*models.ReusableBlock, error) {
+	var out []*models.ReusableBlock
+	for _, id := range ids {
+		if b, err := r.GetByID(ctx, id); err == nil {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryBlockRepo) Update(ctx context.Context, block *models.ReusableBlock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := *block
+	r.blocks[block.ID] = &cp
+	return nil
+}
+
+func (r *memoryBlockRepo) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.blocks, id)
+	return nil
+}
+
+func (r *memoryBlockRepo) List(ctx context.Context, options repository.ListOptions) ([]*models.ReusableBlock, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.ReusableBlock
+	for _, b := range r.blocks {
+		if options.Search == "" || strings.Contains(b.Name, options.Search) {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryBlockRepo) ListUsages(ctx context.Context, blockID string) ([]*models.ReusableBlockUsage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.ReusableBlockUsage
+	for _, list := range r.usages {
+		for _, u := range list {
+			if u.BlockID == blockID {
+				out = append(out, u)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryBlockRepo) CountUsages(ctx context.Context, blockID string) (int, error) {
+	usages, err := r.ListUsages(ctx, blockID)
+	return len(usages), err
+}
+
+func (r *memoryBlockRepo) CountUsagesByBlock(ctx context.Context, blockIDs []string) (map[string]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.countBatches++
+	counts := make(map[string]int, len(blockIDs))
+	for _, id := range blockIDs {
+		for _, list := range r.usages {
+			for _, u := range list {
+				if u.BlockID == id {
+					counts[id]++
+				}
+			}
+		}
+	}
+	return counts, nil
+}
+
+func (r *memoryBlockRepo) ReplaceUsages(ctx context.Context, contentType, contentID string, usages []*models.ReusableBlockUsage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.usages[contentType+"/"+contentID] = usages
+	return nil
+}
+
+// recordingRevalidator captures revalidated paths
+type recordingRevalidator struct {
+	paths chan string
+}
+
+func (r *recordingRevalidator) RevalidatePage(ctx context.Context, path string) error {
+	r.paths <- path
+	return nil
+}
+
+func (r *recordingRevalidator) RevalidateTag(ctx context.Context, tag string) error {
+	return nil
+}
+
+func setupReusableBlockTest() (*ContentService, *memoryBlockRepo) {
+	repo := newMemoryBlockRepo()
+	service := NewContentService(nil, nil)
+	service.SetReusableBlockRepository(repo)
+	return service, repo
+}
+
+func footerContent() *contentv1.PageContent {
+	return &contentv1.PageContent{
+		Blocks: []*contentv1.ContentBlock{
+			{Type: "text", Data: map[string]string{"content": "Call us today"}},
+		},
+	}
+}
+
+func TestContentService_CreateReusableBlock(t *testing.T) {
+	service, _ := setupReusableBlockTest()
+	ctx := context.Background()
+
+	block, err := service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{
+		Name:    "Footer CTA",
+		Content: footerContent(),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, block.Id)
+	assert.Equal(t, "footer-cta", block.Slug)
+	assert.Len(t, block.Content.Blocks, 1)
+
+	// Duplicate slug
+	_, err = service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{
+		Name: "Footer CTA",
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Nested reusable blocks are rejected
+	_, err = service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{
+		Name: "Nested",
+		Content: &contentv1.PageContent{Blocks: []*contentv1.ContentBlock{
+			{Type: models.ContentBlockTypeReusable, Data: map[string]string{models.ReusableBlockRefKey: block.Id}},
+		}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestContentService_ReusableBlocksDisabled(t *testing.T) {
+	service := NewContentService(nil, nil)
+
+	_, err := service.ListReusableBlocks(context.Background(), &contentv1.ListReusableBlocksRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestContentService_ListReusableBlocksCountsUsagesInOneBatch(t *testing.T) {
+	service, repo := setupReusableBlockTest()
+	ctx := context.Background()
+
+	footer, err := service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{Name: "Footer CTA", Content: footerContent()})
+	require.NoError(t, err)
+	banner, err := service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{Name: "Banner", Content: footerContent()})
+	require.NoError(t, err)
+	require.NoError(t, repo.ReplaceUsages(ctx, "page", "page:home", []*models.ReusableBlockUsage{
+		{BlockID: footer.Id, ContentType: "page", ContentID: "page:home"},
+	}))
+	require.NoError(t, repo.ReplaceUsages(ctx, "post", "blog:launch", []*models.ReusableBlockUsage{
+		{BlockID: footer.Id, ContentType: "post", ContentID: "blog:launch"},
+	}))
+
+	resp, err := service.ListReusableBlocks(ctx, &contentv1.ListReusableBlocksRequest{})
+	require.NoError(t, err)
+	counts := map[string]int32{}
+	for _, block := range resp.Blocks {
+		counts[block.Id] = block.UsageCount
+	}
+	assert.Equal(t, map[string]int32{footer.Id: 2, banner.Id: 0}, counts)
+	assert.Equal(t, 1, repo.countBatches)
+}
+
+func TestContentService_ResolveReusableBlocks(t *testing.T) {
+	service, _ := setupReusableBlockTest()
+	ctx := context.Background()
+
+	block, err := service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{
+		Name:    "Footer CTA",
+		Content: footerContent(),
+	})
+	require.NoError(t, err)
+
+	content := &contentv1.PageContent{Blocks: []*contentv1.ContentBlock{
+		{Type: "hero", Data: map[string]string{"title": "Welcome"}},
+		{Type: models.ContentBlockTypeReusable, Data: map[string]string{models.ReusableBlockRefKey: block.Id}},
+		{Type: models.ContentBlockTypeReusable, Data: map[string]string{models.ReusableBlockRefKey: "missing"}},
+	}}
+
+	require.NoError(t, service.resolveReusableBlocks(ctx, content))
+	assert.Empty(t, content.Blocks[0].ResolvedBlocks)
+	require.Len(t, content.Blocks[1].ResolvedBlocks, 1)
+	assert.Equal(t, "Call us today", content.Blocks[1].ResolvedBlocks[0].Data["content"])
+	assert.Empty(t, content.Blocks[2].ResolvedBlocks)
+}
+
+func TestContentService_ValidateReusableBlockRefs(t *testing.T) {
+	service, _ := setupReusableBlockTest()
+	ctx := context.Background()
+
+	block, err := service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{Name: "Banner"})
+	require.NoError(t, err)
+
+	valid := models.Content{Blocks: []models.ContentBlock{
+		{Type: models.ContentBlockTypeReusable, Data: map[string]interface{}{models.ReusableBlockRefKey: block.Id}},
+	}}
+	assert.NoError(t, service.validateReusableBlockRefs(ctx, valid))
+
+	unknown := models.Content{Blocks: []models.ContentBlock{
+		{Type: models.ContentBlockTypeReusable, Data: map[string]interface{}{models.ReusableBlockRefKey: "nope"}},
+	}}
+	assert.Equal(t, codes.InvalidArgument, status.Code(service.validateReusableBlockRefs(ctx, unknown)))
+
+	missingRef := models.Content{Blocks: []models.ContentBlock{
+		{Type: models.ContentBlockTypeReusable, Data: map[string]interface{}{}},
+	}}
+	assert.Equal(t, codes.InvalidArgument, status.Code(service.validateReusableBlockRefs(ctx, missingRef)))
+}
+
+func TestContentService_UpdateReusableBlockRevalidatesUsages(t *testing.T) {
+	service, repo := setupReusableBlockTest()
+	revalidator := &recordingRevalidator{paths: make(chan string, 4)}
+	service.SetRevalidator(revalidator)
+	ctx := context.Background()
+
+	block, err := service.CreateReusableBlock(ctx, &contentv1.CreateReusableBlockRequest{Name: "Banner"})
+	require.NoError(t, err)
+
+	content := models.Content{Blocks: []models.ContentBlock{
+		{Type: models.ContentBlockTypeReusable, Data: map[string]interface{}{models.ReusableBlockRefKey: block.Id}},
+	}}
+	require.NoError(t, service.syncReusableBlockUsages(ctx, models.ContentTypePage, "page:about", "about", "About", content))
+	require.NoError(t, service.syncReusableBlockUsages(ctx, models.ContentTypeBlogPost, "blog:hello", "hello", "Hello", content))
+
+	usages, err := service.ListReusableBlockUsages(ctx, &contentv1.ListReusableBlockUsagesRequest{Id: block.Id})
+	require.NoError(t, err)
+	assert.Len(t, usages.Usages, 2)
+
+	updated, err := service.UpdateReusableBlock(ctx, &contentv1.UpdateReusableBlockRequest{
+		Id:      block.Id,
+		Name:    "Banner",
+		Content: footerContent(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), updated.UsageCount)
+
+	paths := []string{<-revalidator.paths, <-revalidator.paths}
+	assert.ElementsMatch(t, []string{"/about", "/blog/hello"}, paths)
+
+	// Blocks in use are only deleted with force
+	_, err = service.DeleteReusableBlock(ctx, &contentv1.DeleteReusableBlockRequest{Id: block.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Clearing usages (page/post deleted) allows deletion
+	require.NoError(t, service.syncReusableBlockUsages(ctx, models.ContentTypePage, "page:about", "", "", models.Content{}))
+	require.NoError(t, service.syncReusableBlockUsages(ctx, models.ContentTypeBlogPost, "blog:hello", "", "", models.Content{}))
+	_, err = service.DeleteReusableBlock(ctx, &contentv1.DeleteReusableBlockRequest{Id: block.Id})
+	require.NoError(t, err)
+	_, err = repo.GetByID(ctx, block.Id)
+	assert.Error(t, err)
+}
@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
+)
+
+// Frontend cache tags; the website tags its data fetches with these
+const (
+	revalidateTagPages          = "pages"
+	revalidateTagPublishedPages = "published-pages"
+	revalidateTagBlogPosts      = "blog-posts"
+)
+
+// revalidationTargets collects the frontend paths and cache tags affected by a change, without duplicates
+type revalidationTargets struct {
+	paths []string
+	tags  []string
+	seen  map[string]bool
+}
+
+func (t *revalidationTargets) add(list *[]string, kind, value string) {
+	if value == "" {
+		return
+	}
+	if t.seen == nil {
+		t.seen = make(map[string]bool)
+	}
+	if t.seen[kind+":"+value] {
+		return
+	}
+	t.seen[kind+":"+value] = true
+	*list = append(*list, value)
+}
+
+func (t *revalidationTargets) addPath(p string) {
+	t.add(&t.paths, revalidate.KindPath, p)
+}
+
+func (t *revalidationTargets) addTag(tag string) {
+	t.add(&t.tags, revalidate.KindTag, tag)
+}
+
+func (t *revalidationTargets) empty() bool {
+	return len(t.paths) == 0 && len(t.tags) == 0
+}
+
+// addPage adds the URL and cache tags of a page
+func (t *revalidationTargets) addPage(page *models.Page) {
+	t.addPath("/" + page.Slug)
+	t.addTag(revalidateTagPages)
+	t.addTag(revalidateTagPublishedPages)
+	t.addTag("page:" + page.Slug)
+}
+
+// addPost adds the URL of a post, the blog index, the feed and its category and tag pages
+func (t *revalidationTargets) addPost(post *models.BlogPost) {
+	t.addPath("/blog/" + post.Slug)
+	t.addPath("/blog")
+	t.addPath("/blog/rss")
+	t.addTag(revalidateTagBlogPosts)
+	t.addTag("blog-post:" + post.Slug)
+	for _, category := range post.Categories {
+		t.addTag("blog-category:" + taxonomySlug(category))
+	}
+	for _, tag := range post.Tags {
+		t.addTag("blog-tag:" + taxonomySlug(tag))
+	}
+}
+
+// taxonomySlug returns the URL slug of a category or tag, as listed by GetBlogCategories
+func taxonomySlug(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "-"))
+}
+
+// pageChangeTargets returns what to revalidate when a page changes from before to after.
+// Either may be nil for creates and deletes; drafts are not rendered and are skipped.
+func pageChangeTargets(before, after *models.Page) *revalidationTargets {
+	t := &revalidationTargets{}
+	for _, page := range []*models.Page{before, after} {
+		if page != nil && page.Status == models.PageStatusPublished {
+			t.addPage(page)
+		}
+	}
+	return t
+}
+
+// postChangeTargets returns what to revalidate when a post changes from before to after.
+// Both versions count so that old slugs, categories and tags are refreshed too.
+func postChangeTargets(before, after *models.BlogPost) *revalidationTargets {
+	t := &revalidationTargets{}
+	for _, post := range []*models.BlogPost{before, after} {
+		if post != nil && post.Status == models.PageStatusPublished {
+			t.addPost(post)
+		}
+	}
+	return t
+}
+
+// revalidateTargets asynchronously refreshes the given frontend paths and tags.
+// With a revalidate.Queue the calls only enqueue; retries happen there.
+func revalidateTargets(r revalidate.Revalidator, t *revalidationTargets) {
+	if r == nil || t.empty() {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), revalidateTimeout)
+		defer cancel()
+		for _, p := range t.paths {
+			if err := r.RevalidatePage(ctx, p); err != nil {
+				logger.Error("Failed to revalidate frontend path", err, "path", p)
+			}
+		}
+		for _, tag := range t.tags {
+			if err := r.RevalidateTag(ctx, tag); err != nil {
+				logger.Error("Failed to revalidate frontend cache tag", err, "tag", tag)
+			}
+		}
+	}()
+}
+
+// MediaUsageFinder resolves the published pages and posts that embed a media file
+type MediaUsageFinder interface {
+	FindMediaUsages(ctx context.Context, media *models.Media) ([]*models.Page, []*models.BlogPost, error)
+}
+
+var _ MediaUsageFinder = (*ContentService)(nil)
+
+// FindMediaUsages returns the published pages and posts that link the media file from their
+// content blocks or reference it as featured or Open Graph image
+func (s *ContentService) FindMediaUsages(ctx context.Context, media *models.Media) ([]*models.Page, []*models.BlogPost, error) {
+	var pages []*models.Page
+	for skip := 0; ; skip += linkScanBatchSize {
+		batch, err := s.pageRepo.ListByStatus(ctx, models.PageStatusPublished, repository.ListOptions{Limit: linkScanBatchSize, Skip: skip})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list pages: %w", err)
+		}
+		for _, page := range batch {
+			if page.Meta.OGImage == media.ID || contentEmbedsMedia(page.Content, media) {
+				pages = append(pages, page)
+			}
+		}
+		if len(batch) < linkScanBatchSize {
+			break
+		}
+	}
+
+	var posts []*models.BlogPost
+	for skip := 0; ; skip += linkScanBatchSize {
+		batch, err := s.blogRepo.ListByStatus(ctx, models.PageStatusPublished, repository.ListOptions{Limit: linkScanBatchSize, Skip: skip})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list blog posts: %w", err)
+		}
+		for _, post := range batch {
+			if post.FeaturedImage == media.ID || post.Meta.OGImage == media.ID || contentEmbedsMedia(post.Content, media) {
+				posts = append(posts, post)
+			}
+		}
+		if len(batch) < linkScanBatchSize {
+			break
+		}
+	}
+	return pages, posts, nil
+}
+
+// contentEmbedsMedia reports whether any block links the media file, by ID or by its upload URL
+func contentEmbedsMedia(content models.Content, media *models.Media) bool {
+	for _, ref := range extractContentLinks(content) {
+		if referencesMedia(ref.url, media) {
+			return true
+		}
+	}
+	for _, block := range content.Blocks {
+		for _, value := range block.Data {
+			if s, ok := value.(string); ok && s == media.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// referencesMedia reports whether a link points at the media file, absolute or site-relative
+func referencesMedia(link string, media *models.Media) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	if media.URL != "" {
+		if mu, err := url.Parse(media.URL); err == nil && mu.Path != "" && u.Path == mu.Path {
+			return true
+		}
+	}
+	return media.Filename != "" && path.Base(u.Path) == media.Filename && strings.Contains(u.Path, "/uploads/")
+}
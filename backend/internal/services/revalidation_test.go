@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+// collectingRevalidator records every revalidated path and tag
+type collectingRevalidator struct {
+	mu    sync.Mutex
+	paths []string
+	tags  []string
+}
+
+func (r *collectingRevalidator) RevalidatePage(ctx context.Context, path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.paths = append(r.paths, path)
+	return nil
+}
+
+func (r *collectingRevalidator) RevalidateTag(ctx context.Context, tag string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tags = append(r.tags, tag)
+	return nil
+}
+
+// wait returns the recorded paths and tags once at least n targets have been revalidated
+func (r *collectingRevalidator) wait(t *testing.T, n int) ([]string, []string) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return len(r.paths)+len(r.tags) >= n
+	}, time.Second, 5*time.Millisecond)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.paths...), append([]string(nil), r.tags...)
+}
+
+func TestPostChangeTargets(t *testing.T) {
+	before := models.NewBlogPost("Launch", "launch", "jane@example.com")
+	before.Status = models.PageStatusPublished
+	before.Categories = []string{"Product News"}
+	before.Tags = []string{"go"}
+
+	after := *before
+	after.Slug = "launch-day"
+	after.Categories = []string{"Engineering"}
+
+	targets := postChangeTargets(before, &after)
+	assert.Equal(t, []string{"/blog/launch", "/blog", "/blog/rss", "/blog/launch-day"}, targets.paths)
+	assert.Equal(t, []string{
+		"blog-posts", "blog-post:launch", "blog-category:product-news", "blog-tag:go",
+		"blog-post:launch-day", "blog-category:engineering",
+	}, targets.tags)
+
+	draft := models.NewBlogPost("Draft", "draft", "jane@example.com")
+	assert.True(t, postChangeTargets(nil, draft).empty(), "drafts are not rendered")
+	assert.True(t, pageChangeTargets(models.NewPage("About", "about"), nil).empty())
+}
+
+func TestContentService_UpdateBlogPostRevalidates(t *testing.T) {
+	post := models.NewBlogPost("Launch", "launch", "jane@example.com")
+	service := NewContentService(nil, &memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}})
+	revalidator := &collectingRevalidator{}
+	service.SetRevalidator(revalidator)
+
+	_, err := service.UpdateBlogPost(context.Background(), &contentv1.UpdateBlogPostRequest{
+		Id:         post.ID,
+		Title:      "Launch",
+		Slug:       "launch",
+		Author:     "jane@example.com",
+		Status:     contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+		Categories: []string{"News"},
+	})
+	require.NoError(t, err)
+
+	paths, tags := revalidator.wait(t, 6)
+	assert.ElementsMatch(t, []string{"/blog/launch", "/blog", "/blog/rss"}, paths)
+	assert.ElementsMatch(t, []string{"blog-posts", "blog-post:launch", "blog-category:news"}, tags)
+}
+
+func TestContentService_FindMediaUsages(t *testing.T) {
+	image := models.NewMedia("hero.png", "hero.png", "image/png", "admin", 1024)
+	image.URL = "/uploads/hero.png"
+
+	embedding := models.NewPage("About", "about")
+	embedding.Status = models.PageStatusPublished
+	embedding.Content = models.Content{Blocks: []models.ContentBlock{
+		{Type: "text", Data: map[string]interface{}{"content": `&lt;img src=&#34;https://example.com/uploads/hero.png&#34;&gt;`}},
+	}}
+	unrelated := models.NewPage("Contact", "contact")
+	unrelated.Status = models.PageStatusPublished
+	draft := models.NewPage("Draft", "draft")
+	draft.Content = models.Content{Blocks: []models.ContentBlock{
+		{Type: "image", Data: map[string]interface{}{"src": "/uploads/hero.png"}},
+	}}
+
+	featured := models.NewBlogPost("Launch", "launch", "jane@example.com")
+	featured.Status = models.PageStatusPublished
+	featured.FeaturedImage = image.ID
+
+	service := NewContentService(
+		&memoryPageRepo{pages: []*models.Page{embedding, unrelated, draft}},
+		&memoryBlogRepo{posts: map[string]*models.BlogPost{featured.ID: featured}},
+	)
+	pages, posts, err := service.FindMediaUsages(context.Background(), image)
+	require.NoError(t, err)
+	require.Len(t, pages, 1)
+	assert.Equal(t, "about", pages[0].Slug)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "launch", posts[0].Slug)
+
+	revalidator := &collectingRevalidator{}
+	mediaSvc := NewMediaService(newMemoryMediaRepo(image))
+	mediaSvc.SetRevalidator(revalidator, service)
+	mediaSvc.revalidateMediaUsages(image)
+
+	paths, _ := revalidator.wait(t, 9)
+	assert.ElementsMatch(t, []string{"/about", "/blog/launch", "/blog", "/blog/rss"}, paths)
+}
@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// SEO length recommendations, in characters
+const (
+	seoTitleMinLength       = 30
+	seoTitleMaxLength       = 60
+	seoDescriptionMinLength = 70
+	seoDescriptionMaxLength = 160
+)
+
+// seoSeverityPenalty is the score deduction per finding
+var seoSeverityPenalty = map[contentv1.SEOSeverity]int{
+	contentv1.SEOSeverity_SEO_SEVERITY_ERROR:   20,
+	contentv1.SEOSeverity_SEO_SEVERITY_WARNING: 10,
+	contentv1.SEOSeverity_SEO_SEVERITY_INFO:    2,
+}
+
+var (
+	seoHTMLHeadingPattern     = regexp.MustCompile(`(?i)<h([1-6])[\s>]`)
+	seoMarkdownHeadingPattern = regexp.MustCompile(`(?m)^(#{1,6})\s`)
+	seoHTMLImagePattern       = regexp.MustCompile(`(?i)<img\b[^>]*>`)
+	seoHTMLAltPattern         = regexp.MustCompile(`(?i)\balt\s*=\s*["']\s*[^"'\s]`)
+	seoHTMLTagPattern         = regexp.MustCompile(`<[^>]*>`)
+)
+
+// seoItem is the SEO-relevant view of a page or post
+type seoItem struct {
+	contentType string
+	id          string
+	slug        string
+	title       string
+	excerpt     string
+	meta        models.Meta
+	content     models.Content
+}
+
+func seoItemFromPage(page *models.Page) *seoItem {
+	return &seoItem{
+		contentType: models.ContentTypePage,
+		id:          page.ID,
+		slug:        page.Slug,
+		title:       page.Title,
+		meta:        page.Meta,
+		content:     page.Content,
+	}
+}
+
+func seoItemFromPost(post *models.BlogPost) *seoItem {
+	return &seoItem{
+		contentType: models.ContentTypeBlogPost,
+		id:          post.ID,
+		slug:        post.Slug,
+		title:       post.Title,
+		excerpt:     post.Excerpt,
+		meta:        post.Meta,
+		content:     post.Content,
+	}
+}
+
+// seoTitle is the title search engines see: the meta title, falling back to the item title
+func (i *seoItem) seoTitle() string {
+	if title := strings.TrimSpace(i.meta.Title); title != "" {
+		return title
+	}
+	return strings.TrimSpace(i.title)
+}
+
+// label names the item in findings, e.g. `blog post "launch"`
+func (i *seoItem) label() string {
+	if i.contentType == models.ContentTypeBlogPost {
+		return fmt.Sprintf("blog post %q", i.slug)
+	}
+	return fmt.Sprintf("page %q", i.slug)
+}
+
+// seoSiteIndex groups items by normalized title and description to find duplicates
+type seoSiteIndex struct {
+	titles       map[string][]*seoItem
+	descriptions map[string][]*seoItem
+}
+
+func newSEOSiteIndex(items []*seoItem) *seoSiteIndex {
+	index := &seoSiteIndex{
+		titles:       make(map[string][]*seoItem),
+		descriptions: make(map[string][]*seoItem),
+	}
+	for _, item := range items {
+		if title := normalizeSEOText(item.seoTitle()); title != "" {
+			index.titles[title] = append(index.titles[title], item)
+		}
+		if description := normalizeSEOText(item.meta.Description); description != "" {
+			index.descriptions[description] = append(index.descriptions[description], item)
+		}
+	}
+	return index
+}
+
+// AnalyzeSEO scores a single page or post
+func (s *ContentService) AnalyzeSEO(ctx context.Context, req *contentv1.AnalyzeSEORequest) (*contentv1.SEOReport, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content ID is required")
+	}
+
+	var item *seoItem
+	switch req.ContentType {
+	case models.ContentTypePage:
+		page, err := s.pageRepo.GetByID(ctx, req.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "page not found: %v", err)
+		}
+		item = seoItemFromPage(page)
+	case models.ContentTypeBlogPost:
+		post, err := s.blogRepo.GetByID(ctx, req.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "blog post not found: %v", err)
+		}
+		item = seoItemFromPost(post)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "content type must be %q or %q", models.ContentTypePage, models.ContentTypeBlogPost)
+	}
+
+	items, err := s.listSEOItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return analyzeSEOItem(item, newSEOSiteIndex(items)), nil
+}
+
+// ListSEOIssues scores every draft and published page and post and returns those with findings
+func (s *ContentService) ListSEOIssues(ctx context.Context, req *contentv1.ListSEOIssuesRequest) (*contentv1.ListSEOIssuesResponse, error) {
+	if req.ContentType != "" && req.ContentType != models.ContentTypePage && req.ContentType != models.ContentTypeBlogPost {
+		return nil, status.Errorf(codes.InvalidArgument, "content type must be %q or %q", models.ContentTypePage, models.ContentTypeBlogPost)
+	}
+	minSeverity := req.MinSeverity
+	if minSeverity == contentv1.SEOSeverity_SEO_SEVERITY_UNSPECIFIED {
+		minSeverity = contentv1.SEOSeverity_SEO_SEVERITY_WARNING
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Maximum page size
+	}
+
+	skip := 0
+	if req.PageToken != "" {
+		if parsedSkip, err := strconv.Atoi(req.PageToken); err == nil {
+			skip = parsedSkip
+		}
+	}
+
+	items, err := s.listSEOItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := newSEOSiteIndex(items)
+
+	var reports []*contentv1.SEOReport
+	for _, item := range items {
+		if req.ContentType != "" && item.contentType != req.ContentType {
+			continue
+		}
+		report := analyzeSEOItem(item, index)
+		findings := report.Findings[:0]
+		for _, finding := range report.Findings {
+			if finding.Severity >= minSeverity {
+				findings = append(findings, finding)
+			}
+		}
+		if len(findings) == 0 {
+			continue
+		}
+		report.Findings = findings
+		reports = append(reports, report)
+	}
+	sort.SliceStable(reports, func(i, j int) bool { return reports[i].Score < reports[j].Score })
+
+	total := len(reports)
+	if skip > total {
+		skip = total
+	}
+	end := skip + int(pageSize)
+	if end > total {
+		end = total
+	}
+
+	nextPageToken := ""
+	if end < total {
+		nextPageToken = strconv.Itoa(end)
+	}
+
+	return &contentv1.ListSEOIssuesResponse{
+		Reports:       reports[skip:end],
+		NextPageToken: nextPageToken,
+		TotalCount:    int32(total),
+	}, nil
+}
+
+// listSEOItems loads all draft and published pages and posts
+func (s *ContentService) listSEOItems(ctx context.Context) ([]*seoItem, error) {
+	var items []*seoItem
+	for _, contentStatus := range []string{models.PageStatusPublished, models.PageStatusDraft} {
+		for skip := 0; ; skip += 100 {
+			pages, err := s.pageRepo.ListByStatus(ctx, contentStatus, repository.ListOptions{Limit: 100, Skip: skip})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list pages: %v", err)
+			}
+			for _, page := range pages {
+				items = append(items, seoItemFromPage(page))
+			}
+			if len(pages) < 100 {
+				break
+			}
+		}
+
+		for skip := 0; ; skip += 100 {
+			posts, err := s.blogRepo.ListByStatus(ctx, contentStatus, repository.ListOptions{Limit: 100, Skip: skip})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list blog posts: %v", err)
+			}
+			for _, post := range posts {
+				items = append(items, seoItemFromPost(post))
+			}
+			if len(posts) < 100 {
+				break
+			}
+		}
+	}
+	return items, nil
+}
+
+// analyzeSEOItem runs all checks on an item and scores it
+func analyzeSEOItem(item *seoItem, index *seoSiteIndex) *contentv1.SEOReport {
+	report := &contentv1.SEOReport{
+		ContentType: item.contentType,
+		ContentId:   item.id,
+		Slug:        item.slug,
+		Title:       item.title,
+		Findings:    []*contentv1.SEOFinding{},
+	}
+	add := func(check string, severity contentv1.SEOSeverity, blockIndex int, format string, args ...interface{}) {
+		report.Findings = append(report.Findings, &contentv1.SEOFinding{
+			Check:      check,
+			Severity:   severity,
+			Message:    fmt.Sprintf(format, args...),
+			BlockIndex: int32(blockIndex),
+		})
+	}
+
+	checkSEOTitle(item, add)
+	checkSEODescription(item, add)
+	checkSEOKeywords(item, add)
+	checkSEOHeadings(item, add)
+	checkSEOImages(item, add)
+	checkSEODuplicates(item, index, add)
+
+	score := 100
+	for _, finding := range report.Findings {
+		score -= seoSeverityPenalty[finding.Severity]
+	}
+	if score < 0 {
+		score = 0
+	}
+	report.Score = int32(score)
+	return report
+}
+
+// seoFindingFunc records a finding; blockIndex is -1 for item-level findings
+type seoFindingFunc func(check string, severity contentv1.SEOSeverity, blockIndex int, format string, args ...interface{})
+
+func checkSEOTitle(item *seoItem, add seoFindingFunc) {
+	title := item.seoTitle()
+	length := utf8.RuneCountInString(title)
+	switch {
+	case length == 0:
+		add("title_missing", contentv1.SEOSeverity_SEO_SEVERITY_ERROR, -1, "Add a title")
+	case length < seoTitleMinLength:
+		add("title_too_short", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1,
+			"Title is %d characters; aim for %d-%d", length, seoTitleMinLength, seoTitleMaxLength)
+	case length > seoTitleMaxLength:
+		add("title_too_long", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1,
+			"Title is %d characters and will be truncated in search results; aim for %d-%d", length, seoTitleMinLength, seoTitleMaxLength)
+	}
+}
+
+func checkSEODescription(item *seoItem, add seoFindingFunc) {
+	length := utf8.RuneCountInString(strings.TrimSpace(item.meta.Description))
+	switch {
+	case length == 0:
+		add("meta_description_missing", contentv1.SEOSeverity_SEO_SEVERITY_ERROR, -1, "Add a meta description")
+	case length < seoDescriptionMinLength:
+		add("meta_description_too_short", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1,
+			"Meta description is %d characters; aim for %d-%d", length, seoDescriptionMinLength, seoDescriptionMaxLength)
+	case length > seoDescriptionMaxLength:
+		add("meta_description_too_long", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1,
+			"Meta description is %d characters and will be truncated in search results; aim for %d-%d", length, seoDescriptionMinLength, seoDescriptionMaxLength)
+	}
+}
+
+// checkSEOKeywords checks that the focus keyword (the first meta keyword) is used in the
+// title, meta description and body
+func checkSEOKeywords(item *seoItem, add seoFindingFunc) {
+	keyword := ""
+	for _, k := range strings.Split(item.meta.Keywords, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keyword = k
+			break
+		}
+	}
+	if keyword == "" {
+		add("keywords_missing", contentv1.SEOSeverity_SEO_SEVERITY_INFO, -1, "Set a focus keyword in the meta keywords")
+		return
+	}
+
+	needle := normalizeSEOText(keyword)
+	if !strings.Contains(normalizeSEOText(item.seoTitle()), needle) {
+		add("keyword_not_in_title", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1, "Use the focus keyword %q in the title", keyword)
+	}
+	if item.meta.Description != "" && !strings.Contains(normalizeSEOText(item.meta.Description), needle) {
+		add("keyword_not_in_description", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1, "Use the focus keyword %q in the meta description", keyword)
+	}
+	if !strings.Contains(normalizeSEOText(item.excerpt+" "+seoBodyText(item.content)), needle) {
+		add("keyword_not_in_content", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1, "Use the focus keyword %q in the content", keyword)
+	}
+}
+
+// checkSEOHeadings checks the heading outline of the blocks. The website renders the
+// page or post title as the only H1, so blocks should start at H2 and not skip levels.
+func checkSEOHeadings(item *seoItem, add seoFindingFunc) {
+	previous := 1
+	for i, block := range item.content.Blocks {
+		for _, level := range seoBlockHeadings(block) {
+			if level == 1 {
+				add("heading_multiple_h1", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, i,
+					"The title is already the H1; use H2 or lower for headings in the content")
+			} else if level > previous+1 {
+				add("heading_level_skipped", contentv1.SEOSeverity_SEO_SEVERITY_INFO, i,
+					"Heading jumps from H%d to H%d; do not skip heading levels", previous, level)
+			}
+			previous = level
+		}
+	}
+}
+
+// seoBlockHeadings returns the heading levels a block renders, in order
+func seoBlockHeadings(block models.ContentBlock) []int {
+	var levels []int
+	switch block.Type {
+	case "hero":
+		if seoBlockString(block, "title") != "" {
+			levels = append(levels, 1)
+		}
+	case "feature-grid":
+		if seoBlockString(block, "title") != "" {
+			levels = append(levels, 2)
+		}
+		if features, ok := block.Data["features"].([]interface{}); ok && len(features) > 0 {
+			levels = append(levels, 3)
+		}
+	case "cta":
+		if seoBlockString(block, "title") != "" {
+			levels = append(levels, 2)
+		}
+	case "text":
+		content := seoBlockString(block, "content")
+		for _, match := range seoHTMLHeadingPattern.FindAllStringSubmatch(content, -1) {
+			levels = append(levels, int(match[1][0]-'0'))
+		}
+		for _, match := range seoMarkdownHeadingPattern.FindAllStringSubmatch(content, -1) {
+			levels = append(levels, len(match[1]))
+		}
+	}
+	return levels
+}
+
+func checkSEOImages(item *seoItem, add seoFindingFunc) {
+	for i, block := range item.content.Blocks {
+		switch block.Type {
+		case "image":
+			if seoBlockString(block, "alt") == "" {
+				add("image_alt_missing", contentv1.SEOSeverity_SEO_SEVERITY_ERROR, i, "Add alt text describing the image")
+			}
+		case "text":
+			missing := 0
+			for _, img := range seoHTMLImagePattern.FindAllString(seoBlockString(block, "content"), -1) {
+				if !seoHTMLAltPattern.MatchString(img) {
+					missing++
+				}
+			}
+			if missing > 0 {
+				add("image_alt_missing", contentv1.SEOSeverity_SEO_SEVERITY_ERROR, i, "Add alt text to %d image(s) in this block", missing)
+			}
+		}
+	}
+}
+
+func checkSEODuplicates(item *seoItem, index *seoSiteIndex, add seoFindingFunc) {
+	if others := seoOtherItems(index.titles[normalizeSEOText(item.seoTitle())], item); len(others) > 0 {
+		add("duplicate_title", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1, "Title is also used by %s", strings.Join(others, ", "))
+	}
+	if others := seoOtherItems(index.descriptions[normalizeSEOText(item.meta.Description)], item); len(others) > 0 {
+		add("duplicate_meta_description", contentv1.SEOSeverity_SEO_SEVERITY_WARNING, -1, "Meta description is also used by %s", strings.Join(others, ", "))
+	}
+}
+
+// seoOtherItems labels the items in a duplicate group other than item itself
+func seoOtherItems(group []*seoItem, item *seoItem) []string {
+	var labels []string
+	for _, other := range group {
+		if other.contentType != item.contentType || other.id != item.id {
+			labels = append(labels, other.label())
+		}
+	}
+	return labels
+}
+
+// seoBlockString returns a block data string with the HTML escaping applied on save removed
+func seoBlockString(block models.ContentBlock, key string) string {
+	value, _ := block.Data[key].(string)
+	return strings.TrimSpace(html.UnescapeString(value))
+}
+
+// seoBodyText returns the visible text of all string values in the blocks
+func seoBodyText(content models.Content) string {
+	var b strings.Builder
+	for _, block := range content.Blocks {
+		for _, value := range block.Data {
+			if text, ok := value.(string); ok {
+				b.WriteString(seoHTMLTagPattern.ReplaceAllString(html.UnescapeString(text), " "))
+				b.WriteString(" ")
+			}
+		}
+	}
+	return b.String()
+}
+
+// normalizeSEOText lowercases text and collapses whitespace for comparisons
+func normalizeSEOText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
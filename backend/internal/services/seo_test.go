@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"html"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+func newSEOTestService() *ContentService {
+	good := models.NewPage("Pricing", "pricing")
+	good.Status = models.PageStatusPublished
+	good.Meta = models.Meta{
+		Title:       "Pricing plans for teams of every size",
+		Description: "Compare our pricing plans for startups, growing teams and enterprises, with monthly or yearly billing.",
+		Keywords:    "pricing, plans",
+	}
+	good.Content.Blocks = []models.ContentBlock{
+		{Type: "text", Data: map[string]interface{}{"content": html.EscapeString("<h2>Our pricing</h2><p>Simple.</p>")}},
+		{Type: "image", Data: map[string]interface{}{"src": "/uploads/chart.png", "alt": "Plan comparison chart"}},
+	}
+
+	poor := models.NewPage("About", "about-us")
+	poor.Status = models.PageStatusPublished
+	poor.Meta = models.Meta{Keywords: "team"}
+	poor.Content.Blocks = []models.ContentBlock{
+		{Type: "hero", Data: map[string]interface{}{"title": "Welcome"}},
+		{Type: "text", Data: map[string]interface{}{"content": html.EscapeString(`<h4>History</h4><img src="/uploads/a.png"><img src="/uploads/b.png" alt="Office">`)}},
+		{Type: "image", Data: map[string]interface{}{"src": "/uploads/c.png"}},
+	}
+
+	// A draft post reusing the pricing page's title and description
+	copycat := models.NewBlogPost("Pricing", "pricing-post", "author@example.com")
+	copycat.Meta = good.Meta
+	copycat.Content.Blocks = []models.ContentBlock{
+		{Type: "text", Data: map[string]interface{}{"content": "Our pricing plans explained"}},
+	}
+
+	return NewContentService(
+		&memoryPageRepo{pages: []*models.Page{good, poor}},
+		&memoryBlogRepo{posts: map[string]*models.BlogPost{copycat.ID: copycat}},
+	)
+}
+
+func seoChecks(report *contentv1.SEOReport) map[string]int32 {
+	checks := map[string]int32{}
+	for _, finding := range report.Findings {
+		checks[finding.Check] = finding.BlockIndex
+	}
+	return checks
+}
+
+func TestContentService_AnalyzeSEO(t *testing.T) {
+	service := newSEOTestService()
+	ctx := context.Background()
+
+	report, err := service.AnalyzeSEO(ctx, &contentv1.AnalyzeSEORequest{ContentType: models.ContentTypePage, Id: "page:about-us"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{
+		"title_too_short":          -1,
+		"meta_description_missing": -1,
+		"keyword_not_in_title":     -1,
+		"keyword_not_in_content":   -1,
+		"heading_multiple_h1":      0,
+		"heading_level_skipped":    1,
+		"image_alt_missing":        2,
+	}, seoChecks(report))
+	// Both image blocks report missing alt text
+	altFindings := 0
+	for _, finding := range report.Findings {
+		if finding.Check == "image_alt_missing" {
+			altFindings++
+		}
+	}
+	assert.Equal(t, 2, altFindings)
+	assert.Equal(t, int32(0), report.Score, "penalties beyond 100 points floor the score at zero")
+
+	report, err = service.AnalyzeSEO(ctx, &contentv1.AnalyzeSEORequest{ContentType: models.ContentTypePage, Id: "page:pricing"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"duplicate_title": -1, "duplicate_meta_description": -1}, seoChecks(report))
+	assert.Contains(t, report.Findings[0].Message, `blog post "pricing-post"`)
+	assert.Equal(t, int32(80), report.Score)
+
+	_, err = service.AnalyzeSEO(ctx, &contentv1.AnalyzeSEORequest{ContentType: "video", Id: "x"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.AnalyzeSEO(ctx, &contentv1.AnalyzeSEORequest{ContentType: models.ContentTypeBlogPost, Id: "blog:nope"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestContentService_ListSEOIssues(t *testing.T) {
+	service := newSEOTestService()
+	ctx := context.Background()
+
+	resp, err := service.ListSEOIssues(ctx, &contentv1.ListSEOIssuesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	require.Len(t, resp.Reports, 3)
+	assert.Equal(t, "about-us", resp.Reports[0].Slug, "lowest score first")
+	for _, report := range resp.Reports {
+		for _, finding := range report.Findings {
+			assert.NotEqual(t, contentv1.SEOSeverity_SEO_SEVERITY_INFO, finding.Severity, "info findings are hidden by default")
+		}
+	}
+
+	errorsOnly, err := service.ListSEOIssues(ctx, &contentv1.ListSEOIssuesRequest{MinSeverity: contentv1.SEOSeverity_SEO_SEVERITY_ERROR})
+	require.NoError(t, err)
+	require.Len(t, errorsOnly.Reports, 1)
+	assert.Equal(t, "about-us", errorsOnly.Reports[0].Slug)
+
+	posts, err := service.ListSEOIssues(ctx, &contentv1.ListSEOIssuesRequest{ContentType: models.ContentTypeBlogPost, PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, posts.Reports, 1)
+	assert.Equal(t, "pricing-post", posts.Reports[0].Slug)
+	assert.Empty(t, posts.NextPageToken)
+
+	paged, err := service.ListSEOIssues(ctx, &contentv1.ListSEOIssuesRequest{PageSize: 2})
+	require.NoError(t, err)
+	assert.Len(t, paged.Reports, 2)
+	assert.Equal(t, "2", paged.NextPageToken)
+}
@@ -0,0 +1,499 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/mail"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	settingsv1 "github.com/7-solutions/saas-platformbackend/gen/settings/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/revalidate"
+)
+
+const (
+	// DefaultSettingsCacheTTL bounds how long settings changed through another server
+	// instance take to show up; changes made through this instance apply immediately
+	DefaultSettingsCacheTTL = time.Minute
+
+	maxSiteNameLength          = 100
+	maxBlogDescriptionLength   = 500
+	maxSocialLinks             = 20
+	maxContactRecipients       = 20
+	revalidateTagSettings      = "settings"
+	settingsMediaLookupTimeout = 5 * time.Second
+)
+
+// socialNetworkPattern matches the network slugs of social links, e.g. "mastodon" or "x"
+var socialNetworkPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,29}$`)
+
+// SettingsService manages the site-wide settings. Keys that were never saved use the
+// defaults passed to NewSettingsService. Settings are cached in memory and the cache is
+// invalidated on every update; each update is recorded in an audit trail.
+//
+// SettingsService is the SiteInfoSource and ContactRecipientsSource of the services that
+// show the site name, link to the site or notify the site owners.
+type SettingsService struct {
+	settingsv1.UnimplementedSettingsServiceServer
+	repo        repository.SettingsRepository
+	defaults    models.SiteSettings
+	mediaRepo   repository.MediaRepository
+	revalidator revalidate.Revalidator
+	cacheTTL    time.Duration
+	now         func() time.Time
+
+	mu     sync.RWMutex
+	cached *settingsSnapshot
+	// generation is bumped on every invalidation so that a load which started before an
+	// update cannot overwrite the cache with the values from before the update
+	generation uint64
+}
+
+// settingsSnapshot is the cached state of the settings
+type settingsSnapshot struct {
+	settings models.SiteSettings
+	site     SiteInfo
+	loadedAt time.Time
+}
+
+// NewSettingsService creates a settings service; defaults provides the values of the keys
+// that were never saved, usually configured through the environment
+func NewSettingsService(repo repository.SettingsRepository, defaults SiteInfo) *SettingsService {
+	defaults = defaults.SiteInfo(context.Background())
+	return &SettingsService{
+		repo: repo,
+		defaults: models.SiteSettings{
+			SiteName:        defaults.Name,
+			SiteURL:         defaults.URL,
+			LogoURL:         defaults.LogoURL,
+			BlogDescription: defaults.Description,
+			SocialLinks:     defaults.SocialLinks,
+		},
+		cacheTTL: DefaultSettingsCacheTTL,
+		now:      time.Now,
+	}
+}
+
+// SetMediaRepository enables the default meta image. When unset, setting it returns FailedPrecondition.
+func (s *SettingsService) SetMediaRepository(repo repository.MediaRepository) {
+	s.mediaRepo = repo
+}
+
+// SetRevalidator enables frontend cache revalidation after settings change
+func (s *SettingsService) SetRevalidator(r revalidate.Revalidator) {
+	s.revalidator = r
+}
+
+// SetCacheTTL replaces DefaultSettingsCacheTTL
+func (s *SettingsService) SetCacheTTL(ttl time.Duration) {
+	s.cacheTTL = ttl
+}
+
+// Settings returns the current settings
+func (s *SettingsService) Settings(ctx context.Context) (models.SiteSettings, error) {
+	snapshot, err := s.snapshot(ctx)
+	if err != nil {
+		return models.SiteSettings{}, err
+	}
+	return snapshot.settings, nil
+}
+
+// SiteInfo returns the site information for structured data, feeds and emails. When the
+// settings cannot be loaded the last known values, or the defaults, are returned.
+func (s *SettingsService) SiteInfo(ctx context.Context) SiteInfo {
+	snapshot, err := s.snapshot(ctx)
+	if err != nil {
+		logger.Error("Failed to load site settings", err)
+		return s.fallback().site
+	}
+	return snapshot.site
+}
+
+// ContactRecipients returns the addresses notified of contact form submissions
+func (s *SettingsService) ContactRecipients(ctx context.Context) []string {
+	snapshot, err := s.snapshot(ctx)
+	if err != nil {
+		logger.Error("Failed to load site settings", err)
+		snapshot = s.fallback()
+	}
+	return snapshot.settings.ContactRecipients
+}
+
+// GetSettings returns the current settings; contact recipients are only shown to admins
+func (s *SettingsService) GetSettings(ctx context.Context, req *settingsv1.GetSettingsRequest) (*settingsv1.Settings, error) {
+	snapshot, err := s.snapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load settings: %v", err)
+	}
+	role, _ := ctx.Value("user_role").(string)
+	return convertSettingsToProto(snapshot, role == models.UserRoleAdmin), nil
+}
+
+// UpdateSettings validates and saves the fields set in the request. Only keys whose value
+// changes are saved and audited.
+func (s *SettingsService) UpdateSettings(ctx context.Context, req *settingsv1.UpdateSettingsRequest) (*settingsv1.Settings, error) {
+	// Start from the stored values rather than the cache so that concurrent updates through
+	// other instances are not reverted
+	current, err := s.load(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load settings: %v", err)
+	}
+	updated, err := s.applyUpdate(ctx, current.settings, req)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]json.RawMessage)
+	for _, key := range models.SettingKeys {
+		before, err := current.settings.Value(key)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode setting %s: %v", key, err)
+		}
+		after, err := updated.Value(key)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode setting %s: %v", key, err)
+		}
+		if !bytes.Equal(before, after) {
+			changes[key] = after
+		}
+	}
+
+	if len(changes) > 0 {
+		userID, _ := ctx.Value("user_id").(string)
+		if err := s.repo.Save(ctx, changes, userID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save settings: %v", err)
+		}
+		s.invalidate()
+		s.revalidate()
+	}
+
+	snapshot, err := s.snapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load settings: %v", err)
+	}
+	return convertSettingsToProto(snapshot, true), nil
+}
+
+// ListSettingChanges lists the audit trail newest first
+func (s *SettingsService) ListSettingChanges(ctx context.Context, req *settingsv1.ListSettingChangesRequest) (*settingsv1.ListSettingChangesResponse, error) {
+	if req.Key != "" && !isSettingKey(req.Key) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown setting '%s'", req.Key)
+	}
+
+	pageSize, skip := webhookPagination(req.PageSize, req.PageToken)
+	changes, pagination, err := s.repo.ListChanges(ctx, req.Key, repository.ListOptions{Limit: pageSize, Skip: skip})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list setting changes: %v", err)
+	}
+
+	resp := &settingsv1.ListSettingChangesResponse{}
+	if pagination != nil {
+		resp.NextPageToken = pagination.NextPageToken
+		resp.TotalCount = int32(pagination.TotalCount)
+	}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, &settingsv1.SettingChange{
+			Id:        change.ID,
+			Key:       change.Key,
+			OldValue:  settingValueToProto(change.OldValue),
+			NewValue:  settingValueToProto(change.NewValue),
+			ChangedBy: change.ChangedBy,
+			CreatedAt: timestamppb.New(change.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// snapshot returns the cached settings, loading them when the cache is empty or expired
+func (s *SettingsService) snapshot(ctx context.Context) (*settingsSnapshot, error) {
+	s.mu.RLock()
+	cached, generation := s.cached, s.generation
+	s.mu.RUnlock()
+	if cached != nil && s.now().Sub(cached.loadedAt) < s.cacheTTL {
+		return cached, nil
+	}
+
+	loaded, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if s.generation == generation {
+		s.cached = loaded
+	}
+	s.mu.Unlock()
+	return loaded, nil
+}
+
+// load reads the stored settings over the defaults
+func (s *SettingsService) load(ctx context.Context) (*settingsSnapshot, error) {
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := s.defaultSettings()
+	for _, setting := range stored {
+		if !isSettingKey(setting.Key) {
+			continue
+		}
+		if err := settings.Apply(setting.Key, setting.Value); err != nil {
+			logger.Error("Ignoring invalid stored setting", err, "key", setting.Key)
+			continue
+		}
+		if setting.UpdatedAt.After(settings.UpdatedAt) {
+			settings.UpdatedAt = setting.UpdatedAt
+		}
+	}
+	return &settingsSnapshot{
+		settings: settings,
+		site:     s.siteInfo(ctx, settings),
+		loadedAt: s.now(),
+	}, nil
+}
+
+// fallback returns the cached settings regardless of their age, or the defaults
+func (s *SettingsService) fallback() *settingsSnapshot {
+	s.mu.RLock()
+	cached := s.cached
+	s.mu.RUnlock()
+	if cached != nil {
+		return cached
+	}
+	settings := s.defaultSettings()
+	return &settingsSnapshot{settings: settings, site: s.siteInfo(context.Background(), settings)}
+}
+
+// defaultSettings returns a copy of the defaults that can be modified
+func (s *SettingsService) defaultSettings() models.SiteSettings {
+	settings := s.defaults
+	settings.SocialLinks = append([]models.SocialLink(nil), s.defaults.SocialLinks...)
+	settings.ContactRecipients = append([]string(nil), s.defaults.ContactRecipients...)
+	return settings
+}
+
+// siteInfo builds the SiteInfo of the settings and resolves the default meta image;
+// a missing image is logged and left out
+func (s *SettingsService) siteInfo(ctx context.Context, settings models.SiteSettings) SiteInfo {
+	site := SiteInfo{
+		Name:        settings.SiteName,
+		URL:         strings.TrimRight(settings.SiteURL, "/"),
+		LogoURL:     settings.LogoURL,
+		Description: settings.BlogDescription,
+		SocialLinks: settings.SocialLinks,
+	}
+	if settings.DefaultMetaImageID != "" && s.mediaRepo != nil {
+		ctx, cancel := context.WithTimeout(ctx, settingsMediaLookupTimeout)
+		defer cancel()
+		media, err := s.mediaRepo.GetByID(ctx, settings.DefaultMetaImageID)
+		if err != nil {
+			logger.Error("Failed to resolve default meta image", err, "media_id", settings.DefaultMetaImageID)
+		} else {
+			site.DefaultImageURL = absoluteURL(site.URL, media.URL)
+		}
+	}
+	return site
+}
+
+// invalidate drops the cached settings
+func (s *SettingsService) invalidate() {
+	s.mu.Lock()
+	s.cached = nil
+	s.generation++
+	s.mu.Unlock()
+}
+
+// revalidate refreshes the website's cached settings and the pages and feed that show them
+func (s *SettingsService) revalidate() {
+	t := &revalidationTargets{}
+	t.addTag(revalidateTagSettings)
+	t.addTag(revalidateTagPages)
+	t.addTag(revalidateTagBlogPosts)
+	t.addPath("/blog/rss")
+	revalidateTargets(s.revalidator, t)
+}
+
+// applyUpdate returns the settings with the fields of the request applied and validated
+func (s *SettingsService) applyUpdate(ctx context.Context, settings models.SiteSettings, req *settingsv1.UpdateSettingsRequest) (models.SiteSettings, error) {
+	if req.SiteName != nil {
+		name := strings.TrimSpace(*req.SiteName)
+		if name == "" {
+			return settings, status.Errorf(codes.InvalidArgument, "site name is required")
+		}
+		if utf8.RuneCountInString(name) > maxSiteNameLength {
+			return settings, status.Errorf(codes.InvalidArgument, "site name must be at most %d characters", maxSiteNameLength)
+		}
+		settings.SiteName = name
+	}
+
+	if req.SiteUrl != nil {
+		u, ok := parseHTTPURL(*req.SiteUrl)
+		if !ok || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+			return settings, status.Errorf(codes.InvalidArgument, "site URL must be an absolute http(s) URL without query or fragment")
+		}
+		settings.SiteURL = strings.TrimRight(u.String(), "/")
+	}
+
+	if req.LogoUrl != nil {
+		logo := strings.TrimSpace(*req.LogoUrl)
+		if logo != "" && !isSitePath(logo) {
+			if _, ok := parseHTTPURL(logo); !ok {
+				return settings, status.Errorf(codes.InvalidArgument, "logo URL must be an absolute http(s) URL or a path on the site")
+			}
+		}
+		settings.LogoURL = logo
+	}
+
+	if req.BlogDescription != nil {
+		description := strings.TrimSpace(*req.BlogDescription)
+		if utf8.RuneCountInString(description) > maxBlogDescriptionLength {
+			return settings, status.Errorf(codes.InvalidArgument, "blog description must be at most %d characters", maxBlogDescriptionLength)
+		}
+		settings.BlogDescription = description
+	}
+
+	if req.SocialLinks != nil {
+		links, err := normalizeSocialLinks(req.SocialLinks.Links)
+		if err != nil {
+			return settings, err
+		}
+		settings.SocialLinks = links
+	}
+
+	if req.DefaultMetaImage != nil {
+		mediaID := strings.TrimSpace(*req.DefaultMetaImage)
+		if mediaID != "" {
+			if err := s.validateMetaImage(ctx, mediaID); err != nil {
+				return settings, err
+			}
+		}
+		settings.DefaultMetaImageID = mediaID
+	}
+
+	if req.ContactRecipients != nil {
+		recipients, err := normalizeContactRecipients(req.ContactRecipients.Emails)
+		if err != nil {
+			return settings, err
+		}
+		settings.ContactRecipients = recipients
+	}
+	return settings, nil
+}
+
+// validateMetaImage checks that a media item exists and is an image
+func (s *SettingsService) validateMetaImage(ctx context.Context, mediaID string) error {
+	if s.mediaRepo == nil {
+		return status.Errorf(codes.FailedPrecondition, "media references are not configured")
+	}
+	media, err := s.mediaRepo.GetByID(ctx, mediaID)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "default meta image media '%s' does not exist", mediaID)
+	}
+	if !strings.HasPrefix(media.MimeType, "image/") {
+		return status.Errorf(codes.InvalidArgument, "default meta image media '%s' is not an image", mediaID)
+	}
+	return nil
+}
+
+// normalizeSocialLinks validates social links and lowercases their networks
+func normalizeSocialLinks(links []*settingsv1.SocialLink) ([]models.SocialLink, error) {
+	if len(links) > maxSocialLinks {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d social links are allowed", maxSocialLinks)
+	}
+	out := make([]models.SocialLink, 0, len(links))
+	for i, link := range links {
+		network := strings.ToLower(strings.TrimSpace(link.GetNetwork()))
+		if !socialNetworkPattern.MatchString(network) {
+			return nil, status.Errorf(codes.InvalidArgument, "social link %d: network must be a lowercase slug such as 'mastodon'", i+1)
+		}
+		u, ok := parseHTTPURL(link.GetUrl())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "social link %d: URL must be an absolute http(s) URL", i+1)
+		}
+		out = append(out, models.SocialLink{Network: network, URL: u.String()})
+	}
+	return out, nil
+}
+
+// normalizeContactRecipients validates addresses, lowercases them and drops duplicates
+func normalizeContactRecipients(emails []string) ([]string, error) {
+	out := make([]string, 0, len(emails))
+	seen := make(map[string]bool)
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid contact recipient '%s'", email)
+		}
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+		out = append(out, email)
+	}
+	if len(out) > maxContactRecipients {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d contact recipients are allowed", maxContactRecipients)
+	}
+	return out, nil
+}
+
+// isSitePath reports whether ref is a path on the site such as "/logo.png"
+func isSitePath(ref string) bool {
+	return strings.HasPrefix(ref, "/") && !strings.HasPrefix(ref, "//")
+}
+
+func isSettingKey(key string) bool {
+	for _, k := range models.SettingKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// settingValueToProto converts a stored JSON value; a missing value becomes null
+func settingValueToProto(raw json.RawMessage) *structpb.Value {
+	value := structpb.NewNullValue()
+	if len(raw) > 0 {
+		if err := value.UnmarshalJSON(raw); err != nil {
+			logger.Error("Failed to convert setting value", err)
+			return structpb.NewNullValue()
+		}
+	}
+	return value
+}
+
+// convertSettingsToProto converts settings; recipients are only included for admins
+func convertSettingsToProto(snapshot *settingsSnapshot, includeRecipients bool) *settingsv1.Settings {
+	settings := snapshot.settings
+	out := &settingsv1.Settings{
+		SiteName:            settings.SiteName,
+		SiteUrl:             snapshot.site.URL,
+		LogoUrl:             settings.LogoURL,
+		BlogDescription:     settings.BlogDescription,
+		DefaultMetaImage:    settings.DefaultMetaImageID,
+		DefaultMetaImageUrl: snapshot.site.DefaultImageURL,
+	}
+	for _, link := range settings.SocialLinks {
+		out.SocialLinks = append(out.SocialLinks, &settingsv1.SocialLink{Network: link.Network, Url: link.URL})
+	}
+	if includeRecipients {
+		out.ContactRecipients = settings.ContactRecipients
+	}
+	if !settings.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(settings.UpdatedAt)
+	}
+	return out
+}
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	settingsv1 "github.com/7-solutions/saas-platformbackend/gen/settings/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memorySettingsRepo keeps settings and their changes in memory and counts loads
+type memorySettingsRepo struct {
+	mu      sync.Mutex
+	values  map[string]*models.Setting
+	changes []*models.SettingChange
+	loads   int
+}
+
+func newMemorySettingsRepo() *memorySettingsRepo {
+	return &memorySettingsRepo{values: make(map[string]*models.Setting)}
+}
+
+func (r *memorySettingsRepo) List(ctx context.Context) ([]*models.Setting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loads++
+	out := make([]*models.Setting, 0, len(r.values))
+	for _, setting := range r.values {
+		copied := *setting
+		out = append(out, &copied)
+	}
+	return out, nil
+}
+
+func (r *memorySettingsRepo) Save(ctx context.Context, values map[string]json.RawMessage, changedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for key, value := range values {
+		change := &models.SettingChange{
+			ID:        newEventID(),
+			Key:       key,
+			NewValue:  value,
+			ChangedBy: changedBy,
+			CreatedAt: now,
+		}
+		if previous, ok := r.values[key]; ok {
+			change.OldValue = previous.Value
+		}
+		r.changes = append(r.changes, change)
+		r.values[key] = &models.Setting{Key: key, Value: value, UpdatedBy: changedBy, UpdatedAt: now}
+	}
+	return nil
+}
+
+func (r *memorySettingsRepo) ListChanges(ctx context.Context, key string, options repository.ListOptions) ([]*models.SettingChange, *repository.PaginationInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var matching []*models.SettingChange
+	for i := len(r.changes) - 1; i >= 0; i-- {
+		if key == "" || r.changes[i].Key == key {
+			matching = append(matching, r.changes[i])
+		}
+	}
+	info := &repository.PaginationInfo{TotalCount: len(matching)}
+	if options.Skip >= len(matching) {
+		return nil, info, nil
+	}
+	matching = matching[options.Skip:]
+	if len(matching) > options.Limit {
+		matching = matching[:options.Limit]
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(options.Skip + options.Limit)
+	}
+	return matching, info, nil
+}
+
+func (r *memorySettingsRepo) loadCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loads
+}
+
+func newSettingsTestService() (*SettingsService, *memorySettingsRepo) {
+	repo := newMemorySettingsRepo()
+	service := NewSettingsService(repo, SiteInfo{
+		Name:        "Acme",
+		URL:         "https://acme.test/",
+		Description: "News from Acme",
+	})
+	service.SetMediaRepository(newMemoryMediaRepo(
+		models.NewMedia("share.png", "share.png", "image/png", "user-1", 1),
+		models.NewMedia("terms.pdf", "terms.pdf", "application/pdf", "user-1", 1),
+	))
+	return service, repo
+}
+
+func TestSettingsService_DefaultsAndCache(t *testing.T) {
+	service, repo := newSettingsTestService()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	site := service.SiteInfo(ctx)
+	assert.Equal(t, "Acme", site.Name)
+	assert.Equal(t, "https://acme.test", site.URL, "defaults are normalized")
+	assert.Equal(t, "News from Acme", site.Description)
+	assert.Empty(t, service.ContactRecipients(ctx))
+
+	service.SiteInfo(ctx)
+	service.ContactRecipients(ctx)
+	assert.Equal(t, 1, repo.loadCount(), "settings are served from the cache")
+
+	// Changes made through another instance show up once the cache expires
+	require.NoError(t, repo.Save(ctx, map[string]json.RawMessage{models.SettingSiteName: json.RawMessage(`"Acme Corp"`)}, "user-2"))
+	assert.Equal(t, "Acme", service.SiteInfo(ctx).Name)
+	now = now.Add(DefaultSettingsCacheTTL)
+	assert.Equal(t, "Acme Corp", service.SiteInfo(ctx).Name)
+	assert.Equal(t, 2, repo.loadCount())
+}
+
+func TestSettingsService_UpdateAuditsAndInvalidates(t *testing.T) {
+	service, repo := newSettingsTestService()
+	revalidator := &collectingRevalidator{}
+	service.SetRevalidator(revalidator)
+	ctx := readerContext("admin-1", models.UserRoleAdmin)
+
+	require.Equal(t, "Acme", service.SiteInfo(ctx).Name)
+
+	settings, err := service.UpdateSettings(ctx, &settingsv1.UpdateSettingsRequest{
+		SiteName: proto.String("  Acme Corp "),
+		SocialLinks: &settingsv1.SocialLinks{Links: []*settingsv1.SocialLink{
+			{Network: "Mastodon", Url: "https://social.example/@acme"},
+		}},
+		ContactRecipients: &settingsv1.ContactRecipients{Emails: []string{"Sales@Acme.test", "sales@acme.test", "support@acme.test"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Corp", settings.SiteName)
+	assert.Equal(t, "https://acme.test", settings.SiteUrl)
+	assert.Equal(t, []*settingsv1.SocialLink{{Network: "mastodon", Url: "https://social.example/@acme"}}, settings.SocialLinks)
+	assert.Equal(t, []string{"sales@acme.test", "support@acme.test"}, settings.ContactRecipients)
+	assert.NotNil(t, settings.UpdatedAt)
+
+	assert.Equal(t, "Acme Corp", service.SiteInfo(ctx).Name, "updates invalidate the cache")
+	assert.Equal(t, []string{"sales@acme.test", "support@acme.test"}, service.ContactRecipients(ctx))
+
+	_, tags := revalidator.wait(t, 4)
+	assert.Contains(t, tags, "settings")
+
+	require.Len(t, repo.changes, 3, "only changed keys are audited")
+	for _, change := range repo.changes {
+		assert.Equal(t, "admin-1", change.ChangedBy)
+		assert.Nil(t, change.OldValue, "the keys used their defaults")
+	}
+
+	// Saving the same values again changes nothing
+	_, err = service.UpdateSettings(ctx, &settingsv1.UpdateSettingsRequest{SiteName: proto.String("Acme Corp")})
+	require.NoError(t, err)
+	assert.Len(t, repo.changes, 3)
+
+	_, err = service.UpdateSettings(ctx, &settingsv1.UpdateSettingsRequest{SiteName: proto.String("Acme Inc")})
+	require.NoError(t, err)
+
+	resp, err := service.ListSettingChanges(ctx, &settingsv1.ListSettingChangesRequest{Key: models.SettingSiteName})
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 2)
+	assert.EqualValues(t, 2, resp.TotalCount)
+	assert.Equal(t, "Acme Inc", resp.Changes[0].NewValue.GetStringValue(), "newest first")
+	assert.Equal(t, "Acme Corp", resp.Changes[0].OldValue.GetStringValue())
+	assert.IsType(t, &structpb.Value_NullValue{}, resp.Changes[1].OldValue.Kind, "the first change replaced the default")
+	assert.Equal(t, "admin-1", resp.Changes[0].ChangedBy)
+
+	_, err = service.ListSettingChanges(ctx, &settingsv1.ListSettingChangesRequest{Key: "unknown"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSettingsService_UpdateValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *settingsv1.UpdateSettingsRequest
+		code codes.Code
+	}{
+		{"empty site name", &settingsv1.UpdateSettingsRequest{SiteName: proto.String("  ")}, codes.InvalidArgument},
+		{"long site name", &settingsv1.UpdateSettingsRequest{SiteName: proto.String(strings.Repeat("a", maxSiteNameLength+1))}, codes.InvalidArgument},
+		{"relative site URL", &settingsv1.UpdateSettingsRequest{SiteUrl: proto.String("/blog")}, codes.InvalidArgument},
+		{"site URL with query", &settingsv1.UpdateSettingsRequest{SiteUrl: proto.String("https://acme.test/?ref=1")}, codes.InvalidArgument},
+		{"logo scheme", &settingsv1.UpdateSettingsRequest{LogoUrl: proto.String("javascript:alert(1)")}, codes.InvalidArgument},
+		{"protocol relative logo", &settingsv1.UpdateSettingsRequest{LogoUrl: proto.String("//cdn.test/logo.png")}, codes.InvalidArgument},
+		{"long description", &settingsv1.UpdateSettingsRequest{BlogDescription: proto.String(strings.Repeat("a", maxBlogDescriptionLength+1))}, codes.InvalidArgument},
+		{"social network", &settingsv1.UpdateSettingsRequest{SocialLinks: &settingsv1.SocialLinks{Links: []*settingsv1.SocialLink{{Network: "my network", Url: "https://social.example"}}}}, codes.InvalidArgument},
+		{"social URL", &settingsv1.UpdateSettingsRequest{SocialLinks: &settingsv1.SocialLinks{Links: []*settingsv1.SocialLink{{Network: "github", Url: "github.com/acme"}}}}, codes.InvalidArgument},
+		{"missing meta image", &settingsv1.UpdateSettingsRequest{DefaultMetaImage: proto.String("media:missing.png")}, codes.InvalidArgument},
+		{"meta image not an image", &settingsv1.UpdateSettingsRequest{DefaultMetaImage: proto.String("media:terms.pdf")}, codes.InvalidArgument},
+		{"recipient", &settingsv1.UpdateSettingsRequest{ContactRecipients: &settingsv1.ContactRecipients{Emails: []string{"Sales <sales@acme.test>"}}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newSettingsTestService()
+			_, err := service.UpdateSettings(readerContext("admin-1", models.UserRoleAdmin), tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Empty(t, repo.changes)
+		})
+	}
+
+	t.Run("valid values are normalized", func(t *testing.T) {
+		service, _ := newSettingsTestService()
+		settings, err := service.UpdateSettings(readerContext("admin-1", models.UserRoleAdmin), &settingsv1.UpdateSettingsRequest{
+			SiteUrl:          proto.String("https://www.acme.test/"),
+			LogoUrl:          proto.String("/logo.svg"),
+			DefaultMetaImage: proto.String("media:share.png"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "https://www.acme.test", settings.SiteUrl)
+		assert.Equal(t, "/logo.svg", settings.LogoUrl)
+		assert.Equal(t, "https://www.acme.test/uploads/share.png", settings.DefaultMetaImageUrl)
+	})
+
+	t.Run("meta image without media repository", func(t *testing.T) {
+		service := NewSettingsService(newMemorySettingsRepo(), DefaultSiteInfo())
+		_, err := service.UpdateSettings(readerContext("admin-1", models.UserRoleAdmin), &settingsv1.UpdateSettingsRequest{
+			DefaultMetaImage: proto.String("media:share.png"),
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestSettingsService_GetSettingsHidesRecipients(t *testing.T) {
+	service, _ := newSettingsTestService()
+	_, err := service.UpdateSettings(readerContext("admin-1", models.UserRoleAdmin), &settingsv1.UpdateSettingsRequest{
+		ContactRecipients: &settingsv1.ContactRecipients{Emails: []string{"sales@acme.test"}},
+	})
+	require.NoError(t, err)
+
+	public, err := service.GetSettings(context.Background(), &settingsv1.GetSettingsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", public.SiteName)
+	assert.Empty(t, public.ContactRecipients)
+
+	editor, err := service.GetSettings(readerContext("editor-1", models.UserRoleEditor), &settingsv1.GetSettingsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, editor.ContactRecipients)
+
+	admin, err := service.GetSettings(readerContext("admin-1", models.UserRoleAdmin), &settingsv1.GetSettingsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sales@acme.test"}, admin.ContactRecipients)
+}
+
+func TestSettingsService_ContentReadsSettings(t *testing.T) {
+	settings, _ := newSettingsTestService()
+	ctx := readerContext("admin-1", models.UserRoleAdmin)
+	_, err := settings.UpdateSettings(ctx, &settingsv1.UpdateSettingsRequest{
+		SiteName:         proto.String("Acme Corp"),
+		BlogDescription:  proto.String("Notes & news"),
+		DefaultMetaImage: proto.String("media:share.png"),
+		SocialLinks: &settingsv1.SocialLinks{Links: []*settingsv1.SocialLink{
+			{Network: "github", Url: "https://github.com/acme"},
+		}},
+	})
+	require.NoError(t, err)
+
+	post := models.NewBlogPost("Launch week", "launch-week", "Jane Doe")
+	post.SetPublished()
+	content := NewContentService(&memoryPageRepo{}, publishedPostsRepo{&memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}}})
+	content.SetSiteInfo(settings)
+
+	feed, err := content.GetRSSFeed(context.Background(), &contentv1.GetRSSFeedRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, feed.XmlContent, "<title>Acme Corp Blog</title>")
+	assert.Contains(t, feed.XmlContent, "<description>Notes &amp; news</description>")
+	assert.Contains(t, feed.XmlContent, "<link>https://acme.test/blog/launch-week</link>")
+	assert.NotContains(t, feed.XmlContent, "example.com")
+
+	resp, err := content.GetBlogPost(context.Background(), &contentv1.GetBlogPostRequest{Id: post.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.test/uploads/share.png", resp.Meta.OgImageUrl, "posts without images use the default meta image")
+	nodes := jsonLDNodes(t, resp.JsonLd)
+	assert.Equal(t, "https://acme.test/uploads/share.png", nodes["Article"]["image"])
+	assert.Equal(t, "Acme Corp", nodes["Organization"]["name"])
+	assert.Equal(t, []interface{}{"https://github.com/acme"}, nodes["Organization"]["sameAs"])
+}
+
+func TestEmailService_ContactRecipientsFromSettings(t *testing.T) {
+	settings, _ := newSettingsTestService()
+	email := NewEmailService()
+	email.adminEmail = "admin@acme.test"
+	email.SetSiteInfo(settings)
+	email.SetContactRecipients(settings)
+	ctx := context.Background()
+
+	assert.Equal(t, []string{"admin@acme.test"}, email.contactRecipients(ctx), "ADMIN_EMAIL is notified until recipients are set")
+
+	_, err := settings.UpdateSettings(readerContext("admin-1", models.UserRoleAdmin), &settingsv1.UpdateSettingsRequest{
+		SiteName:          proto.String("Acme Corp"),
+		ContactRecipients: &settingsv1.ContactRecipients{Emails: []string{"sales@acme.test", "support@acme.test"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sales@acme.test", "support@acme.test"}, email.contactRecipients(ctx))
+
+	submission := &models.ContactSubmission{Name: "Sam", Email: "sam@example.org", Message: "Hello"}
+	data := contactEmailData{ContactSubmission: submission, SiteName: email.siteName(ctx)}
+	assert.Contains(t, email.generateContactConfirmationText(data), "The Acme Corp Team")
+	html, err := email.generateContactNotificationHTML(data)
+	require.NoError(t, err)
+	assert.Contains(t, html, "your Acme Corp contact form")
+}
@@ -0,0 +1,366 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// robotsDirectives are the robots meta values accepted without an argument
+var robotsDirectives = map[string]bool{
+	"all":          true,
+	"index":        true,
+	"noindex":      true,
+	"follow":       true,
+	"nofollow":     true,
+	"none":         true,
+	"noarchive":    true,
+	"nosnippet":    true,
+	"noimageindex": true,
+	"notranslate":  true,
+}
+
+// robotsArgumentDirectives are the robots meta values that take an argument, e.g. "max-snippet:50"
+var robotsArgumentDirectives = []string{"max-snippet:", "max-image-preview:", "max-video-preview:", "unavailable_after:"}
+
+// SiteInfo describes the website for structured data, feeds and emails
+type SiteInfo struct {
+	Name string
+	// URL is the public base URL of the website, without a trailing slash
+	URL         string
+	LogoURL     string
+	Description string
+	SocialLinks []models.SocialLink
+	// DefaultImageURL is the absolute URL of the image used when content has none
+	DefaultImageURL string
+}
+
+// SiteInfoSource provides the current site information. SettingsService reads it from the
+// site settings; a SiteInfo value is a source that never changes.
+type SiteInfoSource interface {
+	SiteInfo(ctx context.Context) SiteInfo
+}
+
+// SiteInfo returns the site information with the trailing slash removed from the URL
+func (s SiteInfo) SiteInfo(ctx context.Context) SiteInfo {
+	s.URL = strings.TrimRight(s.URL, "/")
+	return s
+}
+
+// DefaultSiteInfo returns the site information used until SetSiteInfo is called
+func DefaultSiteInfo() SiteInfo {
+	return SiteInfo{
+		Name:        "SaaS Startup Platform",
+		URL:         "https://example.com",
+		Description: "Latest insights, tutorials, and updates from our team",
+	}
+}
+
+// SetSiteInfo sets the source of the site name, base URL and logo used for canonical
+// URLs, JSON-LD and the RSS feed
+func (s *ContentService) SetSiteInfo(site SiteInfoSource) {
+	s.site = site
+}
+
+// SetMediaRepository enables media references in content, such as Open Graph images.
+// When unset, saving content that references media returns FailedPrecondition.
+func (s *ContentService) SetMediaRepository(repo repository.MediaRepository) {
+	s.mediaRepo = repo
+}
+
+// SetUserRepository enables resolving post authors to display names for structured data
+func (s *ContentService) SetUserRepository(repo repository.UserRepository) {
+	s.userRepo = repo
+}
+
+// siteInfo returns the current site information or the defaults
+func (s *ContentService) siteInfo(ctx context.Context) SiteInfo {
+	if s.site == nil {
+		return DefaultSiteInfo()
+	}
+	return s.site.SiteInfo(ctx)
+}
+
+// normalizeMeta validates the extended meta fields and normalizes robots directives
+func (s *ContentService) normalizeMeta(ctx context.Context, meta *models.Meta) error {
+	if meta.CanonicalURL != "" {
+		u, err := url.Parse(meta.CanonicalURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return status.Errorf(codes.InvalidArgument, "canonical URL must be an absolute http(s) URL")
+		}
+	}
+
+	if meta.Robots != "" {
+		var directives []string
+		for _, directive := range strings.Split(meta.Robots, ",") {
+			directive = strings.ToLower(strings.TrimSpace(directive))
+			if directive == "" {
+				continue
+			}
+			if !isRobotsDirective(directive) {
+				return status.Errorf(codes.InvalidArgument, "unknown robots directive '%s'", directive)
+			}
+			directives = append(directives, directive)
+		}
+		meta.Robots = strings.Join(directives, ", ")
+	}
+
+	if meta.OGImage != "" {
+		if s.mediaRepo == nil {
+			return status.Errorf(codes.FailedPrecondition, "media references are not configured")
+		}
+		media, err := s.mediaRepo.GetByID(ctx, meta.OGImage)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "og image media '%s' does not exist", meta.OGImage)
+		}
+		if !strings.HasPrefix(media.MimeType, "image/") {
+			return status.Errorf(codes.InvalidArgument, "og image media '%s' is not an image", meta.OGImage)
+		}
+	}
+	return nil
+}
+
+func isRobotsDirective(directive string) bool {
+	if robotsDirectives[directive] {
+		return true
+	}
+	for _, prefix := range robotsArgumentDirectives {
+		if strings.HasPrefix(directive, prefix) && len(directive) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// attachPageStructuredData resolves the Open Graph image and sets the JSON-LD of a page response
+func (s *ContentService) attachPageStructuredData(ctx context.Context, page *models.Page, protoPage *contentv1.Page) {
+	site := s.siteInfo(ctx)
+	pageURL := site.URL + "/" + page.Slug
+	imageURL := s.resolveOGImage(ctx, site, page.Meta, protoPage.Meta)
+	if imageURL == "" {
+		imageURL = site.DefaultImageURL
+		protoPage.Meta.OgImageUrl = imageURL
+	}
+
+	webPage := map[string]interface{}{
+		"@type":       "WebPage",
+		"@id":         canonicalURL(page.Meta, pageURL),
+		"url":         canonicalURL(page.Meta, pageURL),
+		"name":        firstNonEmpty(page.Meta.Title, page.Title),
+		"description": page.Meta.Description,
+		"publisher":   map[string]interface{}{"@id": site.URL + "/#organization"},
+		"breadcrumb":  map[string]interface{}{"@id": pageURL + "#breadcrumb"},
+	}
+	if imageURL != "" {
+		webPage["image"] = imageURL
+	}
+	if !page.Visibility.IsPublic() {
+		webPage["isAccessibleForFree"] = false
+	}
+
+	breadcrumbs := breadcrumbList(pageURL+"#breadcrumb",
+		breadcrumb{name: "Home", url: site.URL + "/"},
+		breadcrumb{name: firstNonEmpty(page.Title, page.Slug), url: pageURL},
+	)
+	protoPage.JsonLd = s.marshalJSONLD(site, webPage, breadcrumbs)
+}
+
+// attachPostStructuredData resolves the Open Graph image and sets the JSON-LD of a blog post response
+func (s *ContentService) attachPostStructuredData(ctx context.Context, post *models.BlogPost, protoPost *contentv1.BlogPost) {
+	site := s.siteInfo(ctx)
+	postURL := site.URL + "/blog/" + post.Slug
+	imageURL := s.resolveOGImage(ctx, site, post.Meta, protoPost.Meta)
+	if imageURL == "" && protoPost.FeaturedImageMedia != nil {
+		imageURL = absoluteURL(site.URL, protoPost.FeaturedImageMedia.Url)
+	}
+	if imageURL == "" {
+		imageURL = site.DefaultImageURL
+		protoPost.Meta.OgImageUrl = imageURL
+	}
+
+	article := map[string]interface{}{
+		"@type":            "Article",
+		"@id":              postURL + "#article",
+		"headline":         firstNonEmpty(post.Meta.Title, post.Title),
+		"description":      firstNonEmpty(post.Meta.Description, post.Excerpt),
+		"url":              canonicalURL(post.Meta, postURL),
+		"mainEntityOfPage": canonicalURL(post.Meta, postURL),
+		"dateModified":     post.UpdatedAt.UTC().Format(time.RFC3339),
+		"author":           s.structuredDataAuthor(ctx, post, site),
+		"publisher":        map[string]interface{}{"@id": site.URL + "/#organization"},
+	}
+	if post.PublishedAt != nil {
+		article["datePublished"] = post.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	if imageURL != "" {
+		article["image"] = imageURL
+	}
+	if post.Meta.Keywords != "" {
+		article["keywords"] = post.Meta.Keywords
+	}
+	if len(post.Categories) > 0 {
+		article["articleSection"] = post.Categories[0]
+	}
+	if !post.Visibility.IsPublic() {
+		article["isAccessibleForFree"] = false
+	}
+
+	breadcrumbs := breadcrumbList(postURL+"#breadcrumb",
+		breadcrumb{name: "Home", url: site.URL + "/"},
+		breadcrumb{name: "Blog", url: site.URL + "/blog"},
+		breadcrumb{name: firstNonEmpty(post.Title, post.Slug), url: postURL},
+	)
+	protoPost.JsonLd = s.marshalJSONLD(site, article, breadcrumbs)
+}
+
+// resolveOGImage sets og_image_url on the response and returns it; missing media is logged and skipped
+func (s *ContentService) resolveOGImage(ctx context.Context, site SiteInfo, meta models.Meta, protoMeta *contentv1.PageMeta) string {
+	if meta.OGImage == "" || s.mediaRepo == nil {
+		return ""
+	}
+	media, err := s.mediaRepo.GetByID(ctx, meta.OGImage)
+	if err != nil {
+		logger.Error("Failed to resolve og image", err, "media_id", meta.OGImage)
+		return ""
+	}
+	imageURL := absoluteURL(site.URL, media.URL)
+	protoMeta.OgImageUrl = imageURL
+	return imageURL
+}
+
+// structuredDataAuthor returns the schema.org author of a post. Authors stored as user IDs
+// or emails are resolved to display names; email addresses are never published.
+func (s *ContentService) structuredDataAuthor(ctx context.Context, post *models.BlogPost, site SiteInfo) map[string]interface{} {
+	name := post.Author
+	if s.userRepo != nil && name != "" {
+		var user *models.User
+		var err error
+		if strings.Contains(name, "@") {
+			user, err = s.userRepo.GetByEmail(ctx, name)
+		} else {
+			user, err = s.userRepo.GetByID(ctx, name)
+		}
+		if err == nil {
+			name = user.Profile.Name
+		}
+	}
+	if name == "" || strings.Contains(name, "@") {
+		return map[string]interface{}{"@id": site.URL + "/#organization"}
+	}
+	return map[string]interface{}{"@type": "Person", "name": name}
+}
+
+// marshalJSONLD wraps the given nodes and the site organization in a schema.org @graph
+func (s *ContentService) marshalJSONLD(site SiteInfo, nodes ...map[string]interface{}) string {
+	organization := map[string]interface{}{
+		"@type": "Organization",
+		"@id":   site.URL + "/#organization",
+		"name":  site.Name,
+		"url":   site.URL,
+	}
+	if site.LogoURL != "" {
+		organization["logo"] = absoluteURL(site.URL, site.LogoURL)
+	}
+	if len(site.SocialLinks) > 0 {
+		profiles := make([]string, len(site.SocialLinks))
+		for i, link := range site.SocialLinks {
+			profiles[i] = link.URL
+		}
+		organization["sameAs"] = profiles
+	}
+
+	graph := append([]map[string]interface{}{}, nodes...)
+	graph = append(graph, organization)
+	data, err := json.Marshal(map[string]interface{}{
+		"@context": "https://schema.org",
+		"@graph":   graph,
+	})
+	if err != nil {
+		logger.Error("Failed to marshal JSON-LD", err)
+		return ""
+	}
+	return string(data)
+}
+
+// breadcrumb is one level of a BreadcrumbList
+type breadcrumb struct {
+	name string
+	url  string
+}
+
+// breadcrumbList builds a BreadcrumbList from the home page down to the item
+func breadcrumbList(id string, crumbs ...breadcrumb) map[string]interface{} {
+	items := make([]map[string]interface{}, len(crumbs))
+	for i, crumb := range crumbs {
+		items[i] = map[string]interface{}{
+			"@type":    "ListItem",
+			"position": i + 1,
+			"name":     crumb.name,
+			"item":     crumb.url,
+		}
+	}
+	return map[string]interface{}{
+		"@type":           "BreadcrumbList",
+		"@id":             id,
+		"itemListElement": items,
+	}
+}
+
+// canonicalURL returns the meta canonical URL, falling back to the item's own URL
+func canonicalURL(meta models.Meta, fallback string) string {
+	if meta.CanonicalURL != "" {
+		return meta.CanonicalURL
+	}
+	return fallback
+}
+
+// absoluteURL resolves a site-relative path such as "/uploads/a.png" against the site URL
+func absoluteURL(siteURL, ref string) string {
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		return ref
+	}
+	return siteURL + "/" + strings.TrimLeft(ref, "/")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// convertModelTwitterCardToProto converts a model Twitter card type to proto
+func (s *ContentService) convertModelTwitterCardToProto(card string) contentv1.TwitterCardType {
+	switch card {
+	case models.TwitterCardSummary:
+		return contentv1.TwitterCardType_TWITTER_CARD_TYPE_SUMMARY
+	case models.TwitterCardSummaryLargeImage:
+		return contentv1.TwitterCardType_TWITTER_CARD_TYPE_SUMMARY_LARGE_IMAGE
+	default:
+		return contentv1.TwitterCardType_TWITTER_CARD_TYPE_UNSPECIFIED
+	}
+}
+
+// convertProtoTwitterCardToModel converts a proto Twitter card type to the model value
+func (s *ContentService) convertProtoTwitterCardToModel(card contentv1.TwitterCardType) string {
+	switch card {
+	case contentv1.TwitterCardType_TWITTER_CARD_TYPE_SUMMARY:
+		return models.TwitterCardSummary
+	case contentv1.TwitterCardType_TWITTER_CARD_TYPE_SUMMARY_LARGE_IMAGE:
+		return models.TwitterCardSummaryLargeImage
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memoryUserRepo serves users by ID and email
+type memoryUserRepo struct {
+	repository.UserRepository
+	users []*models.User
+}
+
+func (r *memoryUserRepo) GetByID(ctx context.Context, id string) (*models.User, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryUserRepo) GetByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	var out []*models.User
+	for _, id := range ids {
+		if user, err := r.GetByID(ctx, id); err == nil {
+			out = append(out, user)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryUserRepo) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func newStructuredDataTestService() *ContentService {
+	post := models.NewBlogPost("Launch week", "launch-week", "jane@example.com")
+	post.SetPublished()
+	post.PublishedAt = timePtr(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	post.Categories = []string{"News"}
+	post.Meta = models.Meta{
+		Description: "Everything we shipped",
+		OGImage:     "media:share.png",
+		TwitterCard: models.TwitterCardSummaryLargeImage,
+	}
+
+	page := models.NewPage("Pricing", "pricing")
+	page.Meta = models.Meta{Title: "Pricing plans", CanonicalURL: "https://example.org/pricing"}
+
+	service := NewContentService(
+		&memoryPageRepo{pages: []*models.Page{page}},
+		&memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}},
+	)
+	service.SetMediaRepository(newMemoryMediaRepo(
+		models.NewMedia("share.png", "share.png", "image/png", "user-1", 1),
+		models.NewMedia("terms.pdf", "terms.pdf", "application/pdf", "user-1", 1),
+	))
+	service.SetUserRepository(&memoryUserRepo{users: []*models.User{
+		{ID: "user-1", Email: "jane@example.com", Profile: models.Profile{Name: "Jane Doe"}},
+	}})
+	service.SetSiteInfo(SiteInfo{Name: "Acme", URL: "https://acme.test/", LogoURL: "/logo.png"})
+	return service
+}
+
+// jsonLDNodes decodes a JSON-LD @graph keyed by node type
+func jsonLDNodes(t *testing.T, doc string) map[string]map[string]interface{} {
+	var decoded struct {
+		Context string                   `json:"@context"`
+		Graph   []map[string]interface{} `json:"@graph"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(doc), &decoded))
+	assert.Equal(t, "https://schema.org", decoded.Context)
+
+	nodes := map[string]map[string]interface{}{}
+	for _, node := range decoded.Graph {
+		nodes[node["@type"].(string)] = node
+	}
+	return nodes
+}
+
+func TestContentService_BlogPostStructuredData(t *testing.T) {
+	service := newStructuredDataTestService()
+
+	post, err := service.GetBlogPost(context.Background(), &contentv1.GetBlogPostRequest{Id: "blog:launch-week"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.test/uploads/share.png", post.Meta.OgImageUrl)
+	assert.Equal(t, contentv1.TwitterCardType_TWITTER_CARD_TYPE_SUMMARY_LARGE_IMAGE, post.Meta.TwitterCard)
+
+	nodes := jsonLDNodes(t, post.JsonLd)
+	article := nodes["Article"]
+	require.NotNil(t, article)
+	assert.Equal(t, "Launch week", article["headline"])
+	assert.Equal(t, "Everything we shipped", article["description"])
+	assert.Equal(t, "https://acme.test/blog/launch-week", article["url"])
+	assert.Equal(t, "2026-03-01T09:00:00Z", article["datePublished"])
+	assert.Equal(t, "https://acme.test/uploads/share.png", article["image"])
+	assert.Equal(t, "News", article["articleSection"])
+	assert.Equal(t, map[string]interface{}{"@type": "Person", "name": "Jane Doe"}, article["author"])
+
+	crumbs := nodes["BreadcrumbList"]["itemListElement"].([]interface{})
+	require.Len(t, crumbs, 3)
+	assert.Equal(t, "https://acme.test/blog", crumbs[1].(map[string]interface{})["item"])
+
+	organization := nodes["Organization"]
+	assert.Equal(t, "Acme", organization["name"])
+	assert.Equal(t, "https://acme.test/logo.png", organization["logo"])
+}
+
+func TestContentService_PageStructuredData(t *testing.T) {
+	service := newStructuredDataTestService()
+
+	page, err := service.GetPage(context.Background(), &contentv1.GetPageRequest{Id: "page:pricing"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.org/pricing", page.Meta.CanonicalUrl)
+
+	nodes := jsonLDNodes(t, page.JsonLd)
+	assert.Equal(t, "https://example.org/pricing", nodes["WebPage"]["url"], "canonical URL overrides the page URL")
+	assert.Equal(t, "Pricing plans", nodes["WebPage"]["name"])
+	assert.Len(t, nodes["BreadcrumbList"]["itemListElement"], 2)
+	assert.NotNil(t, nodes["Organization"])
+}
+
+func TestContentService_StructuredDataAuthorPrivacy(t *testing.T) {
+	service := newStructuredDataTestService()
+	site := service.siteInfo(context.Background())
+
+	unknown := models.NewBlogPost("Post", "post", "someone@example.com")
+	assert.Equal(t, map[string]interface{}{"@id": "https://acme.test/#organization"},
+		service.structuredDataAuthor(context.Background(), unknown, site), "unresolved emails are not published")
+
+	named := models.NewBlogPost("Post", "post", "Guest Writer")
+	assert.Equal(t, map[string]interface{}{"@type": "Person", "name": "Guest Writer"},
+		service.structuredDataAuthor(context.Background(), named, site))
+}
+
+func TestContentService_NormalizeMeta(t *testing.T) {
+	service := newStructuredDataTestService()
+	ctx := context.Background()
+
+	meta := models.Meta{Robots: " NoIndex,nofollow , max-snippet:50", OGImage: "media:share.png"}
+	require.NoError(t, service.normalizeMeta(ctx, &meta))
+	assert.Equal(t, "noindex, nofollow, max-snippet:50", meta.Robots)
+
+	for name, invalid := range map[string]models.Meta{
+		"unknown robots":     {Robots: "noindex, hide"},
+		"relative canonical": {CanonicalURL: "/pricing"},
+		"missing og image":   {OGImage: "media:nope.png"},
+		"og image not image": {OGImage: "media:terms.pdf"},
+	} {
+		err := service.normalizeMeta(ctx, &invalid)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	withoutMedia := NewContentService(nil, nil)
+	err := withoutMedia.normalizeMeta(ctx, &models.Meta{OGImage: "media:share.png"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
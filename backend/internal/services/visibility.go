@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+// maxTeaserBlocks bounds the teaser so it cannot be used to publish a whole gated page
+const maxTeaserBlocks = 20
+
+// contentReader is the caller of a read endpoint, resolved once per request. Group
+// memberships are not part of the token, so they are looked up on the first check
+// that needs them.
+type contentReader struct {
+	ctx      context.Context
+	userID   string
+	role     string
+	userRepo repository.UserRepository
+
+	groupsOnce sync.Once
+	groups     map[string]bool
+}
+
+func newContentReader(ctx context.Context, userRepo repository.UserRepository) *contentReader {
+	userID, _ := ctx.Value("user_id").(string)
+	role, _ := ctx.Value("user_role").(string)
+	return &contentReader{ctx: ctx, userID: userID, role: role, userRepo: userRepo}
+}
+
+// canRead reports whether the reader may see the full content behind a visibility rule.
+// Editors and admins manage gated content, so they can always read it.
+func (r *contentReader) canRead(v models.Visibility) bool {
+	if v.IsPublic() {
+		return true
+	}
+	if r.userID == "" {
+		return false
+	}
+	if v.Level == models.VisibilityAuthenticated || r.role == models.UserRoleAdmin || r.role == models.UserRoleEditor {
+		return true
+	}
+	for _, role := range v.Roles {
+		if role == r.role {
+			return true
+		}
+	}
+	if len(v.Groups) == 0 {
+		return false
+	}
+	groups := r.loadGroups()
+	for _, group := range v.Groups {
+		if groups[strings.ToLower(group)] {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *contentReader) loadGroups() map[string]bool {
+	r.groupsOnce.Do(func() {
+		r.groups = map[string]bool{}
+		if r.userRepo == nil {
+			return
+		}
+		user, err := r.userRepo.GetByID(r.ctx, r.userID)
+		if err != nil {
+			logger.Error("Failed to load user groups for gated content", err, "user_id", r.userID)
+			return
+		}
+		for _, group := range user.Groups {
+			r.groups[strings.ToLower(group)] = true
+		}
+	})
+	return r.groups
+}
+
+// gatePages replaces the content of pages the caller may not read with their teaser
+func (s *ContentService) gatePages(ctx context.Context, pages ...*contentv1.Page) {
+	reader := newContentReader(ctx, s.userRepo)
+	for _, page := range pages {
+		visibility := s.convertProtoVisibilityToModel(page.Visibility)
+		if !reader.canRead(visibility) {
+			page.Content = teaserContent(page.Content, visibility)
+			page.Teaser = true
+		}
+	}
+}
+
+// gatePosts replaces the content of posts the caller may not read with their teaser;
+// the excerpt is kept
+func (s *ContentService) gatePosts(ctx context.Context, posts ...*contentv1.BlogPost) {
+	reader := newContentReader(ctx, s.userRepo)
+	for _, post := range posts {
+		visibility := s.convertProtoVisibilityToModel(post.Visibility)
+		if !reader.canRead(visibility) {
+			post.Content = teaserContent(post.Content, visibility)
+			post.Teaser = true
+		}
+	}
+}
+
+func teaserContent(content *contentv1.PageContent, visibility models.Visibility) *contentv1.PageContent {
+	n := visibility.TeaserBlocks
+	if content == nil || n <= 0 {
+		return &contentv1.PageContent{}
+	}
+	if n > len(content.Blocks) {
+		n = len(content.Blocks)
+	}
+	return &contentv1.PageContent{Blocks: content.Blocks[:n]}
+}
+
+func (s *ContentService) validateVisibility(v *contentv1.Visibility) error {
+	if v == nil {
+		return nil
+	}
+	if v.TeaserBlocks < 0 || v.TeaserBlocks > maxTeaserBlocks {
+		return status.Errorf(codes.InvalidArgument, "teaser_blocks must be between 0 and %d", maxTeaserBlocks)
+	}
+	for _, role := range v.Roles {
+		switch role {
+		case models.UserRoleAdmin, models.UserRoleEditor, models.UserRoleViewer:
+		default:
+			return status.Errorf(codes.InvalidArgument, "unknown role '%s'", role)
+		}
+	}
+	if v.Level == contentv1.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED && len(v.Roles) == 0 && len(v.Groups) == 0 {
+		return status.Errorf(codes.InvalidArgument, "restricted visibility requires at least one role or group")
+	}
+	return nil
+}
+
+func (s *ContentService) convertProtoVisibilityToModel(v *contentv1.Visibility) models.Visibility {
+	if v == nil {
+		return models.Visibility{}
+	}
+	visibility := models.Visibility{TeaserBlocks: int(v.TeaserBlocks)}
+	switch v.Level {
+	case contentv1.VisibilityLevel_VISIBILITY_LEVEL_AUTHENTICATED:
+		visibility.Level = models.VisibilityAuthenticated
+	case contentv1.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED:
+		visibility.Level = models.VisibilityRestricted
+		visibility.Roles = trimmedValues(v.Roles)
+		visibility.Groups = trimmedValues(v.Groups)
+	default:
+		visibility.Level = models.VisibilityPublic
+	}
+	return visibility
+}
+
+func (s *ContentService) convertModelVisibilityToProto(v models.Visibility) *contentv1.Visibility {
+	visibility := &contentv1.Visibility{
+		Level:        contentv1.VisibilityLevel_VISIBILITY_LEVEL_PUBLIC,
+		Roles:        v.Roles,
+		Groups:       v.Groups,
+		TeaserBlocks: int32(v.TeaserBlocks),
+	}
+	switch v.Level {
+	case models.VisibilityAuthenticated:
+		visibility.Level = contentv1.VisibilityLevel_VISIBILITY_LEVEL_AUTHENTICATED
+	case models.VisibilityRestricted:
+		visibility.Level = contentv1.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED
+	}
+	return visibility
+}
+
+// trimmedValues drops blank values and surrounding whitespace
+func trimmedValues(values []string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// hideGatedMatches drops search results the reader may not read unless every query term
+// appears in what the teaser shows, so searches cannot probe text behind the teaser
+func (s *ContentService) hideGatedMatches(ctx context.Context, posts []*models.BlogPost, query string) []*models.BlogPost {
+	reader := newContentReader(ctx, s.userRepo)
+	terms := strings.Fields(strings.ToLower(query))
+	var visible []*models.BlogPost
+	for _, post := range posts {
+		if reader.canRead(post.Visibility) || teaserMatches(post, terms) {
+			visible = append(visible, post)
+		}
+	}
+	return visible
+}
+
+func teaserMatches(post *models.BlogPost, terms []string) bool {
+	parts := []string{post.Title, post.Excerpt, post.Meta.Title, post.Meta.Description}
+	parts = append(parts, post.Categories...)
+	parts = append(parts, post.Tags...)
+	for _, block := range post.Visibility.Teaser(post.Content).Blocks {
+		for _, value := range block.Data {
+			if text, ok := value.(string); ok {
+				parts = append(parts, text)
+			}
+		}
+	}
+	text := strings.ToLower(strings.Join(parts, " "))
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+)
+
+func gatedTestBlocks() models.Content {
+	return models.Content{Blocks: []models.ContentBlock{
+		{Type: "text", Data: map[string]interface{}{"text": "Why premium guides matter"}},
+		{Type: "text", Data: map[string]interface{}{"text": "Step one: the secret sauce"}},
+		{Type: "text", Data: map[string]interface{}{"text": "Step two: more secrets"}},
+	}}
+}
+
+func newVisibilityTestService() *ContentService {
+	public := models.NewPage("Pricing", "pricing")
+	public.Content = gatedTestBlocks()
+	members := models.NewPage("Members guide", "members-guide")
+	members.Content = gatedTestBlocks()
+	members.Visibility = models.Visibility{Level: models.VisibilityAuthenticated, TeaserBlocks: 1}
+	partners := models.NewPage("Partner playbook", "partner-playbook")
+	partners.Content = gatedTestBlocks()
+	partners.Visibility = models.Visibility{Level: models.VisibilityRestricted, Groups: []string{"Partners"}}
+	for _, page := range []*models.Page{public, members, partners} {
+		page.Status = models.PageStatusPublished
+	}
+
+	post := models.NewBlogPost("Premium deep dive", "premium-deep-dive", "user-1")
+	post.Excerpt = "A members-only walkthrough"
+	post.Content = gatedTestBlocks()
+	post.Visibility = models.Visibility{Level: models.VisibilityRestricted, Roles: []string{models.UserRoleViewer}, TeaserBlocks: 2}
+	post.SetPublished()
+
+	service := NewContentService(&memoryPageRepo{pages: []*models.Page{public, members, partners}}, &memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}})
+	service.SetUserRepository(&memoryUserRepo{users: []*models.User{
+		{ID: "partner", Role: models.UserRoleViewer, Groups: []string{"partners"}},
+		{ID: "customer", Role: models.UserRoleViewer},
+	}})
+	return service
+}
+
+func readerContext(userID, role string) context.Context {
+	ctx := context.WithValue(context.Background(), "user_id", userID)
+	return context.WithValue(ctx, "user_role", role)
+}
+
+func TestContentService_GatedPagesReturnTeasers(t *testing.T) {
+	service := newVisibilityTestService()
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		id     string
+		teaser bool
+		blocks int
+	}{
+		{name: "public page for guests", ctx: context.Background(), id: "page:pricing", blocks: 3},
+		{name: "members page for guests", ctx: context.Background(), id: "page:members-guide", teaser: true, blocks: 1},
+		{name: "members page for customers", ctx: readerContext("customer", models.UserRoleViewer), id: "page:members-guide", blocks: 3},
+		{name: "partner page for customers", ctx: readerContext("customer", models.UserRoleViewer), id: "page:partner-playbook", teaser: true, blocks: 0},
+		{name: "partner page for partners", ctx: readerContext("partner", models.UserRoleViewer), id: "page:partner-playbook", blocks: 3},
+		{name: "partner page for editors", ctx: readerContext("editor", models.UserRoleEditor), id: "page:partner-playbook", blocks: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := service.GetPage(tt.ctx, &contentv1.GetPageRequest{Id: tt.id})
+			require.NoError(t, err)
+			assert.Equal(t, tt.teaser, page.Teaser)
+			assert.Len(t, page.Content.Blocks, tt.blocks)
+		})
+	}
+
+	pages, err := service.ListPages(context.Background(), &contentv1.ListPagesRequest{Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED})
+	require.NoError(t, err)
+	require.Len(t, pages.Pages, 3)
+	for _, page := range pages.Pages {
+		assert.Equal(t, page.Slug != "pricing", page.Teaser, page.Slug)
+	}
+}
+
+func TestContentService_GatedPostsKeepExcerptAndFirstBlocks(t *testing.T) {
+	service := newVisibilityTestService()
+
+	post, err := service.GetBlogPost(context.Background(), &contentv1.GetBlogPostRequest{Id: "blog:premium-deep-dive"})
+	require.NoError(t, err)
+	assert.True(t, post.Teaser)
+	assert.Equal(t, "A members-only walkthrough", post.Excerpt)
+	require.Len(t, post.Content.Blocks, 2)
+	assert.Equal(t, "Step one: the secret sauce", post.Content.Blocks[1].Data["text"])
+	assert.Contains(t, post.JsonLd, `"isAccessibleForFree":false`)
+
+	post, err = service.GetBlogPost(readerContext("customer", models.UserRoleViewer), &contentv1.GetBlogPostRequest{Id: "blog:premium-deep-dive"})
+	require.NoError(t, err)
+	assert.False(t, post.Teaser)
+	assert.Len(t, post.Content.Blocks, 3)
+}
+
+func TestContentService_SearchDoesNotMatchGatedContent(t *testing.T) {
+	service := newVisibilityTestService()
+	list := func(ctx context.Context, search string) []string {
+		resp, err := service.ListPages(ctx, &contentv1.ListPagesRequest{Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED, Search: search})
+		require.NoError(t, err)
+		var slugs []string
+		for _, page := range resp.Pages {
+			slugs = append(slugs, page.Slug)
+		}
+		return slugs
+	}
+
+	// The secret is in the second block, which only the public page shows to guests
+	assert.Equal(t, []string{"pricing"}, list(context.Background(), "secret sauce"))
+	// The first block is part of the members page teaser
+	assert.ElementsMatch(t, []string{"pricing", "members-guide"}, list(context.Background(), "premium guides"))
+	assert.ElementsMatch(t, []string{"pricing", "members-guide", "partner-playbook"}, list(readerContext("partner", models.UserRoleViewer), "secret sauce"))
+
+	posts := []*models.BlogPost{{Title: "Premium deep dive", Content: gatedTestBlocks(), Visibility: models.Visibility{Level: models.VisibilityAuthenticated}}}
+	assert.Empty(t, service.hideGatedMatches(context.Background(), posts, "secret"))
+	assert.Len(t, service.hideGatedMatches(context.Background(), posts, "deep dive"), 1)
+	assert.Len(t, service.hideGatedMatches(readerContext("customer", models.UserRoleViewer), posts, "secret"), 1)
+}
+
+func TestContentService_ValidatesVisibility(t *testing.T) {
+	service := newVisibilityTestService()
+	ctx := context.Background()
+
+	_, err := service.CreatePage(ctx, &contentv1.CreatePageRequest{
+		Title:      "Nobody",
+		Visibility: &contentv1.Visibility{Level: contentv1.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.CreatePage(ctx, &contentv1.CreatePageRequest{
+		Title:      "Unknown role",
+		Visibility: &contentv1.Visibility{Level: contentv1.VisibilityLevel_VISIBILITY_LEVEL_RESTRICTED, Roles: []string{"owner"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
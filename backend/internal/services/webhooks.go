@@ -0,0 +1,690 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	webhookv1 "github.com/7-solutions/saas-platformbackend/gen/webhook/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+)
+
+const (
+	// webhookMaxAttempts bounds delivery attempts before a delivery is marked failed
+	webhookMaxAttempts = 6
+	// Retry delays double from webhookRetryBaseDelay: 30s, 1m, 2m, 4m, 8m
+	webhookRetryBaseDelay = 30 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+	// webhookDeliveryRetention is how long settled deliveries stay in the log
+	webhookDeliveryRetention = 30 * 24 * time.Hour
+	webhookDispatchBatchSize = 50
+	webhookRequestTimeout    = 10 * time.Second
+	// webhookMaxErrorBody bounds how much of a failing response body is kept in the log
+	webhookMaxErrorBody = 512
+	webhookUserAgent    = "saas-platform-webhooks/1.0"
+)
+
+// Headers sent with every webhook request
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookEventIDHeader   = "X-Webhook-Event-Id"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// EventPublisher receives domain events such as a published post.
+// Publishing never fails the caller; delivery problems are logged.
+type EventPublisher interface {
+	Publish(ctx context.Context, eventType string, data interface{})
+}
+
+// publishEvent publishes an event when a publisher is configured
+func publishEvent(ctx context.Context, publisher EventPublisher, eventType string, data interface{}) {
+	if publisher != nil {
+		publisher.Publish(ctx, eventType, data)
+	}
+}
+
+// EventPublishers fans every event out to several publishers, e.g. webhooks and the content feed
+type EventPublishers []EventPublisher
+
+// Publish publishes the event to each publisher in order
+func (p EventPublishers) Publish(ctx context.Context, eventType string, data interface{}) {
+	for _, publisher := range p {
+		publishEvent(ctx, publisher, eventType, data)
+	}
+}
+
+// SetEventPublisher enables content events such as post.published; nil disables them
+func (s *ContentService) SetEventPublisher(publisher EventPublisher) {
+	s.events = publisher
+}
+
+// SetEventPublisher enables media.uploaded, media.updated and media.deleted events; nil disables them
+func (s *MediaService) SetEventPublisher(publisher EventPublisher) {
+	s.events = publisher
+}
+
+// SetEventPublisher enables contact_submission.created events; nil disables them
+func (s *ContactService) SetEventPublisher(publisher EventPublisher) {
+	s.events = publisher
+}
+
+// deletedContentEvent is the event data of a deleted page or post
+type deletedContentEvent struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// publishPageEvents publishes a page create or update event, followed by page.published
+// when the page has just become published
+func (s *ContentService) publishPageEvents(ctx context.Context, eventType string, page *models.Page, wasPublished bool) {
+	if s.events == nil {
+		return
+	}
+	data := s.convertModelToProto(page)
+	s.events.Publish(ctx, eventType, data)
+	if page.Status == models.PageStatusPublished && !wasPublished {
+		s.events.Publish(ctx, models.WebhookEventPagePublished, data)
+	}
+}
+
+// publishPostEvents publishes a post create or update event, followed by post.published
+// when the post has just become published
+func (s *ContentService) publishPostEvents(ctx context.Context, eventType string, post *models.BlogPost, wasPublished bool) {
+	if s.events == nil {
+		return
+	}
+	data := s.convertBlogModelToProto(post)
+	s.events.Publish(ctx, eventType, data)
+	if post.Status == models.PageStatusPublished && !wasPublished {
+		s.events.Publish(ctx, models.WebhookEventPostPublished, data)
+	}
+}
+
+// webhookEvent is the JSON envelope sent to subscribers
+type webhookEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebhookService manages webhook subscriptions and delivers signed events to them
+type WebhookService struct {
+	webhookv1.UnimplementedWebhookServiceServer
+	repo       repository.WebhookRepository
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu        sync.Mutex
+	inFlight  map[string]bool
+	lastPrune time.Time
+	// pending tracks asynchronous attempts so tests can wait for them
+	pending sync.WaitGroup
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(repo repository.WebhookRepository) *WebhookService {
+	return &WebhookService{
+		repo:       repo,
+		httpClient: &http.Client{Timeout: webhookRequestTimeout},
+		now:        time.Now,
+		inFlight:   make(map[string]bool),
+	}
+}
+
+// SetHTTPClient replaces the client used to deliver webhooks
+func (s *WebhookService) SetHTTPClient(client *http.Client) {
+	s.httpClient = client
+}
+
+// Start retries due deliveries every interval until the context is cancelled
+func (s *WebhookService) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.processDue(ctx)
+			}
+		}
+	}()
+}
+
+// Publish records one delivery per subscribed webhook and attempts them in the background.
+// Deliveries are stored before returning so events survive a restart.
+func (s *WebhookService) Publish(ctx context.Context, eventType string, data interface{}) {
+	// The event outlives the request that caused it
+	ctx = context.WithoutCancel(ctx)
+
+	subs, err := s.repo.ListSubscriptionsForEvent(ctx, eventType)
+	if err != nil {
+		logger.Error("Failed to list webhook subscriptions", err, "event_type", eventType)
+		return
+	}
+	if len(subs) == 0 {
+		return
+	}
+
+	payload, err := marshalWebhookData(data)
+	if err != nil {
+		logger.Error("Failed to marshal webhook event", err, "event_type", eventType)
+		return
+	}
+	event := webhookEvent{
+		ID:        newEventID(),
+		Type:      eventType,
+		CreatedAt: s.now().UTC(),
+		Data:      payload,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("Failed to marshal webhook event", err, "event_type", eventType)
+		return
+	}
+
+	for _, sub := range subs {
+		delivery, err := s.enqueue(ctx, sub.ID, event.ID, eventType, string(body))
+		if err != nil {
+			logger.Error("Failed to record webhook delivery", err, "webhook_id", sub.ID, "event_type", eventType)
+			continue
+		}
+		s.attemptAsync(delivery)
+	}
+}
+
+// enqueue stores a pending delivery that is due immediately
+func (s *WebhookService) enqueue(ctx context.Context, subscriptionID, eventID, eventType, payload string) (*models.WebhookDelivery, error) {
+	now := s.now()
+	delivery := &models.WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         models.WebhookDeliveryPending,
+		NextAttemptAt:  &now,
+	}
+	if err := s.repo.CreateDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// attemptAsync runs one delivery attempt in the background
+func (s *WebhookService) attemptAsync(delivery *models.WebhookDelivery) {
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), webhookRequestTimeout+5*time.Second)
+		defer cancel()
+		s.attempt(ctx, delivery)
+	}()
+}
+
+// processDue attempts every delivery whose retry is due and prunes the log once a day
+func (s *WebhookService) processDue(ctx context.Context) {
+	now := s.now()
+	due, err := s.repo.ListDueDeliveries(ctx, now, webhookDispatchBatchSize)
+	if err != nil {
+		logger.Error("Failed to list due webhook deliveries", err)
+		return
+	}
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		s.attempt(ctx, delivery)
+	}
+
+	s.mu.Lock()
+	prune := now.Sub(s.lastPrune) >= 24*time.Hour
+	if prune {
+		s.lastPrune = now
+	}
+	s.mu.Unlock()
+	if prune {
+		if err := s.repo.PruneDeliveries(ctx, now.Add(-webhookDeliveryRetention)); err != nil {
+			logger.Error("Failed to prune webhook deliveries", err)
+		}
+	}
+}
+
+// attempt sends a delivery once and records the outcome, scheduling a retry on failure.
+// Deliveries already being attempted by this process, or no longer due, are skipped.
+func (s *WebhookService) attempt(ctx context.Context, queued *models.WebhookDelivery) {
+	s.mu.Lock()
+	if s.inFlight[queued.ID] {
+		s.mu.Unlock()
+		return
+	}
+	s.inFlight[queued.ID] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.inFlight, queued.ID)
+		s.mu.Unlock()
+	}()
+
+	// Reload so a delivery settled by a concurrent attempt is not sent twice
+	delivery, err := s.repo.GetDelivery(ctx, queued.ID)
+	if err != nil {
+		logger.Error("Failed to load webhook delivery", err, "delivery_id", queued.ID)
+		return
+	}
+	if delivery.Status != models.WebhookDeliveryPending || delivery.NextAttemptAt == nil || delivery.NextAttemptAt.After(s.now()) {
+		return
+	}
+
+	sub, err := s.repo.GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		logger.Error("Failed to load webhook subscription", err, "webhook_id", delivery.SubscriptionID)
+		return
+	}
+
+	delivery.Attempts++
+	if sub.Active {
+		delivery.ResponseStatus, err = s.send(ctx, sub, delivery)
+	} else {
+		delivery.ResponseStatus, err = 0, fmt.Errorf("webhook is disabled")
+	}
+
+	now := s.now()
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= webhookMaxAttempts || !sub.Active:
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = nil
+	default:
+		next := now.Add(webhookRetryDelay(delivery.Attempts))
+		delivery.Status = models.WebhookDeliveryPending
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = &next
+	}
+
+	if err := s.repo.UpdateDelivery(ctx, delivery); err != nil {
+		logger.Error("Failed to record webhook delivery attempt", err, "delivery_id", delivery.ID)
+	}
+}
+
+// send POSTs the signed payload and returns the response status; non-2xx responses are errors
+func (s *WebhookService) send(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader([]byte(delivery.Payload)))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	timestamp := s.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookEventIDHeader, delivery.EventID)
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID)
+	req.Header.Set(WebhookSignatureHeader, fmt.Sprintf("t=%d,v1=%s", timestamp, SignWebhookPayload(sub.Secret, timestamp, []byte(delivery.Payload))))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, webhookMaxErrorBody))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the hex HMAC-SHA256 of "<timestamp>.<body>" under the secret.
+// Receivers recompute it from the X-Webhook-Signature timestamp and the raw request body.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookRetryDelay returns the backoff after the given number of failed attempts
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookRetryMaxDelay {
+			return webhookRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// marshalWebhookData encodes proto messages with their JSON mapping and anything else with encoding/json
+func marshalWebhookData(data interface{}) (json.RawMessage, error) {
+	if msg, ok := data.(proto.Message); ok {
+		return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	}
+	return json.Marshal(data)
+}
+
+// newEventID returns a random (version 4) UUID
+func newEventID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// newWebhookSecret returns a random signing secret
+func newWebhookSecret() string {
+	var b [32]byte
+	_, _ = rand.Read(b[:])
+	return "whsec_" + hex.EncodeToString(b[:])
+}
+
+// CreateWebhook creates a subscription and returns it with its signing secret
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *webhookv1.CreateWebhookRequest) (*webhookv1.Webhook, error) {
+	eventTypes, err := validateWebhook(req.Name, req.Url, req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	secret := strings.TrimSpace(req.Secret)
+	if secret == "" {
+		secret = newWebhookSecret()
+	} else if len(secret) < 16 {
+		return nil, status.Errorf(codes.InvalidArgument, "secret must be at least 16 characters")
+	}
+
+	userID, _ := ctx.Value("user_id").(string)
+	sub := &models.WebhookSubscription{
+		Name:       strings.TrimSpace(req.Name),
+		URL:        strings.TrimSpace(req.Url),
+		Secret:     secret,
+		EventTypes: eventTypes,
+		Active:     req.Active == nil || req.GetActive(),
+		CreatedBy:  userID,
+	}
+	if err := s.repo.CreateSubscription(ctx, sub); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
+	}
+
+	resp := convertWebhookToProto(sub)
+	resp.Secret = sub.Secret
+	return resp, nil
+}
+
+// GetWebhook returns a subscription without its secret
+func (s *WebhookService) GetWebhook(ctx context.Context, req *webhookv1.GetWebhookRequest) (*webhookv1.Webhook, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook ID is required")
+	}
+	sub, err := s.repo.GetSubscription(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found: %v", err)
+	}
+	return convertWebhookToProto(sub), nil
+}
+
+// ListWebhooks lists subscriptions, oldest first
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *webhookv1.ListWebhooksRequest) (*webhookv1.ListWebhooksResponse, error) {
+	pageSize, skip := webhookPagination(req.PageSize, req.PageToken)
+	// Fetch one extra row to learn whether another page exists
+	subs, err := s.repo.ListSubscriptions(ctx, repository.ListOptions{Limit: pageSize + 1, Skip: skip})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhooks: %v", err)
+	}
+
+	resp := &webhookv1.ListWebhooksResponse{}
+	if len(subs) > pageSize {
+		subs = subs[:pageSize]
+		resp.NextPageToken = strconv.Itoa(skip + pageSize)
+	}
+	for _, sub := range subs {
+		resp.Webhooks = append(resp.Webhooks, convertWebhookToProto(sub))
+	}
+	return resp, nil
+}
+
+// UpdateWebhook replaces a subscription's settings and optionally rotates its secret
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *webhookv1.UpdateWebhookRequest) (*webhookv1.Webhook, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook ID is required")
+	}
+	eventTypes, err := validateWebhook(req.Name, req.Url, req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := s.repo.GetSubscription(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found: %v", err)
+	}
+
+	sub.Name = strings.TrimSpace(req.Name)
+	sub.URL = strings.TrimSpace(req.Url)
+	sub.EventTypes = eventTypes
+	sub.Active = req.Active
+	if req.RotateSecret {
+		sub.Secret = newWebhookSecret()
+	}
+	if err := s.repo.UpdateSubscription(ctx, sub); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+
+	resp := convertWebhookToProto(sub)
+	if req.RotateSecret {
+		resp.Secret = sub.Secret
+	}
+	return resp, nil
+}
+
+// DeleteWebhook deletes a subscription and its delivery log
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *webhookv1.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook ID is required")
+	}
+	if _, err := s.repo.GetSubscription(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found: %v", err)
+	}
+	if err := s.repo.DeleteSubscription(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhookEventTypes lists the event types a subscription can receive
+func (s *WebhookService) ListWebhookEventTypes(ctx context.Context, req *webhookv1.ListWebhookEventTypesRequest) (*webhookv1.ListWebhookEventTypesResponse, error) {
+	return &webhookv1.ListWebhookEventTypesResponse{
+		EventTypes: append([]string(nil), models.WebhookEventTypes...),
+	}, nil
+}
+
+// ListWebhookDeliveries lists the delivery log of a subscription, newest first
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *webhookv1.ListWebhookDeliveriesRequest) (*webhookv1.ListWebhookDeliveriesResponse, error) {
+	if req.WebhookId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook ID is required")
+	}
+	if _, err := s.repo.GetSubscription(ctx, req.WebhookId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found: %v", err)
+	}
+
+	pageSize, skip := webhookPagination(req.PageSize, req.PageToken)
+	deliveries, info, err := s.repo.ListDeliveries(ctx, req.WebhookId, convertProtoDeliveryStatusToModel(req.Status), repository.ListOptions{Limit: pageSize, Skip: skip})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	resp := &webhookv1.ListWebhookDeliveriesResponse{}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, convertWebhookDeliveryToProto(delivery))
+	}
+	if info != nil {
+		resp.NextPageToken = info.NextPageToken
+		resp.TotalCount = int32(info.TotalCount)
+	}
+	return resp, nil
+}
+
+// RedeliverWebhook sends a past delivery's payload again as a new delivery with the same event ID
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, req *webhookv1.RedeliverWebhookRequest) (*webhookv1.WebhookDelivery, error) {
+	if req.DeliveryId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "delivery ID is required")
+	}
+	original, err := s.repo.GetDelivery(ctx, req.DeliveryId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found: %v", err)
+	}
+	sub, err := s.repo.GetSubscription(ctx, original.SubscriptionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found: %v", err)
+	}
+	if !sub.Active {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhook is disabled")
+	}
+
+	delivery, err := s.enqueue(ctx, original.SubscriptionID, original.EventID, original.EventType, original.Payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record webhook delivery: %v", err)
+	}
+	resp := convertWebhookDeliveryToProto(delivery)
+	s.attemptAsync(delivery)
+	return resp, nil
+}
+
+// validateWebhook checks the name and URL and returns the de-duplicated event types
+func validateWebhook(name, rawURL string, eventTypes []string) ([]string, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > 200 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be 200 characters or fewer")
+	}
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url must be an absolute http(s) URL")
+	}
+	if len(eventTypes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one event type is required")
+	}
+
+	seen := make(map[string]bool, len(eventTypes))
+	out := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !models.IsWebhookEventType(eventType) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type '%s'", eventType)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			out = append(out, eventType)
+		}
+	}
+	return out, nil
+}
+
+// webhookPagination applies the default (50) and maximum (100) page size and parses the skip token
+func webhookPagination(pageSize int32, pageToken string) (int, int) {
+	size := int(pageSize)
+	if size <= 0 {
+		size = 50
+	}
+	if size > 100 {
+		size = 100
+	}
+	skip := 0
+	if pageToken != "" {
+		if parsed, err := strconv.Atoi(pageToken); err == nil && parsed > 0 {
+			skip = parsed
+		}
+	}
+	return size, skip
+}
+
+// convertWebhookToProto converts a subscription to proto without its secret
+func convertWebhookToProto(sub *models.WebhookSubscription) *webhookv1.Webhook {
+	return &webhookv1.Webhook{
+		Id:         sub.ID,
+		Name:       sub.Name,
+		Url:        sub.URL,
+		EventTypes: sub.EventTypes,
+		Active:     sub.Active,
+		CreatedBy:  sub.CreatedBy,
+		CreatedAt:  timestamppb.New(sub.CreatedAt),
+		UpdatedAt:  timestamppb.New(sub.UpdatedAt),
+	}
+}
+
+func convertWebhookDeliveryToProto(delivery *models.WebhookDelivery) *webhookv1.WebhookDelivery {
+	out := &webhookv1.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         convertModelDeliveryStatusToProto(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		ResponseStatus: int32(delivery.ResponseStatus),
+		LastError:      delivery.LastError,
+		Payload:        delivery.Payload,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.NextAttemptAt != nil {
+		out.NextAttemptAt = timestamppb.New(*delivery.NextAttemptAt)
+	}
+	if delivery.DeliveredAt != nil {
+		out.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+	return out
+}
+
+func convertModelDeliveryStatusToProto(s string) webhookv1.WebhookDeliveryStatus {
+	switch s {
+	case models.WebhookDeliveryPending:
+		return webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case models.WebhookDeliverySucceeded:
+		return webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case models.WebhookDeliveryFailed:
+		return webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+func convertProtoDeliveryStatusToModel(s webhookv1.WebhookDeliveryStatus) string {
+	switch s {
+	case webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return models.WebhookDeliveryPending
+	case webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return models.WebhookDeliverySucceeded
+	case webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED:
+		return models.WebhookDeliveryFailed
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,488 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	webhookv1 "github.com/7-solutions/saas-platformbackend/gen/webhook/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+)
+
+// memoryWebhookRepo is an in-memory WebhookRepository
+type memoryWebhookRepo struct {
+	mu         sync.Mutex
+	subs       []*models.WebhookSubscription
+	deliveries []*models.WebhookDelivery
+}
+
+func (r *memoryWebhookRepo) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub.ID = fmt.Sprintf("sub-%d", len(r.subs)+1)
+	sub.CreatedAt = time.Now()
+	sub.UpdatedAt = sub.CreatedAt
+	stored := *sub
+	r.subs = append(r.subs, &stored)
+	return nil
+}
+
+func (r *memoryWebhookRepo) GetSubscription(ctx context.Context, id string) (*models.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, sub := range r.subs {
+		if sub.ID == id {
+			copied := *sub
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryWebhookRepo) ListSubscriptions(ctx context.Context, options repository.ListOptions) ([]*models.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := r.subs
+	if options.Skip >= len(out) {
+		return nil, nil
+	}
+	out = out[options.Skip:]
+	if len(out) > options.Limit {
+		out = out[:options.Limit]
+	}
+	return out, nil
+}
+
+func (r *memoryWebhookRepo) ListSubscriptionsForEvent(ctx context.Context, eventType string) ([]*models.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.WebhookSubscription
+	for _, sub := range r.subs {
+		if sub.Subscribes(eventType) {
+			out = append(out, sub)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryWebhookRepo) UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.subs {
+		if existing.ID == sub.ID {
+			stored := *sub
+			r.subs[i] = &stored
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (r *memoryWebhookRepo) DeleteSubscription(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, sub := range r.subs {
+		if sub.ID == id {
+			r.subs = append(r.subs[:i], r.subs[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (r *memoryWebhookRepo) CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delivery.ID = fmt.Sprintf("delivery-%d", len(r.deliveries)+1)
+	delivery.CreatedAt = time.Now()
+	stored := *delivery
+	r.deliveries = append(r.deliveries, &stored)
+	return nil
+}
+
+func (r *memoryWebhookRepo) GetDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, delivery := range r.deliveries {
+		if delivery.ID == id {
+			copied := *delivery
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *memoryWebhookRepo) ListDeliveries(ctx context.Context, subscriptionID, status string, options repository.ListOptions) ([]*models.WebhookDelivery, *repository.PaginationInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.WebhookDelivery
+	for i := len(r.deliveries) - 1; i >= 0; i-- {
+		delivery := r.deliveries[i]
+		if delivery.SubscriptionID == subscriptionID && (status == "" || delivery.Status == status) {
+			copied := *delivery
+			out = append(out, &copied)
+		}
+	}
+	info := &repository.PaginationInfo{TotalCount: len(out)}
+	if options.Skip >= len(out) {
+		return nil, info, nil
+	}
+	out = out[options.Skip:]
+	if len(out) > options.Limit {
+		out = out[:options.Limit]
+		info.HasMore = true
+		info.NextPageToken = strconv.Itoa(options.Skip + options.Limit)
+	}
+	return out, info, nil
+}
+
+func (r *memoryWebhookRepo) ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == models.WebhookDeliveryPending && delivery.NextAttemptAt != nil && !delivery.NextAttemptAt.After(before) {
+			copied := *delivery
+			out = append(out, &copied)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].NextAttemptAt.Before(*out[j].NextAttemptAt) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *memoryWebhookRepo) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.deliveries {
+		if existing.ID == delivery.ID {
+			stored := *delivery
+			r.deliveries[i] = &stored
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (r *memoryWebhookRepo) PruneDeliveries(ctx context.Context, before time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := r.deliveries[:0]
+	for _, delivery := range r.deliveries {
+		if delivery.Status == models.WebhookDeliveryPending || !delivery.CreatedAt.Before(before) {
+			kept = append(kept, delivery)
+		}
+	}
+	r.deliveries = kept
+	return nil
+}
+
+// webhookReceiver records requests and answers with queued status codes, then 200
+type webhookReceiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (rc *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	rc.mu.Lock()
+	rc.requests = append(rc.requests, receivedWebhook{header: r.Header.Clone(), body: body})
+	code := http.StatusOK
+	if len(rc.statuses) > 0 {
+		code, rc.statuses = rc.statuses[0], rc.statuses[1:]
+	}
+	rc.mu.Unlock()
+	w.WriteHeader(code)
+}
+
+func (rc *webhookReceiver) received() []receivedWebhook {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]receivedWebhook(nil), rc.requests...)
+}
+
+// verifyWebhookSignature checks the X-Webhook-Signature header the way a receiver would
+func verifyWebhookSignature(t *testing.T, secret string, req receivedWebhook) {
+	t.Helper()
+	var timestamp int64
+	var signature string
+	for _, part := range strings.Split(req.header.Get(WebhookSignatureHeader), ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp, _ = strconv.ParseInt(value, 10, 64)
+		case "v1":
+			signature = value
+		}
+	}
+	require.NotZero(t, timestamp)
+	assert.Equal(t, SignWebhookPayload(secret, timestamp, req.body), signature)
+}
+
+func newWebhookTestService(t *testing.T, receiver *webhookReceiver) (*WebhookService, *memoryWebhookRepo, string, *time.Time) {
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	repo := &memoryWebhookRepo{}
+	service := NewWebhookService(repo)
+	service.SetHTTPClient(server.Client())
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	return service, repo, server.URL, &now
+}
+
+func TestWebhookService_CreateWebhookValidation(t *testing.T) {
+	service, _, url, _ := newWebhookTestService(t, &webhookReceiver{})
+	ctx := context.Background()
+
+	for name, req := range map[string]*webhookv1.CreateWebhookRequest{
+		"missing name":       {Url: url, EventTypes: []string{models.WebhookEventPostPublished}},
+		"relative url":       {Name: "CI", Url: "/hook", EventTypes: []string{models.WebhookEventPostPublished}},
+		"no events":          {Name: "CI", Url: url},
+		"unknown event type": {Name: "CI", Url: url, EventTypes: []string{"post.liked"}},
+		"short secret":       {Name: "CI", Url: url, EventTypes: []string{models.WebhookEventPostPublished}, Secret: "short"},
+	} {
+		_, err := service.CreateWebhook(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	created, err := service.CreateWebhook(ctx, &webhookv1.CreateWebhookRequest{
+		Name:       "CI",
+		Url:        url,
+		EventTypes: []string{models.WebhookEventPostPublished, models.WebhookEventPostPublished},
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(created.Secret, "whsec_"), "a secret is generated")
+	assert.True(t, created.Active)
+	assert.Equal(t, []string{models.WebhookEventPostPublished}, created.EventTypes)
+
+	fetched, err := service.GetWebhook(ctx, &webhookv1.GetWebhookRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.Empty(t, fetched.Secret, "the secret is only returned on create")
+
+	rotated, err := service.UpdateWebhook(ctx, &webhookv1.UpdateWebhookRequest{
+		Id: created.Id, Name: "CI", Url: url, EventTypes: created.EventTypes, Active: true, RotateSecret: true,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, rotated.Secret)
+	assert.NotEqual(t, created.Secret, rotated.Secret)
+}
+
+func TestWebhookService_PublishDeliversSignedPayload(t *testing.T) {
+	receiver := &webhookReceiver{}
+	service, repo, url, _ := newWebhookTestService(t, receiver)
+	ctx := context.Background()
+
+	created, err := service.CreateWebhook(ctx, &webhookv1.CreateWebhookRequest{
+		Name: "CI", Url: url, EventTypes: []string{models.WebhookEventPostPublished}, Secret: "0123456789abcdef",
+	})
+	require.NoError(t, err)
+	_, err = service.CreateWebhook(ctx, &webhookv1.CreateWebhookRequest{
+		Name: "Paused", Url: url, EventTypes: []string{models.WebhookEventPostPublished}, Active: proto.Bool(false),
+	})
+	require.NoError(t, err)
+
+	service.Publish(ctx, models.WebhookEventPageCreated, map[string]string{"id": "page:about"})
+	service.Publish(ctx, models.WebhookEventPostPublished, &contentv1.BlogPost{Id: "blog:launch", Slug: "launch"})
+	service.pending.Wait()
+
+	requests := receiver.received()
+	require.Len(t, requests, 1, "only the active subscription to the event is called")
+	req := requests[0]
+	verifyWebhookSignature(t, "0123456789abcdef", req)
+	assert.Equal(t, models.WebhookEventPostPublished, req.header.Get(WebhookEventHeader))
+	assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+
+	var event struct {
+		ID   string            `json:"id"`
+		Type string            `json:"type"`
+		Data map[string]string `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(req.body, &event))
+	assert.Equal(t, models.WebhookEventPostPublished, event.Type)
+	assert.Equal(t, req.header.Get(WebhookEventIDHeader), event.ID)
+	assert.Equal(t, "launch", event.Data["slug"])
+
+	deliveries, err := service.ListWebhookDeliveries(ctx, &webhookv1.ListWebhookDeliveriesRequest{WebhookId: created.Id})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 1)
+	delivery := deliveries.Deliveries[0]
+	assert.Equal(t, webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED, delivery.Status)
+	assert.Equal(t, int32(1), delivery.Attempts)
+	assert.Equal(t, int32(http.StatusOK), delivery.ResponseStatus)
+	assert.Equal(t, string(req.body), delivery.Payload)
+	assert.Len(t, repo.deliveries, 1)
+}
+
+func TestWebhookService_RetriesWithBackoff(t *testing.T) {
+	receiver := &webhookReceiver{statuses: []int{http.StatusInternalServerError, http.StatusBadGateway}}
+	service, repo, url, now := newWebhookTestService(t, receiver)
+	ctx := context.Background()
+
+	_, err := service.CreateWebhook(ctx, &webhookv1.CreateWebhookRequest{
+		Name: "CI", Url: url, EventTypes: []string{models.WebhookEventMediaUploaded},
+	})
+	require.NoError(t, err)
+
+	service.Publish(ctx, models.WebhookEventMediaUploaded, map[string]string{"id": "media:a.png"})
+	service.pending.Wait()
+
+	delivery := repo.deliveries[0]
+	assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseStatus)
+	assert.Equal(t, now.Add(30*time.Second), *delivery.NextAttemptAt)
+
+	// Not yet due
+	service.processDue(ctx)
+	assert.Len(t, receiver.received(), 1)
+
+	*now = now.Add(30 * time.Second)
+	service.processDue(ctx)
+	delivery = repo.deliveries[0]
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.Equal(t, now.Add(time.Minute), *delivery.NextAttemptAt, "the delay doubles")
+
+	*now = now.Add(time.Minute)
+	service.processDue(ctx)
+	delivery = repo.deliveries[0]
+	assert.Equal(t, models.WebhookDeliverySucceeded, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Nil(t, delivery.NextAttemptAt)
+	assert.Empty(t, delivery.LastError)
+	assert.Len(t, receiver.received(), 3)
+}
+
+func TestWebhookService_GivesUpAfterMaxAttempts(t *testing.T) {
+	statuses := make([]int, webhookMaxAttempts)
+	for i := range statuses {
+		statuses[i] = http.StatusServiceUnavailable
+	}
+	receiver := &webhookReceiver{statuses: statuses}
+	service, repo, url, now := newWebhookTestService(t, receiver)
+	ctx := context.Background()
+
+	_, err := service.CreateWebhook(ctx, &webhookv1.CreateWebhookRequest{
+		Name: "CI", Url: url, EventTypes: []string{models.WebhookEventContactSubmitted},
+	})
+	require.NoError(t, err)
+
+	service.Publish(ctx, models.WebhookEventContactSubmitted, map[string]string{"id": "contact-1"})
+	service.pending.Wait()
+	for i := 1; i < webhookMaxAttempts; i++ {
+		*now = now.Add(webhookRetryMaxDelay)
+		service.processDue(ctx)
+	}
+
+	delivery := repo.deliveries[0]
+	assert.Equal(t, models.WebhookDeliveryFailed, delivery.Status)
+	assert.Equal(t, webhookMaxAttempts, delivery.Attempts)
+	assert.Nil(t, delivery.NextAttemptAt)
+	assert.Contains(t, delivery.LastError, "unexpected status 503")
+
+	*now = now.Add(webhookRetryMaxDelay)
+	service.processDue(ctx)
+	assert.Len(t, receiver.received(), webhookMaxAttempts, "failed deliveries are not retried")
+}
+
+func TestWebhookService_RedeliverWebhook(t *testing.T) {
+	receiver := &webhookReceiver{}
+	service, repo, url, _ := newWebhookTestService(t, receiver)
+	ctx := context.Background()
+
+	created, err := service.CreateWebhook(ctx, &webhookv1.CreateWebhookRequest{
+		Name: "CI", Url: url, EventTypes: []string{models.WebhookEventPageDeleted},
+	})
+	require.NoError(t, err)
+
+	service.Publish(ctx, models.WebhookEventPageDeleted, deletedContentEvent{ID: "page:old", Slug: "old"})
+	service.pending.Wait()
+	original := repo.deliveries[0]
+
+	redelivery, err := service.RedeliverWebhook(ctx, &webhookv1.RedeliverWebhookRequest{DeliveryId: original.ID})
+	require.NoError(t, err)
+	service.pending.Wait()
+
+	assert.NotEqual(t, original.ID, redelivery.Id)
+	assert.Equal(t, original.EventID, redelivery.EventId)
+
+	requests := receiver.received()
+	require.Len(t, requests, 2)
+	assert.Equal(t, requests[0].body, requests[1].body, "redeliveries send the original payload")
+	assert.Equal(t, redelivery.Id, requests[1].header.Get(WebhookDeliveryHeader))
+	verifyWebhookSignature(t, repo.subs[0].Secret, requests[1])
+
+	_, err = service.UpdateWebhook(ctx, &webhookv1.UpdateWebhookRequest{
+		Id: created.Id, Name: "CI", Url: url, EventTypes: created.EventTypes, Active: false,
+	})
+	require.NoError(t, err)
+	_, err = service.RedeliverWebhook(ctx, &webhookv1.RedeliverWebhookRequest{DeliveryId: original.ID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = service.RedeliverWebhook(ctx, &webhookv1.RedeliverWebhookRequest{DeliveryId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhookRetryDelay(1))
+	assert.Equal(t, time.Minute, webhookRetryDelay(2))
+	assert.Equal(t, 8*time.Minute, webhookRetryDelay(5))
+	assert.Equal(t, webhookRetryMaxDelay, webhookRetryDelay(20))
+}
+
+// recordingPublisher records published event types
+type recordingPublisher struct {
+	events []string
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, eventType string, data interface{}) {
+	p.events = append(p.events, eventType)
+}
+
+func TestContentService_PublishesPostEvents(t *testing.T) {
+	post := models.NewBlogPost("Launch", "launch", "jane@example.com")
+	service := NewContentService(nil, &memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}})
+	publisher := &recordingPublisher{}
+	service.SetEventPublisher(publisher)
+
+	update := &contentv1.UpdateBlogPostRequest{
+		Id:     post.ID,
+		Title:  "Launch",
+		Slug:   "launch",
+		Author: "jane@example.com",
+		Status: contentv1.PageStatus_PAGE_STATUS_PUBLISHED,
+	}
+	_, err := service.UpdateBlogPost(context.Background(), update)
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.WebhookEventPostUpdated, models.WebhookEventPostPublished}, publisher.events)
+
+	publisher.events = nil
+	_, err = service.UpdateBlogPost(context.Background(), update)
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.WebhookEventPostUpdated}, publisher.events, "already published posts only emit updates")
+}
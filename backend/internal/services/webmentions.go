@@ -0,0 +1,542 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commentv1 "github.com/7-solutions/saas-platformbackend/gen/comment/v1"
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/logger"
+	"github.com/7-solutions/saas-platformbackend/internal/utils/spam"
+)
+
+const (
+	webmentionRequestTimeout = 10 * time.Second
+	// webmentionVerifyTimeout bounds the asynchronous verification of one received mention
+	webmentionVerifyTimeout = 30 * time.Second
+	// webmentionSendTimeout bounds sending the mentions of one published post
+	webmentionSendTimeout = 2 * time.Minute
+	// webmentionMaxPageSize bounds source pages and pages fetched for endpoint discovery
+	webmentionMaxPageSize = 1 << 20
+	// webmentionMaxLinks bounds the mentions sent for one post
+	webmentionMaxLinks = 50
+	// webmentionMaxTitleLength bounds the title stored from a source page
+	webmentionMaxTitleLength = 200
+)
+
+var (
+	webmentionAnchorTag  = regexp.MustCompile(`(?i)<a\s[^>]*>`)
+	webmentionLinkTag    = regexp.MustCompile(`(?i)<(?:link|a)\s[^>]*>`)
+	webmentionTitle      = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	webmentionMetaTag    = regexp.MustCompile(`(?i)<meta\s[^>]*>`)
+	webmentionLinkHeader = regexp.MustCompile(`<([^>]*)>([^,]*)`)
+	webmentionRelParam   = regexp.MustCompile(`(?i);\s*rel\s*=\s*(?:"([^"]*)"|([^\s;,]+))`)
+)
+
+// SetWebmentions enables receiving Webmentions for posts under the public base URL of the
+// site. When unset, the Webmention RPCs return FailedPrecondition.
+func (s *CommentService) SetWebmentions(repo repository.WebmentionRepository, site SiteInfoSource) {
+	s.webmentionRepo = repo
+	s.site = site
+}
+
+// SetHTTPClient replaces the client used to fetch the sources of received Webmentions
+func (s *CommentService) SetHTTPClient(client *http.Client) {
+	s.httpClient = client
+}
+
+func (s *CommentService) requireWebmentions() error {
+	if s.webmentionRepo == nil {
+		return status.Errorf(codes.FailedPrecondition, "webmentions are not configured")
+	}
+	return nil
+}
+
+// ReceiveWebmention records that source mentions target, a published post on this site.
+// The source is verified in the background; the mention is returned while still pending.
+// Sending the same mention again, e.g. after the source was edited, verifies it again.
+func (s *CommentService) ReceiveWebmention(ctx context.Context, source, target, ipAddress string) (*models.Webmention, error) {
+	if err := s.requireWebmentions(); err != nil {
+		return nil, err
+	}
+	if s.limiter != nil && !s.limiter.Allow("webmention:"+ipAddress) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many webmentions, please try again later")
+	}
+
+	sourceURL, ok := parseHTTPURL(source)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "source must be an http or https URL")
+	}
+	targetURL, ok := parseHTTPURL(target)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "target must be an http or https URL")
+	}
+	if sameDocument(sourceURL, targetURL) {
+		return nil, status.Errorf(codes.InvalidArgument, "source and target must differ")
+	}
+
+	post, err := s.mentionedPost(ctx, targetURL)
+	if err != nil {
+		return nil, err
+	}
+
+	mention := models.NewWebmention(post.ID, sourceURL.String(), targetURL.String())
+	mention.IPAddress = ipAddress
+	if err := s.webmentionRepo.Upsert(ctx, mention); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store webmention: %v", err)
+	}
+
+	if mention.Status == models.WebmentionStatusPending {
+		pending := *mention
+		s.pending.Add(1)
+		go func() {
+			defer s.pending.Done()
+			s.verifyWebmention(&pending)
+		}()
+	}
+	return mention, nil
+}
+
+// mentionedPost returns the published post a Webmention target points at
+func (s *CommentService) mentionedPost(ctx context.Context, target *url.URL) (*models.BlogPost, error) {
+	site, err := url.Parse(s.site.SiteInfo(ctx).URL)
+	if err != nil || !strings.EqualFold(target.Host, site.Host) || !strings.HasPrefix(target.Path, "/blog/") {
+		return nil, status.Errorf(codes.InvalidArgument, "target is not a blog post on this site")
+	}
+	slug := strings.TrimSuffix(strings.TrimPrefix(target.Path, "/blog/"), "/")
+	if slug == "" || strings.Contains(slug, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "target is not a blog post on this site")
+	}
+
+	post, err := s.blogRepo.GetBySlug(ctx, slug)
+	if err != nil || !post.IsPublished() {
+		return nil, status.Errorf(codes.InvalidArgument, "target is not a blog post on this site")
+	}
+	if post.CommentsDisabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "comments are disabled for this post")
+	}
+	return post, nil
+}
+
+// verifyWebmention fetches the source of a mention and records whether it links to the target
+func (s *CommentService) verifyWebmention(mention *models.Webmention) {
+	ctx, cancel := context.WithTimeout(context.Background(), webmentionVerifyTimeout)
+	defer cancel()
+
+	mention.Title, mention.AuthorName, mention.LastError, mention.VerifiedAt = "", "", "", nil
+	code, _, body, err := fetchLimited(ctx, s.httpClient, mention.Source, webmentionMaxPageSize)
+	switch {
+	case err != nil:
+		mention.Status, mention.LastError = models.WebmentionStatusRejected, err.Error()
+	case code == http.StatusGone:
+		mention.Status, mention.LastError = models.WebmentionStatusRejected, "source was deleted"
+	case code != http.StatusOK:
+		mention.Status, mention.LastError = models.WebmentionStatusRejected, fmt.Sprintf("source returned %d", code)
+	case !linksTo(string(body), mention.Source, mention.Target):
+		mention.Status, mention.LastError = models.WebmentionStatusRejected, "source does not link to the target"
+	default:
+		now := time.Now()
+		mention.VerifiedAt = &now
+		mention.Title, mention.AuthorName = sourceTitleAndAuthor(string(body))
+		mention.Status = models.WebmentionStatusVerified
+		if spam.IsSpam(mention.Title, mention.AuthorName) {
+			mention.Status = models.WebmentionStatusSpam
+		}
+	}
+
+	if err := s.webmentionRepo.UpdateVerification(ctx, mention); err != nil {
+		logger.Error("Failed to store webmention verification", err, "webmention_id", mention.ID)
+	}
+}
+
+// linksTo reports whether an HTML page contains a link to target
+func linksTo(page, pageURL, target string) bool {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return false
+	}
+	want, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	for _, tag := range webmentionAnchorTag.FindAllString(page, -1) {
+		href, ok := tagAttributes(tag)["href"]
+		if !ok {
+			continue
+		}
+		ref, err := url.Parse(strings.TrimSpace(href))
+		if err == nil && sameDocument(base.ResolveReference(ref), want) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameDocument compares two URLs, ignoring fragments and a trailing slash
+func sameDocument(a, b *url.URL) bool {
+	normalize := func(u *url.URL) string {
+		return strings.ToLower(u.Scheme+"://"+u.Host) + strings.TrimSuffix(u.EscapedPath(), "/") + "?" + u.RawQuery
+	}
+	return normalize(a) == normalize(b)
+}
+
+// sourceTitleAndAuthor reads the page title and <meta name="author"> of a source page
+func sourceTitleAndAuthor(page string) (string, string) {
+	var title, author string
+	if m := webmentionTitle.FindStringSubmatch(page); m != nil {
+		title = strings.Join(strings.Fields(html.UnescapeString(seoHTMLTagPattern.ReplaceAllString(m[1], ""))), " ")
+		if len(title) > webmentionMaxTitleLength {
+			title = strings.ToValidUTF8(title[:webmentionMaxTitleLength], "")
+		}
+	}
+	for _, tag := range webmentionMetaTag.FindAllString(page, -1) {
+		attrs := tagAttributes(tag)
+		if strings.EqualFold(attrs["name"], "author") {
+			author = strings.TrimSpace(attrs["content"])
+			break
+		}
+	}
+	return title, author
+}
+
+// ListWebmentions returns the approved mentions of a post
+func (s *CommentService) ListWebmentions(ctx context.Context, req *commentv1.ListWebmentionsRequest) (*commentv1.ListWebmentionsResponse, error) {
+	if err := s.requireWebmentions(); err != nil {
+		return nil, err
+	}
+	if req.PostId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "post ID is required")
+	}
+
+	mentions, err := s.webmentionRepo.ListByPost(ctx, req.PostId, models.WebmentionStatusApproved)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webmentions: %v", err)
+	}
+
+	resp := &commentv1.ListWebmentionsResponse{TotalCount: int32(len(mentions))}
+	for _, mention := range mentions {
+		resp.Webmentions = append(resp.Webmentions, s.webmentionToProto(mention, false))
+	}
+	return resp, nil
+}
+
+// ListWebmentionQueue lists mentions by state, verified mentions awaiting moderation by default
+func (s *CommentService) ListWebmentionQueue(ctx context.Context, req *commentv1.ListWebmentionQueueRequest) (*commentv1.ListWebmentionQueueResponse, error) {
+	if err := s.requireWebmentions(); err != nil {
+		return nil, err
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 50
+	}
+
+	skip := 0
+	if req.PageToken != "" {
+		var err error
+		skip, err = strconv.Atoi(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	mentionStatus := models.WebmentionStatusVerified
+	if req.Status != commentv1.WebmentionStatus_WEBMENTION_STATUS_UNSPECIFIED {
+		mentionStatus = s.protoWebmentionStatusToModel(req.Status)
+	}
+
+	mentions, pagination, err := s.webmentionRepo.ListByStatus(ctx, mentionStatus, req.PostId, repository.ListOptions{
+		Limit: pageSize,
+		Skip:  skip,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webmentions: %v", err)
+	}
+
+	resp := &commentv1.ListWebmentionQueueResponse{TotalCount: int32(len(mentions))}
+	for _, mention := range mentions {
+		resp.Webmentions = append(resp.Webmentions, s.webmentionToProto(mention, true))
+	}
+	if pagination != nil {
+		resp.NextPageToken = pagination.NextPageToken
+		resp.TotalCount = int32(pagination.TotalCount)
+	}
+	return resp, nil
+}
+
+// ModerateWebmention approves, rejects or files a mention as spam. Only mentions whose
+// source was verified to link to the post can be approved.
+func (s *CommentService) ModerateWebmention(ctx context.Context, req *commentv1.ModerateWebmentionRequest) (*commentv1.Webmention, error) {
+	if err := s.requireWebmentions(); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webmention ID is required")
+	}
+	switch req.Status {
+	case commentv1.WebmentionStatus_WEBMENTION_STATUS_APPROVED,
+		commentv1.WebmentionStatus_WEBMENTION_STATUS_REJECTED,
+		commentv1.WebmentionStatus_WEBMENTION_STATUS_SPAM:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "status must be approved, rejected or spam")
+	}
+
+	if req.Status == commentv1.WebmentionStatus_WEBMENTION_STATUS_APPROVED {
+		mention, err := s.webmentionRepo.GetByID(ctx, req.Id)
+		if err != nil {
+			if errors.Is(err, appErr.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "webmention not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get webmention: %v", err)
+		}
+		if mention.VerifiedAt == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "only verified webmentions can be approved")
+		}
+	}
+
+	moderatedBy, _ := ctx.Value("user_id").(string)
+	mention, err := s.webmentionRepo.UpdateStatus(ctx, req.Id, s.protoWebmentionStatusToModel(req.Status), moderatedBy)
+	if err != nil {
+		if errors.Is(err, appErr.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "webmention not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to moderate webmention: %v", err)
+	}
+	return s.webmentionToProto(mention, true), nil
+}
+
+// webmentionToProto converts a mention to protobuf; the verification error is only included for moderators
+func (s *CommentService) webmentionToProto(mention *models.Webmention, includePrivate bool) *commentv1.Webmention {
+	out := &commentv1.Webmention{
+		Id:         mention.ID,
+		PostId:     mention.PostID,
+		Source:     mention.Source,
+		Target:     mention.Target,
+		Title:      mention.Title,
+		AuthorName: mention.AuthorName,
+		Status:     s.modelWebmentionStatusToProto(mention.Status),
+		CreatedAt:  timestamppb.New(mention.CreatedAt),
+		UpdatedAt:  timestamppb.New(mention.UpdatedAt),
+	}
+	if mention.VerifiedAt != nil {
+		out.VerifiedAt = timestamppb.New(*mention.VerifiedAt)
+	}
+	if includePrivate {
+		out.LastError = mention.LastError
+	}
+	return out
+}
+
+func (s *CommentService) modelWebmentionStatusToProto(status string) commentv1.WebmentionStatus {
+	switch status {
+	case models.WebmentionStatusPending:
+		return commentv1.WebmentionStatus_WEBMENTION_STATUS_PENDING
+	case models.WebmentionStatusVerified:
+		return commentv1.WebmentionStatus_WEBMENTION_STATUS_VERIFIED
+	case models.WebmentionStatusApproved:
+		return commentv1.WebmentionStatus_WEBMENTION_STATUS_APPROVED
+	case models.WebmentionStatusRejected:
+		return commentv1.WebmentionStatus_WEBMENTION_STATUS_REJECTED
+	case models.WebmentionStatusSpam:
+		return commentv1.WebmentionStatus_WEBMENTION_STATUS_SPAM
+	default:
+		return commentv1.WebmentionStatus_WEBMENTION_STATUS_UNSPECIFIED
+	}
+}
+
+func (s *CommentService) protoWebmentionStatusToModel(status commentv1.WebmentionStatus) string {
+	switch status {
+	case commentv1.WebmentionStatus_WEBMENTION_STATUS_VERIFIED:
+		return models.WebmentionStatusVerified
+	case commentv1.WebmentionStatus_WEBMENTION_STATUS_APPROVED:
+		return models.WebmentionStatusApproved
+	case commentv1.WebmentionStatus_WEBMENTION_STATUS_REJECTED:
+		return models.WebmentionStatusRejected
+	case commentv1.WebmentionStatus_WEBMENTION_STATUS_SPAM:
+		return models.WebmentionStatusSpam
+	default:
+		return models.WebmentionStatusPending
+	}
+}
+
+// WebmentionSender sends Webmentions to the external pages a post links to when the post is
+// published. It is an EventPublisher and reacts to post.published events.
+type WebmentionSender struct {
+	blogRepo   repository.BlogRepository
+	site       SiteInfoSource
+	httpClient *http.Client
+	// pending tracks asynchronous sends so tests can wait for them
+	pending sync.WaitGroup
+}
+
+// NewWebmentionSender creates a sender for posts of the given site
+func NewWebmentionSender(blogRepo repository.BlogRepository, site SiteInfoSource) *WebmentionSender {
+	return &WebmentionSender{
+		blogRepo:   blogRepo,
+		site:       site,
+		httpClient: newPublicHTTPClient(webmentionRequestTimeout),
+	}
+}
+
+// SetHTTPClient replaces the client used for endpoint discovery and sending
+func (s *WebmentionSender) SetHTTPClient(client *http.Client) {
+	s.httpClient = client
+}
+
+// Publish sends the mentions of a newly published post in the background
+func (s *WebmentionSender) Publish(ctx context.Context, eventType string, data interface{}) {
+	post, ok := data.(*contentv1.BlogPost)
+	if eventType != models.WebhookEventPostPublished || !ok {
+		return
+	}
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		s.sendPostMentions(post.Id)
+	}()
+}
+
+// sendPostMentions sends a Webmention to every external page linked from a post that
+// advertises an endpoint. Pages without an endpoint are skipped; failures are logged.
+func (s *WebmentionSender) sendPostMentions(postID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), webmentionSendTimeout)
+	defer cancel()
+
+	post, err := s.blogRepo.GetByID(ctx, postID)
+	if err != nil {
+		logger.Error("Failed to load post for webmentions", err, "post_id", postID)
+		return
+	}
+	// Readers of other sites cannot verify mentions from gated posts
+	if !post.IsPublished() || !post.Visibility.IsPublic() {
+		return
+	}
+
+	siteURL := s.site.SiteInfo(ctx).URL
+	source := siteURL + "/blog/" + post.Slug
+	for _, target := range s.outboundLinks(siteURL, post.Content) {
+		endpoint, err := s.discoverEndpoint(ctx, target)
+		if err != nil {
+			logger.Warn("Webmention endpoint discovery failed", "post_id", post.ID, "target", target, "error", err.Error())
+			continue
+		}
+		if endpoint == "" {
+			continue
+		}
+		if err := s.send(ctx, endpoint, source, target); err != nil {
+			logger.Warn("Failed to send webmention", "post_id", post.ID, "target", target, "error", err.Error())
+			continue
+		}
+		logger.Info("Sent webmention", "post_id", post.ID, "target", target)
+	}
+}
+
+// outboundLinks returns the distinct absolute links of content to other sites
+func (s *WebmentionSender) outboundLinks(siteURL string, content models.Content) []string {
+	siteHost := ""
+	if site, err := url.Parse(siteURL); err == nil {
+		siteHost = site.Host
+	}
+
+	var links []string
+	seen := make(map[string]bool)
+	for _, ref := range extractContentLinks(content) {
+		u, ok := parseHTTPURL(ref.url)
+		if !ok || strings.EqualFold(u.Host, siteHost) {
+			continue
+		}
+		u.Fragment = ""
+		if link := u.String(); !seen[link] {
+			seen[link] = true
+			links = append(links, link)
+		}
+		if len(links) == webmentionMaxLinks {
+			break
+		}
+	}
+	return links
+}
+
+// discoverEndpoint returns the Webmention endpoint of a page: the first rel="webmention" of
+// its Link headers, or else of its <link> and <a> elements. It returns "" when there is none.
+func (s *WebmentionSender) discoverEndpoint(ctx context.Context, target string) (string, error) {
+	base, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+	code, header, body, err := fetchLimited(ctx, s.httpClient, target, webmentionMaxPageSize)
+	if err != nil {
+		return "", err
+	}
+	if code >= http.StatusBadRequest {
+		return "", fmt.Errorf("GET %s returned %d", target, code)
+	}
+
+	resolve := func(href string) (string, error) {
+		ref, err := url.Parse(strings.TrimSpace(href))
+		if err != nil {
+			return "", err
+		}
+		endpoint := base.ResolveReference(ref)
+		if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+			return "", fmt.Errorf("unsupported endpoint '%s'", endpoint)
+		}
+		return endpoint.String(), nil
+	}
+
+	for _, m := range webmentionLinkHeader.FindAllStringSubmatch(strings.Join(header.Values("Link"), ","), -1) {
+		if rel := webmentionRelParam.FindStringSubmatch(m[2]); rel != nil && hasRel(rel[1]+rel[2], "webmention") {
+			return resolve(m[1])
+		}
+	}
+	for _, tag := range webmentionLinkTag.FindAllString(string(body), -1) {
+		attrs := tagAttributes(tag)
+		if href, ok := attrs["href"]; ok && hasRel(attrs["rel"], "webmention") {
+			return resolve(href)
+		}
+	}
+	return "", nil
+}
+
+// hasRel reports whether a space-separated rel value contains the given link type
+func hasRel(rel, linkType string) bool {
+	for _, r := range strings.Fields(rel) {
+		if strings.EqualFold(r, linkType) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *WebmentionSender) send(ctx context.Context, endpoint, source, target string) error {
+	form := url.Values{"source": {source}, "target": {target}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint returned %d", resp.StatusCode)
+	}
+	return nil
+}
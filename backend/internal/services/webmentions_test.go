@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commentv1 "github.com/7-solutions/saas-platformbackend/gen/comment/v1"
+	contentv1 "github.com/7-solutions/saas-platformbackend/gen/content/v1"
+	"github.com/7-solutions/saas-platformbackend/internal/models"
+	"github.com/7-solutions/saas-platformbackend/internal/repository"
+	appErr "github.com/7-solutions/saas-platformbackend/internal/utils/errors"
+)
+
+// memoryWebmentionRepo is an in-memory WebmentionRepository
+type memoryWebmentionRepo struct {
+	mu       sync.Mutex
+	seq      int
+	mentions map[string]*models.Webmention
+}
+
+func newMemoryWebmentionRepo() *memoryWebmentionRepo {
+	return &memoryWebmentionRepo{mentions: map[string]*models.Webmention{}}
+}
+
+func (r *memoryWebmentionRepo) Upsert(ctx context.Context, mention *models.Webmention) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, m := range r.mentions {
+		if m.Source == mention.Source && m.Target == mention.Target {
+			if m.Status != models.WebmentionStatusSpam {
+				m.Status = models.WebmentionStatusPending
+			}
+			m.PostID, m.IPAddress = mention.PostID, mention.IPAddress
+			*mention = *m
+			return nil
+		}
+	}
+	r.seq++
+	mention.ID = fmt.Sprintf("wm-%d", r.seq)
+	stored := *mention
+	r.mentions[mention.ID] = &stored
+	return nil
+}
+
+func (r *memoryWebmentionRepo) GetByID(ctx context.Context, id string) (*models.Webmention, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.mentions[id]
+	if !ok {
+		return nil, appErr.ErrNotFound
+	}
+	out := *m
+	return &out, nil
+}
+
+func (r *memoryWebmentionRepo) UpdateVerification(ctx context.Context, mention *models.Webmention) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := r.mentions[mention.ID]
+	m.Status, m.Title, m.AuthorName, m.LastError, m.VerifiedAt = mention.Status, mention.Title, mention.AuthorName, mention.LastError, mention.VerifiedAt
+	return nil
+}
+
+func (r *memoryWebmentionRepo) ListByPost(ctx context.Context, postID, status string) ([]*models.Webmention, error) {
+	mentions, _, err := r.ListByStatus(ctx, status, postID, repository.ListOptions{Limit: 100})
+	return mentions, err
+}
+
+func (r *memoryWebmentionRepo) ListByStatus(ctx context.Context, status, postID string, options repository.ListOptions) ([]*models.Webmention, *repository.PaginationInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*models.Webmention
+	for _, m := range r.mentions {
+		if m.Status == status && (postID == "" || m.PostID == postID) {
+			copied := *m
+			out = append(out, &copied)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, &repository.PaginationInfo{TotalCount: len(out)}, nil
+}
+
+func (r *memoryWebmentionRepo) UpdateStatus(ctx context.Context, id, status, moderatedBy string) (*models.Webmention, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.mentions[id]
+	if !ok {
+		return nil, appErr.ErrNotFound
+	}
+	m.Status, m.ModeratedBy = status, moderatedBy
+	out := *m
+	return &out, nil
+}
+
+// newWebmentionSourceSite serves pages that mention https://blog.test/blog/hello
+func newWebmentionSourceSite(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/reply", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><title>Re: Hello &amp; welcome</title><meta name="author" content="Ada"></head>
+<body><p>Great post! <a class="u-in-reply-to" href="https://blog.test/blog/hello/#intro">Hello</a></p></body></html>`))
+	})
+	mux.HandleFunc("/unrelated", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<p>Nothing to see, just <a href="https://blog.test/blog/other">another post</a></p>`))
+	})
+	mux.HandleFunc("/deleted", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newWebmentionTestService() (*CommentService, *memoryWebmentionRepo) {
+	hello := models.NewBlogPost("Hello", "hello", "user-1")
+	hello.SetPublished()
+	draft := models.NewBlogPost("Draft", "draft", "user-1")
+	service := NewCommentService(nil, &memoryBlogRepo{posts: map[string]*models.BlogPost{hello.ID: hello, draft.ID: draft}}, nil, nil)
+	repo := newMemoryWebmentionRepo()
+	service.SetWebmentions(repo, SiteInfo{URL: "https://blog.test/"})
+	return service, repo
+}
+
+func TestCommentService_ReceiveWebmentionVerifiesSource(t *testing.T) {
+	site := newWebmentionSourceSite(t)
+	service, repo := newWebmentionTestService()
+	service.SetHTTPClient(site.Client())
+	ctx := context.Background()
+
+	tests := []struct {
+		path      string
+		wantState string
+		wantError string
+	}{
+		{path: "/reply", wantState: models.WebmentionStatusVerified},
+		{path: "/unrelated", wantState: models.WebmentionStatusRejected, wantError: "source does not link to the target"},
+		{path: "/deleted", wantState: models.WebmentionStatusRejected, wantError: "source was deleted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			mention, err := service.ReceiveWebmention(ctx, site.URL+tt.path, "https://blog.test/blog/hello", "203.0.113.1")
+			require.NoError(t, err)
+			assert.Equal(t, models.WebmentionStatusPending, mention.Status)
+			assert.Equal(t, "blog:hello", mention.PostID)
+			service.pending.Wait()
+
+			stored, err := repo.GetByID(ctx, mention.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantState, stored.Status)
+			assert.Equal(t, tt.wantError, stored.LastError)
+		})
+	}
+
+	verified, _, err := repo.ListByStatus(ctx, models.WebmentionStatusVerified, "", repository.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, verified, 1)
+	assert.Equal(t, "Re: Hello & welcome", verified[0].Title)
+	assert.Equal(t, "Ada", verified[0].AuthorName)
+	assert.NotNil(t, verified[0].VerifiedAt)
+}
+
+func TestCommentService_ReceiveWebmentionRejectsInternalSources(t *testing.T) {
+	// The default client refuses to fetch sources on loopback, e.g. http://127.0.0.1
+	site := newWebmentionSourceSite(t)
+	service, repo := newWebmentionTestService()
+	ctx := context.Background()
+
+	mention, err := service.ReceiveWebmention(ctx, site.URL+"/reply", "https://blog.test/blog/hello", "203.0.113.1")
+	require.NoError(t, err)
+	service.pending.Wait()
+
+	stored, err := repo.GetByID(ctx, mention.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebmentionStatusRejected, stored.Status)
+	assert.Contains(t, stored.LastError, errNonPublicAddress.Error())
+}
+
+func TestCommentService_ReceiveWebmentionValidation(t *testing.T) {
+	service, _ := newWebmentionTestService()
+	ctx := context.Background()
+
+	for name, tc := range map[string][2]string{
+		"relative source": {"/reply", "https://blog.test/blog/hello"},
+		"other site":      {"https://elsewhere.test/reply", "https://other.test/blog/hello"},
+		"not a post":      {"https://elsewhere.test/reply", "https://blog.test/about"},
+		"draft post":      {"https://elsewhere.test/reply", "https://blog.test/blog/draft"},
+		"self mention":    {"https://blog.test/blog/hello", "https://blog.test/blog/hello/"},
+	} {
+		_, err := service.ReceiveWebmention(ctx, tc[0], tc[1], "203.0.113.1")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	disabled := NewCommentService(nil, &memoryBlogRepo{}, nil, nil)
+	_, err := disabled.ReceiveWebmention(ctx, "https://elsewhere.test/reply", "https://blog.test/blog/hello", "203.0.113.1")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = disabled.ListWebmentions(ctx, &commentv1.ListWebmentionsRequest{PostId: "blog:hello"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCommentService_ModerateWebmentions(t *testing.T) {
+	service, repo := newWebmentionTestService()
+	ctx := context.WithValue(context.Background(), "user_id", "editor-1")
+	verifiedAt := time.Now()
+	verified := &models.Webmention{PostID: "blog:hello", Source: "https://a.test/", Target: "https://blog.test/blog/hello", Status: models.WebmentionStatusVerified, VerifiedAt: &verifiedAt}
+	rejected := &models.Webmention{PostID: "blog:hello", Source: "https://b.test/", Target: "https://blog.test/blog/hello", Status: models.WebmentionStatusRejected, LastError: "source returned 500"}
+	require.NoError(t, repo.Upsert(ctx, verified))
+	require.NoError(t, repo.Upsert(ctx, rejected))
+	for _, m := range []*models.Webmention{verified, rejected} {
+		require.NoError(t, repo.UpdateVerification(ctx, m))
+	}
+
+	queue, err := service.ListWebmentionQueue(ctx, &commentv1.ListWebmentionQueueRequest{})
+	require.NoError(t, err)
+	require.Len(t, queue.Webmentions, 1, "the queue lists verified mentions by default")
+	assert.Equal(t, verified.ID, queue.Webmentions[0].Id)
+
+	_, err = service.ModerateWebmention(ctx, &commentv1.ModerateWebmentionRequest{Id: rejected.ID, Status: commentv1.WebmentionStatus_WEBMENTION_STATUS_APPROVED})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "unverified mentions cannot be approved")
+	_, err = service.ModerateWebmention(ctx, &commentv1.ModerateWebmentionRequest{Id: verified.ID, Status: commentv1.WebmentionStatus_WEBMENTION_STATUS_PENDING})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.ModerateWebmention(ctx, &commentv1.ModerateWebmentionRequest{Id: "wm-404", Status: commentv1.WebmentionStatus_WEBMENTION_STATUS_SPAM})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	approved, err := service.ModerateWebmention(ctx, &commentv1.ModerateWebmentionRequest{Id: verified.ID, Status: commentv1.WebmentionStatus_WEBMENTION_STATUS_APPROVED})
+	require.NoError(t, err)
+	assert.Equal(t, commentv1.WebmentionStatus_WEBMENTION_STATUS_APPROVED, approved.Status)
+
+	list, err := service.ListWebmentions(context.Background(), &commentv1.ListWebmentionsRequest{PostId: "blog:hello"})
+	require.NoError(t, err)
+	require.Len(t, list.Webmentions, 1)
+	assert.Equal(t, "https://a.test/", list.Webmentions[0].Source)
+	assert.NotNil(t, list.Webmentions[0].VerifiedAt)
+
+	stored, err := repo.GetByID(ctx, verified.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "editor-1", stored.ModeratedBy)
+}
+
+func TestWebmentionSender_SendsOnPublish(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/header", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Link", `</style.css>; rel="stylesheet", </endpoint?via=header>; rel="webmention"`)
+	})
+	mux.HandleFunc("/html", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><link rel="me webmention" href="endpoint?via=html"></head></html>`))
+	})
+	mux.HandleFunc("/plain", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<p>No endpoint here</p>`))
+	})
+	mux.HandleFunc("/endpoint", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		mu.Lock()
+		received[r.URL.Query().Get("via")] = r.PostForm.Get("source") + " -> " + r.PostForm.Get("target")
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	})
+	remote := httptest.NewServer(mux)
+	defer remote.Close()
+
+	post := models.NewBlogPost("Links", "links", "user-1")
+	post.SetPublished()
+	post.Content = models.Content{Blocks: []models.ContentBlock{
+		{Type: "text", Data: map[string]interface{}{"content": fmt.Sprintf(`See &lt;a href=&#34;%s/header&#34;&gt;this&lt;/a&gt; and [that](%s/html#top), not [ours](https://blog.test/blog/hello)`, remote.URL, remote.URL)}},
+		{Type: "cta", Data: map[string]interface{}{"primaryButtonLink": remote.URL + "/plain"}},
+	}}
+	sender := NewWebmentionSender(&memoryBlogRepo{posts: map[string]*models.BlogPost{post.ID: post}}, SiteInfo{Name: "Blog", URL: "https://blog.test"})
+	sender.SetHTTPClient(remote.Client())
+
+	sender.Publish(context.Background(), models.WebhookEventPostUpdated, &contentv1.BlogPost{Id: post.ID})
+	sender.Publish(context.Background(), models.WebhookEventPostPublished, &contentv1.BlogPost{Id: post.ID})
+	sender.pending.Wait()
+
+	assert.Equal(t, map[string]string{
+		"header": "https://blog.test/blog/links -> " + remote.URL + "/header",
+		"html":   "https://blog.test/blog/links -> " + remote.URL + "/html",
+	}, received)
+}
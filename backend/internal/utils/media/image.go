@@ -68,6 +68,23 @@ func (ip *ImageProcessor) ProcessImage(content []byte, mimeType string) ([]byte,
 	return ip.encodeImage(resizedImg, format)
 }
 
+// Resize scales an image down to fit within maxWidth x maxHeight, keeping its aspect ratio
+// and format, and returns the encoded image with its new dimensions
+func (ip *ImageProcessor) Resize(content []byte, maxWidth, maxHeight int) ([]byte, int, int, error) {
+	img, format, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := NewImageProcessor(maxWidth, maxHeight, ip.quality)
+	width, height := bounds.calculateNewDimensions(img.Bounds().Dx(), img.Bounds().Dy())
+	resized, err := ip.encodeImage(ip.resizeImage(img, width, height), format)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return resized, width, height, nil
+}
+
 // GetImageDimensions returns the dimensions of an image
 func (ip *ImageProcessor) GetImageDimensions(content []byte) (int, int, error) {
 	img, _, err := image.Decode(bytes.NewReader(content))
@@ -39,6 +39,10 @@ type Metrics struct {
 	UsersTotal        prometheus.Gauge
 	ContactFormsTotal *prometheus.CounterVec
 
+	// Frontend revalidation metrics
+	RevalidationsTotal         *prometheus.CounterVec
+	RevalidationRetryQueueSize prometheus.Gauge
+
 	// System metrics
 	ProcessMemoryBytes    prometheus.Gauge
 	ProcessCPUUsage       prometheus.Gauge
@@ -160,6 +164,21 @@ func NewMetrics() *Metrics {
 			[]string{"status"},
 		),
 
+		// Frontend revalidation metrics
+		RevalidationsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "frontend_revalidations_total",
+				Help: "Total number of frontend ISR revalidation attempts by target kind and result",
+			},
+			[]string{"kind", "result"},
+		),
+		RevalidationRetryQueueSize: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "frontend_revalidation_retry_queue_size",
+				Help: "Number of frontend revalidations waiting for a retry",
+			},
+		),
+
 		// System metrics
 		ProcessMemoryBytes: promauto.NewGauge(
 			prometheus.GaugeOpts{
@@ -228,6 +247,16 @@ func (m *Metrics) RecordContactFormSubmission(success bool) {
 	m.ContactFormsTotal.WithLabelValues(status).Inc()
 }
 
+// RecordRevalidation records the outcome of a frontend revalidation attempt
+func (m *Metrics) RecordRevalidation(kind, result string) {
+	m.RevalidationsTotal.WithLabelValues(kind, result).Inc()
+}
+
+// SetRevalidationRetryQueueSize records how many frontend revalidations wait for a retry
+func (m *Metrics) SetRevalidationRetryQueueSize(size int) {
+	m.RevalidationRetryQueueSize.Set(float64(size))
+}
+
 // UpdateBusinessMetrics updates business-related metrics
 func (m *Metrics) UpdateBusinessMetrics(pages, mediaFiles, users int) {
 	m.PagesTotal.Set(float64(pages))
@@ -263,6 +292,11 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap exposes the underlying writer so http.ResponseController can flush streamed responses
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // MetricsHandler returns the Prometheus metrics handler
 func (m *Metrics) MetricsHandler() http.Handler {
 	return promhttp.Handler()
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter is an in-memory sliding-window rate limiter keyed by an arbitrary string
+// (typically a client IP). It is safe for concurrent use.
+type Limiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	hits   map[string][]time.Time
+	now    func() time.Time
+	sweeps int
+}
+
+// New creates a limiter allowing at most limit events per key within window
+func New(limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		limit:  limit,
+		window: window,
+		hits:   make(map[string][]time.Time),
+		now:    time.Now,
+	}
+}
+
+// Allow records an event for key and reports whether it is within the limit.
+// Rejected events are not recorded.
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	cutoff := now.Add(-l.window)
+
+	recent := prune(l.hits[key], cutoff)
+	if len(recent) >= l.limit {
+		l.hits[key] = recent
+		return false
+	}
+	l.hits[key] = append(recent, now)
+
+	// Periodically drop idle keys so the map does not grow without bound
+	l.sweeps++
+	if l.sweeps >= 1000 {
+		l.sweeps = 0
+		for k, times := range l.hits {
+			if len(prune(times, cutoff)) == 0 {
+				delete(l.hits, k)
+			}
+		}
+	}
+
+	return true
+}
+
+// prune returns the timestamps after cutoff; times are in ascending order
+func prune(times []time.Time, cutoff time.Time) []time.Time {
+	i := 0
+	for i < len(times) && !times[i].After(cutoff) {
+		i++
+	}
+	return times[i:]
+}
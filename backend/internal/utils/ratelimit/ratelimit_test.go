@@ -0,0 +1,25 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	l := New(2, time.Minute)
+	l.now = func() time.Time { return now }
+
+	assert.True(t, l.Allow("1.2.3.4"))
+	assert.True(t, l.Allow("1.2.3.4"))
+	assert.False(t, l.Allow("1.2.3.4"), "third event within the window is rejected")
+	assert.True(t, l.Allow("5.6.7.8"), "keys are limited independently")
+
+	now = now.Add(30 * time.Second)
+	assert.False(t, l.Allow("1.2.3.4"))
+
+	now = now.Add(31 * time.Second)
+	assert.True(t, l.Allow("1.2.3.4"), "events older than the window expire")
+}
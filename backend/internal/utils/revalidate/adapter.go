@@ -3,6 +3,7 @@ package revalidate
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -18,18 +19,25 @@ type Revalidator interface {
 	RevalidateTag(ctx context.Context, tag string) error
 }
 
-// NextRevalidatorHTTP implements a minimal HTTP client to Next.js ISR revalidate APIs.
-// It is provider-agnostic and uses placeholders with normalized errors.
+// NextRevalidatorHTTP implements a minimal HTTP client to the Next.js ISR revalidate API.
+// It POSTs {"path"|"tag", "secret"} to {baseURL}/api/revalidate and normalizes errors.
 type NextRevalidatorHTTP struct {
 	baseURL    string
-	token      string
+	secret     string
 	httpClient *http.Client
 	timeout    time.Duration
 }
 
-// NewNextRevalidatorHTTP constructs the adapter with baseURL, token and http client.
+// revalidateRequest is the body expected by the website's /api/revalidate route
+type revalidateRequest struct {
+	Path   string `json:"path,omitempty"`
+	Tag    string `json:"tag,omitempty"`
+	Secret string `json:"secret"`
+}
+
+// NewNextRevalidatorHTTP constructs the adapter with baseURL, the website's REVALIDATION_SECRET and http client.
 // A default timeout of 5s is applied if client has no timeout.
-func NewNextRevalidatorHTTP(baseURL, token string, client *http.Client) *NextRevalidatorHTTP {
+func NewNextRevalidatorHTTP(baseURL, secret string, client *http.Client) *NextRevalidatorHTTP {
 	if client == nil {
 		client = &http.Client{Timeout: 5 * time.Second}
 	}
@@ -39,7 +47,7 @@ func NewNextRevalidatorHTTP(baseURL, token string, client *http.Client) *NextRev
 	}
 	return &NextRevalidatorHTTP{
 		baseURL:    stringsTrimRightSlash(baseURL),
-		token:      token,
+		secret:     secret,
 		httpClient: client,
 		timeout:    timeout,
 	}
@@ -49,25 +57,27 @@ func (n *NextRevalidatorHTTP) RevalidatePage(ctx context.Context, path string) e
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	u := fmt.Sprintf("%s/api/revalidate/page", n.baseURL)
-	body := []byte(fmt.Sprintf(`{"path":%q,"token":%q}`, path, n.token))
-	return n.doPost(ctx, u, body)
+	return n.doPost(ctx, revalidateRequest{Path: path, Secret: n.secret})
 }
 
 func (n *NextRevalidatorHTTP) RevalidateTag(ctx context.Context, tag string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	u := fmt.Sprintf("%s/api/revalidate/tag", n.baseURL)
-	body := []byte(fmt.Sprintf(`{"tag":%q,"token":%q}`, tag, n.token))
-	return n.doPost(ctx, u, body)
+	return n.doPost(ctx, revalidateRequest{Tag: tag, Secret: n.secret})
 }
 
-func (n *NextRevalidatorHTTP) doPost(ctx context.Context, endpoint string, payload []byte) error {
+func (n *NextRevalidatorHTTP) doPost(ctx context.Context, body revalidateRequest) error {
+	endpoint := n.baseURL + "/api/revalidate"
 	if _, err := url.Parse(endpoint); err != nil {
 		return fmt.Errorf("revalidate: invalid url %q: %w", endpoint, err)
 	}
 
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("revalidate: encode request: %w", err)
+	}
+
 	// Respect context cancellation via http.NewRequestWithContext
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
 	if err != nil {
@@ -75,7 +85,7 @@ func (n *NextRevalidatorHTTP) doPost(ctx context.Context, endpoint string, paylo
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	// TODO: add retry/backoff if needed.
+	// Retries with backoff are handled by Queue.
 	resp, err := n.httpClient.Do(req)
 	if err != nil {
 		// Propagate ctx cancellation transparently.
@@ -89,6 +99,8 @@ func (n *NextRevalidatorHTTP) doPost(ctx context.Context, endpoint string, paylo
 	switch resp.StatusCode {
 	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
 		return nil
+	case http.StatusUnauthorized:
+		return fmt.Errorf("revalidate: secret rejected by %s", endpoint)
 	case http.StatusNotFound:
 		return appErr.ErrNotFound
 	case http.StatusConflict:
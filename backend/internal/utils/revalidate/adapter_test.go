@@ -0,0 +1,39 @@
+package revalidate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextRevalidatorHTTP_RequestShape(t *testing.T) {
+	var paths []string
+	var bodies []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, body)
+		if body["secret"] != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}))
+	defer server.Close()
+
+	r := NewNextRevalidatorHTTP(server.URL+"/", "s3cret", server.Client())
+	require.NoError(t, r.RevalidatePage(context.Background(), "/blog/launch"))
+	require.NoError(t, r.RevalidateTag(context.Background(), "pages"))
+
+	assert.Equal(t, []string{"/api/revalidate", "/api/revalidate"}, paths)
+	assert.Equal(t, map[string]string{"path": "/blog/launch", "secret": "s3cret"}, bodies[0])
+	assert.Equal(t, map[string]string{"tag": "pages", "secret": "s3cret"}, bodies[1])
+
+	wrong := NewNextRevalidatorHTTP(server.URL, "wrong", server.Client())
+	assert.Error(t, wrong.RevalidatePage(context.Background(), "/"), "a rejected secret is an error")
+}
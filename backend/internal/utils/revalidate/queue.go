@@ -0,0 +1,293 @@
+package revalidate
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Target kinds, also used as metric labels
+const (
+	KindPath = "path"
+	KindTag  = "tag"
+)
+
+// Result labels reported to the Observer
+const (
+	ResultSuccess = "success"
+	ResultRetry   = "retry"
+	ResultFailed  = "failed"
+	ResultDropped = "dropped"
+)
+
+// ErrQueueFull is returned when a revalidation cannot be buffered
+var ErrQueueFull = errors.New("revalidate: queue is full")
+
+// Observer receives revalidation outcomes, e.g. to export them as metrics
+type Observer interface {
+	RecordRevalidation(kind, result string)
+	SetRevalidationRetryQueueSize(size int)
+}
+
+// QueueConfig tunes a Queue; zero values use the defaults
+type QueueConfig struct {
+	// MaxAttempts bounds attempts per target before it is recorded as failed (default 5)
+	MaxAttempts int
+	// BaseDelay is the first retry delay; it doubles per attempt (default 2s)
+	BaseDelay time.Duration
+	// MaxDelay caps the retry delay (default 5m)
+	MaxDelay time.Duration
+	// Timeout bounds one request to the target (default 10s)
+	Timeout time.Duration
+	// BufferSize bounds targets waiting for their first attempt (default 256)
+	BufferSize int
+	// MaxFailures bounds how many permanent failures are kept for inspection (default 100)
+	MaxFailures int
+}
+
+func (c QueueConfig) withDefaults() QueueConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 5
+	}
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = 2 * time.Second
+	}
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = 5 * time.Minute
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+	if c.BufferSize <= 0 {
+		c.BufferSize = 256
+	}
+	if c.MaxFailures <= 0 {
+		c.MaxFailures = 100
+	}
+	return c
+}
+
+// Failure is a target that could not be revalidated within MaxAttempts
+type Failure struct {
+	Kind     string    `json:"kind"`
+	Value    string    `json:"value"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// QueueStats is a snapshot of a Queue
+type QueueStats struct {
+	// Queued targets are waiting for their first attempt
+	Queued int `json:"queued"`
+	// Retrying targets failed at least once and wait for a retry
+	Retrying int       `json:"retrying"`
+	Failures []Failure `json:"failures,omitempty"`
+}
+
+type job struct {
+	kind     string
+	value    string
+	attempts int
+	due      time.Time
+}
+
+func (j *job) key() string {
+	return j.kind + ":" + j.value
+}
+
+// Queue revalidates paths and tags asynchronously through another Revalidator,
+// retrying failures with exponential backoff. Duplicate targets that are still
+// waiting are coalesced. Queue itself implements Revalidator: its methods only
+// enqueue and never block on the network.
+type Queue struct {
+	target   Revalidator
+	cfg      QueueConfig
+	observer Observer
+	now      func() time.Time
+
+	jobs chan *job
+
+	mu       sync.Mutex
+	waiting  map[string]bool
+	retries  []*job
+	failures []Failure
+}
+
+var _ Revalidator = (*Queue)(nil)
+
+// NewQueue wraps target in an asynchronous retry queue. Call Start to process it.
+func NewQueue(target Revalidator, cfg QueueConfig) *Queue {
+	cfg = cfg.withDefaults()
+	return &Queue{
+		target:  target,
+		cfg:     cfg,
+		now:     time.Now,
+		jobs:    make(chan *job, cfg.BufferSize),
+		waiting: make(map[string]bool),
+	}
+}
+
+// SetObserver sets the receiver of revalidation outcomes
+func (q *Queue) SetObserver(observer Observer) {
+	q.observer = observer
+}
+
+// RevalidatePage enqueues a path
+func (q *Queue) RevalidatePage(ctx context.Context, path string) error {
+	return q.enqueue(&job{kind: KindPath, value: path})
+}
+
+// RevalidateTag enqueues a cache tag
+func (q *Queue) RevalidateTag(ctx context.Context, tag string) error {
+	return q.enqueue(&job{kind: KindTag, value: tag})
+}
+
+func (q *Queue) enqueue(j *job) error {
+	q.mu.Lock()
+	if q.waiting[j.key()] {
+		q.mu.Unlock()
+		return nil
+	}
+	q.waiting[j.key()] = true
+	q.mu.Unlock()
+
+	select {
+	case q.jobs <- j:
+		return nil
+	default:
+		q.mu.Lock()
+		delete(q.waiting, j.key())
+		q.mu.Unlock()
+		q.record(j.kind, ResultDropped)
+		return ErrQueueFull
+	}
+}
+
+// Start processes queued targets and due retries until the context is cancelled
+func (q *Queue) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(q.cfg.BaseDelay / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case j := <-q.jobs:
+				q.process(ctx, j)
+			case <-ticker.C:
+				q.processDue(ctx)
+			}
+		}
+	}()
+}
+
+// processDue attempts every retry whose delay has passed
+func (q *Queue) processDue(ctx context.Context) {
+	now := q.now()
+	q.mu.Lock()
+	var due []*job
+	kept := q.retries[:0]
+	for _, j := range q.retries {
+		if !j.due.After(now) {
+			due = append(due, j)
+		} else {
+			kept = append(kept, j)
+		}
+	}
+	q.retries = kept
+	q.mu.Unlock()
+
+	for _, j := range due {
+		if ctx.Err() != nil {
+			// Put unattempted retries back for the next run
+			q.mu.Lock()
+			q.retries = append(q.retries, j)
+			q.mu.Unlock()
+			continue
+		}
+		q.process(ctx, j)
+	}
+}
+
+// process makes one attempt and schedules a retry or records a failure
+func (q *Queue) process(ctx context.Context, j *job) {
+	reqCtx, cancel := context.WithTimeout(ctx, q.cfg.Timeout)
+	var err error
+	if j.kind == KindTag {
+		err = q.target.RevalidateTag(reqCtx, j.value)
+	} else {
+		err = q.target.RevalidatePage(reqCtx, j.value)
+	}
+	cancel()
+	j.attempts++
+
+	q.mu.Lock()
+	switch {
+	case err == nil:
+		delete(q.waiting, j.key())
+	case j.attempts >= q.cfg.MaxAttempts:
+		delete(q.waiting, j.key())
+		q.failures = append(q.failures, Failure{
+			Kind:     j.kind,
+			Value:    j.value,
+			Attempts: j.attempts,
+			Error:    err.Error(),
+			FailedAt: q.now(),
+		})
+		if len(q.failures) > q.cfg.MaxFailures {
+			q.failures = q.failures[len(q.failures)-q.cfg.MaxFailures:]
+		}
+	default:
+		j.due = q.now().Add(q.retryDelay(j.attempts))
+		q.retries = append(q.retries, j)
+	}
+	retrying := len(q.retries)
+	q.mu.Unlock()
+
+	switch {
+	case err == nil:
+		q.record(j.kind, ResultSuccess)
+	case j.attempts >= q.cfg.MaxAttempts:
+		q.record(j.kind, ResultFailed)
+	default:
+		q.record(j.kind, ResultRetry)
+	}
+	if q.observer != nil {
+		q.observer.SetRevalidationRetryQueueSize(retrying)
+	}
+}
+
+// retryDelay returns the backoff after the given number of failed attempts
+func (q *Queue) retryDelay(attempts int) time.Duration {
+	delay := q.cfg.BaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= q.cfg.MaxDelay {
+			return q.cfg.MaxDelay
+		}
+	}
+	return delay
+}
+
+func (q *Queue) record(kind, result string) {
+	if q.observer != nil {
+		q.observer.RecordRevalidation(kind, result)
+	}
+}
+
+// Stats returns the queue sizes and the most recent permanent failures, newest first
+func (q *Queue) Stats() QueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	failures := make([]Failure, 0, len(q.failures))
+	for i := len(q.failures) - 1; i >= 0; i-- {
+		failures = append(failures, q.failures[i])
+	}
+	return QueueStats{
+		Queued:   len(q.jobs),
+		Retrying: len(q.retries),
+		Failures: failures,
+	}
+}
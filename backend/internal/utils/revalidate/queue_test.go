@@ -0,0 +1,138 @@
+package revalidate
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRevalidator fails the first failures calls, then succeeds
+type fakeRevalidator struct {
+	mu       sync.Mutex
+	failures int
+	calls    []string
+}
+
+func (f *fakeRevalidator) RevalidatePage(ctx context.Context, path string) error {
+	return f.call("path:" + path)
+}
+
+func (f *fakeRevalidator) RevalidateTag(ctx context.Context, tag string) error {
+	return f.call("tag:" + tag)
+}
+
+func (f *fakeRevalidator) call(target string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, target)
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("frontend unavailable")
+	}
+	return nil
+}
+
+// countingObserver counts outcomes by "kind:result"
+type countingObserver struct {
+	results   map[string]int
+	retryings []int
+}
+
+func (o *countingObserver) RecordRevalidation(kind, result string) {
+	o.results[kind+":"+result]++
+}
+
+func (o *countingObserver) SetRevalidationRetryQueueSize(size int) {
+	o.retryings = append(o.retryings, size)
+}
+
+func newTestQueue(target Revalidator, cfg QueueConfig) (*Queue, *countingObserver, *time.Time) {
+	q := NewQueue(target, cfg)
+	observer := &countingObserver{results: map[string]int{}}
+	q.SetObserver(observer)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	q.now = func() time.Time { return now }
+	return q, observer, &now
+}
+
+// drain processes every buffered target
+func drain(q *Queue) {
+	for {
+		select {
+		case j := <-q.jobs:
+			q.process(context.Background(), j)
+		default:
+			return
+		}
+	}
+}
+
+func TestQueue_RetriesWithBackoff(t *testing.T) {
+	target := &fakeRevalidator{failures: 2}
+	q, observer, now := newTestQueue(target, QueueConfig{BaseDelay: time.Second})
+
+	assert.NoError(t, q.RevalidatePage(context.Background(), "/blog"))
+	drain(q)
+	assert.Equal(t, 1, q.Stats().Retrying)
+
+	q.processDue(context.Background())
+	assert.Len(t, target.calls, 1, "the retry is not due yet")
+
+	*now = now.Add(time.Second)
+	q.processDue(context.Background())
+	assert.Len(t, target.calls, 2)
+
+	*now = now.Add(time.Second)
+	q.processDue(context.Background())
+	assert.Len(t, target.calls, 2, "the second delay doubles")
+
+	*now = now.Add(time.Second)
+	q.processDue(context.Background())
+	assert.Len(t, target.calls, 3)
+	assert.Equal(t, QueueStats{Failures: []Failure{}}, q.Stats())
+	assert.Equal(t, map[string]int{"path:retry": 2, "path:success": 1}, observer.results)
+	assert.Equal(t, []int{1, 1, 0}, observer.retryings)
+}
+
+func TestQueue_RecordsPermanentFailures(t *testing.T) {
+	target := &fakeRevalidator{failures: 100}
+	q, observer, now := newTestQueue(target, QueueConfig{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Second})
+
+	start := *now
+	assert.NoError(t, q.RevalidateTag(context.Background(), "pages"))
+	drain(q)
+	for i := 0; i < 5; i++ {
+		*now = now.Add(time.Second)
+		q.processDue(context.Background())
+	}
+
+	stats := q.Stats()
+	assert.Len(t, target.calls, 3)
+	assert.Equal(t, 0, stats.Retrying)
+	if assert.Len(t, stats.Failures, 1) {
+		assert.Equal(t, Failure{Kind: KindTag, Value: "pages", Attempts: 3, Error: "frontend unavailable", FailedAt: start.Add(2 * time.Second)}, stats.Failures[0])
+	}
+	assert.Equal(t, 1, observer.results["tag:failed"])
+
+	// A failed target can be queued again
+	assert.NoError(t, q.RevalidateTag(context.Background(), "pages"))
+	assert.Equal(t, 1, q.Stats().Queued)
+}
+
+func TestQueue_CoalescesAndBounds(t *testing.T) {
+	target := &fakeRevalidator{}
+	q, observer, _ := newTestQueue(target, QueueConfig{BufferSize: 2})
+
+	assert.NoError(t, q.RevalidatePage(context.Background(), "/a"))
+	assert.NoError(t, q.RevalidatePage(context.Background(), "/a"), "duplicates are coalesced")
+	assert.NoError(t, q.RevalidateTag(context.Background(), "/a"), "paths and tags are distinct")
+	assert.ErrorIs(t, q.RevalidatePage(context.Background(), "/b"), ErrQueueFull)
+	assert.Equal(t, 1, observer.results["path:dropped"])
+
+	drain(q)
+	assert.Equal(t, []string{"path:/a", "tag:/a"}, target.calls)
+}
@@ -0,0 +1,62 @@
+package spam
+
+import (
+	"strings"
+)
+
+// suspiciousPatterns are substrings that commonly appear in spam submissions
+var suspiciousPatterns = []string{
+	"http://",
+	"https://",
+	"www.",
+	"<a href",
+	"<script",
+	"viagra",
+	"casino",
+	"lottery",
+	"winner",
+	"congratulations",
+}
+
+// IsSpam performs basic spam detection on user-submitted text.
+// The body is checked for suspicious patterns and excessive word repetition;
+// the other fields (e.g. the author name) are checked for suspicious patterns only.
+func IsSpam(body string, fields ...string) bool {
+	if ContainsSuspiciousPattern(body) {
+		return true
+	}
+	for _, field := range fields {
+		if ContainsSuspiciousPattern(field) {
+			return true
+		}
+	}
+	return HasExcessiveRepetition(body)
+}
+
+// ContainsSuspiciousPattern reports whether text contains a known spam pattern
+func ContainsSuspiciousPattern(text string) bool {
+	lower := strings.ToLower(text)
+	for _, pattern := range suspiciousPatterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasExcessiveRepetition reports whether a longer text repeats any word more than five times
+func HasExcessiveRepetition(text string) bool {
+	words := strings.Fields(strings.ToLower(text))
+	if len(words) <= 10 {
+		return false
+	}
+
+	wordCount := make(map[string]int)
+	for _, word := range words {
+		wordCount[word]++
+		if wordCount[word] > 5 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package spam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSpam(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		fields []string
+		want   bool
+	}{
+		{
+			name: "plain message",
+			body: "I would like to know more about your pricing plans.",
+			want: false,
+		},
+		{
+			name: "link in body",
+			body: "Check out https://example.org for cheap deals",
+			want: true,
+		},
+		{
+			name: "pattern is case insensitive",
+			body: "You are a WINNER",
+			want: true,
+		},
+		{
+			name:   "pattern in extra field",
+			body:   "Nice post",
+			fields: []string{"Casino Bot"},
+			want:   true,
+		},
+		{
+			name: "excessive repetition",
+			body: "buy buy buy buy buy buy now now now now now",
+			want: true,
+		},
+		{
+			name: "short repetitive body is allowed",
+			body: "ha ha ha ha ha ha",
+			want: false,
+		},
+		{
+			name:   "repetition in extra field is ignored",
+			body:   "Thanks for the write-up",
+			fields: []string{"a a a a a a a a a a a"},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsSpam(tt.body, tt.fields...))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+-- 000002_reusable_blocks.sql
+-- Reusable content blocks referenced from page and post content
+
+BEGIN;
+
+-- reusable_blocks
+CREATE TABLE IF NOT EXISTS reusable_blocks (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT,
+  content TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS reusable_blocks_slug_unique ON reusable_blocks (slug);
+CREATE INDEX IF NOT EXISTS reusable_blocks_name_trgm_idx ON reusable_blocks USING GIN (name gin_trgm_ops);
+
+-- reusable_block_usages: pages/posts embedding a reusable block, maintained on content save
+CREATE TABLE IF NOT EXISTS reusable_block_usages (
+  block_id UUID NOT NULL REFERENCES reusable_blocks(id) ON DELETE CASCADE,
+  content_type TEXT NOT NULL,
+  content_id TEXT NOT NULL,
+  content_slug TEXT NOT NULL,
+  content_title TEXT NOT NULL DEFAULT '',
+  PRIMARY KEY (block_id, content_type, content_id)
+);
+CREATE INDEX IF NOT EXISTS reusable_block_usages_content_idx ON reusable_block_usages (content_type, content_id);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_reusable_blocks'
+  ) THEN
+    CREATE TRIGGER set_updated_at_reusable_blocks BEFORE UPDATE ON reusable_blocks
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+COMMIT;
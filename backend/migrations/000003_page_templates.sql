@@ -0,0 +1,40 @@
+-- 000003_page_templates.sql
+-- Versioned page templates used to instantiate new pages
+
+BEGIN;
+
+-- page_templates: current version of each template
+CREATE TABLE IF NOT EXISTS page_templates (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT,
+  version INTEGER NOT NULL DEFAULT 1,
+  content TEXT NOT NULL,
+  meta TEXT NOT NULL DEFAULT '{}',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS page_templates_slug_unique ON page_templates (slug);
+
+-- page_template_versions: immutable snapshot of every template version
+CREATE TABLE IF NOT EXISTS page_template_versions (
+  template_id UUID NOT NULL REFERENCES page_templates(id) ON DELETE CASCADE,
+  version INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  meta TEXT NOT NULL DEFAULT '{}',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (template_id, version)
+);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_page_templates'
+  ) THEN
+    CREATE TRIGGER set_updated_at_page_templates BEFORE UPDATE ON page_templates
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+COMMIT;
@@ -0,0 +1,38 @@
+-- 000004_comments.sql
+-- Threaded, moderated comments on blog posts
+
+BEGIN;
+
+-- comments: post_id is the blog post document ID ("blog:{slug}")
+CREATE TABLE IF NOT EXISTS comments (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  post_id TEXT NOT NULL,
+  parent_id UUID REFERENCES comments(id) ON DELETE SET NULL,
+  author_user_id TEXT,
+  author_name TEXT NOT NULL,
+  author_email TEXT NOT NULL,
+  author_url TEXT,
+  body TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'spam', 'deleted')),
+  ip_address TEXT,
+  user_agent TEXT,
+  moderated_by TEXT,
+  moderated_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS comments_post_status_created_idx ON comments (post_id, status, created_at);
+CREATE INDEX IF NOT EXISTS comments_status_created_idx ON comments (status, created_at);
+CREATE INDEX IF NOT EXISTS comments_parent_idx ON comments (parent_id);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_comments'
+  ) THEN
+    CREATE TRIGGER set_updated_at_comments BEFORE UPDATE ON comments
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+COMMIT;
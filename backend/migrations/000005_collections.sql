@@ -0,0 +1,38 @@
+-- 000005_collections.sql
+-- Ordered post series and curated collections (e.g. "featured")
+
+BEGIN;
+
+-- collections: kind 'series' powers prev/next navigation on posts, 'curated' holds hand-picked lists
+CREATE TABLE IF NOT EXISTS collections (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL UNIQUE,
+  title TEXT NOT NULL,
+  description TEXT,
+  kind TEXT NOT NULL CHECK (kind IN ('series', 'curated')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS collections_kind_title_idx ON collections (kind, title);
+
+-- collection_posts: post_id is the blog post document ID ("blog:{slug}"); position is 1-based
+CREATE TABLE IF NOT EXISTS collection_posts (
+  collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  post_id TEXT NOT NULL,
+  position INTEGER NOT NULL,
+  PRIMARY KEY (collection_id, post_id)
+);
+CREATE INDEX IF NOT EXISTS collection_posts_order_idx ON collection_posts (collection_id, position);
+CREATE INDEX IF NOT EXISTS collection_posts_post_idx ON collection_posts (post_id);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_collections'
+  ) THEN
+    CREATE TRIGGER set_updated_at_collections BEFORE UPDATE ON collections
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+COMMIT;
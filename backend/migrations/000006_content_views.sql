@@ -0,0 +1,45 @@
+-- 000006_content_views.sql
+-- Privacy-friendly view analytics: daily rollups per page/post, no raw visitor data
+
+BEGIN;
+
+-- content_view_daily: one row per content item and UTC day
+CREATE TABLE IF NOT EXISTS content_view_daily (
+  content_id TEXT NOT NULL,
+  day DATE NOT NULL,
+  content_type TEXT NOT NULL CHECK (content_type IN ('page', 'post')),
+  path TEXT NOT NULL DEFAULT '',
+  views BIGINT NOT NULL DEFAULT 0,
+  unique_visitors BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (content_id, day)
+);
+CREATE INDEX IF NOT EXISTS content_view_daily_day_idx ON content_view_daily (day, content_type);
+
+-- content_view_referrers: referrer host breakdown per content item and day ('' = direct)
+CREATE TABLE IF NOT EXISTS content_view_referrers (
+  content_id TEXT NOT NULL,
+  day DATE NOT NULL,
+  referrer TEXT NOT NULL,
+  views BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (content_id, day, referrer)
+);
+CREATE INDEX IF NOT EXISTS content_view_referrers_day_idx ON content_view_referrers (day);
+
+-- content_view_visitors: salted visitor hashes used only to count unique visitors for the
+-- current day. Each day has its own random salt, so hashes cannot be linked across days;
+-- rows from previous days are purged together with their salts.
+CREATE TABLE IF NOT EXISTS content_view_visitors (
+  content_id TEXT NOT NULL,
+  day DATE NOT NULL,
+  visitor_hash TEXT NOT NULL,
+  PRIMARY KEY (content_id, day, visitor_hash)
+);
+CREATE INDEX IF NOT EXISTS content_view_visitors_day_idx ON content_view_visitors (day);
+
+-- content_view_salts: the visitor hash salt of the current day, shared by all instances
+CREATE TABLE IF NOT EXISTS content_view_salts (
+  day DATE PRIMARY KEY,
+  salt BYTEA NOT NULL
+);
+
+COMMIT;
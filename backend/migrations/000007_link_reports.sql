@@ -0,0 +1,36 @@
+-- 000007_link_reports.sql
+-- Link-integrity scan reports: broken internal links, missing media and failing external URLs
+
+BEGIN;
+
+-- link_reports: one row per completed scan; trigger is 'scheduled' or 'manual'
+CREATE TABLE IF NOT EXISTS link_reports (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  trigger TEXT NOT NULL CHECK (trigger IN ('scheduled', 'manual')),
+  pages_scanned INTEGER NOT NULL DEFAULT 0,
+  posts_scanned INTEGER NOT NULL DEFAULT 0,
+  links_checked INTEGER NOT NULL DEFAULT 0,
+  external_checked BOOLEAN NOT NULL DEFAULT FALSE,
+  started_at TIMESTAMPTZ NOT NULL,
+  finished_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS link_reports_started_idx ON link_reports (started_at DESC);
+
+-- link_report_issues: content_id is the page or post document ID; block_index is -1 for
+-- fields outside the content blocks such as a post's featured image
+CREATE TABLE IF NOT EXISTS link_report_issues (
+  id BIGSERIAL PRIMARY KEY,
+  report_id UUID NOT NULL REFERENCES link_reports(id) ON DELETE CASCADE,
+  content_type TEXT NOT NULL CHECK (content_type IN ('page', 'blog_post')),
+  content_id TEXT NOT NULL,
+  content_slug TEXT NOT NULL,
+  block_index INTEGER NOT NULL,
+  field TEXT NOT NULL,
+  url TEXT NOT NULL,
+  kind TEXT NOT NULL CHECK (kind IN ('missing_page', 'missing_post', 'missing_media', 'broken_external')),
+  status_code INTEGER NOT NULL DEFAULT 0,
+  detail TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS link_report_issues_report_idx ON link_report_issues (report_id, id);
+
+COMMIT;
@@ -0,0 +1,87 @@
+-- 000009_content_types.sql
+-- Administrator-defined content types and their entries
+
+BEGIN;
+
+-- content_types: fields is a JSON array of field definitions (key, type, validation, filterable)
+CREATE TABLE IF NOT EXISTS content_types (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL,
+  name TEXT NOT NULL,
+  description TEXT,
+  fields JSONB NOT NULL DEFAULT '[]',
+  created_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS content_types_slug_unique ON content_types (slug);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_content_types'
+  ) THEN
+    CREATE TRIGGER set_updated_at_content_types BEFORE UPDATE ON content_types
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- entries: data holds the field values of an entry keyed by field key
+CREATE TABLE IF NOT EXISTS entries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  content_type_id UUID NOT NULL REFERENCES content_types(id) ON DELETE CASCADE,
+  slug TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published', 'archived')),
+  data JSONB NOT NULL DEFAULT '{}',
+  published_at TIMESTAMPTZ,
+  created_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS entries_type_slug_unique ON entries (content_type_id, slug);
+CREATE INDEX IF NOT EXISTS entries_type_status_created_idx ON entries (content_type_id, status, created_at DESC);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_entries'
+  ) THEN
+    CREATE TRIGGER set_updated_at_entries BEFORE UPDATE ON entries
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- sync_entry_field_indexes keeps one expression index per filterable field of a content type,
+-- scoped to its entries, and drops the indexes of fields that are no longer filterable.
+-- ListEntries filters a field with (data -> 'field') @> value, which these GIN indexes serve.
+-- Index names are entry_field_<type hash>_<field hash> to stay within the identifier limit.
+CREATE OR REPLACE FUNCTION sync_entry_field_indexes(p_content_type_id UUID, p_fields TEXT[])
+RETURNS VOID AS $$
+DECLARE
+  prefix TEXT := 'entry_field_' || left(md5(p_content_type_id::text), 8) || '_';
+  wanted TEXT[];
+  existing RECORD;
+  field TEXT;
+BEGIN
+  p_fields := COALESCE(p_fields, '{}');
+  wanted := ARRAY(SELECT prefix || left(md5(f), 8) FROM unnest(p_fields) AS f);
+
+  FOR existing IN
+    SELECT indexname FROM pg_indexes
+    WHERE tablename = 'entries' AND starts_with(indexname, prefix)
+  LOOP
+    IF NOT existing.indexname = ANY(wanted) THEN
+      EXECUTE format('DROP INDEX IF EXISTS %I', existing.indexname);
+    END IF;
+  END LOOP;
+
+  FOREACH field IN ARRAY p_fields LOOP
+    EXECUTE format(
+      'CREATE INDEX IF NOT EXISTS %I ON entries USING GIN ((data -> %L) jsonb_path_ops) WHERE content_type_id = %L',
+      prefix || left(md5(field), 8), field, p_content_type_id
+    );
+  END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
@@ -0,0 +1,13 @@
+-- 000010_blog_post_ordering.sql
+-- Pinned posts and the manual sort weight of blog listings
+
+BEGIN;
+
+-- Pinned posts are listed first until pinned_until, if set; sort_weight orders the manual
+-- sort, lower weights first. Listings rank pins against now(), so the order is computed per
+-- query rather than read from an index.
+ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS pinned BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS pinned_until TIMESTAMPTZ;
+ALTER TABLE blog_posts ADD COLUMN IF NOT EXISTS sort_weight INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,39 @@
+-- 000011_webmentions.sql
+-- Received Webmentions of blog posts, verified asynchronously and moderated like comments
+
+BEGIN;
+
+-- webmentions: one row per source and target; post_id is the mentioned blog post document ID.
+-- status moves from 'pending' to 'verified' or 'rejected' once the source has been fetched,
+-- then moderators approve verified mentions or file them as spam.
+CREATE TABLE IF NOT EXISTS webmentions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  post_id TEXT NOT NULL,
+  source TEXT NOT NULL,
+  target TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  author_name TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'verified', 'approved', 'rejected', 'spam')),
+  last_error TEXT NOT NULL DEFAULT '',
+  ip_address TEXT,
+  verified_at TIMESTAMPTZ,
+  moderated_by TEXT,
+  moderated_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (source, target)
+);
+CREATE INDEX IF NOT EXISTS webmentions_post_status_created_idx ON webmentions (post_id, status, created_at);
+CREATE INDEX IF NOT EXISTS webmentions_status_created_idx ON webmentions (status, created_at);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_webmentions'
+  ) THEN
+    CREATE TRIGGER set_updated_at_webmentions BEFORE UPDATE ON webmentions
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+COMMIT;
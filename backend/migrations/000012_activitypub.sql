@@ -0,0 +1,56 @@
+-- 000012_activitypub.sql
+-- ActivityPub federation: actor signing keys, followers and the signed delivery queue
+
+BEGIN;
+
+-- activitypub_keys: one RSA key pair per actor, generated on first use. actor is the
+-- preferred username, e.g. the site actor 'blog' or an author actor.
+CREATE TABLE IF NOT EXISTS activitypub_keys (
+  actor TEXT PRIMARY KEY,
+  public_key_pem TEXT NOT NULL,
+  private_key_pem TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- activitypub_followers: remote actors following one of our actors. shared_inbox_url is
+-- preferred for deliveries so a server hosting many followers receives each activity once.
+CREATE TABLE IF NOT EXISTS activitypub_followers (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  actor TEXT NOT NULL,
+  follower_uri TEXT NOT NULL,
+  inbox_url TEXT NOT NULL,
+  shared_inbox_url TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  UNIQUE (actor, follower_uri)
+);
+CREATE INDEX IF NOT EXISTS activitypub_followers_actor_created_idx ON activitypub_followers (actor, created_at);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_activitypub_followers'
+  ) THEN
+    CREATE TRIGGER set_updated_at_activitypub_followers BEFORE UPDATE ON activitypub_followers
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+-- activitypub_deliveries: one row per activity and remote inbox, signed with the actor's key
+-- at send time. Retries follow the webhook delivery schedule; next_attempt_at is NULL once settled.
+CREATE TABLE IF NOT EXISTS activitypub_deliveries (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  actor TEXT NOT NULL,
+  inbox_url TEXT NOT NULL,
+  activity_id TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+  attempts INTEGER NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  next_attempt_at TIMESTAMPTZ,
+  delivered_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS activitypub_deliveries_due_idx ON activitypub_deliveries (next_attempt_at) WHERE status = 'pending';
+
+COMMIT;
@@ -0,0 +1,49 @@
+-- 000013_newsletter.sql
+-- Newsletter subscribers with double opt-in and a log of the post digests sent to them
+
+BEGIN;
+
+-- newsletter_subscribers: one row per email address (stored lowercased). Subscribers are
+-- 'pending' until they follow the confirmation link and only 'active' subscribers receive
+-- digests. topics holds category slugs; an empty array subscribes to every category.
+-- 'bounced' and 'complained' are set from the delivery webhook and stop all mail.
+CREATE TABLE IF NOT EXISTS newsletter_subscribers (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  email TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'active', 'unsubscribed', 'bounced', 'complained')),
+  topics TEXT[] NOT NULL DEFAULT '{}',
+  soft_bounces INTEGER NOT NULL DEFAULT 0,
+  ip_address TEXT,
+  confirmation_sent_at TIMESTAMPTZ,
+  confirmed_at TIMESTAMPTZ,
+  unsubscribed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS newsletter_subscribers_status_created_idx ON newsletter_subscribers (status, created_at);
+
+-- newsletter_digests: one row per digest run, covering posts published in
+-- [period_start, period_end). The latest period_end is where the next digest starts.
+CREATE TABLE IF NOT EXISTS newsletter_digests (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  period_start TIMESTAMPTZ NOT NULL,
+  period_end TIMESTAMPTZ NOT NULL,
+  post_ids TEXT[] NOT NULL DEFAULT '{}',
+  recipients INTEGER NOT NULL DEFAULT 0,
+  failures INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS newsletter_digests_period_end_idx ON newsletter_digests (period_end DESC);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_newsletter_subscribers'
+  ) THEN
+    CREATE TRIGGER set_updated_at_newsletter_subscribers BEFORE UPDATE ON newsletter_subscribers
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+COMMIT;
@@ -0,0 +1,30 @@
+-- 000014_navigation.sql
+-- Named navigation menus (e.g. "header", "footer") managed from the CMS
+
+BEGIN;
+
+-- navigation_menus: items is a JSON tree of menu items in display order. Each item has a
+-- default label, optional labels per locale, a link (page ID, post ID, category slug,
+-- external URL or none) and its children. Content links are resolved when a menu is read.
+CREATE TABLE IF NOT EXISTS navigation_menus (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  slug TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL,
+  description TEXT,
+  items JSONB NOT NULL DEFAULT '[]',
+  created_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1 FROM pg_trigger WHERE tgname = 'set_updated_at_navigation_menus'
+  ) THEN
+    CREATE TRIGGER set_updated_at_navigation_menus BEFORE UPDATE ON navigation_menus
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+  END IF;
+END$$;
+
+COMMIT;
@@ -0,0 +1,29 @@
+-- 000015_settings.sql
+-- Site-wide settings (name, base URL, social links, ...) edited from the CMS, with an audit trail
+
+BEGIN;
+
+-- site_settings: one row per setting key. value is the JSON encoding of the setting;
+-- keys without a row use the defaults configured on the server.
+CREATE TABLE IF NOT EXISTS site_settings (
+  key TEXT PRIMARY KEY,
+  value JSONB NOT NULL,
+  updated_by TEXT,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- site_setting_changes: one row per changed key and update. old_value is NULL when the
+-- key previously used its default.
+CREATE TABLE IF NOT EXISTS site_setting_changes (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  key TEXT NOT NULL,
+  old_value JSONB,
+  new_value JSONB NOT NULL,
+  changed_by TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_site_setting_changes_created_at ON site_setting_changes(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_site_setting_changes_key ON site_setting_changes(key, created_at DESC);
+
+COMMIT;
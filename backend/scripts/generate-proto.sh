@@ -58,4 +58,28 @@ protoc \
   --grpc-gateway_opt=paths=source_relative \
   proto/contact/v1/contact.proto
 
+# Generate Go code for comment service
+protoc \
+  --proto_path=proto \
+  --proto_path=third_party/googleapis-master \
+  --go_out=gen \
+  --go_opt=paths=source_relative \
+  --go-grpc_out=gen \
+  --go-grpc_opt=paths=source_relative \
+  --grpc-gateway_out=gen \
+  --grpc-gateway_opt=paths=source_relative \
+  proto/comment/v1/comment.proto
+
+# Generate Go code for analytics service
+protoc \
+  --proto_path=proto \
+  --proto_path=third_party/googleapis-master \
+  --go_out=gen \
+  --go_opt=paths=source_relative \
+  --go-grpc_out=gen \
+  --go-grpc_opt=paths=source_relative \
+  --grpc-gateway_out=gen \
+  --grpc-gateway_opt=paths=source_relative \
+  proto/analytics/v1/analytics.proto
+
 echo "Proto generation completed successfully!"
\ No newline at end of file